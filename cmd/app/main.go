@@ -3,13 +3,18 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"net/http"
 	"runtime"
+	"time"
+	// часовые пояса дайджестов: в образе alpine нет zoneinfo
+	_ "time/tzdata"
 
 	"github.com/grafana/pyroscope-go"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/jaeger"
@@ -19,9 +24,15 @@ import (
 
 	"github.com/alnoi/pr-reviewer-service/config"
 	dbpkg "github.com/alnoi/pr-reviewer-service/db"
+	"github.com/alnoi/pr-reviewer-service/internal/forge"
+	grpcv1 "github.com/alnoi/pr-reviewer-service/internal/grpc/v1"
 	v1 "github.com/alnoi/pr-reviewer-service/internal/http/v1"
 	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/notify"
+	"github.com/alnoi/pr-reviewer-service/internal/repository"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/memory"
 	"github.com/alnoi/pr-reviewer-service/internal/repository/postgres"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/sqlite"
 	"github.com/alnoi/pr-reviewer-service/internal/usecase"
 )
 
@@ -54,23 +65,36 @@ func main() {
 
 	// --- App setup ---
 
-	pool, err := pgxpool.New(ctx, cfg.DB.DSN())
-	if err != nil {
-		log.Fatalf("failed to connect to DB: %v", err)
-	}
-	defer pool.Close()
+	store := newStorage(ctx, logg, cfg.DB)
+	defer store.close()
 
-	dbpkg.SetupPostgres(pool, logg)
-
-	teamRepo := postgres.NewTeamRepository(pool)
-	userRepo := postgres.NewUserRepository(pool)
-	prRepo := postgres.NewPRRepository(pool)
+	logg.Info("reviewer selection seed", zap.Int64("seed", cfg.SelectionSeed))
+	var forgeClients []usecase.ForgeClient
+	if cfg.GitHubToken != "" {
+		forgeClients = append(forgeClients, forge.NewGitHubClient(cfg.GitHubAPIURL, cfg.GitHubToken))
+	}
+	var mail usecase.MailSender
+	if cfg.SMTPAddr != "" {
+		mail = notify.NewSMTPSender(cfg.SMTPAddr, cfg.SMTPFrom, cfg.SMTPUsername, cfg.SMTPPassword)
+	}
+	useCase := usecase.NewService(store.teams, store.users, store.prs, store.avail, store.events, store.transactor, cfg.SelectionSeed, cfg.StalePRAfter, notify.NewSlackSender(), mail, forgeClients...)
 
-	transactor := dbpkg.NewTransactor(pool)
+	go runAvailabilityScheduler(ctx, logg, useCase, cfg.AvailabilityCheckInterval)
+	go runFairnessReporter(ctx, logg, useCase, cfg.FairnessRefreshInterval, cfg.FairnessIdleDays)
+	go runStalePRScheduler(ctx, logg, useCase, cfg.StaleCheckInterval)
+	if len(forgeClients) > 0 {
+		go runForgeSyncWorker(ctx, logg, useCase, cfg.ForgeSyncInterval)
+	}
+	if mail != nil {
+		go runDigestScheduler(ctx, logg, useCase, cfg.DigestCheckInterval)
+	}
 
-	useCase := usecase.NewService(teamRepo, userRepo, prRepo, transactor)
+	handler := v1.NewServerHandler(useCase, useCase, useCase, useCase, useCase, useCase, useCase, useCase, v1.WebhookSecrets{
+		GitHub: cfg.GitHubWebhookSecret,
+		GitLab: cfg.GitLabWebhookToken,
+	})
 
-	handler := v1.NewServerHandler(useCase, useCase, useCase, useCase)
+	go runGRPCServer(logg, grpcv1.NewServer(useCase, useCase, useCase, useCase), cfg.GRPCPort)
 
 	r := v1.NewRouter(handler)
 	r.Use(logger.Middleware(logg))
@@ -80,6 +104,227 @@ func main() {
 	}
 }
 
+// --- gRPC ---
+
+func runGRPCServer(l *zap.Logger, srv *grpcv1.Server, port string) {
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		l.Fatal("can not listen for grpc", zap.Error(err))
+	}
+
+	gs := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(l)),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(l)),
+	)
+	srv.Register(gs)
+
+	l.Info("starting grpc server", zap.String("port", port))
+
+	if err := gs.Serve(lis); err != nil {
+		l.Fatal("grpc server error", zap.Error(err))
+	}
+}
+
+// --- Storage ---
+
+type storage struct {
+	teams      repository.TeamRepository
+	users      repository.UserRepository
+	prs        repository.PRRepository
+	avail      repository.AvailabilityRepository
+	events     repository.EventRepository
+	transactor usecase.Transactor
+	close      func()
+}
+
+func newStorage(ctx context.Context, l *zap.Logger, cfg config.DB) storage {
+	l.Info("initializing storage", zap.String("driver", cfg.Driver))
+
+	switch cfg.Driver {
+	case config.DriverPostgres:
+		pool, err := pgxpool.New(ctx, cfg.DSN())
+		if err != nil {
+			log.Fatalf("failed to connect to DB: %v", err)
+		}
+
+		dbpkg.SetupPostgres(pool, l)
+
+		return storage{
+			teams:      postgres.NewTeamRepository(pool),
+			users:      postgres.NewUserRepository(pool),
+			prs:        postgres.NewPRRepository(pool),
+			avail:      postgres.NewAvailabilityRepository(pool),
+			events:     postgres.NewEventRepository(pool),
+			transactor: dbpkg.NewTransactor(pool),
+			close:      pool.Close,
+		}
+	case config.DriverSQLite:
+		db, err := sqlite.Open(cfg.Path)
+		if err != nil {
+			log.Fatalf("failed to open SQLite DB: %v", err)
+		}
+
+		dbpkg.SetupSQLite(db, l)
+
+		return storage{
+			teams:      sqlite.NewTeamRepository(db),
+			users:      sqlite.NewUserRepository(db),
+			prs:        sqlite.NewPRRepository(db),
+			avail:      sqlite.NewAvailabilityRepository(db),
+			events:     sqlite.NewEventRepository(db),
+			transactor: sqlite.NewTransactor(db),
+			close:      func() { _ = db.Close() },
+		}
+	case config.DriverMemory:
+		l.Warn("in-memory storage is not persistent, data will be lost on restart")
+
+		store := memory.NewStore()
+
+		return storage{
+			teams:      memory.NewTeamRepository(store),
+			users:      memory.NewUserRepository(store),
+			prs:        memory.NewPRRepository(store),
+			avail:      memory.NewAvailabilityRepository(store),
+			events:     memory.NewEventRepository(store),
+			transactor: memory.NewTransactor(store),
+			close:      func() {},
+		}
+	default:
+		l.Fatal("unknown DB_DRIVER", zap.String("driver", cfg.Driver))
+		return storage{}
+	}
+}
+
+// --- Availability scheduler ---
+
+func runAvailabilityScheduler(ctx context.Context, l *zap.Logger, uc usecase.AvailabilityUseCase, interval time.Duration) {
+	l.Info("starting availability scheduler", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			applied, err := uc.ApplyStartedAvailability(ctx, now)
+			if err != nil {
+				l.Error("availability scheduler run failed", zap.Error(err))
+				continue
+			}
+			if applied > 0 {
+				l.Info("availability periods applied", zap.Int("count", applied))
+			}
+		}
+	}
+}
+
+// --- Fairness gauges ---
+
+// runFairnessReporter периодически пересчитывает отчёт о равномерности нагрузки,
+// чтобы gauges review_load_* были актуальны без запросов к /stats/fairness.
+func runFairnessReporter(ctx context.Context, l *zap.Logger, uc usecase.StatsUseCase, interval time.Duration, idleDays int) {
+	l.Info("starting fairness reporter", zap.Duration("interval", interval), zap.Int("idle_days", idleDays))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := uc.GetFairness(ctx, "", idleDays, now); err != nil {
+				l.Error("fairness reporter run failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// --- Stale PR scheduler ---
+
+func runStalePRScheduler(ctx context.Context, l *zap.Logger, uc usecase.PRUseCase, interval time.Duration) {
+	l.Info("starting stale PR scheduler", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			res, err := uc.ProcessStalePRs(ctx, now)
+			if err != nil {
+				l.Error("stale PR scheduler run failed", zap.Error(err))
+				continue
+			}
+			if res.Detected > 0 {
+				l.Info("stale PRs detected", zap.Int("count", res.Detected), zap.Int("rotated", res.Rotated))
+			}
+		}
+	}
+}
+
+// --- Forge sync ---
+
+// runForgeSyncWorker периодически отправляет на хостинги изменения ревьюверов из очереди.
+func runForgeSyncWorker(ctx context.Context, l *zap.Logger, uc usecase.IntegrationUseCase, interval time.Duration) {
+	l.Info("starting forge sync worker", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			res, err := uc.ProcessForgeSyncJobs(ctx, now)
+			if err != nil {
+				l.Error("forge sync worker run failed", zap.Error(err))
+				continue
+			}
+			if res.Sent > 0 || res.Retried > 0 || res.Dropped > 0 {
+				l.Info("forge sync jobs processed",
+					zap.Int("sent", res.Sent),
+					zap.Int("retried", res.Retried),
+					zap.Int("dropped", res.Dropped),
+				)
+			}
+		}
+	}
+}
+
+// runDigestScheduler периодически рассылает дайджесты ревью, время которых наступило.
+func runDigestScheduler(ctx context.Context, l *zap.Logger, uc usecase.UserUseCase, interval time.Duration) {
+	l.Info("starting digest scheduler", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			res, err := uc.ProcessDigests(ctx, now)
+			if err != nil {
+				l.Error("digest scheduler run failed", zap.Error(err))
+				continue
+			}
+			if res.Sent > 0 || res.Failed > 0 {
+				l.Info("digests processed",
+					zap.Int("sent", res.Sent),
+					zap.Int("empty", res.Empty),
+					zap.Int("failed", res.Failed),
+				)
+			}
+		}
+	}
+}
+
 // --- Pyroscope ---
 
 func runPyroscope(l *zap.Logger, addr string) {
@@ -4,43 +4,109 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
+	"time"
 )
 
 type Config struct {
 	HTTPPort           string
+	GRPCPort           string
 	DB                 DB
 	MetricsPort        string
 	PyroscopeEnabled   bool
 	PyroscopeAddress   string
 	JaegerCollectorURL string
+
+	AvailabilityCheckInterval time.Duration
+	SelectionSeed             int64
+
+	// FairnessRefreshInterval — как часто пересчитывать gauges равномерности нагрузки.
+	FairnessRefreshInterval time.Duration
+	FairnessIdleDays        int
+
+	// StalePRAfter — порог зависания PR для команд без собственной настройки.
+	StalePRAfter       time.Duration
+	StaleCheckInterval time.Duration
+
+	// Секреты вебхуков git-хостингов; пустой секрет отключает вебхук.
+	GitHubWebhookSecret string
+	GitLabWebhookToken  string
+
+	// Отправка назначенных ревьюверов в GitHub; пустой токен её отключает.
+	GitHubAPIURL      string
+	GitHubToken       string
+	ForgeSyncInterval time.Duration
+
+	// SMTP для дайджестов ревью; пустой адрес отключает дайджесты.
+	SMTPAddr            string
+	SMTPFrom            string
+	SMTPUsername        string
+	SMTPPassword        string
+	DigestCheckInterval time.Duration
 }
 
+// Драйверы хранилища, см. DB_DRIVER.
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+	DriverMemory   = "memory"
+)
+
 type DB struct {
+	Driver   string
 	Host     string
 	Port     string
 	User     string
 	Password string
 	Name     string
+
+	// Path — файл базы для драйвера sqlite.
+	Path string
 }
 
 func Load() *Config {
 	return &Config{
 		HTTPPort:           getEnv("HTTP_PORT", "8080"),
+		GRPCPort:           getEnv("GRPC_PORT", "9090"),
 		DB:                 loadDB(),
 		MetricsPort:        getEnv("METRICS_PORT", "9100"),
 		PyroscopeEnabled:   getEnv("PYROSCOPE_ENABLED", "false") == "true",
 		PyroscopeAddress:   getEnv("PYROSCOPE_SERVER_ADDRESS", "http://pyroscope:4040"),
 		JaegerCollectorURL: getEnv("JAEGER_COLLECTOR_URL", ""),
+
+		AvailabilityCheckInterval: getDurationEnv("AVAILABILITY_CHECK_INTERVAL", time.Minute),
+		SelectionSeed:             getInt64Env("SELECTION_SEED", time.Now().UnixNano()),
+
+		FairnessRefreshInterval: getDurationEnv("FAIRNESS_REFRESH_INTERVAL", time.Minute),
+		FairnessIdleDays:        int(getInt64Env("FAIRNESS_IDLE_DAYS", 14)),
+
+		StalePRAfter:       getDurationEnv("STALE_PR_AFTER", 72*time.Hour),
+		StaleCheckInterval: getDurationEnv("STALE_CHECK_INTERVAL", 10*time.Minute),
+
+		GitHubWebhookSecret: getEnv("GITHUB_WEBHOOK_SECRET", ""),
+		GitLabWebhookToken:  getEnv("GITLAB_WEBHOOK_TOKEN", ""),
+
+		GitHubAPIURL:      getEnv("GITHUB_API_URL", "https://api.github.com"),
+		GitHubToken:       getEnv("GITHUB_TOKEN", ""),
+		ForgeSyncInterval: getDurationEnv("FORGE_SYNC_INTERVAL", 30*time.Second),
+
+		SMTPAddr:            getEnv("SMTP_ADDR", ""),
+		SMTPFrom:            getEnv("SMTP_FROM", "pr-reviewer@localhost"),
+		SMTPUsername:        getEnv("SMTP_USERNAME", ""),
+		SMTPPassword:        getEnv("SMTP_PASSWORD", ""),
+		DigestCheckInterval: getDurationEnv("DIGEST_CHECK_INTERVAL", 5*time.Minute),
 	}
 }
 
 func loadDB() DB {
 	return DB{
+		Driver:   getEnv("DB_DRIVER", DriverPostgres),
 		Host:     getEnv("DB_HOST", "localhost"),
 		Port:     getEnv("DB_PORT", "5432"),
 		User:     getEnv("DB_USER", "postgres"),
 		Password: getEnv("DB_PASSWORD", "postgres"),
 		Name:     getEnv("DB_NAME", "pr_review"),
+		Path:     getEnv("DB_PATH", "pr_review.db"),
 	}
 }
 
@@ -59,3 +125,27 @@ func getEnv(key, def string) string {
 	}
 	return v
 }
+
+func getDurationEnv(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
+
+func getInt64Env(key string, def int64) int64 {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return def
+	}
+	return n
+}
@@ -13,6 +13,9 @@ import (
 //go:embed migrations/*.sql
 var embedMigrations embed.FS
 
+//go:embed migrations_sqlite/*.sql
+var embedSQLiteMigrations embed.FS
+
 func SetupPostgres(pool *pgxpool.Pool, logger *zap.Logger) {
 	goose.SetBaseFS(embedMigrations)
 
@@ -28,3 +31,17 @@ func SetupPostgres(pool *pgxpool.Pool, logger *zap.Logger) {
 
 	logger.Info("migrations applied successfully")
 }
+
+func SetupSQLite(db *sql.DB, logger *zap.Logger) {
+	goose.SetBaseFS(embedSQLiteMigrations)
+
+	if err := goose.SetDialect("sqlite3"); err != nil {
+		logger.Fatal("cannot set goose dialect", zap.Error(err))
+	}
+
+	if err := goose.Up(db, "migrations_sqlite"); err != nil {
+		logger.Fatal("migration failed", zap.Error(err))
+	}
+
+	logger.Info("migrations applied successfully")
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS user_availability (
+                                                 id BIGSERIAL PRIMARY KEY,
+                                                 user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                                 starts_at TIMESTAMPTZ NOT NULL,
+                                                 ends_at TIMESTAMPTZ NOT NULL,
+                                                 reason TEXT NOT NULL DEFAULT '',
+                                                 applied_at TIMESTAMPTZ,
+                                                 created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                                 CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_availability_user_period
+    ON user_availability(user_id, starts_at, ends_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_user_availability_user_period;
+DROP TABLE IF EXISTS user_availability;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMPTZ;
+
+UPDATE pr_reviewers r
+SET assigned_at = pr.created_at
+FROM pull_requests pr
+WHERE pr.id = r.pr_id;
+
+ALTER TABLE pr_reviewers ALTER COLUMN assigned_at SET DEFAULT now();
+ALTER TABLE pr_reviewers ALTER COLUMN assigned_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_reviewer_assigned
+    ON pr_reviewers(reviewer_id, assigned_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer_assigned;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS assigned_at;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS team_settings (
+                                             team_name TEXT PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+                                             stale_after_seconds BIGINT NOT NULL DEFAULT 0 CHECK (stale_after_seconds >= 0),
+                                             stale_auto_rotate BOOLEAN NOT NULL DEFAULT FALSE,
+                                             updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS stale_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS stale_at;
+DROP TABLE IF EXISTS team_settings;
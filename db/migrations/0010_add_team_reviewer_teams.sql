@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS reviewer_teams TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE team_settings DROP COLUMN IF EXISTS reviewer_teams;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS team_code_owners (
+    team_name TEXT    NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    position  INTEGER NOT NULL,
+    pattern   TEXT    NOT NULL,
+    owners    TEXT[]  NOT NULL,
+    PRIMARY KEY (team_name, position)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS team_code_owners;
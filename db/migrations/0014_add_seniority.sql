@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN IF NOT EXISTS seniority TEXT NOT NULL DEFAULT '';
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS min_senior_reviewers INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE team_settings DROP COLUMN IF EXISTS min_senior_reviewers;
+ALTER TABLE users DROP COLUMN IF EXISTS seniority;
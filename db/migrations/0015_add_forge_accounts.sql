@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS forge_accounts (
+    forge   TEXT NOT NULL,
+    login   TEXT NOT NULL,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (forge, login)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS forge_accounts;
@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE team_settings ADD COLUMN IF NOT EXISTS forge_sync BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- очередь отправки ревьюверов на git-хостинг; строка удаляется после успешной отправки
+CREATE TABLE IF NOT EXISTS forge_sync_jobs (
+    id               BIGSERIAL PRIMARY KEY,
+    pr_id            TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    add_reviewers    TEXT[] NOT NULL DEFAULT '{}',
+    remove_reviewers TEXT[] NOT NULL DEFAULT '{}',
+    attempts         INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMPTZ NOT NULL,
+    last_error       TEXT NOT NULL DEFAULT '',
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_forge_sync_jobs_next_attempt
+    ON forge_sync_jobs(next_attempt_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_forge_sync_jobs_next_attempt;
+DROP TABLE IF EXISTS forge_sync_jobs;
+ALTER TABLE team_settings DROP COLUMN IF EXISTS forge_sync;
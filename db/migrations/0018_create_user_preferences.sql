@@ -0,0 +1,14 @@
+-- +goose Up
+-- настройки дайджеста ревью; digest_weekday — день недели, 0 — воскресенье
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id        TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email          TEXT NOT NULL DEFAULT '',
+    digest         TEXT NOT NULL DEFAULT 'off',
+    digest_hour    INTEGER NOT NULL DEFAULT 9,
+    digest_weekday INTEGER NOT NULL DEFAULT 1,
+    timezone       TEXT NOT NULL DEFAULT 'UTC',
+    last_digest_at TIMESTAMPTZ
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_preferences;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- лента событий для /events/stream; id — Last-Event-ID для переподключения.
+-- user_ids — все затронутые пользователи, по ним фильтрует лента
+CREATE TABLE IF NOT EXISTS events (
+    id                   BIGSERIAL PRIMARY KEY,
+    type                 TEXT NOT NULL,
+    team_name            TEXT NOT NULL DEFAULT '',
+    pr_id                TEXT NOT NULL DEFAULT '',
+    pr_name              TEXT NOT NULL DEFAULT '',
+    author_id            TEXT NOT NULL DEFAULT '',
+    reviewers            TEXT[] NOT NULL DEFAULT '{}',
+    old_reviewer_id      TEXT NOT NULL DEFAULT '',
+    new_reviewer_id      TEXT NOT NULL DEFAULT '',
+    deactivated_user_ids TEXT[] NOT NULL DEFAULT '{}',
+    user_ids             TEXT[] NOT NULL DEFAULT '{}',
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_team_name ON events(team_name, id);
+CREATE INDEX IF NOT EXISTS idx_events_user_ids ON events USING GIN (user_ids);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_events_user_ids;
+DROP INDEX IF EXISTS idx_events_team_name;
+DROP TABLE IF EXISTS events;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS teams (
+    team_name  TEXT PRIMARY KEY,
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+);
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS trigger_update_teams_timestamp
+    AFTER UPDATE ON teams
+    FOR EACH ROW
+BEGIN
+    UPDATE teams SET updated_at = strftime('%Y-%m-%dT%H:%M:%fZ', 'now') WHERE team_name = NEW.team_name;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_update_teams_timestamp;
+DROP TABLE IF EXISTS teams;
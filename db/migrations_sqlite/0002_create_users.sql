@@ -0,0 +1,23 @@
+-- +goose Up
+-- team_name допускает NULL: так хранятся пользователи, выведенные из команды
+CREATE TABLE IF NOT EXISTS users (
+    id         TEXT PRIMARY KEY,
+    team_name  TEXT REFERENCES teams(team_name) ON DELETE CASCADE,
+    username   TEXT NOT NULL,
+    is_active  INTEGER NOT NULL DEFAULT 1,
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+);
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS trigger_update_users_timestamp
+    AFTER UPDATE ON users
+    FOR EACH ROW
+BEGIN
+    UPDATE users SET updated_at = strftime('%Y-%m-%dT%H:%M:%fZ', 'now') WHERE id = NEW.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_update_users_timestamp;
+DROP TABLE IF EXISTS users;
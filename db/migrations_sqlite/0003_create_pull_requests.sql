@@ -0,0 +1,25 @@
+-- +goose Up
+-- created_at и merged_at пишутся приложением в UTC с фиксированной точностью,
+-- чтобы строки сравнивались так же, как моменты времени
+CREATE TABLE IF NOT EXISTS pull_requests (
+    id                TEXT PRIMARY KEY,
+    pull_request_name TEXT NOT NULL,
+    author_id         TEXT NOT NULL REFERENCES users(id),
+    status            TEXT NOT NULL CHECK (status IN ('OPEN', 'MERGED')),
+    created_at        TEXT NOT NULL,
+    updated_at        TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    merged_at         TEXT
+);
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS trigger_update_pull_requests_timestamp
+    AFTER UPDATE ON pull_requests
+    FOR EACH ROW
+BEGIN
+    UPDATE pull_requests SET updated_at = strftime('%Y-%m-%dT%H:%M:%fZ', 'now') WHERE id = NEW.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trigger_update_pull_requests_timestamp;
+DROP TABLE IF EXISTS pull_requests;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- assigned_at пишется приложением; для старых назначений берётся время создания PR
+ALTER TABLE pr_reviewers ADD COLUMN assigned_at TEXT;
+
+UPDATE pr_reviewers
+SET assigned_at = (SELECT created_at FROM pull_requests WHERE id = pr_reviewers.pr_id);
+
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_reviewer_assigned
+    ON pr_reviewers(reviewer_id, assigned_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer_assigned;
+ALTER TABLE pr_reviewers DROP COLUMN assigned_at;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS team_settings (
+    team_name           TEXT PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    stale_after_seconds INTEGER NOT NULL DEFAULT 0 CHECK (stale_after_seconds >= 0),
+    stale_auto_rotate   INTEGER NOT NULL DEFAULT 0,
+    updated_at          TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+);
+
+ALTER TABLE pull_requests ADD COLUMN stale_at TEXT;
+
+-- +goose Down
+ALTER TABLE pull_requests DROP COLUMN stale_at;
+DROP TABLE IF EXISTS team_settings;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- JSON-массив имён команд
+ALTER TABLE team_settings ADD COLUMN reviewer_teams TEXT NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE team_settings DROP COLUMN reviewer_teams;
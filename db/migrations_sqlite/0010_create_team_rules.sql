@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS team_rules (
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    team_name     TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    rule_type     TEXT NOT NULL CHECK (rule_type IN ('conflict', 'pair')),
+    user_id       TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    other_user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at    TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    UNIQUE (team_name, rule_type, user_id, other_user_id),
+    CHECK (user_id <> other_user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS team_rules;
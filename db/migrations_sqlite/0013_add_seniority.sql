@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN seniority TEXT NOT NULL DEFAULT '';
+ALTER TABLE team_settings ADD COLUMN min_senior_reviewers INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE team_settings DROP COLUMN min_senior_reviewers;
+ALTER TABLE users DROP COLUMN seniority;
@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE team_settings ADD COLUMN forge_sync INTEGER NOT NULL DEFAULT 0;
+
+-- очередь отправки ревьюверов на git-хостинг; строка удаляется после успешной отправки.
+-- add_reviewers и remove_reviewers — JSON-массивы id пользователей
+CREATE TABLE IF NOT EXISTS forge_sync_jobs (
+    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id            TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    add_reviewers    TEXT NOT NULL DEFAULT '[]',
+    remove_reviewers TEXT NOT NULL DEFAULT '[]',
+    attempts         INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at  TEXT NOT NULL,
+    last_error       TEXT NOT NULL DEFAULT '',
+    created_at       TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_forge_sync_jobs_next_attempt
+    ON forge_sync_jobs(next_attempt_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_forge_sync_jobs_next_attempt;
+DROP TABLE IF EXISTS forge_sync_jobs;
+ALTER TABLE team_settings DROP COLUMN forge_sync;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- куда команда получает уведомления о ревью; templates — JSON-объект шаблонов сообщений по событиям
+CREATE TABLE IF NOT EXISTS team_notifications (
+    team_name   TEXT PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    webhook_url TEXT NOT NULL DEFAULT '',
+    channel     TEXT NOT NULL DEFAULT '',
+    templates   TEXT NOT NULL DEFAULT '{}',
+    updated_at  TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+);
+
+-- пользователи, отказавшиеся от уведомлений в чат
+CREATE TABLE IF NOT EXISTS notification_opt_outs (
+    user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_opt_outs;
+DROP TABLE IF EXISTS team_notifications;
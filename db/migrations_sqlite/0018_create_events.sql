@@ -0,0 +1,24 @@
+-- +goose Up
+-- лента событий для /events/stream; id — Last-Event-ID для переподключения.
+-- reviewers, deactivated_user_ids и user_ids — JSON-массивы id пользователей;
+-- user_ids — все затронутые пользователи, по ним фильтрует лента
+CREATE TABLE IF NOT EXISTS events (
+    id                   INTEGER PRIMARY KEY AUTOINCREMENT,
+    type                 TEXT NOT NULL,
+    team_name            TEXT NOT NULL DEFAULT '',
+    pr_id                TEXT NOT NULL DEFAULT '',
+    pr_name              TEXT NOT NULL DEFAULT '',
+    author_id            TEXT NOT NULL DEFAULT '',
+    reviewers            TEXT NOT NULL DEFAULT '[]',
+    old_reviewer_id      TEXT NOT NULL DEFAULT '',
+    new_reviewer_id      TEXT NOT NULL DEFAULT '',
+    deactivated_user_ids TEXT NOT NULL DEFAULT '[]',
+    user_ids             TEXT NOT NULL DEFAULT '[]',
+    created_at           TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_team_name ON events(team_name, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_events_team_name;
+DROP TABLE IF EXISTS events;
@@ -0,0 +1,25 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Querier — общий набор методов pgxpool.Pool и pgx.Tx, которым пользуются репозитории.
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+}
+
+// QuerierFromContext возвращает транзакцию, открытую через WithTx, либо пул, если транзакции в контексте нет.
+func QuerierFromContext(ctx context.Context, pool *pgxpool.Pool) Querier {
+	if tx, err := extractTx(ctx); err == nil {
+		return tx
+	}
+	return pool
+}
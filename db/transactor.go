@@ -22,6 +22,11 @@ func NewTransactor(db *pgxpool.Pool) *transactorImpl {
 	}
 }
 func (t *transactorImpl) WithTx(ctx context.Context, function func(ctx context.Context) error) (txErr error) {
+	// вложенный вызов выполняется в уже открытой транзакции, фиксирует её внешний WithTx
+	if _, err := extractTx(ctx); err == nil {
+		return function(ctx)
+	}
+
 	ctxWithTx, tx, err := injectTx(ctx, t.db)
 
 	if err != nil {
@@ -30,11 +35,13 @@ func (t *transactorImpl) WithTx(ctx context.Context, function func(ctx context.C
 
 	defer func() {
 		if txErr != nil {
-			err = tx.Rollback(ctxWithTx)
+			_ = tx.Rollback(ctxWithTx)
 			return
 		}
 
-		err = tx.Commit(ctxWithTx)
+		if err := tx.Commit(ctxWithTx); err != nil {
+			txErr = fmt.Errorf("can not commit transaction, error: %w", err)
+		}
 	}()
 
 	err = function(ctxWithTx)
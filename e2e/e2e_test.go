@@ -6,36 +6,169 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/testcontainers/testcontainers-go"
+	"io"
+	"net"
 	"net/http"
-	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go/wait"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/alnoi/pr-reviewer-service/config"
 	dbpkg "github.com/alnoi/pr-reviewer-service/db"
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/forge"
+	"github.com/alnoi/pr-reviewer-service/internal/forge/forgetest"
+	grpcv1 "github.com/alnoi/pr-reviewer-service/internal/grpc/v1"
 	v1 "github.com/alnoi/pr-reviewer-service/internal/http/v1"
 	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/notify"
+	"github.com/alnoi/pr-reviewer-service/internal/notify/notifytest"
 	"github.com/alnoi/pr-reviewer-service/internal/repository/postgres"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/sqlite"
 	"github.com/alnoi/pr-reviewer-service/internal/usecase"
+	reviewerv1 "github.com/alnoi/pr-reviewer-service/pkg/api/reviewer/v1"
+	"github.com/alnoi/pr-reviewer-service/pkg/client"
 
 	"net/http/httptest"
 )
 
 var (
-	dbPool      *pgxpool.Pool
-	httpServer  *httptest.Server
-	pgContainer testcontainers.Container
+	httpServer *httptest.Server
+	// api — клиент из pkg/client, через который тесты ходят в HTTP API.
+	api *client.API
+	// grpcConn — соединение с gRPC-сервером поверх того же сервиса, в памяти через bufconn.
+	grpcConn *grpc.ClientConn
+
+	// resetDB очищает хранилище перед тестом, зависит от выбранного драйвера.
+	resetDB func(t *testing.T)
+
+	// githubAPI — поддельный GitHub, куда сервис отправляет ревьюверов.
+	githubAPI *forgetest.GitHubServer
+	// slackAPI — заглушка входящего вебхука чата для уведомлений.
+	slackAPI *notifytest.SlackServer
+	// smtpAPI — заглушка SMTP-сервера для дайджестов.
+	smtpAPI *notifytest.SMTPServer
+	// app — сервис под тестом; нужен, чтобы прогнать очередь отправки без фонового воркера.
+	app service
+)
+
+// Секреты вебхуков, с которыми поднимается тестовый сервер.
+const (
+	githubWebhookSecret = "github-e2e-secret"
+	gitlabWebhookToken  = "gitlab-e2e-token"
 )
 
+// digestFrom — адрес отправителя дайджестов в тестах.
+const digestFrom = "reviews@example.com"
+
+// TestMain поднимает сервис поверх Postgres в testcontainers.
+// С E2E_DB_DRIVER=sqlite тот же набор тестов идёт на SQLite во временном файле, без контейнеров.
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
+	logg := logger.New()
+	defer logg.Sync()
+	zap.ReplaceGlobals(logg)
+
+	githubAPI = forgetest.NewGitHubServer()
+	slackAPI = notifytest.NewSlackServer()
+	smtpAPI = notifytest.NewSMTPServer()
+	github := forge.NewGitHubClient(githubAPI.URL, "github-e2e-token")
+
+	var (
+		svc     service
+		cleanup func()
+	)
+
+	switch os.Getenv("E2E_DB_DRIVER") {
+	case config.DriverSQLite:
+		svc, cleanup = setupSQLite(logg, github)
+	default:
+		svc, cleanup = setupPostgres(ctx, logg, github)
+	}
+	app = svc
+
+	handler := v1.NewServerHandler(svc, svc, svc, svc, svc, svc, svc, svc, v1.WebhookSecrets{
+		GitHub: githubWebhookSecret,
+		GitLab: gitlabWebhookToken,
+	})
+	e := v1.NewRouter(handler)
+	e.Use(logger.Middleware(logg))
+
+	httpServer = httptest.NewServer(e)
+
+	var err error
+	api, err = client.New(httpServer.URL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create api client: %v\n", err)
+		os.Exit(1)
+	}
+
+	grpcLis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(logg)),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(logg)),
+	)
+	grpcv1.NewServer(svc, svc, svc, svc).Register(gs)
+	go func() { _ = gs.Serve(grpcLis) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return grpcLis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create grpc client: %v\n", err)
+		os.Exit(1)
+	}
+	grpcConn = conn
+
+	code := m.Run()
+
+	_ = grpcConn.Close()
+	gs.Stop()
+	httpServer.Close()
+	githubAPI.Close()
+	slackAPI.Close()
+	smtpAPI.Close()
+	cleanup()
+
+	os.Exit(code)
+}
+
+// service — все usecase-интерфейсы, которые нужны ServerHandler.
+type service interface {
+	usecase.TeamUseCase
+	usecase.UserUseCase
+	usecase.PRUseCase
+	usecase.StatsUseCase
+	usecase.AvailabilityUseCase
+	usecase.ImportUseCase
+	usecase.IntegrationUseCase
+	usecase.EventUseCase
+}
+
+func setupPostgres(ctx context.Context, logg *zap.Logger, forgeClients ...usecase.ForgeClient) (service, func()) {
 	req := testcontainers.ContainerRequest{
 		Image:        "postgres:16-alpine",
 		Env:          map[string]string{"POSTGRES_DB": "prreviewer", "POSTGRES_USER": "test", "POSTGRES_PASSWORD": "test"},
@@ -51,7 +184,6 @@ func TestMain(m *testing.M) {
 		fmt.Fprintf(os.Stderr, "failed to start postgres container: %v\n", err)
 		os.Exit(1)
 	}
-	pgContainer = container
 
 	host, err := container.Host(ctx)
 	if err != nil {
@@ -68,147 +200,183 @@ func TestMain(m *testing.M) {
 	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
 		"test", "test", host, port.Port(), "prreviewer")
 
-	dbPool, err = pgxpool.New(ctx, dsn)
+	dbPool, err := pgxpool.New(ctx, dsn)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to create pgx pool: %v\n", err)
 		os.Exit(1)
 	}
 
-	logg := logger.New()
-	defer logg.Sync()
-	zap.ReplaceGlobals(logg)
-
 	dbpkg.SetupPostgres(dbPool, logg)
 
-	teamRepo := postgres.NewTeamRepository(dbPool)
-	userRepo := postgres.NewUserRepository(dbPool)
-	prRepo := postgres.NewPRRepository(dbPool)
-	transactor := dbpkg.NewTransactor(dbPool)
+	resetDB = func(t *testing.T) {
+		t.Helper()
+		_, err := dbPool.Exec(context.Background(),
+			`TRUNCATE TABLE events, user_preferences, notification_opt_outs, team_notifications, forge_sync_jobs, forge_accounts, user_tags, team_code_owners, team_rules, team_settings, user_availability, pr_reviewers, pull_requests, users, teams RESTART IDENTITY CASCADE`)
+		require.NoError(t, err)
+	}
 
-	svc := usecase.NewService(teamRepo, userRepo, prRepo, transactor)
+	svc := usecase.NewService(
+		postgres.NewTeamRepository(dbPool),
+		postgres.NewUserRepository(dbPool),
+		postgres.NewPRRepository(dbPool),
+		postgres.NewAvailabilityRepository(dbPool),
+		postgres.NewEventRepository(dbPool),
+		dbpkg.NewTransactor(dbPool),
+		1,
+		72*time.Hour,
+		notify.NewSlackSender(),
+		notify.NewSMTPSender(smtpAPI.Addr, digestFrom, "", ""),
+		forgeClients...,
+	)
 
-	handler := v1.NewServerHandler(svc, svc, svc, svc)
-	e := v1.NewRouter(handler)
-	e.Use(logger.Middleware(logg))
+	return svc, func() {
+		dbPool.Close()
+		_ = container.Terminate(ctx)
+	}
+}
 
-	httpServer = httptest.NewServer(e)
+func setupSQLite(logg *zap.Logger, forgeClients ...usecase.ForgeClient) (service, func()) {
+	dir, err := os.MkdirTemp("", "pr-reviewer-e2e")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create temp dir: %v\n", err)
+		os.Exit(1)
+	}
 
-	code := m.Run()
+	db, err := sqlite.Open(filepath.Join(dir, "e2e.db"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to open sqlite db: %v\n", err)
+		os.Exit(1)
+	}
 
-	httpServer.Close()
-	dbPool.Close()
-	_ = pgContainer.Terminate(ctx)
+	dbpkg.SetupSQLite(db, logg)
 
-	os.Exit(code)
+	resetDB = func(t *testing.T) {
+		t.Helper()
+		for _, table := range []string{"events", "user_preferences", "notification_opt_outs", "team_notifications", "forge_sync_jobs", "forge_accounts", "user_tags", "team_code_owners", "team_rules", "team_settings", "user_availability", "pr_reviewers", "pull_requests", "users", "teams", "sqlite_sequence"} {
+			_, err := db.Exec(`DELETE FROM ` + table)
+			require.NoError(t, err)
+		}
+	}
+
+	svc := usecase.NewService(
+		sqlite.NewTeamRepository(db),
+		sqlite.NewUserRepository(db),
+		sqlite.NewPRRepository(db),
+		sqlite.NewAvailabilityRepository(db),
+		sqlite.NewEventRepository(db),
+		sqlite.NewTransactor(db),
+		1,
+		72*time.Hour,
+		notify.NewSlackSender(),
+		notify.NewSMTPSender(smtpAPI.Addr, digestFrom, "", ""),
+		forgeClients...,
+	)
+
+	return svc, func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}
 }
 
 func truncateAll(t *testing.T) {
 	t.Helper()
-	_, err := dbPool.Exec(context.Background(),
-		`TRUNCATE TABLE pr_reviewers, pull_requests, users, teams RESTART IDENTITY CASCADE`)
-	require.NoError(t, err)
+	resetDB(t)
+}
+
+// requireAPIError проверяет, что сервис отклонил запрос клиента со статусом status и ошибкой target.
+func requireAPIError(t *testing.T, err error, status int, target error) {
+	t.Helper()
+
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, status, apiErr.StatusCode)
+	require.ErrorIs(t, err, target)
 }
 
 func TestTeamAddAndGet_E2E(t *testing.T) {
 	truncateAll(t)
+	ctx := context.Background()
 
-	teamReq := v1.Team{
+	teamReq := client.Team{
 		TeamName: "backend",
-		Members: []v1.TeamMember{
+		Members: []client.TeamMember{
 			{UserId: "u1", Username: "Alice", IsActive: true},
 			{UserId: "u2", Username: "Bob", IsActive: true},
 			{UserId: "u3", Username: "Charlie", IsActive: true},
 		},
 	}
 
-	var buf bytes.Buffer
-	require.NoError(t, json.NewEncoder(&buf).Encode(teamReq))
-
-	resp, err := http.Post(httpServer.URL+"/team/add", "application/json", &buf)
+	resp, err := api.PostTeamAddWithResponse(ctx, teamReq)
 	require.NoError(t, err)
-	defer resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
 
-	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	created := resp.JSON201.Team
+	require.Equal(t, teamReq.TeamName, created.TeamName)
+	require.Len(t, created.Members, len(teamReq.Members))
 
-	var created struct {
-		Team v1.Team `json:"team"`
-	}
-	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
-	require.Equal(t, teamReq.TeamName, created.Team.TeamName)
-	require.Len(t, created.Team.Members, len(teamReq.Members))
-
-	getURL := httpServer.URL + "/team/get?team_name=" + url.QueryEscape(teamReq.TeamName)
-	resp2, err := http.Get(getURL)
+	resp2, err := api.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: teamReq.TeamName})
 	require.NoError(t, err)
-	defer resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode())
 
-	require.Equal(t, http.StatusOK, resp2.StatusCode)
-
-	var got v1.Team
-	require.NoError(t, json.NewDecoder(resp2.Body).Decode(&got))
-	require.Equal(t, created.Team.TeamName, got.TeamName)
+	got := resp2.JSON200
+	require.Equal(t, created.TeamName, got.TeamName)
 	require.Len(t, got.Members, len(teamReq.Members))
+
+	_, err = api.PostTeamAddWithResponse(ctx, teamReq)
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrTeamExists)
+
+	_, err = api.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: "missing"})
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
 }
 
 func TestCreatePRAndStats_E2E(t *testing.T) {
 	truncateAll(t)
+	ctx := context.Background()
 
-	teamReq := v1.Team{
+	teamReq := client.Team{
 		TeamName: "backend",
-		Members: []v1.TeamMember{
+		Members: []client.TeamMember{
 			{UserId: "u1", Username: "Alice", IsActive: true},
 			{UserId: "u2", Username: "Bob", IsActive: true},
 			{UserId: "u3", Username: "Charlie", IsActive: true},
 		},
 	}
 
-	var buf bytes.Buffer
-	require.NoError(t, json.NewEncoder(&buf).Encode(teamReq))
-
-	resp, err := http.Post(httpServer.URL+"/team/add", "application/json", &buf)
+	resp, err := api.PostTeamAddWithResponse(ctx, teamReq)
 	require.NoError(t, err)
-	defer resp.Body.Close()
-	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
 
-	prReq := v1.PostPullRequestCreateJSONBody{
+	prReq := client.PostPullRequestCreateJSONRequestBody{
 		AuthorId:        "u1",
 		PullRequestId:   "pr-1",
 		PullRequestName: "Test PR",
 	}
 
-	buf.Reset()
-	require.NoError(t, json.NewEncoder(&buf).Encode(prReq))
-
-	respPR, err := http.Post(httpServer.URL+"/pullRequest/create", "application/json", &buf)
+	respPR, err := api.PostPullRequestCreateWithResponse(ctx, prReq)
 	require.NoError(t, err)
-	defer respPR.Body.Close()
-	require.Equal(t, http.StatusCreated, respPR.StatusCode)
+	require.Equal(t, http.StatusCreated, respPR.StatusCode())
 
-	var createdPR struct {
-		Pr v1.PullRequest `json:"pr"`
-	}
-	require.NoError(t, json.NewDecoder(respPR.Body).Decode(&createdPR))
-	prResp := createdPR.Pr
+	prResp := respPR.JSON201.Pr
 	require.Equal(t, prReq.PullRequestId, prResp.PullRequestId)
 	require.Equal(t, prReq.PullRequestName, prResp.PullRequestName)
 	require.Equal(t, prReq.AuthorId, prResp.AuthorId)
 	require.Len(t, prResp.AssignedReviewers, 2)
 
-	respStats, err := http.Get(httpServer.URL + "/stats")
-	require.NoError(t, err)
-	defer respStats.Body.Close()
-	require.Equal(t, http.StatusOK, respStats.StatusCode)
+	_, err = api.PostPullRequestCreateWithResponse(ctx, prReq)
+	requireAPIError(t, err, http.StatusConflict, client.ErrPRExists)
 
-	var stats v1.Stats
-	require.NoError(t, json.NewDecoder(respStats.Body).Decode(&stats))
+	respStats, err := api.GetStatsWithResponse(ctx, &client.GetStatsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respStats.StatusCode())
 
+	stats := respStats.JSON200
 	require.Equal(t, int32(1), stats.PrStatusCounts.Total)
 	require.Equal(t, int32(1), stats.PrStatusCounts.Open)
 	require.Equal(t, int32(0), stats.PrStatusCounts.Merged)
 
 	require.Len(t, stats.AssignmentsByUser, 3)
 
-	var authorStat *v1.UserAssignmentsStat
+	var authorStat *client.UserAssignmentsStat
 	var totalAssignments int32
 	for i := range stats.AssignmentsByUser {
 		u := stats.AssignmentsByUser[i]
@@ -221,56 +389,1147 @@ func TestCreatePRAndStats_E2E(t *testing.T) {
 	require.NotNil(t, authorStat)
 	require.Equal(t, int32(0), authorStat.ReviewAssignmentsCount)
 	require.Equal(t, int32(2), totalAssignments)
+
+	teamName, granularity := "backend", client.Day
+	respTeam, err := api.GetStatsWithResponse(ctx, &client.GetStatsParams{TeamName: &teamName, Granularity: &granularity})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respTeam.StatusCode())
+
+	teamStats := respTeam.JSON200
+	require.NotNil(t, teamStats.ByTeam)
+	require.Equal(t, []client.TeamStats{{
+		TeamName:       "backend",
+		PrStatusCounts: client.PRStatusCounts{Open: 1, Merged: 0, Total: 1},
+	}}, *teamStats.ByTeam)
+	require.NotNil(t, teamStats.TimeSeries)
+	require.Len(t, *teamStats.TimeSeries, 1)
+	require.Equal(t, int32(1), (*teamStats.TimeSeries)[0].Created)
+
+	missing := "missing"
+	_, err = api.GetStatsWithResponse(ctx, &client.GetStatsParams{TeamName: &missing})
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
 }
 
 func TestDeactivateMembers_NoCandidate_E2E(t *testing.T) {
 	truncateAll(t)
+	ctx := context.Background()
 
-	teamReq := v1.Team{
+	teamReq := client.Team{
 		TeamName: "backend",
-		Members: []v1.TeamMember{
+		Members: []client.TeamMember{
 			{UserId: "u1", Username: "Author", IsActive: true},
 			{UserId: "u2", Username: "OnlyReviewer", IsActive: true},
 		},
 	}
 
-	var buf bytes.Buffer
-	require.NoError(t, json.NewEncoder(&buf).Encode(teamReq))
-
-	resp, err := http.Post(httpServer.URL+"/team/add", "application/json", &buf)
+	resp, err := api.PostTeamAddWithResponse(ctx, teamReq)
 	require.NoError(t, err)
-	defer resp.Body.Close()
-	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
 
-	prReq := v1.PostPullRequestCreateJSONBody{
+	_, err = api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{
 		AuthorId:        "u1",
 		PullRequestId:   "pr-2",
 		PullRequestName: "PR for NO_CANDIDATE",
+	})
+	require.NoError(t, err)
+
+	_, err = api.PostTeamDeactivateMembersWithResponse(ctx, client.PostTeamDeactivateMembersJSONRequestBody{
+		TeamName: "backend",
+		UserIds:  []string{"u2"},
+	})
+	requireAPIError(t, err, http.StatusConflict, client.ErrNoCandidate)
+}
+
+func TestTeamMembersAddAndRemove_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	teamReq := client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Author", IsActive: true},
+			{UserId: "u2", Username: "Reviewer", IsActive: true},
+		},
 	}
 
-	buf.Reset()
-	require.NoError(t, json.NewEncoder(&buf).Encode(prReq))
+	resp, err := api.PostTeamAddWithResponse(ctx, teamReq)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+
+	respPR, err := api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{
+		AuthorId:        "u1",
+		PullRequestId:   "pr-3",
+		PullRequestName: "PR before new hire",
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, respPR.StatusCode())
 
-	respPR, err := http.Post(httpServer.URL+"/pullRequest/create", "application/json", &buf)
+	respAdd, err := api.PostTeamMembersAddWithResponse(ctx, client.PostTeamMembersAddJSONRequestBody{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u3", Username: "NewHire", IsActive: true},
+		},
+	})
 	require.NoError(t, err)
-	defer respPR.Body.Close()
-	require.True(t, respPR.StatusCode >= 200 && respPR.StatusCode < 300)
+	require.Equal(t, http.StatusOK, respAdd.StatusCode())
+	require.Len(t, respAdd.JSON200.Team.Members, 3)
 
-	deactivateReq := v1.PostTeamDeactivateMembersJSONBody{
+	respRemove, err := api.PostTeamMembersRemoveWithResponse(ctx, client.PostTeamMembersRemoveJSONRequestBody{
 		TeamName: "backend",
 		UserIds:  []string{"u2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respRemove.StatusCode())
+	require.Len(t, respRemove.JSON200.Team.Members, 2)
+
+	respReview, err := api.GetUsersGetReviewWithResponse(ctx, &client.GetUsersGetReviewParams{UserId: "u3"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respReview.StatusCode())
+
+	review := respReview.JSON200
+	require.Len(t, review.PullRequests, 1)
+	require.Equal(t, "pr-3", review.PullRequests[0].PullRequestId)
+}
+
+func TestAdminImportExport_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	body := strings.Join([]string{
+		`{"type":"team","team_name":"backend"}`,
+		`{"type":"user","user_id":"u1","username":"Alice","team_name":"backend"}`,
+		`{"type":"user","user_id":"u2","username":"Bob","team_name":"backend","is_active":false}`,
+		``,
+		`{"type":"pull_request","pull_request_id":"pr-old","pull_request_name":"Historic","author_id":"u1","status":"MERGED","assigned_reviewers":["u2"],"created_at":"2024-05-01T10:00:00Z","merged_at":"2024-05-02T12:00:00Z"}`,
+		`{"type":"pull_request","pull_request_id":"pr-bad","pull_request_name":"Bad","author_id":"u1","status":"OPEN","assigned_reviewers":["u1"],"created_at":"2024-05-01T10:00:00Z"}`,
+		`not json`,
+	}, "\n")
+
+	resp, err := api.PostAdminImportWithBodyWithResponse(ctx, "application/x-ndjson", strings.NewReader(body))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+
+	res := resp.JSON200
+	require.Equal(t, 1, res.Teams)
+	require.Equal(t, 2, res.Users)
+	require.Equal(t, 1, res.PullRequests)
+	require.Len(t, res.Errors, 2)
+	require.Equal(t, 6, res.Errors[0].Line)
+	require.Equal(t, "BAD_REQUEST", res.Errors[0].Code)
+	require.Equal(t, 7, res.Errors[1].Line)
+
+	respReview, err := api.GetUsersGetReviewWithResponse(ctx, &client.GetUsersGetReviewParams{UserId: "u2"})
+	require.NoError(t, err)
+
+	review := respReview.JSON200
+	require.Len(t, review.PullRequests, 1)
+	require.Equal(t, client.MERGED, review.PullRequests[0].Status)
+
+	respExport, err := api.GetAdminExportWithResponse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respExport.StatusCode())
+	require.Equal(t, "application/x-ndjson", respExport.HTTPResponse.Header.Get("Content-Type"))
+
+	var exported []client.ImportRecord
+	dec := json.NewDecoder(bytes.NewReader(respExport.Body))
+	for dec.More() {
+		var rec client.ImportRecord
+		require.NoError(t, dec.Decode(&rec))
+		exported = append(exported, rec)
+	}
+	require.Len(t, exported, 4)
+
+	pr := exported[3]
+	require.Equal(t, client.ImportRecordTypePullRequest, pr.Type)
+	require.Equal(t, "pr-old", *pr.PullRequestId)
+	require.Equal(t, []string{"u2"}, *pr.AssignedReviewers)
+	require.True(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC).Equal(*pr.CreatedAt))
+}
+
+func TestCreatePRBatch_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	teamReq := client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+			{UserId: "u4", Username: "Dave", IsActive: true},
+		},
+	}
+
+	resp, err := api.PostTeamAddWithResponse(ctx, teamReq)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+
+	respBatch, err := api.PostPullRequestCreateBatchWithResponse(ctx, client.PostPullRequestCreateBatchJSONRequestBody{
+		PullRequests: []client.PRBatchCreateItem{
+			{PullRequestId: "pr-1", PullRequestName: "Stack 1", AuthorId: "u1"},
+			{PullRequestId: "pr-2", PullRequestName: "Stack 2", AuthorId: "u1"},
+			{PullRequestId: "pr-3", PullRequestName: "Stack 3", AuthorId: "u1"},
+			{PullRequestId: "pr-1", PullRequestName: "Duplicate", AuthorId: "u1"},
+			{PullRequestId: "pr-4", PullRequestName: "Ghost", AuthorId: "missing"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respBatch.StatusCode())
+
+	batchResp := respBatch.JSON200
+	require.Equal(t, 3, batchResp.Created)
+	require.Len(t, batchResp.Results, 5)
+
+	// 6 назначений на трёх кандидатов распределяются поровну
+	load := map[string]int{}
+	for _, r := range batchResp.Results[:3] {
+		require.Nil(t, r.Error)
+		require.NotNil(t, r.Pr)
+		require.Len(t, r.Pr.AssignedReviewers, 2)
+		for _, id := range r.Pr.AssignedReviewers {
+			load[id]++
+		}
+	}
+	require.Equal(t, map[string]int{"u2": 2, "u3": 2, "u4": 2}, load)
+
+	require.NotNil(t, batchResp.Results[3].Error)
+	require.Equal(t, "PR_EXISTS", batchResp.Results[3].Error.Code)
+	require.NotNil(t, batchResp.Results[4].Error)
+	require.Equal(t, "NOT_FOUND", batchResp.Results[4].Error.Code)
+
+	respStats, err := api.GetStatsWithResponse(ctx, &client.GetStatsParams{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), respStats.JSON200.PrStatusCounts.Open)
+
+	_, err = api.PostPullRequestCreateBatchWithResponse(ctx, client.PostPullRequestCreateBatchJSONRequestBody{})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+}
+
+func TestRequestedReviewersAndSetReviewers_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	for _, team := range []client.Team{
+		{
+			TeamName: "backend",
+			Members: []client.TeamMember{
+				{UserId: "u1", Username: "Alice", IsActive: true},
+				{UserId: "u2", Username: "Bob", IsActive: true},
+				{UserId: "u3", Username: "Charlie", IsActive: true},
+			},
+		},
+		{
+			TeamName: "platform",
+			Members:  []client.TeamMember{{UserId: "p1", Username: "Pat", IsActive: true}},
+		},
+	} {
+		_, err := api.PostTeamAddWithResponse(ctx, team)
+		require.NoError(t, err)
+	}
+
+	createReq := client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:      "pr-1",
+		PullRequestName:    "Infra change",
+		AuthorId:           "u1",
+		RequestedReviewers: &[]string{"p1"},
+	}
+
+	// участник чужой команды до разрешения в настройках
+	_, err := api.PostPullRequestCreateWithResponse(ctx, createReq)
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	reviewerTeams := []string{"platform"}
+	_, err = api.PostTeamSettingsWithResponse(ctx, client.TeamSettings{TeamName: "backend", ReviewerTeams: &reviewerTeams})
+	require.NoError(t, err)
+
+	created, err := api.PostPullRequestCreateWithResponse(ctx, createReq)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+	require.Len(t, created.JSON201.Pr.AssignedReviewers, 2)
+	require.Contains(t, created.JSON201.Pr.AssignedReviewers, "p1")
+
+	updated, err := api.PostPullRequestSetReviewersWithResponse(ctx, client.PostPullRequestSetReviewersJSONRequestBody{
+		PullRequestId: "pr-1",
+		Reviewers:     []string{"u3"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"u3"}, updated.JSON200.Pr.AssignedReviewers)
+
+	_, err = api.PostPullRequestSetReviewersWithResponse(ctx, client.PostPullRequestSetReviewersJSONRequestBody{
+		PullRequestId: "pr-1",
+		Reviewers:     []string{"u1"},
+	})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	_, err = api.PostPullRequestSetReviewersWithResponse(ctx, client.PostPullRequestSetReviewersJSONRequestBody{
+		PullRequestId: "pr-1",
+		Reviewers:     []string{},
+	})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	_, err = api.PostPullRequestMergeWithResponse(ctx, client.PostPullRequestMergeJSONRequestBody{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+
+	_, err = api.PostPullRequestSetReviewersWithResponse(ctx, client.PostPullRequestSetReviewersJSONRequestBody{
+		PullRequestId: "pr-1",
+		Reviewers:     []string{"u2"},
+	})
+	requireAPIError(t, err, http.StatusConflict, client.ErrPRMerged)
+}
+
+func TestReviewRules_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	_, err := api.PostTeamAddWithResponse(ctx, client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+			{UserId: "j1", Username: "Junior", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	var ruleIDs []int64
+	for _, body := range []client.PostTeamRulesJSONRequestBody{
+		{TeamName: "backend", Type: client.Conflict, UserId: "u2", OtherUserId: "u1"},
+		{TeamName: "backend", Type: client.Pair, UserId: "j1", OtherUserId: "u3"},
+	} {
+		resp, err := api.PostTeamRulesWithResponse(ctx, body)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		ruleIDs = append(ruleIDs, resp.JSON201.Rule.Id)
 	}
 
-	buf.Reset()
-	require.NoError(t, json.NewEncoder(&buf).Encode(deactivateReq))
+	_, err = api.PostTeamRulesWithResponse(ctx, client.PostTeamRulesJSONRequestBody{TeamName: "backend", Type: client.Pair, UserId: "j1", OtherUserId: "ghost"})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
 
-	respDeact, err := http.Post(httpServer.URL+"/team/deactivateMembers", "application/json", &buf)
+	list, err := api.GetTeamRulesWithResponse(ctx, &client.GetTeamRulesParams{TeamName: "backend"})
 	require.NoError(t, err)
-	defer respDeact.Body.Close()
+	require.Len(t, list.JSON200.Rules, 2)
 
-	require.Equal(t, http.StatusConflict, respDeact.StatusCode)
+	// u2 не ревьюит u1, а j1 попадает в ревьюверы только вместе с u3
+	created, err := api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorId:        "u1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"j1", "u3"}, created.JSON201.Pr.AssignedReviewers)
+
+	reassign := client.PostPullRequestReassignJSONRequestBody{PullRequestId: "pr-1", OldUserId: "u3"}
+
+	_, err = api.PostPullRequestReassignWithResponse(ctx, reassign)
+	requireAPIError(t, err, http.StatusConflict, client.ErrNoCandidate)
+
+	_, err = api.PostTeamRulesDeleteWithResponse(ctx, client.PostTeamRulesDeleteJSONRequestBody{Id: ruleIDs[0]})
+	require.NoError(t, err)
+
+	// без правила конфликта u2 подошёл бы, но j1 останется без напарника
+	_, err = api.PostPullRequestReassignWithResponse(ctx, reassign)
+	requireAPIError(t, err, http.StatusConflict, client.ErrNoCandidate)
+
+	_, err = api.PostTeamRulesDeleteWithResponse(ctx, client.PostTeamRulesDeleteJSONRequestBody{Id: ruleIDs[1]})
+	require.NoError(t, err)
+
+	_, err = api.PostPullRequestReassignWithResponse(ctx, reassign)
+	require.NoError(t, err)
+
+	_, err = api.PostTeamRulesDeleteWithResponse(ctx, client.PostTeamRulesDeleteJSONRequestBody{Id: ruleIDs[1]})
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
+}
+
+func TestCodeOwners_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	_, err := api.PostTeamAddWithResponse(ctx, client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+			{UserId: "u4", Username: "Dave", IsActive: true},
+			{UserId: "u5", Username: "Eve", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	codeowners := "# backend\n" +
+		"*              @bob\n" +
+		"/internal/db/  @eve @mallory\n"
+
+	resp, err := api.PostTeamCodeownersImportWithTextBodyWithResponse(ctx, &client.PostTeamCodeownersImportParams{TeamName: "backend"}, codeowners)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+
+	imported := resp.JSON200
+	require.Equal(t, []client.CodeOwnerRule{
+		{Pattern: "*", Owners: []string{"u2"}},
+		{Pattern: "/internal/db/", Owners: []string{"u5"}},
+	}, imported.Rules)
+	require.Equal(t, []string{"@mallory"}, imported.IgnoredOwners)
+
+	stored, err := api.GetTeamCodeownersWithResponse(ctx, &client.GetTeamCodeownersParams{TeamName: "backend"})
+	require.NoError(t, err)
+	require.Equal(t, imported.Rules, stored.JSON200.Rules)
 
-	var errResp v1.ErrorResponse
-	require.NoError(t, json.NewDecoder(respDeact.Body).Decode(&errResp))
-	require.Equal(t, v1.NOCANDIDATE, errResp.Error.Code)
+	paths := []string{"internal/db/conn.go", "README.md"}
+	created, err := api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Tune pool",
+		AuthorId:        "u1",
+		ChangedPaths:    &paths,
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"u2", "u5"}, created.JSON201.Pr.AssignedReviewers)
+
+	_, err = api.PostTeamCodeownersWithResponse(ctx, client.TeamCodeOwners{
+		TeamName: "backend",
+		Rules:    []client.CodeOwnerRule{{Pattern: "*.go", Owners: []string{"ghost"}}},
+	})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	_, err = api.PostTeamCodeownersImportWithTextBodyWithResponse(ctx, &client.PostTeamCodeownersImportParams{TeamName: "unknown"}, codeowners)
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
+}
+
+func TestUserTags_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	_, err := api.PostTeamAddWithResponse(ctx, client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+			{UserId: "u4", Username: "Dave", IsActive: true},
+			{UserId: "u5", Username: "Eve", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := api.PostUsersTagsWithResponse(ctx, client.UserTags{UserId: "u4", Tags: []string{"Security", "db", "db"}})
+	require.NoError(t, err)
+
+	saved := resp.JSON200
+	require.Equal(t, []string{"db", "security"}, saved.Tags)
+
+	_, err = api.PostUsersTagsWithResponse(ctx, client.UserTags{UserId: "u4", Tags: []string{"two words"}})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	_, err = api.PostUsersTagsWithResponse(ctx, client.UserTags{UserId: "ghost", Tags: []string{"db"}})
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
+
+	stored, err := api.GetUsersTagsWithResponse(ctx, &client.GetUsersTagsParams{UserId: "u4"})
+	require.NoError(t, err)
+	require.Equal(t, saved, stored.JSON200)
+
+	tags := []string{"security", "mobile"}
+	created, err := api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Harden auth",
+		AuthorId:        "u1",
+		RequiredTags:    &tags,
+	})
+	require.NoError(t, err)
+	require.Contains(t, created.JSON201.Pr.AssignedReviewers, "u4")
+	require.Len(t, created.JSON201.Pr.AssignedReviewers, 2)
+	require.Equal(t, []string{"mobile"}, created.JSON201.UnmetTags)
+}
+
+func TestSeniority_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	level := func(s client.Seniority) *client.Seniority { return &s }
+
+	_, err := api.PostTeamAddWithResponse(ctx, client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true, Seniority: level(client.Middle)},
+			{UserId: "u2", Username: "Bob", IsActive: true, Seniority: level(client.Junior)},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+			{UserId: "u4", Username: "Dave", IsActive: true, Seniority: level(client.Senior)},
+		},
+	})
+	require.NoError(t, err)
+
+	minSeniors := 1
+	settings, err := api.PostTeamSettingsWithResponse(ctx, client.TeamSettings{TeamName: "backend", MinSeniorReviewers: &minSeniors})
+	require.NoError(t, err)
+	require.Equal(t, &minSeniors, settings.JSON200.MinSeniorReviewers)
+
+	created, err := api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Rework billing",
+		AuthorId:        "u1",
+	})
+	require.NoError(t, err)
+	reviewers := created.JSON201.Pr.AssignedReviewers
+	require.Contains(t, reviewers, "u4")
+	require.Len(t, reviewers, 2)
+
+	// u4 — единственный сеньор, заменить его некем
+	reassign := client.PostPullRequestReassignJSONRequestBody{PullRequestId: "pr-1", OldUserId: "u4"}
+
+	_, err = api.PostPullRequestReassignWithResponse(ctx, reassign)
+	requireAPIError(t, err, http.StatusConflict, client.ErrNoCandidate)
+
+	_, err = api.PostUsersSetSeniorityWithResponse(ctx, client.PostUsersSetSeniorityJSONRequestBody{UserId: "u3", Seniority: "lead"})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	_, err = api.PostUsersSetSeniorityWithResponse(ctx, client.PostUsersSetSeniorityJSONRequestBody{UserId: "ghost", Seniority: client.Senior})
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
+
+	for _, id := range []string{"u2", "u3"} {
+		if !slices.Contains(reviewers, id) {
+			updated, err := api.PostUsersSetSeniorityWithResponse(ctx, client.PostUsersSetSeniorityJSONRequestBody{UserId: id, Seniority: client.Senior})
+			require.NoError(t, err)
+			require.Equal(t, level(client.Senior), updated.JSON200.User.Seniority)
+		}
+	}
+
+	_, err = api.PostPullRequestReassignWithResponse(ctx, reassign)
+	require.NoError(t, err)
+
+	// пустой уровень при обновлении участника оставляет прежний
+	_, err = api.PostTeamMembersUpdateWithResponse(ctx, client.PostTeamMembersUpdateJSONRequestBody{
+		TeamName: "backend",
+		UserId:   "u4",
+		Username: "Dave",
+		IsActive: true,
+	})
+	require.NoError(t, err)
+
+	team, err := api.GetTeamGetWithResponse(ctx, &client.GetTeamGetParams{TeamName: "backend"})
+	require.NoError(t, err)
+	for _, m := range team.JSON200.Members {
+		if m.UserId == "u4" {
+			require.Equal(t, level(client.Senior), m.Seniority)
+		}
+	}
+}
+
+// readFixture читает тело вебхука из тестовых данных пакета forge.
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("..", "internal", "forge", "testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+// postGitHubWebhook отправляет тело вебхука GitHub как есть: подпись считается по точным байтам.
+func postGitHubWebhook(ctx context.Context, event, secret string, body []byte) (*client.PostIntegrationsGithubResponse, error) {
+	signature := forge.SignGitHub(secret, body)
+	return api.PostIntegrationsGithubWithBodyWithResponse(ctx, &client.PostIntegrationsGithubParams{
+		XGitHubEvent:     &event,
+		XHubSignature256: &signature,
+	}, "application/json", bytes.NewReader(body))
+}
+
+func TestForgeWebhooks_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	github := func(event, name string) client.WebhookResult {
+		t.Helper()
+		resp, err := postGitHubWebhook(ctx, event, githubWebhookSecret, readFixture(t, name))
+		require.NoError(t, err)
+		return *resp.JSON200
+	}
+	gitlabEvent := forge.GitLabMergeRequestEvent
+	gitlab := func(token, name string) (*client.PostIntegrationsGitlabResponse, error) {
+		return api.PostIntegrationsGitlabWithBodyWithResponse(ctx, &client.PostIntegrationsGitlabParams{
+			XGitlabEvent: &gitlabEvent,
+			XGitlabToken: &token,
+		}, "application/json", bytes.NewReader(readFixture(t, name)))
+	}
+	gitlabResult := func(name string) client.WebhookResult {
+		t.Helper()
+		resp, err := gitlab(gitlabWebhookToken, name)
+		require.NoError(t, err)
+		return *resp.JSON200
+	}
+
+	_, err := api.PostTeamAddWithResponse(ctx, client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	// чужая подпись отклоняется
+	_, err = postGitHubWebhook(ctx, forge.GitHubPullRequestEvent, "wrong", readFixture(t, "github_pull_request_opened.json"))
+	requireAPIError(t, err, http.StatusUnauthorized, client.ErrUnauthorized)
+
+	require.Equal(t, client.Ignored, github("ping", "github_ping.json").Result)
+
+	// автор ещё не сопоставлен с пользователем
+	res := github("pull_request", "github_pull_request_opened.json")
+	require.Equal(t, client.Ignored, res.Result)
+	require.Nil(t, res.Pr)
+
+	account, err := api.PostIntegrationsAccountsWithResponse(ctx, client.ForgeAccount{Forge: client.Github, Login: "Alice-Dev", UserId: "u1"})
+	require.NoError(t, err)
+	require.Equal(t, "alice-dev", account.JSON200.Login)
+
+	res = github("pull_request", "github_pull_request_opened.json")
+	require.Equal(t, client.Created, res.Result)
+	require.NotNil(t, res.Pr)
+	require.Equal(t, "github:acme/billing#42", res.Pr.PullRequestId)
+	require.Equal(t, "Add invoice export", res.Pr.PullRequestName)
+	require.Equal(t, "u1", res.Pr.AuthorId)
+	require.ElementsMatch(t, []string{"u2", "u3"}, res.Pr.AssignedReviewers)
+
+	// повторная доставка не создаёт второй PR
+	require.Equal(t, client.Ignored, github("pull_request", "github_pull_request_opened.json").Result)
+
+	res = github("pull_request", "github_pull_request_merged.json")
+	require.Equal(t, client.Merged, res.Result)
+	require.Equal(t, client.PullRequestStatusMERGED, res.Pr.Status)
+
+	// GitLab
+	_, err = gitlab("wrong", "gitlab_merge_request_open.json")
+	requireAPIError(t, err, http.StatusUnauthorized, client.ErrUnauthorized)
+
+	_, err = api.PostIntegrationsAccountsWithResponse(ctx, client.ForgeAccount{Forge: client.Gitlab, Login: "alice.dev", UserId: "u2"})
+	require.NoError(t, err)
+
+	res = gitlabResult("gitlab_merge_request_open.json")
+	require.Equal(t, client.Created, res.Result)
+	require.Equal(t, "gitlab:acme/platform/billing!7", res.Pr.PullRequestId)
+	require.Equal(t, "u2", res.Pr.AuthorId)
+
+	require.Equal(t, client.Ignored, gitlabResult("gitlab_merge_request_update.json").Result)
+	require.Equal(t, client.Merged, gitlabResult("gitlab_merge_request_merge.json").Result)
+
+	list, err := api.GetIntegrationsAccountsWithResponse(ctx, &client.GetIntegrationsAccountsParams{Forge: client.Github})
+	require.NoError(t, err)
+	require.Equal(t, []client.ForgeAccount{{Forge: client.Github, Login: "alice-dev", UserId: "u1"}}, list.JSON200.Accounts)
+
+	_, err = api.PostIntegrationsAccountsDeleteWithResponse(ctx, client.PostIntegrationsAccountsDeleteJSONRequestBody{Forge: client.Github, Login: "Alice-Dev"})
+	require.NoError(t, err)
+
+	_, err = api.PostIntegrationsAccountsDeleteWithResponse(ctx, client.PostIntegrationsAccountsDeleteJSONRequestBody{Forge: client.Github, Login: "alice-dev"})
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
+}
+
+func TestForgeSync_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	_, err := api.PostTeamAddWithResponse(ctx, client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+			{UserId: "u4", Username: "Dave", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	logins := map[string]string{"u1": "alice-dev", "u2": "bob", "u3": "charlie", "u4": "dave"}
+	for id, login := range logins {
+		_, err = api.PostIntegrationsAccountsWithResponse(ctx, client.ForgeAccount{Forge: client.Github, Login: login, UserId: id})
+		require.NoError(t, err)
+	}
+
+	forgeSync := true
+	settings, err := api.PostTeamSettingsWithResponse(ctx, client.TeamSettings{TeamName: "backend", ForgeSync: &forgeSync})
+	require.NoError(t, err)
+	require.NotNil(t, settings.JSON200.ForgeSync)
+	require.True(t, *settings.JSON200.ForgeSync)
+
+	hookResp, err := postGitHubWebhook(ctx, forge.GitHubPullRequestEvent, githubWebhookSecret, readFixture(t, "github_pull_request_opened.json"))
+	require.NoError(t, err)
+
+	hook := hookResp.JSON200
+	require.Equal(t, client.Created, hook.Result)
+	require.Len(t, hook.Pr.AssignedReviewers, 2)
+
+	requested := func(ids []string) []string {
+		res := make([]string, 0, len(ids))
+		for _, id := range ids {
+			res = append(res, logins[id])
+		}
+		return res
+	}
+
+	// первая попытка падает на стороне GitHub и откладывается
+	githubAPI.Fail(1, http.StatusBadGateway)
+	now := time.Now()
+
+	run, err := app.ProcessForgeSyncJobs(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Retried: 1}, run)
+
+	run, err = app.ProcessForgeSyncJobs(ctx, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Sent: 1}, run)
+	require.ElementsMatch(t, requested(hook.Pr.AssignedReviewers), githubAPI.Reviewers("acme/billing", 42))
+
+	old := hook.Pr.AssignedReviewers[0]
+	reassigned, err := api.PostPullRequestReassignWithResponse(ctx, client.PostPullRequestReassignJSONRequestBody{PullRequestId: hook.Pr.PullRequestId, OldUserId: old})
+	require.NoError(t, err)
+
+	run, err = app.ProcessForgeSyncJobs(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Sent: 1}, run)
+	require.ElementsMatch(t, requested(reassigned.JSON200.Pr.AssignedReviewers), githubAPI.Reviewers("acme/billing", 42))
+	require.NotContains(t, githubAPI.Reviewers("acme/billing", 42), logins[old])
+}
+
+func TestNotifications_E2E(t *testing.T) {
+	truncateAll(t)
+	slackAPI.Reset()
+	ctx := context.Background()
+
+	_, err := api.PostTeamAddWithResponse(ctx, client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+			{UserId: "u4", Username: "Dave", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = api.PostTeamNotificationsWithResponse(ctx, client.TeamNotifications{TeamName: "backend", WebhookUrl: "not a url"})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	badTemplate := "{{.Nope}}"
+	_, err = api.PostTeamNotificationsWithResponse(ctx, client.TeamNotifications{
+		TeamName:   "backend",
+		WebhookUrl: slackAPI.URL + "/hooks/backend",
+		Templates:  &client.NotificationTemplates{Assigned: &badTemplate},
+	})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	_, err = api.PostTeamNotificationsWithResponse(ctx, client.TeamNotifications{TeamName: "ghost", WebhookUrl: slackAPI.URL})
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
+
+	channel := "#reviews"
+	assigned := `new PR {{.PullRequestID}} for {{join .Reviewers ", "}}`
+	_, err = api.PostTeamNotificationsWithResponse(ctx, client.TeamNotifications{
+		TeamName:   "backend",
+		WebhookUrl: slackAPI.URL + "/hooks/backend",
+		Channel:    &channel,
+		Templates:  &client.NotificationTemplates{Assigned: &assigned},
+	})
+	require.NoError(t, err)
+
+	getResp, err := api.GetTeamNotificationsWithResponse(ctx, &client.GetTeamNotificationsParams{TeamName: "backend"})
+	require.NoError(t, err)
+
+	cfg := getResp.JSON200
+	require.Equal(t, slackAPI.URL+"/hooks/backend", cfg.WebhookUrl)
+	require.Equal(t, &channel, cfg.Channel)
+	require.NotNil(t, cfg.Templates)
+	require.Equal(t, &assigned, cfg.Templates.Assigned)
+	require.Nil(t, cfg.Templates.Replaced)
+
+	created, err := api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add feature",
+		AuthorId:        "u1",
+	})
+	require.NoError(t, err)
+
+	reviewers := created.JSON201.Pr.AssignedReviewers
+	require.Len(t, reviewers, 2)
+
+	msgs := waitSlackMessages(t, 1)
+	require.Len(t, msgs, 1)
+	require.Equal(t, "/hooks/backend", msgs[0].Path)
+	require.Equal(t, "#reviews", msgs[0].Channel)
+	require.Equal(t, "new PR pr-1 for "+strings.Join(reviewers, ", "), msgs[0].Text)
+
+	_, err = api.PostUsersSetNotificationsWithResponse(ctx, client.PostUsersSetNotificationsJSONRequestBody{UserId: "ghost", Enabled: false})
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
+
+	// замена приходит новому ревьюверу по встроенному шаблону, пока он не отключил уведомления
+	old := reviewers[0]
+	reassigned, err := api.PostPullRequestReassignWithResponse(ctx, client.PostPullRequestReassignJSONRequestBody{PullRequestId: "pr-1", OldUserId: old})
+	require.NoError(t, err)
+	replacedBy := reassigned.JSON200.ReplacedBy
+
+	msgs = waitSlackMessages(t, 2)
+	require.Len(t, msgs, 2)
+	require.Contains(t, msgs[1].Text, replacedBy)
+	require.Contains(t, msgs[1].Text, "you replace "+old)
+
+	_, err = api.PostUsersSetNotificationsWithResponse(ctx, client.PostUsersSetNotificationsJSONRequestBody{UserId: replacedBy, Enabled: false})
+	require.NoError(t, err)
+
+	stale, err := app.ProcessStalePRs(ctx, time.Now().Add(100*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, stale.Detected)
+
+	msgs = waitSlackMessages(t, 3)
+	require.Len(t, msgs, 3)
+	require.Contains(t, msgs[2].Text, "pr-1")
+	require.NotContains(t, msgs[2].Text, replacedBy)
+}
+
+// waitSlackMessages ждёт, пока в чат придёт n сообщений: уведомления отправляются в фоне.
+func waitSlackMessages(t *testing.T, n int) []notifytest.Message {
+	t.Helper()
+	require.Eventually(t, func() bool { return len(slackAPI.Messages()) >= n }, 5*time.Second, 10*time.Millisecond)
+	return slackAPI.Messages()
+
+}
+
+func TestDigest_E2E(t *testing.T) {
+	truncateAll(t)
+	smtpAPI.Reset()
+	ctx := context.Background()
+
+	_, err := api.PostTeamAddWithResponse(ctx, client.Team{
+		TeamName: "backend",
+		Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	getResp, err := api.GetUsersPreferencesWithResponse(ctx, &client.GetUsersPreferencesParams{UserId: "u2"})
+	require.NoError(t, err)
+
+	prefs := getResp.JSON200
+	require.Equal(t, client.Off, prefs.Digest)
+	require.Equal(t, "UTC", *prefs.Timezone)
+	require.Nil(t, prefs.LastDigestAt)
+
+	_, err = api.PostUsersPreferencesWithResponse(ctx, client.UserPreferences{UserId: "u2", Digest: client.Daily})
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	_, err = api.PostUsersPreferencesWithResponse(ctx, client.UserPreferences{UserId: "ghost", Digest: client.Off})
+	requireAPIError(t, err, http.StatusNotFound, client.ErrNotFound)
+
+	email, hour, tz := "bob@example.com", 9, "Europe/Moscow"
+	weekday := client.Monday
+	resp, err := api.PostUsersPreferencesWithResponse(ctx, client.UserPreferences{
+		UserId:        "u2",
+		Email:         &email,
+		Digest:        client.Weekly,
+		DigestHour:    &hour,
+		DigestWeekday: &weekday,
+		Timezone:      &tz,
+	})
+	require.NoError(t, err)
+	require.Equal(t, client.Weekly, resp.JSON200.Digest)
+	require.Equal(t, &weekday, resp.JSON200.DigestWeekday)
+
+	_, err = api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:      "pr-1",
+		PullRequestName:    "Add feature",
+		AuthorId:           "u1",
+		RequestedReviewers: &[]string{"u2"},
+	})
+	require.NoError(t, err)
+
+	msk, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+	// 2030-01-07 — понедельник
+	monday := time.Date(2030, 1, 7, 9, 30, 0, 0, msk)
+
+	run, err := app.ProcessDigests(ctx, monday.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, run)
+
+	smtpAPI.Fail(1, 451)
+	run, err = app.ProcessDigests(ctx, monday)
+	require.NoError(t, err)
+	require.Equal(t, domain.DigestRunResult{Failed: 1}, run)
+
+	run, err = app.ProcessDigests(ctx, monday.Add(5*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, domain.DigestRunResult{Sent: 1}, run)
+
+	mails := smtpAPI.Mails()
+	require.Len(t, mails, 1)
+	require.Equal(t, digestFrom, mails[0].From)
+	require.Equal(t, []string{"bob@example.com"}, mails[0].To)
+	require.Equal(t, "1 pull request is waiting for your review", mails[0].Subject)
+	require.Contains(t, mails[0].Body, `pr-1 "Add feature" by u1`)
+
+	// повторный запуск в тот же день письмо не дублирует
+	run, err = app.ProcessDigests(ctx, monday.Add(time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, run)
+
+	getResp, err = api.GetUsersPreferencesWithResponse(ctx, &client.GetUsersPreferencesParams{UserId: "u2"})
+	require.NoError(t, err)
+	require.NotNil(t, getResp.JSON200.LastDigestAt)
+	require.True(t, getResp.JSON200.LastDigestAt.Equal(monday.Add(5*time.Minute)))
+}
+
+func TestEventStream_E2E(t *testing.T) {
+	truncateAll(t)
+	ctx := context.Background()
+
+	// subscribe читает ленту клиентом в фоне; лента закрывается вызовом stop или в конце теста.
+	subscribe := func(params client.GetEventsStreamParams) (events <-chan client.Event, stop func()) {
+		streamCtx, cancel := context.WithCancel(ctx)
+		ch := make(chan client.Event)
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+			_ = api.StreamEvents(streamCtx, &params, func(e client.Event) error {
+				select {
+				case ch <- e:
+					return nil
+				case <-streamCtx.Done():
+					return streamCtx.Err()
+				}
+			})
+		}()
+
+		stop = func() {
+			cancel()
+			<-done
+		}
+		t.Cleanup(stop)
+		return ch, stop
+	}
+	next := func(events <-chan client.Event) client.Event {
+		t.Helper()
+		select {
+		case ev := <-events:
+			return ev
+		case <-time.After(10 * time.Second):
+			t.Fatal("no event in stream")
+			return client.Event{}
+		}
+	}
+	eventID := func(id int64) *int64 { return &id }
+
+	for _, team := range []client.Team{
+		{TeamName: "backend", Members: []client.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Charlie", IsActive: true},
+			{UserId: "u4", Username: "Dave", IsActive: true},
+		}},
+		{TeamName: "frontend", Members: []client.TeamMember{
+			{UserId: "f1", Username: "Frank", IsActive: true},
+			{UserId: "f2", Username: "Grace", IsActive: true},
+			{UserId: "f3", Username: "Heidi", IsActive: true},
+			{UserId: "f4", Username: "Ivan", IsActive: true},
+		}},
+	} {
+		_, err := api.PostTeamAddWithResponse(ctx, team)
+		require.NoError(t, err)
+	}
+
+	created, err := api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{PullRequestId: "pr-1", PullRequestName: "Add search", AuthorId: "u1"})
+	require.NoError(t, err)
+	reviewers := created.JSON201.Pr.AssignedReviewers
+
+	_, err = api.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{PullRequestId: "pr-2", PullRequestName: "Fix layout", AuthorId: "f1"})
+	require.NoError(t, err)
+
+	// история с начала, затем живые события; события frontend отфильтрованы
+	teamName := "backend"
+	backend, stopBackend := subscribe(client.GetEventsStreamParams{TeamName: &teamName, LastEventID: eventID(0)})
+
+	ev := next(backend)
+	require.Equal(t, client.EventType("pr.created"), ev.Type)
+	require.Equal(t, "backend", ev.TeamName)
+	require.Equal(t, "pr-1", *ev.PullRequestId)
+	require.Equal(t, "Add search", *ev.PullRequestName)
+	require.Equal(t, reviewers, *ev.Reviewers)
+	createdID := ev.Id
+
+	oldReviewer := reviewers[0]
+	_, err = api.PostPullRequestReassignWithResponse(ctx, client.PostPullRequestReassignJSONRequestBody{PullRequestId: "pr-1", OldUserId: oldReviewer})
+	require.NoError(t, err)
+
+	ev = next(backend)
+	require.Equal(t, client.EventType("pr.reassigned"), ev.Type)
+	require.Greater(t, ev.Id, createdID)
+	require.Equal(t, oldReviewer, *ev.OldReviewerId)
+	require.NotNil(t, ev.NewReviewerId)
+	require.Contains(t, *ev.Reviewers, *ev.NewReviewerId)
+	require.NotContains(t, *ev.Reviewers, oldReviewer)
+	reassignedID := ev.Id
+
+	_, err = api.PostPullRequestMergeWithResponse(ctx, client.PostPullRequestMergeJSONRequestBody{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+
+	ev = next(backend)
+	require.Equal(t, client.EventType("pr.merged"), ev.Type)
+	require.Equal(t, "pr-1", *ev.PullRequestId)
+	stopBackend()
+
+	_, err = api.PostTeamDeactivateMembersWithResponse(ctx, client.PostTeamDeactivateMembersJSONRequestBody{TeamName: "frontend", UserIds: []string{"f4"}})
+	require.NoError(t, err)
+
+	// переподключение после переназначения: пропущенный мерж приходит из хранилища
+	author := "u1"
+	authorEvents, _ := subscribe(client.GetEventsStreamParams{UserId: &author, LastEventId: eventID(reassignedID)})
+	ev = next(authorEvents)
+	require.Equal(t, client.EventType("pr.merged"), ev.Type)
+
+	// заголовок важнее параметра запроса
+	deactivatedUser := "f4"
+	deactivated, _ := subscribe(client.GetEventsStreamParams{UserId: &deactivatedUser, LastEventId: eventID(999), LastEventID: eventID(createdID)})
+	ev = next(deactivated)
+	if ev.Type == "pr.created" {
+		// f4 мог попасть в ревьюверы pr-2
+		require.Equal(t, "pr-2", *ev.PullRequestId)
+		ev = next(deactivated)
+	}
+	require.Equal(t, client.EventType("team.deactivated"), ev.Type)
+	require.Equal(t, "frontend", ev.TeamName)
+	require.Equal(t, []string{"f4"}, *ev.DeactivatedUserIds)
+	require.Nil(t, ev.PullRequestId)
+
+	err = api.StreamEvents(ctx, &client.GetEventsStreamParams{LastEventID: eventID(-1)}, func(client.Event) error { return nil })
+	requireAPIError(t, err, http.StatusBadRequest, client.ErrBadRequest)
+
+	_, err = api.GetEventsStreamWithResponse(ctx, &client.GetEventsStreamParams{}, func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Last-Event-ID", "abc")
+		return nil
+	})
+	// ошибку разбора заголовка отдаёт сгенерированная обёртка, без кода в теле
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+}
+
+func TestGRPC_E2E(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	teams := reviewerv1.NewTeamServiceClient(grpcConn)
+	users := reviewerv1.NewUserServiceClient(grpcConn)
+	prs := reviewerv1.NewPullRequestServiceClient(grpcConn)
+	stats := reviewerv1.NewStatsServiceClient(grpcConn)
+
+	added, err := teams.AddTeam(ctx, &reviewerv1.AddTeamRequest{Team: &reviewerv1.Team{
+		TeamName: "backend",
+		Members: []*reviewerv1.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true, Seniority: reviewerv1.Seniority_SENIORITY_SENIOR},
+		},
+	}})
+	require.NoError(t, err)
+	require.Len(t, added.GetTeam().GetMembers(), 2)
+
+	// ошибка домена приходит статусом gRPC с кодом домена в ErrorInfo
+	_, err = teams.AddTeam(ctx, &reviewerv1.AddTeamRequest{Team: added.GetTeam()})
+	requireGRPCError(t, err, codes.AlreadyExists, domain.ErrorCodeTeamExists)
+
+	_, err = teams.GetTeam(ctx, &reviewerv1.GetTeamRequest{TeamName: "missing"})
+	requireGRPCError(t, err, codes.NotFound, domain.ErrorCodeNotFound)
+
+	_, err = teams.GetTeam(ctx, &reviewerv1.GetTeamRequest{})
+	requireGRPCError(t, err, codes.InvalidArgument, domain.ErrorCodeBadRequest)
+
+	user, err := users.SetUserSeniority(ctx, &reviewerv1.SetUserSeniorityRequest{
+		UserId:    "u1",
+		Seniority: reviewerv1.Seniority_SENIORITY_JUNIOR,
+	})
+	require.NoError(t, err)
+	require.Equal(t, reviewerv1.Seniority_SENIORITY_JUNIOR, user.GetUser().GetSeniority())
+	require.Equal(t, "backend", user.GetUser().GetTeamName())
+
+	created, err := prs.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add feature",
+		AuthorId:        "u1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN, created.GetPr().GetStatus())
+	require.Equal(t, []string{"u2"}, created.GetPr().GetAssignedReviewers())
+
+	review, err := users.GetUserReview(ctx, &reviewerv1.GetUserReviewRequest{UserId: "u2"})
+	require.NoError(t, err)
+	require.Len(t, review.GetPullRequests(), 1)
+	require.Equal(t, "pr-1", review.GetPullRequests()[0].GetPullRequestId())
+
+	// порог в одну секунду, чтобы PR успел зависнуть
+	_, err = teams.UpdateTeamSettings(ctx, &reviewerv1.UpdateTeamSettingsRequest{Settings: &reviewerv1.TeamSettings{
+		TeamName:   "backend",
+		StaleAfter: durationpb.New(time.Second),
+	}})
+	require.NoError(t, err)
+
+	_, err = teams.UpdateTeamSettings(ctx, &reviewerv1.UpdateTeamSettingsRequest{Settings: &reviewerv1.TeamSettings{
+		TeamName:   "backend",
+		StaleAfter: durationpb.New(1500 * time.Millisecond),
+	}})
+	requireGRPCError(t, err, codes.InvalidArgument, domain.ErrorCodeBadRequest)
+
+	time.Sleep(1100 * time.Millisecond)
+
+	stream, err := prs.ListStalePullRequests(ctx, &reviewerv1.ListStalePullRequestsRequest{TeamName: "backend"})
+	require.NoError(t, err)
+
+	var stale []*reviewerv1.StalePullRequest
+	for {
+		p, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		stale = append(stale, p)
+	}
+	require.Len(t, stale, 1)
+	require.Equal(t, "pr-1", stale[0].GetPr().GetPullRequestId())
+	require.Equal(t, time.Second, stale[0].GetStaleAfter().AsDuration())
+
+	merged, err := prs.MergePullRequest(ctx, &reviewerv1.MergePullRequestRequest{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	require.Equal(t, reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED, merged.GetPr().GetStatus())
+	require.NotNil(t, merged.GetPr().GetMergedAt())
+
+	_, err = prs.ReassignReviewer(ctx, &reviewerv1.ReassignReviewerRequest{PullRequestId: "pr-1", OldUserId: "u2"})
+	requireGRPCError(t, err, codes.FailedPrecondition, domain.ErrorCodePRMerged)
+
+	counts, err := stats.GetStats(ctx, &reviewerv1.GetStatsRequest{
+		Filter:      &reviewerv1.StatsFilter{TeamName: "backend"},
+		Granularity: reviewerv1.StatsGranularity_STATS_GRANULARITY_DAY,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), counts.GetPrStatusCounts().GetMerged())
+	require.Len(t, counts.GetTimeSeries(), 1)
+
+	_, err = stats.GetCycleTime(ctx, &reviewerv1.GetCycleTimeRequest{Filter: &reviewerv1.StatsFilter{
+		CreatedFrom: timestamppb.New(time.Now()),
+		CreatedTo:   timestamppb.New(time.Now().Add(-time.Hour)),
+	}})
+	requireGRPCError(t, err, codes.InvalidArgument, domain.ErrorCodeBadRequest)
+}
+
+// requireGRPCError проверяет код статуса gRPC и код домена в его ErrorInfo.
+func requireGRPCError(t *testing.T, err error, code codes.Code, reason domain.ErrorCode) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok, "not a grpc status: %v", err)
+	require.Equal(t, code, st.Code(), st.Message())
+
+	var info *errdetails.ErrorInfo
+	for _, d := range st.Details() {
+		if i, ok := d.(*errdetails.ErrorInfo); ok {
+			info = i
+		}
+	}
+	require.NotNil(t, info)
+	require.Equal(t, string(reason), info.GetReason())
 }
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// Availability — период, когда пользователь недоступен для ревью (отпуск, out-of-office).
+type Availability struct {
+	ID        int64
+	UserID    string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Reason    string
+	AppliedAt *time.Time
+}
+
+// Covers сообщает, попадает ли момент t в период недоступности.
+func (a Availability) Covers(t time.Time) bool {
+	return !t.Before(a.StartsAt) && t.Before(a.EndsAt)
+}
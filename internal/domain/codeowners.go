@@ -0,0 +1,15 @@
+package domain
+
+// CodeOwnerRule — строка CODEOWNERS команды: файлы, подходящие под Pattern, принадлежат Owners.
+// Как и в CODEOWNERS, для файла действует последнее подходящее правило.
+type CodeOwnerRule struct {
+	Pattern string
+	Owners  []string
+}
+
+// CodeOwnersImport — итог загрузки файла CODEOWNERS в команду.
+type CodeOwnersImport struct {
+	Rules []CodeOwnerRule
+	// IgnoredOwners — владельцы из файла, не найденные среди участников команды
+	IgnoredOwners []string
+}
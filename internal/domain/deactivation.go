@@ -0,0 +1,18 @@
+package domain
+
+// PRReviewersUpdate — изменение состава ревьюверов одного PR.
+type PRReviewersUpdate struct {
+	PullRequestID string
+	Removed       []string
+	Added         []string
+	Reviewers     []string
+}
+
+// DeactivationResult — итог деактивации участников команды.
+// При DryRun изменения не применяются, а Team отражает состояние до деактивации.
+type DeactivationResult struct {
+	Team        Team
+	DryRun      bool
+	Deactivated []string
+	Updates     []PRReviewersUpdate
+}
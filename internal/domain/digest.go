@@ -0,0 +1,99 @@
+package domain
+
+import "time"
+
+// DigestSchedule — как часто пользователю присылается дайджест ревью.
+type DigestSchedule string
+
+const (
+	DigestOff    DigestSchedule = "off"
+	DigestDaily  DigestSchedule = "daily"
+	DigestWeekly DigestSchedule = "weekly"
+)
+
+// Значения настроек дайджеста для пользователей, которые их не меняли.
+const (
+	DefaultDigestHour     = 9
+	DefaultDigestWeekday  = time.Monday
+	DefaultDigestTimezone = "UTC"
+)
+
+// UserPreferences — настройки дайджеста ревью пользователя.
+type UserPreferences struct {
+	UserID string
+	Email  string
+	Digest DigestSchedule
+	// DigestHour — час отправки по местному времени пользователя, 0–23
+	DigestHour int
+	// DigestWeekday — день отправки еженедельного дайджеста
+	DigestWeekday time.Weekday
+	// Timezone — часовой пояс IANA, например Europe/Moscow
+	Timezone string
+	// LastDigestAt — когда отправлен последний дайджест; nil — ещё не отправлялся
+	LastDigestAt *time.Time
+}
+
+// DefaultUserPreferences — настройки пользователя, который их не менял: дайджест выключен.
+func DefaultUserPreferences(userID string) UserPreferences {
+	return UserPreferences{
+		UserID:        userID,
+		Digest:        DigestOff,
+		DigestHour:    DefaultDigestHour,
+		DigestWeekday: DefaultDigestWeekday,
+		Timezone:      DefaultDigestTimezone,
+	}
+}
+
+// DigestSlot возвращает последний момент отправки по расписанию не позже now.
+// ok == false — дайджест выключен или часовой пояс неизвестен.
+func (p UserPreferences) DigestSlot(now time.Time) (slot time.Time, ok bool) {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	local := now.In(loc)
+	slot = time.Date(local.Year(), local.Month(), local.Day(), p.DigestHour, 0, 0, 0, loc)
+
+	switch p.Digest {
+	case DigestDaily:
+		if slot.After(now) {
+			slot = slot.AddDate(0, 0, -1)
+		}
+	case DigestWeekly:
+		slot = slot.AddDate(0, 0, -((int(slot.Weekday()) - int(p.DigestWeekday) + 7) % 7))
+		if slot.After(now) {
+			slot = slot.AddDate(0, 0, -7)
+		}
+	default:
+		return time.Time{}, false
+	}
+
+	return slot, true
+}
+
+// DigestDue сообщает, что на момент now пора отправить дайджест: момент по расписанию наступил,
+// а дайджест после него ещё не отправлялся. Пропущенный дайджест досылается не позже чем через сутки,
+// поэтому только что включённый дайджест приходит сразу, если момент по расписанию был меньше суток назад.
+func (p UserPreferences) DigestDue(now time.Time) bool {
+	slot, ok := p.DigestSlot(now)
+	if !ok || now.Sub(slot) >= 24*time.Hour {
+		return false
+	}
+	return p.LastDigestAt == nil || p.LastDigestAt.Before(slot)
+}
+
+// DigestRunResult — итог одного прохода рассылки дайджестов.
+type DigestRunResult struct {
+	Sent int
+	// Empty — пользователи, которым пора было отправить дайджест, но ревью не ждёт ни один PR
+	Empty  int
+	Failed int
+}
+
+// Email — письмо для отправки через SMTP.
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,89 @@
+package domain
+
+import "time"
+
+// EventType — вид события в ленте /events/stream.
+type EventType string
+
+const (
+	EventPRCreated       EventType = "pr.created"
+	EventPRMerged        EventType = "pr.merged"
+	EventPRReassigned    EventType = "pr.reassigned"
+	EventPRReviewersSet  EventType = "pr.reviewers_set"
+	EventTeamDeactivated EventType = "team.deactivated"
+)
+
+// Event — запись ленты событий. ID растут в порядке записи и служат Last-Event-ID для переподключения.
+type Event struct {
+	ID   int64
+	Type EventType
+	// TeamName — команда автора PR или команда, участников которой деактивировали
+	TeamName string
+
+	// поля PR; Reviewers — ревьюверы после события
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	Reviewers       []string
+
+	// OldReviewerID и NewReviewerID — для EventPRReassigned
+	OldReviewerID string
+	NewReviewerID string
+
+	// DeactivatedUserIDs — для EventTeamDeactivated
+	DeactivatedUserIDs []string
+
+	CreatedAt time.Time
+}
+
+// UserIDs возвращает пользователей, которых касается событие, без повторов: автора, ревьюверов
+// и деактивированных участников. По ним работает фильтр ленты по пользователю.
+func (e Event) UserIDs() []string {
+	var (
+		res  []string
+		seen = make(map[string]struct{})
+	)
+	add := func(ids ...string) {
+		for _, id := range ids {
+			if id == "" {
+				continue
+			}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			res = append(res, id)
+		}
+	}
+
+	add(e.AuthorID)
+	add(e.Reviewers...)
+	add(e.OldReviewerID, e.NewReviewerID)
+	add(e.DeactivatedUserIDs...)
+
+	return res
+}
+
+// EventFilter ограничивает ленту событий. Пустые поля не ограничивают.
+type EventFilter struct {
+	TeamName string
+	UserID   string
+	// AfterID — последнее полученное клиентом событие; nil — только события после подключения
+	AfterID *int64
+}
+
+// Matches сообщает, проходит ли событие фильтр по команде и пользователю.
+func (f EventFilter) Matches(e Event) bool {
+	if f.TeamName != "" && e.TeamName != f.TeamName {
+		return false
+	}
+	if f.UserID == "" {
+		return true
+	}
+	for _, id := range e.UserIDs() {
+		if id == f.UserID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// DefaultFairnessIdleDays — за сколько дней без назначений активный участник считается простаивающим.
+const DefaultFairnessIdleDays = 14
+
+// ReviewerLoad — текущая нагрузка пользователя как ревьювера.
+type ReviewerLoad struct {
+	UserID          string
+	TeamName        string
+	IsActive        bool
+	OpenAssignments int
+	LastAssignedAt  *time.Time
+}
+
+// TeamFairness — равномерность распределения открытых ревью между активными участниками команды.
+type TeamFairness struct {
+	TeamName        string
+	ActiveMembers   int
+	OpenAssignments int
+	MeanLoad        float64
+	MinLoad         int
+	MaxLoad         int
+	// Gini — коэффициент Джини по открытой нагрузке: 0 — поровну, ближе к 1 — всё на одном.
+	Gini float64
+	// Members — нагрузка активных участников.
+	Members []ReviewerLoad
+	// IdleMembers — активные участники без назначений с IdleSince.
+	IdleMembers []string
+}
+
+// Spread — разница между максимальной и минимальной нагрузкой.
+func (f TeamFairness) Spread() int {
+	return f.MaxLoad - f.MinLoad
+}
+
+type FairnessReport struct {
+	IdleSince time.Time
+	Teams     []TeamFairness
+}
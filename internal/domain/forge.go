@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Forge — внешний git-хостинг, присылающий вебхуки о PR.
+type Forge string
+
+const (
+	ForgeGitHub Forge = "github"
+	ForgeGitLab Forge = "gitlab"
+)
+
+// ForgeAccount сопоставляет логин на хостинге с пользователем сервиса.
+type ForgeAccount struct {
+	Forge  Forge
+	Login  string
+	UserID string
+}
+
+type ForgePRAction string
+
+const (
+	ForgePROpened ForgePRAction = "opened"
+	ForgePRMerged ForgePRAction = "merged"
+	// ForgePRClosed — PR закрыт без мержа.
+	ForgePRClosed ForgePRAction = "closed"
+)
+
+// ForgePREvent — событие о PR из вебхука хостинга.
+type ForgePREvent struct {
+	Forge  Forge
+	Action ForgePRAction
+	// Repo — полное имя репозитория (owner/repo, group/project), Number — номер PR в нём
+	Repo        string
+	Number      int64
+	Title       string
+	AuthorLogin string
+}
+
+// PullRequestID — id, под которым PR из вебхука хранится в сервисе:
+// github:acme/billing#42, gitlab:acme/billing!7.
+func (e ForgePREvent) PullRequestID() string {
+	sep := "#"
+	if e.Forge == ForgeGitLab {
+		sep = "!"
+	}
+	return fmt.Sprintf("%s:%s%s%d", e.Forge, e.Repo, sep, e.Number)
+}
+
+// ParseForgePRID разбирает id, полученный из PullRequestID. ok == false — PR создан не из вебхука.
+func ParseForgePRID(prID string) (forge Forge, repo string, number int64, ok bool) {
+	name, rest, found := strings.Cut(prID, ":")
+	if !found {
+		return "", "", 0, false
+	}
+
+	forge = Forge(name)
+	sep := "#"
+	switch forge {
+	case ForgeGitHub:
+	case ForgeGitLab:
+		sep = "!"
+	default:
+		return "", "", 0, false
+	}
+
+	i := strings.LastIndex(rest, sep)
+	if i <= 0 {
+		return "", "", 0, false
+	}
+	number, err := strconv.ParseInt(rest[i+1:], 10, 64)
+	if err != nil || number <= 0 {
+		return "", "", 0, false
+	}
+
+	return forge, rest[:i], number, true
+}
+
+type ForgeEventOutcome string
+
+const (
+	ForgeEventCreated ForgeEventOutcome = "created"
+	ForgeEventMerged  ForgeEventOutcome = "merged"
+	ForgeEventIgnored ForgeEventOutcome = "ignored"
+)
+
+// ForgeEventResult — что сервис сделал по событию вебхука.
+type ForgeEventResult struct {
+	Outcome ForgeEventOutcome
+	// Reason — почему событие пропущено, для ForgeEventIgnored
+	Reason string
+	// PR — созданный или смерженный PR
+	PR *PullRequest
+}
+
+// ForgeSyncJob — отложенная отправка изменений ревьюверов PR на git-хостинг.
+// Add и Remove — id пользователей; логины подставляются в момент отправки.
+// Add остаётся для истории: на хостинг всегда запрашивается текущий состав ревьюверов PR,
+// а из Remove снимаются только те, кого в нём уже нет.
+type ForgeSyncJob struct {
+	ID            int64
+	PullRequestID string
+	Add           []string
+	Remove        []string
+
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+}
+
+// ForgeSyncRunResult — итог одного прохода очереди отправки.
+type ForgeSyncRunResult struct {
+	Sent    int
+	Retried int
+	// Dropped — задания, от которых отказались: хостинг отклонил запрос или кончились попытки
+	Dropped int
+}
+
+// ErrForgeRejected — хостинг отклонил запрос, и повторять его бессмысленно.
+var ErrForgeRejected = errors.New("forge rejected request")
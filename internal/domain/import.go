@@ -0,0 +1,35 @@
+package domain
+
+// ImportRecordType — тип строки NDJSON импорта и экспорта.
+type ImportRecordType string
+
+const (
+	ImportRecordTeam        ImportRecordType = "team"
+	ImportRecordUser        ImportRecordType = "user"
+	ImportRecordPullRequest ImportRecordType = "pull_request"
+)
+
+// ImportRecord — одна строка импорта или экспорта. Заполнено поле, соответствующее Type.
+type ImportRecord struct {
+	// Line — номер строки во входных данных, начиная с 1. При экспорте не заполняется.
+	Line     int
+	Type     ImportRecordType
+	TeamName string
+	User     User
+	PR       PullRequest
+}
+
+// ImportLineError — причина, по которой строка импорта пропущена.
+type ImportLineError struct {
+	Line    int
+	Code    ErrorCode
+	Message string
+}
+
+// ImportResult — число применённых записей каждого типа и ошибки по пропущенным строкам.
+type ImportResult struct {
+	Teams        int
+	Users        int
+	PullRequests int
+	Errors       []ImportLineError
+}
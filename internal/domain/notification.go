@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// NotificationEvent — событие, о котором ревьюверам пишут в чат.
+type NotificationEvent string
+
+const (
+	// NotificationAssigned — ревьюверы назначены на новый PR.
+	NotificationAssigned NotificationEvent = "assigned"
+	// NotificationReplaced — ревьювер PR заменён другим.
+	NotificationReplaced NotificationEvent = "replaced"
+	// NotificationStale — PR завис без активности.
+	NotificationStale NotificationEvent = "stale"
+)
+
+var NotificationEvents = []NotificationEvent{NotificationAssigned, NotificationReplaced, NotificationStale}
+
+// TeamNotifications — куда отправлять уведомления о PR авторов команды.
+// Пустой WebhookURL отключает уведомления.
+type TeamNotifications struct {
+	TeamName string
+	// WebhookURL — входящий вебхук Slack или Mattermost
+	WebhookURL string
+	// Channel переопределяет канал вебхука, если чат это позволяет
+	Channel string
+	// Templates — шаблоны text/template по событиям; для событий без шаблона используется встроенный
+	Templates map[NotificationEvent]string
+}
+
+// Notification — данные, доступные в шаблоне сообщения.
+type Notification struct {
+	Event           NotificationEvent
+	TeamName        string
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	// Reviewers — ревьюверы, которым адресовано сообщение; отказавшиеся от уведомлений исключены
+	Reviewers []string
+	// OldReviewerID — заменённый ревьювер, для NotificationReplaced
+	OldReviewerID string
+	// LastActivityAt и StaleAfter — для NotificationStale
+	LastActivityAt time.Time
+	StaleAfter     time.Duration
+}
+
+// ChatMessage — готовое сообщение для входящего вебхука чата.
+type ChatMessage struct {
+	WebhookURL string
+	Channel    string
+	Text       string
+}
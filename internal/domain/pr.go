@@ -25,3 +25,19 @@ type PullRequestShort struct {
 	AuthorID        string
 	Status          PRStatus
 }
+
+// NewPullRequest — PR для пакетного создания.
+type NewPullRequest struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+}
+
+// PRBatchItemResult — итог создания одного PR пачки: созданный PR или причина пропуска.
+type PRBatchItemResult struct {
+	PullRequestID string
+	// PR заполнен, если PR создан.
+	PR *PullRequest
+	// Err заполнен, если PR пропущен.
+	Err *DomainError
+}
@@ -0,0 +1,21 @@
+package domain
+
+// ReviewRuleType — вид правила подбора ревьюверов.
+type ReviewRuleType string
+
+const (
+	// ReviewRuleConflict — UserID не назначается ревьювером на PR, автор которых OtherUserID.
+	ReviewRuleConflict ReviewRuleType = "conflict"
+	// ReviewRulePair — UserID назначается ревьювером только вместе с OtherUserID.
+	// Если у UserID несколько таких правил, достаточно любого из напарников.
+	ReviewRulePair ReviewRuleType = "pair"
+)
+
+// ReviewRule — правило подбора ревьюверов в команде.
+type ReviewRule struct {
+	ID          int64
+	TeamName    string
+	Type        ReviewRuleType
+	UserID      string
+	OtherUserID string
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// StalePR — открытый PR, по которому дольше порога команды не было активности.
+// Активность — создание PR и назначение ревьюверов.
+type StalePR struct {
+	PullRequest    PullRequest
+	TeamName       string
+	LastActivityAt time.Time
+	StaleAfter     time.Duration
+	AutoRotate     bool
+	// MarkedAt — когда PR последний раз отмечался зависшим.
+	MarkedAt *time.Time
+	// OldestReviewerID — ревьювер, назначенный раньше остальных, кандидат на ротацию.
+	OldestReviewerID string
+}
+
+// IsNewlyStale сообщает, что после последней активности PR ещё не отмечался зависшим.
+func (p StalePR) IsNewlyStale() bool {
+	return p.MarkedAt == nil || p.MarkedAt.Before(p.LastActivityAt)
+}
+
+type StaleRunResult struct {
+	Detected int
+	Rotated  int
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type UserAssignmentsStat struct {
 	UserID                 string
 	ReviewAssignmentsCount int
@@ -11,7 +13,121 @@ type PRStatusCounts struct {
 	Total  int
 }
 
+type TeamStats struct {
+	TeamName       string
+	PRStatusCounts PRStatusCounts
+}
+
+// StatsBucket — число PR, созданных и смерженных за период, начинающийся в Start.
+type StatsBucket struct {
+	Start   time.Time
+	Created int
+	Merged  int
+}
+
 type Stats struct {
 	AssignmentsByUser []UserAssignmentsStat
 	PRStatusCounts    PRStatusCounts
+	ByTeam            []TeamStats
+	TimeSeries        []StatsBucket
+}
+
+type StatsGranularity string
+
+const (
+	StatsGranularityDay  StatsGranularity = "day"
+	StatsGranularityWeek StatsGranularity = "week"
+)
+
+// StatsFilter ограничивает выборку PR для статистики. Пустые поля не ограничивают.
+// Команда PR — текущая команда автора. Границы периодов: [From, To).
+type StatsFilter struct {
+	TeamName    string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+}
+
+// BucketStart возвращает начало периода в UTC, в который попадает t. Неделя начинается с понедельника.
+func (g StatsGranularity) BucketStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	if g == StatsGranularityWeek {
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	}
+
+	return day
+}
+
+// Next возвращает начало следующего периода.
+func (g StatsGranularity) Next(start time.Time) time.Time {
+	if g == StatsGranularityWeek {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// PRTiming — сроки жизни PR и текущая команда его автора.
+type PRTiming struct {
+	PullRequestID string
+	TeamName      string
+	Reviewers     []string
+	CreatedAt     time.Time
+	MergedAt      *time.Time
+}
+
+// DurationSummary — распределение длительностей. Перцентили считаются по методу ближайшего ранга.
+type DurationSummary struct {
+	Count int
+	Mean  time.Duration
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+}
+
+type TeamCycleTime struct {
+	TeamName  string
+	CycleTime DurationSummary
+}
+
+type ReviewerCycleTime struct {
+	UserID    string
+	CycleTime DurationSummary
+}
+
+// AgeBucket — число открытых PR с возрастом до UpperBound включительно
+// (и больше границы предыдущей корзины). Нулевой UpperBound — корзина без верхней границы.
+type AgeBucket struct {
+	UpperBound time.Duration
+	Count      int
+}
+
+type OpenPRAge struct {
+	Age     DurationSummary
+	Buckets []AgeBucket
+}
+
+// CycleTimeStats — время от создания до мержа PR и возраст ещё открытых PR.
+type CycleTimeStats struct {
+	Overall    DurationSummary
+	ByTeam     []TeamCycleTime
+	ByReviewer []ReviewerCycleTime
+	OpenAge    OpenPRAge
+}
+
+// CycleTimeBuckets — верхние границы корзин распределения возраста PR.
+// Те же границы используются в гистограммах Prometheus.
+var CycleTimeBuckets = []time.Duration{
+	time.Hour,
+	4 * time.Hour,
+	12 * time.Hour,
+	24 * time.Hour,
+	2 * 24 * time.Hour,
+	3 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+	14 * 24 * time.Hour,
+	30 * 24 * time.Hour,
 }
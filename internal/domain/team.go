@@ -1,12 +1,31 @@
 package domain
 
+import "time"
+
 type TeamMember struct {
 	UserID   string
 	Username string
 	IsActive bool
+	// Seniority — уровень участника; пустое значение при обновлении сохраняет прежний.
+	Seniority Seniority
 }
 
 type Team struct {
 	TeamName string
 	Members  []TeamMember
 }
+
+// TeamSettings — настройки команды. Нулевые значения означают поведение сервиса по умолчанию.
+type TeamSettings struct {
+	TeamName string
+	// StaleAfter — сколько открытый PR может простоять без активности, прежде чем считаться зависшим.
+	StaleAfter time.Duration
+	// StaleAutoRotate — заменять ли на зависшем PR ревьювера, назначенного раньше остальных.
+	StaleAutoRotate bool
+	// ReviewerTeams — другие команды, участников которых автор может запросить в ревьюверы.
+	ReviewerTeams []string
+	// MinSeniorReviewers — сколько ревьюверов PR должны быть сеньорами.
+	MinSeniorReviewers int
+	// ForgeSync — отправлять ли назначенных ревьюверов в PR на git-хостинге.
+	ForgeSync bool
+}
@@ -1,8 +1,18 @@
 package domain
 
+// Seniority — уровень пользователя; пустое значение — уровень не указан.
+type Seniority string
+
+const (
+	SeniorityJunior Seniority = "junior"
+	SeniorityMiddle Seniority = "middle"
+	SenioritySenior Seniority = "senior"
+)
+
 type User struct {
-	UserID   string
-	Username string
-	TeamName string
-	IsActive bool
+	UserID    string
+	Username  string
+	TeamName  string
+	IsActive  bool
+	Seniority Seniority
 }
@@ -0,0 +1,48 @@
+// Package forge разбирает вебхуки GitHub и GitLab о pull/merge request'ах,
+// проверяет их подлинность и отправляет назначенных ревьюверов обратно в GitHub.
+package forge
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+// VerifyGitHubSignature проверяет заголовок X-Hub-Signature-256 — HMAC-SHA256 тела с секретом вебхука.
+// С пустым секретом подпись не принимается.
+func VerifyGitHubSignature(secret, signature string, body []byte) bool {
+	if secret == "" {
+		return false
+	}
+
+	sum, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(sum)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// SignGitHub возвращает значение X-Hub-Signature-256 для тела; нужен для тестов и ручной отправки вебхуков.
+func SignGitHub(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyGitLabToken сравнивает заголовок X-Gitlab-Token с секретом вебхука.
+// С пустым секретом токен не принимается.
+func VerifyGitLabToken(secret, token string) bool {
+	if secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
@@ -0,0 +1,116 @@
+package forge
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func TestVerifyGitHubSignature(t *testing.T) {
+	body := fixture(t, "github_pull_request_opened.json")
+	sig := SignGitHub("s3cret", body)
+
+	require.True(t, VerifyGitHubSignature("s3cret", sig, body))
+	require.False(t, VerifyGitHubSignature("other", sig, body))
+	require.False(t, VerifyGitHubSignature("s3cret", sig, append(body, ' ')))
+	require.False(t, VerifyGitHubSignature("s3cret", sig[len("sha256="):], body))
+	require.False(t, VerifyGitHubSignature("s3cret", "sha256=zz", body))
+	require.False(t, VerifyGitHubSignature("", SignGitHub("", body), body))
+}
+
+func TestVerifyGitLabToken(t *testing.T) {
+	require.True(t, VerifyGitLabToken("s3cret", "s3cret"))
+	require.False(t, VerifyGitLabToken("s3cret", "s3cre"))
+	require.False(t, VerifyGitLabToken("", ""))
+}
+
+func TestParseGitHub(t *testing.T) {
+	opened := domain.ForgePREvent{
+		Forge:       domain.ForgeGitHub,
+		Action:      domain.ForgePROpened,
+		Repo:        "acme/billing",
+		Number:      42,
+		Title:       "Add invoice export",
+		AuthorLogin: "Alice-Dev",
+	}
+	merged, closed := opened, opened
+	merged.Action = domain.ForgePRMerged
+	closed.Action = domain.ForgePRClosed
+
+	tests := []struct {
+		event   string
+		fixture string
+		want    domain.ForgePREvent
+		ok      bool
+	}{
+		{"pull_request", "github_pull_request_opened.json", opened, true},
+		{"pull_request", "github_pull_request_merged.json", merged, true},
+		{"pull_request", "github_pull_request_closed.json", closed, true},
+		{"ping", "github_ping.json", domain.ForgePREvent{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			ev, ok, err := ParseGitHub(tt.event, fixture(t, tt.fixture))
+			require.NoError(t, err)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.want, ev)
+		})
+	}
+
+	_, _, err := ParseGitHub("pull_request", []byte(`{"action":`))
+	require.Error(t, err)
+
+	_, _, err = ParseGitHub("pull_request", []byte(`{"action":"opened"}`))
+	require.Error(t, err)
+}
+
+func TestParseGitLab(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    domain.ForgePREvent
+		ok      bool
+	}{
+		{"gitlab_merge_request_open.json", domain.ForgePREvent{
+			Forge:       domain.ForgeGitLab,
+			Action:      domain.ForgePROpened,
+			Repo:        "acme/platform/billing",
+			Number:      7,
+			Title:       "Add invoice export",
+			AuthorLogin: "alice.dev",
+		}, true},
+		{"gitlab_merge_request_merge.json", domain.ForgePREvent{
+			Forge:       domain.ForgeGitLab,
+			Action:      domain.ForgePRMerged,
+			Repo:        "acme/platform/billing",
+			Number:      7,
+			Title:       "Add invoice export",
+			AuthorLogin: "bob.ops",
+		}, true},
+		{"gitlab_merge_request_update.json", domain.ForgePREvent{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			ev, ok, err := ParseGitLab(GitLabMergeRequestEvent, fixture(t, tt.fixture))
+			require.NoError(t, err)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.want, ev)
+		})
+	}
+
+	_, ok, err := ParseGitLab("Push Hook", fixture(t, "gitlab_merge_request_open.json"))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,139 @@
+// Package forgetest содержит поддельный GitHub API для тестов отправки ревьюверов.
+package forgetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Request — запрос к requested_reviewers, принятый поддельным сервером.
+type Request struct {
+	Method    string
+	Repo      string
+	Number    int64
+	Reviewers []string
+	// Token — значение из заголовка Authorization без префикса Bearer
+	Token string
+}
+
+// GitHubServer отвечает на POST и DELETE /repos/{owner}/{repo}/pulls/{n}/requested_reviewers
+// и хранит запрошенных ревьюверов каждого PR. Остальные пути отдают 404.
+type GitHubServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	requests  []Request
+	reviewers map[string][]string
+	failures  []int
+}
+
+// NewGitHubServer запускает сервер; остановить его нужно через Close.
+func NewGitHubServer() *GitHubServer {
+	s := &GitHubServer{reviewers: make(map[string][]string)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Fail заставляет сервер ответить status на n следующих запросов; сами запросы записываются.
+func (s *GitHubServer) Fail(n, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for range n {
+		s.failures = append(s.failures, status)
+	}
+}
+
+// Requests возвращает принятые запросы в порядке поступления.
+func (s *GitHubServer) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+// Reviewers возвращает запрошенных ревьюверов PR после всех успешных запросов.
+func (s *GitHubServer) Reviewers(repo string, number int64) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.reviewers[prKey(repo, number)])
+}
+
+func (s *GitHubServer) serve(w http.ResponseWriter, r *http.Request) {
+	repo, number, ok := parsePath(r.URL.Path)
+	if !ok || (r.Method != http.MethodPost && r.Method != http.MethodDelete) {
+		http.NotFound(w, r)
+		return
+	}
+
+	var body struct {
+		Reviewers []string `json:"reviewers"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, `{"message":"Problems parsing JSON"}`, http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{
+		Method:    r.Method,
+		Repo:      repo,
+		Number:    number,
+		Reviewers: body.Reviewers,
+		Token:     strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "),
+	})
+
+	if len(s.failures) > 0 {
+		status := s.failures[0]
+		s.failures = s.failures[1:]
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"message":"injected failure"}`))
+		return
+	}
+
+	key := prKey(repo, number)
+	current := s.reviewers[key]
+	status := http.StatusOK
+	if r.Method == http.MethodPost {
+		for _, login := range body.Reviewers {
+			if !slices.Contains(current, login) {
+				current = append(current, login)
+			}
+		}
+		status = http.StatusCreated
+	} else {
+		current = slices.DeleteFunc(current, func(login string) bool {
+			return slices.Contains(body.Reviewers, login)
+		})
+	}
+	s.reviewers[key] = current
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"number": number})
+}
+
+// parsePath разбирает /repos/{owner}/{repo}/pulls/{n}/requested_reviewers.
+func parsePath(path string) (repo string, number int64, ok bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != 6 || parts[0] != "repos" || parts[3] != "pulls" || parts[5] != "requested_reviewers" {
+		return "", 0, false
+	}
+	number, err := strconv.ParseInt(parts[4], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return parts[1] + "/" + parts[2], number, true
+}
+
+func prKey(repo string, number int64) string {
+	return repo + "#" + strconv.FormatInt(number, 10)
+}
@@ -0,0 +1,64 @@
+package forge
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+// GitHubPullRequestEvent — значение X-GitHub-Event для событий о PR.
+const GitHubPullRequestEvent = "pull_request"
+
+type githubPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int64  `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// ParseGitHub разбирает тело вебхука GitHub с типом события eventType (X-GitHub-Event).
+// ok == false — событие не про открытие или закрытие PR, и его можно пропустить.
+func ParseGitHub(eventType string, body []byte) (ev domain.ForgePREvent, ok bool, err error) {
+	if eventType != GitHubPullRequestEvent {
+		return domain.ForgePREvent{}, false, nil
+	}
+
+	var p githubPullRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return domain.ForgePREvent{}, false, fmt.Errorf("invalid github payload: %w", err)
+	}
+
+	var action domain.ForgePRAction
+	switch {
+	case p.Action == "opened" || p.Action == "reopened":
+		action = domain.ForgePROpened
+	case p.Action == "closed" && p.PullRequest.Merged:
+		action = domain.ForgePRMerged
+	case p.Action == "closed":
+		action = domain.ForgePRClosed
+	default:
+		return domain.ForgePREvent{}, false, nil
+	}
+
+	if p.Repository.FullName == "" || p.Number <= 0 {
+		return domain.ForgePREvent{}, false, fmt.Errorf("github payload has no repository or pull request number")
+	}
+
+	return domain.ForgePREvent{
+		Forge:       domain.ForgeGitHub,
+		Action:      action,
+		Repo:        p.Repository.FullName,
+		Number:      p.Number,
+		Title:       p.PullRequest.Title,
+		AuthorLogin: p.PullRequest.User.Login,
+	}, true, nil
+}
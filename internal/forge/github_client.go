@@ -0,0 +1,97 @@
+package forge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+// GitHubAPIVersion — версия REST API, под которую написан клиент.
+const GitHubAPIVersion = "2022-11-28"
+
+// GitHubClient запрашивает и снимает ревьюверов PR через REST API GitHub.
+type GitHubClient struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// NewGitHubClient создаёт клиент; baseURL — https://api.github.com или адрес GitHub Enterprise (…/api/v3).
+func NewGitHubClient(baseURL, token string) *GitHubClient {
+	return &GitHubClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (c *GitHubClient) Forge() domain.Forge {
+	return domain.ForgeGitHub
+}
+
+// RequestReviewers добавляет логины в запрошенные ревьюверы PR.
+func (c *GitHubClient) RequestReviewers(ctx context.Context, repo string, number int64, logins []string) error {
+	return c.requestedReviewers(ctx, http.MethodPost, repo, number, logins)
+}
+
+// RemoveReviewers снимает запрос ревью с логинов.
+func (c *GitHubClient) RemoveReviewers(ctx context.Context, repo string, number int64, logins []string) error {
+	return c.requestedReviewers(ctx, http.MethodDelete, repo, number, logins)
+}
+
+func (c *GitHubClient) requestedReviewers(ctx context.Context, method, repo string, number int64, logins []string) error {
+	body, err := json.Marshal(struct {
+		Reviewers []string `json:"reviewers"`
+	}{logins})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/pulls/%d/requested_reviewers", c.baseURL, repo, number)
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", GitHubAPIVersion)
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 300 {
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = fmt.Errorf("github %s %s: %s: %s", method, url, resp.Status, bytes.TrimSpace(msg))
+	if isPermanent(resp) {
+		return fmt.Errorf("%w: %w", domain.ErrForgeRejected, err)
+	}
+	return err
+}
+
+// isPermanent — ответы, после которых повтор того же запроса ничего не изменит.
+// Исчерпанный rate limit GitHub отдаёт как 403 с X-RateLimit-Remaining: 0, его стоит повторить.
+func isPermanent(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	case http.StatusForbidden:
+		return resp.Header.Get("X-RateLimit-Remaining") != "0"
+	}
+	return resp.StatusCode >= 400 && resp.StatusCode < 500
+}
@@ -0,0 +1,62 @@
+package forge
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/forge/forgetest"
+)
+
+func TestGitHubClient_RequestAndRemoveReviewers(t *testing.T) {
+	srv := forgetest.NewGitHubServer()
+	t.Cleanup(srv.Close)
+	client := NewGitHubClient(srv.URL+"/", "tok")
+	ctx := context.Background()
+
+	require.NoError(t, client.RequestReviewers(ctx, "acme/billing", 42, []string{"bob", "carol"}))
+	require.NoError(t, client.RemoveReviewers(ctx, "acme/billing", 42, []string{"bob"}))
+
+	require.Equal(t, []string{"carol"}, srv.Reviewers("acme/billing", 42))
+	require.Equal(t, []forgetest.Request{
+		{Method: http.MethodPost, Repo: "acme/billing", Number: 42, Reviewers: []string{"bob", "carol"}, Token: "tok"},
+		{Method: http.MethodDelete, Repo: "acme/billing", Number: 42, Reviewers: []string{"bob"}, Token: "tok"},
+	}, srv.Requests())
+}
+
+func TestGitHubClient_Errors(t *testing.T) {
+	srv := forgetest.NewGitHubServer()
+	t.Cleanup(srv.Close)
+	client := NewGitHubClient(srv.URL, "tok")
+	ctx := context.Background()
+
+	tests := []struct {
+		status   int
+		rejected bool
+	}{
+		{http.StatusUnprocessableEntity, true},
+		{http.StatusNotFound, true},
+		{http.StatusForbidden, true},
+		{http.StatusTooManyRequests, false},
+		{http.StatusBadGateway, false},
+	}
+
+	for _, tt := range tests {
+		srv.Fail(1, tt.status)
+
+		err := client.RequestReviewers(ctx, "acme/billing", 42, []string{"bob"})
+		require.Error(t, err, tt.status)
+		require.Equal(t, tt.rejected, errors.Is(err, domain.ErrForgeRejected), tt.status)
+	}
+
+	require.Empty(t, srv.Reviewers("acme/billing", 42))
+
+	closed := NewGitHubClient("http://127.0.0.1:1", "tok")
+	err := closed.RequestReviewers(ctx, "acme/billing", 42, []string{"bob"})
+	require.Error(t, err)
+	require.False(t, errors.Is(err, domain.ErrForgeRejected))
+}
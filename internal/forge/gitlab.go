@@ -0,0 +1,64 @@
+package forge
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+// GitLabMergeRequestEvent — значение X-Gitlab-Event для событий о merge request'ах.
+const GitLabMergeRequestEvent = "Merge Request Hook"
+
+type gitlabMergeRequestPayload struct {
+	// User — кто совершил действие; для open и reopen это автор MR
+	User struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID    int64  `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+	} `json:"object_attributes"`
+}
+
+// ParseGitLab разбирает тело вебхука GitLab с типом события eventType (X-Gitlab-Event).
+// ok == false — событие не про открытие или закрытие MR, и его можно пропустить.
+func ParseGitLab(eventType string, body []byte) (ev domain.ForgePREvent, ok bool, err error) {
+	if eventType != GitLabMergeRequestEvent {
+		return domain.ForgePREvent{}, false, nil
+	}
+
+	var p gitlabMergeRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return domain.ForgePREvent{}, false, fmt.Errorf("invalid gitlab payload: %w", err)
+	}
+
+	var action domain.ForgePRAction
+	switch p.ObjectAttributes.Action {
+	case "open", "reopen":
+		action = domain.ForgePROpened
+	case "merge":
+		action = domain.ForgePRMerged
+	case "close":
+		action = domain.ForgePRClosed
+	default:
+		return domain.ForgePREvent{}, false, nil
+	}
+
+	if p.Project.PathWithNamespace == "" || p.ObjectAttributes.IID <= 0 {
+		return domain.ForgePREvent{}, false, fmt.Errorf("gitlab payload has no project or merge request iid")
+	}
+
+	return domain.ForgePREvent{
+		Forge:       domain.ForgeGitLab,
+		Action:      action,
+		Repo:        p.Project.PathWithNamespace,
+		Number:      p.ObjectAttributes.IID,
+		Title:       p.ObjectAttributes.Title,
+		AuthorLogin: p.User.Username,
+	}, true, nil
+}
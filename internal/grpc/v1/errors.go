@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+// errorDomain — поле domain в google.rpc.ErrorInfo ошибок сервиса.
+const errorDomain = "pr-reviewer-service"
+
+func mapDomainErrorToCode(code domain.ErrorCode) codes.Code {
+	switch code {
+	case domain.ErrorCodeTeamExists:
+		return codes.AlreadyExists
+	case domain.ErrorCodePRExists:
+		return codes.AlreadyExists
+	case domain.ErrorCodePRMerged:
+		return codes.FailedPrecondition
+	case domain.ErrorCodeNotAssigned:
+		return codes.FailedPrecondition
+	case domain.ErrorCodeNoCandidate:
+		return codes.FailedPrecondition
+	case domain.ErrorCodeNotFound:
+		return codes.NotFound
+	case domain.ErrorCodeBadRequest:
+		return codes.InvalidArgument
+	default:
+		return codes.Internal
+	}
+}
+
+// newStatusError возвращает статус gRPC с кодом домена в ErrorInfo.Reason,
+// чтобы клиент мог различать, например, PR_MERGED и NOT_ASSIGNED.
+func newStatusError(code domain.ErrorCode, msg string) error {
+	st := status.New(mapDomainErrorToCode(code), msg)
+	if withInfo, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(code),
+		Domain: errorDomain,
+	}); err == nil {
+		st = withInfo
+	}
+	return st.Err()
+}
+
+// toStatusError переводит ошибку юзкейса в статус gRPC; подробности внутренних ошибок не раскрываются.
+func toStatusError(err error) error {
+	var derr *domain.DomainError
+	if errors.As(err, &derr) {
+		return newStatusError(derr.Code, derr.Error())
+	}
+
+	return newStatusError(domain.ErrorCodeInternal, "internal server error")
+}
@@ -0,0 +1,234 @@
+package v1
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	reviewerv1 "github.com/alnoi/pr-reviewer-service/pkg/api/reviewer/v1"
+)
+
+func timestampPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// timeValue возвращает nil для незаданной метки, чтобы она не ограничивала фильтр.
+func timeValue(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func toProtoSeniority(s domain.Seniority) reviewerv1.Seniority {
+	switch s {
+	case domain.SeniorityJunior:
+		return reviewerv1.Seniority_SENIORITY_JUNIOR
+	case domain.SeniorityMiddle:
+		return reviewerv1.Seniority_SENIORITY_MIDDLE
+	case domain.SenioritySenior:
+		return reviewerv1.Seniority_SENIORITY_SENIOR
+	default:
+		return reviewerv1.Seniority_SENIORITY_UNSPECIFIED
+	}
+}
+
+// fromProtoSeniority возвращает пустой уровень для UNSPECIFIED; неизвестное значение
+// передаётся как есть и отклоняется проверкой в юзкейсе.
+func fromProtoSeniority(s reviewerv1.Seniority) domain.Seniority {
+	switch s {
+	case reviewerv1.Seniority_SENIORITY_UNSPECIFIED:
+		return ""
+	case reviewerv1.Seniority_SENIORITY_JUNIOR:
+		return domain.SeniorityJunior
+	case reviewerv1.Seniority_SENIORITY_MIDDLE:
+		return domain.SeniorityMiddle
+	case reviewerv1.Seniority_SENIORITY_SENIOR:
+		return domain.SenioritySenior
+	default:
+		return domain.Seniority(s.String())
+	}
+}
+
+func toProtoPRStatus(s domain.PRStatus) reviewerv1.PullRequestStatus {
+	switch s {
+	case domain.PRStatusOpen:
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case domain.PRStatusMerged:
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	default:
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoTeam(t domain.Team) *reviewerv1.Team {
+	members := make([]*reviewerv1.TeamMember, 0, len(t.Members))
+	for _, m := range t.Members {
+		members = append(members, &reviewerv1.TeamMember{
+			UserId:    m.UserID,
+			Username:  m.Username,
+			IsActive:  m.IsActive,
+			Seniority: toProtoSeniority(m.Seniority),
+		})
+	}
+
+	return &reviewerv1.Team{
+		TeamName: t.TeamName,
+		Members:  members,
+	}
+}
+
+func fromProtoTeamMember(m *reviewerv1.TeamMember) domain.TeamMember {
+	return domain.TeamMember{
+		UserID:    m.GetUserId(),
+		Username:  m.GetUsername(),
+		IsActive:  m.GetIsActive(),
+		Seniority: fromProtoSeniority(m.GetSeniority()),
+	}
+}
+
+func toProtoUser(u domain.User) *reviewerv1.User {
+	return &reviewerv1.User{
+		UserId:    u.UserID,
+		Username:  u.Username,
+		TeamName:  u.TeamName,
+		IsActive:  u.IsActive,
+		Seniority: toProtoSeniority(u.Seniority),
+	}
+}
+
+func toProtoPR(pr domain.PullRequest) *reviewerv1.PullRequest {
+	return &reviewerv1.PullRequest{
+		PullRequestId:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorID,
+		Status:            toProtoPRStatus(pr.Status),
+		AssignedReviewers: append([]string{}, pr.AssignedReviewers...),
+		CreatedAt:         timestamppb.New(pr.CreatedAt),
+		MergedAt:          timestampPtr(pr.MergedAt),
+	}
+}
+
+func toProtoPRShort(pr domain.PullRequestShort) *reviewerv1.PullRequestShort {
+	return &reviewerv1.PullRequestShort{
+		PullRequestId:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorID,
+		Status:          toProtoPRStatus(pr.Status),
+	}
+}
+
+func toProtoTeamSettings(s domain.TeamSettings) *reviewerv1.TeamSettings {
+	return &reviewerv1.TeamSettings{
+		TeamName:           s.TeamName,
+		StaleAfter:         durationpb.New(s.StaleAfter),
+		StaleAutoRotate:    s.StaleAutoRotate,
+		ReviewerTeams:      append([]string{}, s.ReviewerTeams...),
+		MinSeniorReviewers: int32(s.MinSeniorReviewers),
+		ForgeSync:          s.ForgeSync,
+	}
+}
+
+func fromProtoTeamSettings(s *reviewerv1.TeamSettings) domain.TeamSettings {
+	return domain.TeamSettings{
+		TeamName:           s.GetTeamName(),
+		StaleAfter:         s.GetStaleAfter().AsDuration(),
+		StaleAutoRotate:    s.GetStaleAutoRotate(),
+		ReviewerTeams:      s.GetReviewerTeams(),
+		MinSeniorReviewers: int(s.GetMinSeniorReviewers()),
+		ForgeSync:          s.GetForgeSync(),
+	}
+}
+
+func toProtoPRReviewersUpdate(u domain.PRReviewersUpdate) *reviewerv1.PRReviewersUpdate {
+	return &reviewerv1.PRReviewersUpdate{
+		PullRequestId: u.PullRequestID,
+		Removed:       append([]string{}, u.Removed...),
+		Added:         append([]string{}, u.Added...),
+		Reviewers:     append([]string{}, u.Reviewers...),
+	}
+}
+
+func toProtoStalePR(p domain.StalePR) *reviewerv1.StalePullRequest {
+	return &reviewerv1.StalePullRequest{
+		Pr:             toProtoPR(p.PullRequest),
+		TeamName:       p.TeamName,
+		LastActivityAt: timestamppb.New(p.LastActivityAt),
+		StaleAfter:     durationpb.New(p.StaleAfter),
+		MarkedAt:       timestampPtr(p.MarkedAt),
+	}
+}
+
+func fromProtoPRBatchItems(items []*reviewerv1.NewPullRequest) []domain.NewPullRequest {
+	res := make([]domain.NewPullRequest, 0, len(items))
+	for _, it := range items {
+		res = append(res, domain.NewPullRequest{
+			PullRequestID:   it.GetPullRequestId(),
+			PullRequestName: it.GetPullRequestName(),
+			AuthorID:        it.GetAuthorId(),
+		})
+	}
+	return res
+}
+
+func toProtoPRBatchResult(r domain.PRBatchItemResult) *reviewerv1.PullRequestBatchResult {
+	res := &reviewerv1.PullRequestBatchResult{PullRequestId: r.PullRequestID}
+	if r.PR != nil {
+		res.Pr = toProtoPR(*r.PR)
+	}
+	if r.Err != nil {
+		res.Error = &reviewerv1.PullRequestBatchError{
+			Code:    string(r.Err.Code),
+			Message: r.Err.Message,
+		}
+	}
+	return res
+}
+
+func fromProtoStatsFilter(f *reviewerv1.StatsFilter) domain.StatsFilter {
+	return domain.StatsFilter{
+		TeamName:    f.GetTeamName(),
+		CreatedFrom: timeValue(f.GetCreatedFrom()),
+		CreatedTo:   timeValue(f.GetCreatedTo()),
+		MergedFrom:  timeValue(f.GetMergedFrom()),
+		MergedTo:    timeValue(f.GetMergedTo()),
+	}
+}
+
+// fromProtoGranularity возвращает ok == false для неизвестного значения.
+func fromProtoGranularity(g reviewerv1.StatsGranularity) (domain.StatsGranularity, bool) {
+	switch g {
+	case reviewerv1.StatsGranularity_STATS_GRANULARITY_UNSPECIFIED:
+		return "", true
+	case reviewerv1.StatsGranularity_STATS_GRANULARITY_DAY:
+		return domain.StatsGranularityDay, true
+	case reviewerv1.StatsGranularity_STATS_GRANULARITY_WEEK:
+		return domain.StatsGranularityWeek, true
+	default:
+		return "", false
+	}
+}
+
+func toProtoStatusCounts(c domain.PRStatusCounts) *reviewerv1.PRStatusCounts {
+	return &reviewerv1.PRStatusCounts{
+		Open:   int32(c.Open),
+		Merged: int32(c.Merged),
+		Total:  int32(c.Total),
+	}
+}
+
+func toProtoDurationSummary(d domain.DurationSummary) *reviewerv1.DurationSummary {
+	return &reviewerv1.DurationSummary{
+		Count: int32(d.Count),
+		Mean:  durationpb.New(d.Mean),
+		P50:   durationpb.New(d.P50),
+		P90:   durationpb.New(d.P90),
+		P99:   durationpb.New(d.P99),
+	}
+}
@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	applog "github.com/alnoi/pr-reviewer-service/internal/logger"
+	reviewerv1 "github.com/alnoi/pr-reviewer-service/pkg/api/reviewer/v1"
+)
+
+func (s *Server) CreatePullRequest(ctx context.Context, req *reviewerv1.CreatePullRequestRequest) (*reviewerv1.CreatePullRequestResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("CreatePullRequest called")
+
+	if req.GetPullRequestId() == "" || req.GetPullRequestName() == "" || req.GetAuthorId() == "" {
+		log.Warn("invalid data in CreatePullRequest", zap.String("pull_request_id", req.GetPullRequestId()), zap.String("pull_request_name", req.GetPullRequestName()), zap.String("author_id", req.GetAuthorId()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "pull_request_id, pull_request_name and author_id are required")
+	}
+
+	pr, unmetTags, err := s.prUC.CreatePR(
+		ctx,
+		req.GetPullRequestId(),
+		req.GetPullRequestName(),
+		req.GetAuthorId(),
+		req.GetRequestedReviewers(),
+		req.GetChangedPaths(),
+		req.GetRequiredTags(),
+	)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.CreatePullRequestResponse{
+		Pr:        toProtoPR(pr),
+		UnmetTags: append([]string{}, unmetTags...),
+	}, nil
+}
+
+func (s *Server) CreatePullRequestBatch(ctx context.Context, req *reviewerv1.CreatePullRequestBatchRequest) (*reviewerv1.CreatePullRequestBatchResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("CreatePullRequestBatch called")
+
+	results, err := s.prUC.CreatePRBatch(ctx, fromProtoPRBatchItems(req.GetPullRequests()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	var created int32
+	out := make([]*reviewerv1.PullRequestBatchResult, 0, len(results))
+	for _, r := range results {
+		if r.PR != nil {
+			created++
+		}
+		out = append(out, toProtoPRBatchResult(r))
+	}
+
+	return &reviewerv1.CreatePullRequestBatchResponse{
+		Created: created,
+		Results: out,
+	}, nil
+}
+
+func (s *Server) MergePullRequest(ctx context.Context, req *reviewerv1.MergePullRequestRequest) (*reviewerv1.MergePullRequestResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("MergePullRequest called")
+
+	if req.GetPullRequestId() == "" {
+		log.Warn("invalid data in MergePullRequest", zap.String("pull_request_id", req.GetPullRequestId()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "pull_request_id is required")
+	}
+
+	pr, err := s.prUC.MergePR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.MergePullRequestResponse{Pr: toProtoPR(pr)}, nil
+}
+
+func (s *Server) ReassignReviewer(ctx context.Context, req *reviewerv1.ReassignReviewerRequest) (*reviewerv1.ReassignReviewerResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("ReassignReviewer called")
+
+	if req.GetPullRequestId() == "" || req.GetOldUserId() == "" {
+		log.Warn("invalid data in ReassignReviewer", zap.String("pull_request_id", req.GetPullRequestId()), zap.String("old_user_id", req.GetOldUserId()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "pull_request_id and old_user_id are required")
+	}
+
+	pr, replacedBy, err := s.prUC.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldUserId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.ReassignReviewerResponse{
+		Pr:         toProtoPR(pr),
+		ReplacedBy: replacedBy,
+	}, nil
+}
+
+func (s *Server) SetReviewers(ctx context.Context, req *reviewerv1.SetReviewersRequest) (*reviewerv1.SetReviewersResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("SetReviewers called")
+
+	if req.GetPullRequestId() == "" {
+		log.Warn("invalid data in SetReviewers", zap.String("pull_request_id", req.GetPullRequestId()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "pull_request_id is required")
+	}
+
+	pr, err := s.prUC.SetReviewers(ctx, req.GetPullRequestId(), req.GetReviewers())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.SetReviewersResponse{Pr: toProtoPR(pr)}, nil
+}
+
+func (s *Server) ListStalePullRequests(req *reviewerv1.ListStalePullRequestsRequest, stream reviewerv1.PullRequestService_ListStalePullRequestsServer) error {
+	ctx := stream.Context()
+	log := applog.FromContext(ctx)
+	log.Info("ListStalePullRequests called", zap.String("team_name", req.GetTeamName()))
+
+	prs, err := s.prUC.ListStalePRs(ctx, req.GetTeamName(), time.Now())
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	for _, p := range prs {
+		if err := stream.Send(toProtoStalePR(p)); err != nil {
+			// клиент отключился — статус уже не дойдёт
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/alnoi/pr-reviewer-service/internal/usecase"
+	reviewerv1 "github.com/alnoi/pr-reviewer-service/pkg/api/reviewer/v1"
+)
+
+var (
+	_ reviewerv1.TeamServiceServer        = &Server{}
+	_ reviewerv1.UserServiceServer        = &Server{}
+	_ reviewerv1.PullRequestServiceServer = &Server{}
+	_ reviewerv1.StatsServiceServer       = &Server{}
+)
+
+// Server — gRPC-слой поверх тех же юзкейсов, что и HTTP-слой.
+type Server struct {
+	reviewerv1.UnimplementedTeamServiceServer
+	reviewerv1.UnimplementedUserServiceServer
+	reviewerv1.UnimplementedPullRequestServiceServer
+	reviewerv1.UnimplementedStatsServiceServer
+
+	teamUC  usecase.TeamUseCase
+	userUC  usecase.UserUseCase
+	prUC    usecase.PRUseCase
+	statsUC usecase.StatsUseCase
+}
+
+// NewServer собирает gRPC-слой поверх юзкейсов.
+func NewServer(
+	teamUC usecase.TeamUseCase,
+	userUC usecase.UserUseCase,
+	prUC usecase.PRUseCase,
+	statsUC usecase.StatsUseCase,
+) *Server {
+	return &Server{
+		teamUC:  teamUC,
+		userUC:  userUC,
+		prUC:    prUC,
+		statsUC: statsUC,
+	}
+}
+
+// Register регистрирует все сервисы в gs.
+func (s *Server) Register(gs grpc.ServiceRegistrar) {
+	reviewerv1.RegisterTeamServiceServer(gs, s)
+	reviewerv1.RegisterUserServiceServer(gs, s)
+	reviewerv1.RegisterPullRequestServiceServer(gs, s)
+	reviewerv1.RegisterStatsServiceServer(gs, s)
+}
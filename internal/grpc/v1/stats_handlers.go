@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	applog "github.com/alnoi/pr-reviewer-service/internal/logger"
+	reviewerv1 "github.com/alnoi/pr-reviewer-service/pkg/api/reviewer/v1"
+)
+
+func (s *Server) GetStats(ctx context.Context, req *reviewerv1.GetStatsRequest) (*reviewerv1.GetStatsResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("GetStats called")
+
+	filter := fromProtoStatsFilter(req.GetFilter())
+
+	granularity, ok := fromProtoGranularity(req.GetGranularity())
+	if !ok {
+		log.Warn("invalid granularity in GetStats", zap.Stringer("granularity", req.GetGranularity()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "granularity must be one of: day, week")
+	}
+
+	if !validStatsFilter(filter) {
+		log.Warn("invalid range in GetStats")
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "range start must be before range end")
+	}
+
+	stats, err := s.statsUC.GetStats(ctx, filter, granularity)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &reviewerv1.GetStatsResponse{
+		AssignmentsByUser: make([]*reviewerv1.UserAssignmentsStat, 0, len(stats.AssignmentsByUser)),
+		PrStatusCounts:    toProtoStatusCounts(stats.PRStatusCounts),
+		ByTeam:            make([]*reviewerv1.TeamStats, 0, len(stats.ByTeam)),
+		TimeSeries:        make([]*reviewerv1.StatsBucket, 0, len(stats.TimeSeries)),
+	}
+
+	for _, u := range stats.AssignmentsByUser {
+		res.AssignmentsByUser = append(res.AssignmentsByUser, &reviewerv1.UserAssignmentsStat{
+			UserId:                 u.UserID,
+			ReviewAssignmentsCount: int32(u.ReviewAssignmentsCount),
+		})
+	}
+	for _, t := range stats.ByTeam {
+		res.ByTeam = append(res.ByTeam, &reviewerv1.TeamStats{
+			TeamName:       t.TeamName,
+			PrStatusCounts: toProtoStatusCounts(t.PRStatusCounts),
+		})
+	}
+	for _, b := range stats.TimeSeries {
+		res.TimeSeries = append(res.TimeSeries, &reviewerv1.StatsBucket{
+			BucketStart: timestamppb.New(b.Start),
+			Created:     int32(b.Created),
+			Merged:      int32(b.Merged),
+		})
+	}
+
+	return res, nil
+}
+
+func (s *Server) GetCycleTime(ctx context.Context, req *reviewerv1.GetCycleTimeRequest) (*reviewerv1.GetCycleTimeResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("GetCycleTime called")
+
+	filter := fromProtoStatsFilter(req.GetFilter())
+
+	if !validStatsFilter(filter) {
+		log.Warn("invalid range in GetCycleTime")
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "range start must be before range end")
+	}
+
+	stats, err := s.statsUC.GetCycleTime(ctx, filter, time.Now())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &reviewerv1.GetCycleTimeResponse{
+		Overall:    toProtoDurationSummary(stats.Overall),
+		ByTeam:     make([]*reviewerv1.TeamCycleTime, 0, len(stats.ByTeam)),
+		ByReviewer: make([]*reviewerv1.ReviewerCycleTime, 0, len(stats.ByReviewer)),
+		OpenAge: &reviewerv1.OpenPRAge{
+			Age:     toProtoDurationSummary(stats.OpenAge.Age),
+			Buckets: make([]*reviewerv1.AgeBucket, 0, len(stats.OpenAge.Buckets)),
+		},
+	}
+
+	for _, t := range stats.ByTeam {
+		res.ByTeam = append(res.ByTeam, &reviewerv1.TeamCycleTime{
+			TeamName:  t.TeamName,
+			CycleTime: toProtoDurationSummary(t.CycleTime),
+		})
+	}
+	for _, r := range stats.ByReviewer {
+		res.ByReviewer = append(res.ByReviewer, &reviewerv1.ReviewerCycleTime{
+			UserId:    r.UserID,
+			CycleTime: toProtoDurationSummary(r.CycleTime),
+		})
+	}
+	for _, b := range stats.OpenAge.Buckets {
+		bucket := &reviewerv1.AgeBucket{Count: int32(b.Count)}
+		if b.UpperBound > 0 {
+			bucket.Le = durationpb.New(b.UpperBound)
+		}
+		res.OpenAge.Buckets = append(res.OpenAge.Buckets, bucket)
+	}
+
+	return res, nil
+}
+
+func (s *Server) GetFairness(ctx context.Context, req *reviewerv1.GetFairnessRequest) (*reviewerv1.GetFairnessResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("GetFairness called", zap.String("team_name", req.GetTeamName()))
+
+	// в proto3 нельзя отличить ноль от незаданного поля, поэтому ноль — значение по умолчанию
+	idleDays := int(req.GetIdleDays())
+	if idleDays == 0 {
+		idleDays = domain.DefaultFairnessIdleDays
+	}
+
+	if idleDays < 1 {
+		log.Warn("invalid idle_days in GetFairness", zap.Int("idle_days", idleDays))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "idle_days must be positive")
+	}
+
+	report, err := s.statsUC.GetFairness(ctx, req.GetTeamName(), idleDays, time.Now())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &reviewerv1.GetFairnessResponse{
+		IdleSince: timestamppb.New(report.IdleSince),
+		Teams:     make([]*reviewerv1.TeamFairness, 0, len(report.Teams)),
+	}
+
+	for _, t := range report.Teams {
+		members := make([]*reviewerv1.MemberLoad, 0, len(t.Members))
+		for _, m := range t.Members {
+			members = append(members, &reviewerv1.MemberLoad{
+				UserId:          m.UserID,
+				OpenAssignments: int32(m.OpenAssignments),
+				LastAssignedAt:  timestampPtr(m.LastAssignedAt),
+			})
+		}
+
+		res.Teams = append(res.Teams, &reviewerv1.TeamFairness{
+			TeamName:        t.TeamName,
+			ActiveMembers:   int32(t.ActiveMembers),
+			OpenAssignments: int32(t.OpenAssignments),
+			MeanLoad:        t.MeanLoad,
+			MinLoad:         int32(t.MinLoad),
+			MaxLoad:         int32(t.MaxLoad),
+			Spread:          int32(t.Spread()),
+			Gini:            t.Gini,
+			Members:         members,
+			IdleMembers:     append([]string{}, t.IdleMembers...),
+		})
+	}
+
+	return res, nil
+}
+
+func validStatsFilter(f domain.StatsFilter) bool {
+	return validRange(f.CreatedFrom, f.CreatedTo) && validRange(f.MergedFrom, f.MergedTo)
+}
+
+// validRange проверяет, что у полуинтервала [from, to) начало строго раньше конца.
+func validRange(from, to *time.Time) bool {
+	return from == nil || to == nil || from.Before(*to)
+}
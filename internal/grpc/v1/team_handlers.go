@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	applog "github.com/alnoi/pr-reviewer-service/internal/logger"
+	reviewerv1 "github.com/alnoi/pr-reviewer-service/pkg/api/reviewer/v1"
+)
+
+func (s *Server) AddTeam(ctx context.Context, req *reviewerv1.AddTeamRequest) (*reviewerv1.AddTeamResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("AddTeam called")
+
+	members := make([]domain.TeamMember, 0, len(req.GetTeam().GetMembers()))
+	for _, m := range req.GetTeam().GetMembers() {
+		members = append(members, fromProtoTeamMember(m))
+	}
+
+	team, err := s.teamUC.CreateTeam(ctx, domain.Team{
+		TeamName: req.GetTeam().GetTeamName(),
+		Members:  members,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.AddTeamResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *Server) GetTeam(ctx context.Context, req *reviewerv1.GetTeamRequest) (*reviewerv1.GetTeamResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("GetTeam called", zap.String("team_name", req.GetTeamName()))
+
+	if req.GetTeamName() == "" {
+		log.Warn("invalid data in GetTeam", zap.String("team_name", req.GetTeamName()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "team_name is required")
+	}
+
+	team, err := s.teamUC.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.GetTeamResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *Server) GetTeamSettings(ctx context.Context, req *reviewerv1.GetTeamSettingsRequest) (*reviewerv1.GetTeamSettingsResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("GetTeamSettings called", zap.String("team_name", req.GetTeamName()))
+
+	if req.GetTeamName() == "" {
+		log.Warn("invalid data in GetTeamSettings", zap.String("team_name", req.GetTeamName()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "team_name is required")
+	}
+
+	settings, err := s.teamUC.GetTeamSettings(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.GetTeamSettingsResponse{Settings: toProtoTeamSettings(settings)}, nil
+}
+
+func (s *Server) UpdateTeamSettings(ctx context.Context, req *reviewerv1.UpdateTeamSettingsRequest) (*reviewerv1.UpdateTeamSettingsResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("UpdateTeamSettings called")
+
+	in := fromProtoTeamSettings(req.GetSettings())
+	// порог хранится в секундах, как stale_after_seconds в REST
+	if in.TeamName == "" || in.StaleAfter < 0 || in.StaleAfter%time.Second != 0 {
+		log.Warn("invalid data in UpdateTeamSettings",
+			zap.String("team_name", in.TeamName),
+			zap.Duration("stale_after", in.StaleAfter),
+		)
+		return nil, newStatusError(domain.ErrorCodeBadRequest,
+			"team_name is required and stale_after must be a non-negative whole number of seconds")
+	}
+
+	settings, err := s.teamUC.UpdateTeamSettings(ctx, in)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.UpdateTeamSettingsResponse{Settings: toProtoTeamSettings(settings)}, nil
+}
+
+func (s *Server) AddTeamMembers(ctx context.Context, req *reviewerv1.AddTeamMembersRequest) (*reviewerv1.AddTeamMembersResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("AddTeamMembers called")
+
+	if req.GetTeamName() == "" || len(req.GetMembers()) == 0 {
+		log.Warn("invalid data in AddTeamMembers",
+			zap.String("team_name", req.GetTeamName()),
+			zap.Int("members_count", len(req.GetMembers())),
+		)
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "team_name and members are required")
+	}
+
+	members := make([]domain.TeamMember, 0, len(req.GetMembers()))
+	for _, m := range req.GetMembers() {
+		if m.GetUserId() == "" {
+			log.Warn("invalid member in AddTeamMembers", zap.String("team_name", req.GetTeamName()))
+			return nil, newStatusError(domain.ErrorCodeBadRequest, "user_id is required for every member")
+		}
+		members = append(members, fromProtoTeamMember(m))
+	}
+
+	team, err := s.teamUC.AddTeamMembers(ctx, req.GetTeamName(), members)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.AddTeamMembersResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *Server) RemoveTeamMembers(ctx context.Context, req *reviewerv1.RemoveTeamMembersRequest) (*reviewerv1.RemoveTeamMembersResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("RemoveTeamMembers called")
+
+	if req.GetTeamName() == "" || len(req.GetUserIds()) == 0 {
+		log.Warn("invalid data in RemoveTeamMembers",
+			zap.String("team_name", req.GetTeamName()),
+			zap.Int("user_ids_count", len(req.GetUserIds())),
+		)
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "team_name and user_ids are required")
+	}
+
+	team, err := s.teamUC.RemoveTeamMembers(ctx, req.GetTeamName(), req.GetUserIds())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.RemoveTeamMembersResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *Server) UpdateTeamMember(ctx context.Context, req *reviewerv1.UpdateTeamMemberRequest) (*reviewerv1.UpdateTeamMemberResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("UpdateTeamMember called")
+
+	member := fromProtoTeamMember(req.GetMember())
+	if req.GetTeamName() == "" || member.UserID == "" || member.Username == "" {
+		log.Warn("invalid data in UpdateTeamMember",
+			zap.String("team_name", req.GetTeamName()),
+			zap.String("user_id", member.UserID),
+		)
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "team_name, user_id and username are required")
+	}
+
+	team, err := s.teamUC.UpdateTeamMember(ctx, req.GetTeamName(), member)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.UpdateTeamMemberResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *Server) DeactivateTeamMembers(ctx context.Context, req *reviewerv1.DeactivateTeamMembersRequest) (*reviewerv1.DeactivateTeamMembersResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("DeactivateTeamMembers called")
+
+	if req.GetTeamName() == "" || len(req.GetUserIds()) == 0 {
+		log.Warn("invalid data in DeactivateTeamMembers",
+			zap.String("team_name", req.GetTeamName()),
+			zap.Int("user_ids_count", len(req.GetUserIds())),
+		)
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "team_name and user_ids are required")
+	}
+
+	res, err := s.teamUC.DeactivateTeamMembers(ctx, req.GetTeamName(), req.GetUserIds(), req.GetDryRun())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	updates := make([]*reviewerv1.PRReviewersUpdate, 0, len(res.Updates))
+	for _, u := range res.Updates {
+		updates = append(updates, toProtoPRReviewersUpdate(u))
+	}
+
+	return &reviewerv1.DeactivateTeamMembersResponse{
+		Team:               toProtoTeam(res.Team),
+		DryRun:             res.DryRun,
+		DeactivatedUserIds: append([]string{}, res.Deactivated...),
+		PrUpdates:          updates,
+	}, nil
+}
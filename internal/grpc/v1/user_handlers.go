@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	applog "github.com/alnoi/pr-reviewer-service/internal/logger"
+	reviewerv1 "github.com/alnoi/pr-reviewer-service/pkg/api/reviewer/v1"
+)
+
+func (s *Server) SetUserIsActive(ctx context.Context, req *reviewerv1.SetUserIsActiveRequest) (*reviewerv1.SetUserIsActiveResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("SetUserIsActive called")
+
+	if req.GetUserId() == "" {
+		log.Warn("invalid data in SetUserIsActive", zap.String("user_id", req.GetUserId()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "user_id is required")
+	}
+
+	user, err := s.userUC.SetUserIsActive(ctx, req.GetUserId(), req.GetIsActive(), req.GetRebalance())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.SetUserIsActiveResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *Server) SetUserSeniority(ctx context.Context, req *reviewerv1.SetUserSeniorityRequest) (*reviewerv1.SetUserSeniorityResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("SetUserSeniority called")
+
+	if req.GetUserId() == "" {
+		log.Warn("invalid data in SetUserSeniority", zap.String("user_id", req.GetUserId()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "user_id is required")
+	}
+
+	user, err := s.userUC.SetUserSeniority(ctx, req.GetUserId(), fromProtoSeniority(req.GetSeniority()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.SetUserSeniorityResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *Server) GetUserReview(ctx context.Context, req *reviewerv1.GetUserReviewRequest) (*reviewerv1.GetUserReviewResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("GetUserReview called", zap.String("user_id", req.GetUserId()))
+
+	if req.GetUserId() == "" {
+		log.Warn("invalid data in GetUserReview", zap.String("user_id", req.GetUserId()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "user_id is required")
+	}
+
+	prs, err := s.userUC.GetUserReviewPRs(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := make([]*reviewerv1.PullRequestShort, 0, len(prs))
+	for _, pr := range prs {
+		res = append(res, toProtoPRShort(pr))
+	}
+
+	return &reviewerv1.GetUserReviewResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: res,
+	}, nil
+}
+
+func (s *Server) GetUserTags(ctx context.Context, req *reviewerv1.GetUserTagsRequest) (*reviewerv1.GetUserTagsResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("GetUserTags called", zap.String("user_id", req.GetUserId()))
+
+	if req.GetUserId() == "" {
+		log.Warn("invalid data in GetUserTags", zap.String("user_id", req.GetUserId()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "user_id is required")
+	}
+
+	tags, err := s.userUC.GetUserTags(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.GetUserTagsResponse{
+		UserId: req.GetUserId(),
+		Tags:   tags,
+	}, nil
+}
+
+func (s *Server) SetUserTags(ctx context.Context, req *reviewerv1.SetUserTagsRequest) (*reviewerv1.SetUserTagsResponse, error) {
+	log := applog.FromContext(ctx)
+	log.Info("SetUserTags called")
+
+	if req.GetUserId() == "" {
+		log.Warn("invalid data in SetUserTags", zap.String("user_id", req.GetUserId()))
+		return nil, newStatusError(domain.ErrorCodeBadRequest, "user_id is required")
+	}
+
+	tags, err := s.userUC.SetUserTags(ctx, req.GetUserId(), req.GetTags())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &reviewerv1.SetUserTagsResponse{
+		UserId: req.GetUserId(),
+		Tags:   tags,
+	}, nil
+}
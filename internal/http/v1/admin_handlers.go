@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	applog "github.com/alnoi/pr-reviewer-service/internal/logger"
+	"go.uber.org/zap"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+const (
+	// maxImportLineSize — предельная длина строки NDJSON при импорте.
+	maxImportLineSize = 1 << 20
+	// exportFlushEvery — через сколько записей экспорт сбрасывает буфер клиенту.
+	exportFlushEvery = 100
+)
+
+// POST /admin/import
+func (s *ServerHandler) PostAdminImport(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostAdminImport called")
+
+	records, lineErrs, err := readImportRecords(ctx.Request().Body)
+	if err != nil {
+		log.Warn("invalid ndjson in PostAdminImport", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid ndjson: "+err.Error())
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if len(records) == 0 && len(lineErrs) == 0 {
+		log.Warn("empty body in PostAdminImport")
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "no records to import")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	res, err := s.importUC.ImportData(ctx.Request().Context(), records)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	res.Errors = append(res.Errors, lineErrs...)
+	sort.SliceStable(res.Errors, func(i, j int) bool {
+		return res.Errors[i].Line < res.Errors[j].Line
+	})
+
+	return ctx.JSON(http.StatusOK, toAPIImportResult(res))
+}
+
+// GET /admin/export
+func (s *ServerHandler) GetAdminExport(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetAdminExport called")
+
+	w := ctx.Response()
+	enc := json.NewEncoder(w)
+	written := 0
+
+	err := s.importUC.ExportData(ctx.Request().Context(), func(rec domain.ImportRecord) error {
+		if written == 0 {
+			w.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+			w.WriteHeader(http.StatusOK)
+		}
+
+		if err := enc.Encode(toAPIImportRecord(rec)); err != nil {
+			return err
+		}
+
+		written++
+		if written%exportFlushEvery == 0 {
+			w.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		if written > 0 {
+			// заголовки уже отправлены, клиент получит оборванный поток
+			log.Error("export interrupted", zap.Error(err), zap.Int("records", written))
+			return nil
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	if written == 0 {
+		return ctx.Blob(http.StatusOK, "application/x-ndjson", nil)
+	}
+
+	w.Flush()
+	return nil
+}
+
+// readImportRecords разбирает NDJSON построчно. Пустые строки пропускаются, но учитываются в нумерации.
+// Строки с некорректным JSON попадают в ошибки, остальные возвращаются для импорта.
+func readImportRecords(r io.Reader) ([]domain.ImportRecord, []domain.ImportLineError, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+
+	var (
+		records  []domain.ImportRecord
+		lineErrs []domain.ImportLineError
+	)
+
+	for line := 1; sc.Scan(); line++ {
+		raw := bytes.TrimSpace(sc.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+
+		var rec ImportRecord
+		if err := dec.Decode(&rec); err != nil {
+			lineErrs = append(lineErrs, domain.ImportLineError{
+				Line:    line,
+				Code:    domain.ErrorCodeBadRequest,
+				Message: "invalid json: " + err.Error(),
+			})
+			continue
+		}
+
+		records = append(records, fromAPIImportRecord(line, rec))
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return records, lineErrs, nil
+}
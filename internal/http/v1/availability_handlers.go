@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	applog "github.com/alnoi/pr-reviewer-service/internal/logger"
+	"go.uber.org/zap"
+)
+
+// GET /users/availability
+func (s *ServerHandler) GetUsersAvailability(ctx echo.Context, params GetUsersAvailabilityParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetUsersAvailability called", zap.String("user_id", params.UserId))
+
+	if params.UserId == "" {
+		log.Warn("invalid data in GetUsersAvailability", zap.String("user_id", params.UserId))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "user_id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	periods, err := s.availUC.ListAvailability(ctx.Request().Context(), params.UserId)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	items := make([]Availability, 0, len(periods))
+	for _, p := range periods {
+		items = append(items, toAPIAvailability(p))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"user_id": params.UserId,
+		"periods": items,
+	})
+}
+
+// POST /users/availability
+func (s *ServerHandler) PostUsersAvailability(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostUsersAvailability called")
+
+	var body PostUsersAvailabilityJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostUsersAvailability", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.UserId == "" || body.StartsAt.IsZero() || !body.EndsAt.After(body.StartsAt) {
+		log.Warn("invalid data in PostUsersAvailability",
+			zap.String("user_id", body.UserId),
+			zap.Time("starts_at", body.StartsAt),
+			zap.Time("ends_at", body.EndsAt),
+		)
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"),
+			"user_id and starts_at are required, ends_at must be after starts_at")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	created, err := s.availUC.CreateAvailability(ctx.Request().Context(), domain.Availability{
+		UserID:   body.UserId,
+		StartsAt: body.StartsAt,
+		EndsAt:   body.EndsAt,
+		Reason:   stringValue(body.Reason),
+	})
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusCreated, map[string]any{
+		"availability": toAPIAvailability(created),
+	})
+}
+
+// POST /users/availability/update
+func (s *ServerHandler) PostUsersAvailabilityUpdate(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostUsersAvailabilityUpdate called")
+
+	var body PostUsersAvailabilityUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostUsersAvailabilityUpdate", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.Id <= 0 || body.StartsAt.IsZero() || !body.EndsAt.After(body.StartsAt) {
+		log.Warn("invalid data in PostUsersAvailabilityUpdate",
+			zap.Int64("id", body.Id),
+			zap.Time("starts_at", body.StartsAt),
+			zap.Time("ends_at", body.EndsAt),
+		)
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"),
+			"id and starts_at are required, ends_at must be after starts_at")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	updated, err := s.availUC.UpdateAvailability(ctx.Request().Context(), domain.Availability{
+		ID:       body.Id,
+		StartsAt: body.StartsAt,
+		EndsAt:   body.EndsAt,
+		Reason:   stringValue(body.Reason),
+	})
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"availability": toAPIAvailability(updated),
+	})
+}
+
+// POST /users/availability/delete
+func (s *ServerHandler) PostUsersAvailabilityDelete(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostUsersAvailabilityDelete called")
+
+	var body PostUsersAvailabilityDeleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostUsersAvailabilityDelete", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.Id <= 0 {
+		log.Warn("invalid data in PostUsersAvailabilityDelete", zap.Int64("id", body.Id))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if err := s.availUC.DeleteAvailability(ctx.Request().Context(), body.Id); err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"id": body.Id,
+	})
+}
@@ -9,12 +9,43 @@ import (
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	BADREQUEST   ErrorResponseErrorCode = "BAD_REQUEST"
+	INTERNAL     ErrorResponseErrorCode = "INTERNAL"
+	NOCANDIDATE  ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED  ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND     ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS     ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED     ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS   ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED ErrorResponseErrorCode = "UNAUTHORIZED"
+)
+
+// Defines values for EventType.
+const (
+	PrCreated       EventType = "pr.created"
+	PrMerged        EventType = "pr.merged"
+	PrReassigned    EventType = "pr.reassigned"
+	PrReviewersSet  EventType = "pr.reviewers_set"
+	TeamDeactivated EventType = "team.deactivated"
+)
+
+// Defines values for Forge.
+const (
+	Github Forge = "github"
+	Gitlab Forge = "gitlab"
+)
+
+// Defines values for ImportRecordStatus.
+const (
+	ImportRecordStatusMERGED ImportRecordStatus = "MERGED"
+	ImportRecordStatusOPEN   ImportRecordStatus = "OPEN"
+)
+
+// Defines values for ImportRecordType.
+const (
+	ImportRecordTypePullRequest ImportRecordType = "pull_request"
+	ImportRecordTypeTeam        ImportRecordType = "team"
+	ImportRecordTypeUser        ImportRecordType = "user"
 )
 
 // Defines values for PullRequestStatus.
@@ -25,10 +56,97 @@ const (
 
 // Defines values for PullRequestShortStatus.
 const (
-	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
-	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+	MERGED PullRequestShortStatus = "MERGED"
+	OPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Defines values for ReviewRuleType.
+const (
+	Conflict ReviewRuleType = "conflict"
+	Pair     ReviewRuleType = "pair"
 )
 
+// Defines values for Seniority.
+const (
+	Junior Seniority = "junior"
+	Middle Seniority = "middle"
+	Senior Seniority = "senior"
+)
+
+// Defines values for UserPreferencesDigest.
+const (
+	Daily  UserPreferencesDigest = "daily"
+	Off    UserPreferencesDigest = "off"
+	Weekly UserPreferencesDigest = "weekly"
+)
+
+// Defines values for UserPreferencesDigestWeekday.
+const (
+	Friday    UserPreferencesDigestWeekday = "friday"
+	Monday    UserPreferencesDigestWeekday = "monday"
+	Saturday  UserPreferencesDigestWeekday = "saturday"
+	Sunday    UserPreferencesDigestWeekday = "sunday"
+	Thursday  UserPreferencesDigestWeekday = "thursday"
+	Tuesday   UserPreferencesDigestWeekday = "tuesday"
+	Wednesday UserPreferencesDigestWeekday = "wednesday"
+)
+
+// Defines values for WebhookResultResult.
+const (
+	Created WebhookResultResult = "created"
+	Ignored WebhookResultResult = "ignored"
+	Merged  WebhookResultResult = "merged"
+)
+
+// Defines values for GetStatsParamsGranularity.
+const (
+	Day  GetStatsParamsGranularity = "day"
+	Week GetStatsParamsGranularity = "week"
+)
+
+// AgeBucket defines model for AgeBucket.
+type AgeBucket struct {
+	Count int32 `json:"count"`
+
+	// LeSeconds Верхняя граница корзины включительно; null — без верхней границы
+	LeSeconds *float64 `json:"le_seconds"`
+}
+
+// Availability defines model for Availability.
+type Availability struct {
+	// AppliedAt Когда открытые PR пользователя были переназначены планировщиком
+	AppliedAt *time.Time `json:"applied_at"`
+	EndsAt    time.Time  `json:"ends_at"`
+	Id        int64      `json:"id"`
+	Reason    string     `json:"reason"`
+	StartsAt  time.Time  `json:"starts_at"`
+	UserId    string     `json:"user_id"`
+}
+
+// CodeOwnerRule defines model for CodeOwnerRule.
+type CodeOwnerRule struct {
+	Owners  []string `json:"owners"`
+	Pattern string   `json:"pattern"`
+}
+
+// CycleTimeStats defines model for CycleTimeStats.
+type CycleTimeStats struct {
+	// ByReviewer По текущим ревьюверам смерженных PR
+	ByReviewer []ReviewerCycleTime `json:"by_reviewer"`
+	ByTeam     []TeamCycleTime     `json:"by_team"`
+	OpenAge    OpenPRAge           `json:"open_age"`
+	Overall    DurationSummary     `json:"overall"`
+}
+
+// DurationSummary defines model for DurationSummary.
+type DurationSummary struct {
+	Count       int32   `json:"count"`
+	MeanSeconds float64 `json:"mean_seconds"`
+	P50Seconds  float64 `json:"p50_seconds"`
+	P90Seconds  float64 `json:"p90_seconds"`
+	P99Seconds  float64 `json:"p99_seconds"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -40,6 +158,150 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// Event Событие ленты /events/stream. Набор полей зависит от type:
+// pr.created, pr.merged, pr.reviewers_set — поля PR; pr.reassigned — ещё old_reviewer_id и new_reviewer_id;
+// team.deactivated — deactivated_user_ids. Замена ревьювера при деактивации, удалении из команды
+// и начале отсутствия — тоже pr.reassigned, по событию на каждую замену; pr.reviewers_set — состав
+// задан через /pullRequest/setReviewers или вернувшийся пользователь добавлен при rebalance.
+type Event struct {
+	AuthorId           *string   `json:"author_id,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+	DeactivatedUserIds *[]string `json:"deactivated_user_ids,omitempty"`
+
+	// Id Порядковый номер события, он же id в SSE
+	Id              int64   `json:"id"`
+	NewReviewerId   *string `json:"new_reviewer_id,omitempty"`
+	OldReviewerId   *string `json:"old_reviewer_id,omitempty"`
+	PullRequestId   *string `json:"pull_request_id,omitempty"`
+	PullRequestName *string `json:"pull_request_name,omitempty"`
+
+	// Reviewers Ревьюверы PR после события
+	Reviewers *[]string `json:"reviewers,omitempty"`
+
+	// TeamName Команда автора PR или команда, участников которой деактивировали
+	TeamName string    `json:"team_name"`
+	Type     EventType `json:"type"`
+}
+
+// EventType defines model for EventType.
+type EventType string
+
+// FairnessReport defines model for FairnessReport.
+type FairnessReport struct {
+	IdleSince time.Time      `json:"idle_since"`
+	Teams     []TeamFairness `json:"teams"`
+}
+
+// Forge Git-хостинг, присылающий вебхуки
+type Forge string
+
+// ForgeAccount defines model for ForgeAccount.
+type ForgeAccount struct {
+	// Forge Git-хостинг, присылающий вебхуки
+	Forge Forge `json:"forge"`
+
+	// Login Логин на хостинге, без учёта регистра
+	Login  string `json:"login"`
+	UserId string `json:"user_id"`
+}
+
+// ImportLineError defines model for ImportLineError.
+type ImportLineError struct {
+	Code string `json:"code"`
+
+	// Line Номер строки во входных данных, начиная с 1
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// ImportRecord Строка NDJSON импорта и экспорта. Набор полей зависит от type:
+// team — team_name; user — user_id, username, team_name (пусто — вне команд), is_active;
+// pull_request — pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at.
+type ImportRecord struct {
+	AssignedReviewers *[]string  `json:"assigned_reviewers,omitempty"`
+	AuthorId          *string    `json:"author_id,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	IsActive          *bool      `json:"is_active,omitempty"`
+
+	// MergedAt Только для MERGED
+	MergedAt        *time.Time          `json:"merged_at,omitempty"`
+	PullRequestId   *string             `json:"pull_request_id,omitempty"`
+	PullRequestName *string             `json:"pull_request_name,omitempty"`
+	Status          *ImportRecordStatus `json:"status,omitempty"`
+	TeamName        *string             `json:"team_name,omitempty"`
+	Type            ImportRecordType    `json:"type"`
+	UserId          *string             `json:"user_id,omitempty"`
+	Username        *string             `json:"username,omitempty"`
+}
+
+// ImportRecordStatus defines model for ImportRecord.Status.
+type ImportRecordStatus string
+
+// ImportRecordType defines model for ImportRecord.Type.
+type ImportRecordType string
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	// Errors Пропущенные строки
+	Errors       []ImportLineError `json:"errors"`
+	PullRequests int               `json:"pull_requests"`
+
+	// Teams Применено строк с командами
+	Teams int `json:"teams"`
+	Users int `json:"users"`
+}
+
+// MemberLoad defines model for MemberLoad.
+type MemberLoad struct {
+	LastAssignedAt  *time.Time `json:"last_assigned_at"`
+	OpenAssignments int32      `json:"open_assignments"`
+	UserId          string     `json:"user_id"`
+}
+
+// NotificationTemplates Шаблоны сообщений по событиям; пустые и не указанные заменяются встроенными
+type NotificationTemplates struct {
+	Assigned *string `json:"assigned,omitempty"`
+	Replaced *string `json:"replaced,omitempty"`
+	Stale    *string `json:"stale,omitempty"`
+}
+
+// OpenPRAge defines model for OpenPRAge.
+type OpenPRAge struct {
+	Age     DurationSummary `json:"age"`
+	Buckets []AgeBucket     `json:"buckets"`
+}
+
+// PRBatchCreateError Причина, по которой PR не создан
+type PRBatchCreateError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// PRBatchCreateItem defines model for PRBatchCreateItem.
+type PRBatchCreateItem struct {
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+}
+
+// PRBatchCreateResult defines model for PRBatchCreateResult.
+type PRBatchCreateResult struct {
+	// Error Причина, по которой PR не создан
+	Error         *PRBatchCreateError `json:"error,omitempty"`
+	Pr            *PullRequest        `json:"pr,omitempty"`
+	PullRequestId string              `json:"pull_request_id"`
+}
+
+// PRReviewersUpdate defines model for PRReviewersUpdate.
+type PRReviewersUpdate struct {
+	AddedReviewers []string `json:"added_reviewers"`
+
+	// AssignedReviewers Итоговый состав ревьюверов PR
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	PullRequestId     string   `json:"pull_request_id"`
+	RemovedReviewers  []string `json:"removed_reviewers"`
+}
+
 // PRStatusCounts defines model for PRStatusCounts.
 type PRStatusCounts struct {
 	Merged int32 `json:"merged"`
@@ -73,10 +335,59 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReviewRule defines model for ReviewRule.
+type ReviewRule struct {
+	Id          int64          `json:"id"`
+	OtherUserId string         `json:"other_user_id"`
+	TeamName    string         `json:"team_name"`
+	Type        ReviewRuleType `json:"type"`
+	UserId      string         `json:"user_id"`
+}
+
+// ReviewRuleType defines model for ReviewRuleType.
+type ReviewRuleType string
+
+// ReviewerCycleTime defines model for ReviewerCycleTime.
+type ReviewerCycleTime struct {
+	CycleTime DurationSummary `json:"cycle_time"`
+	UserId    string          `json:"user_id"`
+}
+
+// Seniority Уровень пользователя
+type Seniority string
+
+// StalePullRequest defines model for StalePullRequest.
+type StalePullRequest struct {
+	// LastActivityAt Создание PR или последнее назначение ревьювера
+	LastActivityAt time.Time `json:"last_activity_at"`
+
+	// MarkedAt Когда планировщик последний раз отметил PR зависшим
+	MarkedAt *time.Time  `json:"marked_at"`
+	Pr       PullRequest `json:"pr"`
+
+	// StaleAfterSeconds Действующий порог команды
+	StaleAfterSeconds int64  `json:"stale_after_seconds"`
+	TeamName          string `json:"team_name"`
+}
+
 // Stats defines model for Stats.
 type Stats struct {
 	AssignmentsByUser []UserAssignmentsStat `json:"assignments_by_user"`
-	PrStatusCounts    PRStatusCounts        `json:"pr_status_counts"`
+
+	// ByTeam Статусы PR в разрезе команд авторов
+	ByTeam         *[]TeamStats   `json:"by_team,omitempty"`
+	PrStatusCounts PRStatusCounts `json:"pr_status_counts"`
+
+	// TimeSeries Число созданных и смерженных PR по периодам (только при заданном granularity)
+	TimeSeries *[]StatsBucket `json:"time_series,omitempty"`
+}
+
+// StatsBucket defines model for StatsBucket.
+type StatsBucket struct {
+	// BucketStart Начало периода в UTC (неделя начинается с понедельника)
+	BucketStart time.Time `json:"bucket_start"`
+	Created     int32     `json:"created"`
+	Merged      int32     `json:"merged"`
 }
 
 // Team defines model for Team.
@@ -85,19 +396,100 @@ type Team struct {
 	TeamName string       `json:"team_name"`
 }
 
+// TeamCodeOwners defines model for TeamCodeOwners.
+type TeamCodeOwners struct {
+	Rules    []CodeOwnerRule `json:"rules"`
+	TeamName string          `json:"team_name"`
+}
+
+// TeamCycleTime defines model for TeamCycleTime.
+type TeamCycleTime struct {
+	CycleTime DurationSummary `json:"cycle_time"`
+	TeamName  string          `json:"team_name"`
+}
+
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	ActiveMembers int32 `json:"active_members"`
+
+	// Gini Коэффициент Джини открытой нагрузки (0 — поровну)
+	Gini float64 `json:"gini"`
+
+	// IdleMembers Активные участники без назначений с idle_since
+	IdleMembers []string `json:"idle_members"`
+	MaxLoad     int32    `json:"max_load"`
+	MeanLoad    float64  `json:"mean_load"`
+
+	// Members Нагрузка активных участников
+	Members []MemberLoad `json:"members"`
+	MinLoad int32        `json:"min_load"`
+
+	// OpenAssignments Открытые ревью на активных участниках
+	OpenAssignments int32 `json:"open_assignments"`
+
+	// Spread max_load - min_load
+	Spread   int32  `json:"spread"`
+	TeamName string `json:"team_name"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// Seniority Уровень пользователя
+	Seniority *Seniority `json:"seniority,omitempty"`
+	UserId    string     `json:"user_id"`
+	Username  string     `json:"username"`
+}
+
+// TeamNotifications defines model for TeamNotifications.
+type TeamNotifications struct {
+	// Channel Канал вместо канала вебхука, если чат позволяет его переопределить
+	Channel  *string `json:"channel,omitempty"`
+	TeamName string  `json:"team_name"`
+
+	// Templates Шаблоны сообщений по событиям; пустые и не указанные заменяются встроенными
+	Templates *NotificationTemplates `json:"templates,omitempty"`
+
+	// WebhookUrl Входящий вебхук Slack или Mattermost; пустая строка выключает уведомления
+	WebhookUrl string `json:"webhook_url"`
+}
+
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// ForgeSync Запрашивать назначенных ревьюверов в PR на GitHub, если PR создан вебхуком.
+	// Работает, когда сервису задан GITHUB_TOKEN; не указано — false
+	ForgeSync *bool `json:"forge_sync,omitempty"`
+
+	// MinSeniorReviewers Сколько ревьюверов каждого PR должны быть сеньорами; PR, для которого столько сеньоров не нашлось, не создаётся (NO_CANDIDATE); не указано — 0
+	MinSeniorReviewers *int `json:"min_senior_reviewers,omitempty"`
+
+	// ReviewerTeams Другие команды, участников которых авторы этой команды могут запрашивать в ревьюверы; не указано — нет таких команд
+	ReviewerTeams *[]string `json:"reviewer_teams,omitempty"`
+
+	// StaleAfterSeconds Через сколько секунд без активности открытый PR считается зависшим; 0 — значение сервиса по умолчанию
+	StaleAfterSeconds int64 `json:"stale_after_seconds"`
+
+	// StaleAutoRotate Заменять на зависшем PR ревьювера, назначенного раньше остальных
+	StaleAutoRotate bool   `json:"stale_auto_rotate"`
+	TeamName        string `json:"team_name"`
+}
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	PrStatusCounts PRStatusCounts `json:"pr_status_counts"`
+	TeamName       string         `json:"team_name"`
 }
 
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// Seniority Уровень пользователя
+	Seniority *Seniority `json:"seniority,omitempty"`
+	TeamName  string     `json:"team_name"`
+	UserId    string     `json:"user_id"`
+	Username  string     `json:"username"`
 }
 
 // UserAssignmentsStat defines model for UserAssignmentsStat.
@@ -106,17 +498,134 @@ type UserAssignmentsStat struct {
 	UserId                 string `json:"user_id"`
 }
 
+// UserPreferences defines model for UserPreferences.
+type UserPreferences struct {
+	Digest UserPreferencesDigest `json:"digest"`
+
+	// DigestHour Час отправки по местному времени пользователя, по умолчанию 9
+	DigestHour *int `json:"digest_hour,omitempty"`
+
+	// DigestWeekday День еженедельного дайджеста, по умолчанию monday
+	DigestWeekday *UserPreferencesDigestWeekday `json:"digest_weekday,omitempty"`
+
+	// Email Адрес для дайджеста; обязателен, если дайджест включён
+	Email *string `json:"email,omitempty"`
+
+	// LastDigestAt Когда отправлен последний дайджест
+	LastDigestAt *time.Time `json:"last_digest_at,omitempty"`
+
+	// Timezone Часовой пояс IANA, по умолчанию UTC
+	Timezone *string `json:"timezone,omitempty"`
+	UserId   string  `json:"user_id"`
+}
+
+// UserPreferencesDigest defines model for UserPreferences.Digest.
+type UserPreferencesDigest string
+
+// UserPreferencesDigestWeekday День еженедельного дайджеста, по умолчанию monday
+type UserPreferencesDigestWeekday string
+
+// UserTags defines model for UserTags.
+type UserTags struct {
+	Tags   []string `json:"tags"`
+	UserId string   `json:"user_id"`
+}
+
+// WebhookResult defines model for WebhookResult.
+type WebhookResult struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+
+	// Reason Почему событие пропущено
+	Reason *string             `json:"reason,omitempty"`
+	Result WebhookResultResult `json:"result"`
+}
+
+// WebhookResultResult defines model for WebhookResult.Result.
+type WebhookResultResult string
+
+// CreatedFromQuery defines model for CreatedFromQuery.
+type CreatedFromQuery = time.Time
+
+// CreatedToQuery defines model for CreatedToQuery.
+type CreatedToQuery = time.Time
+
+// MergedFromQuery defines model for MergedFromQuery.
+type MergedFromQuery = time.Time
+
+// MergedToQuery defines model for MergedToQuery.
+type MergedToQuery = time.Time
+
+// StatsTeamQuery defines model for StatsTeamQuery.
+type StatsTeamQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	// TeamName Только события команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// UserId Только события, где пользователь автор, ревьювер (в том числе снятый) или деактивирован
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// LastEventId То же, что заголовок Last-Event-ID, для клиентов, которые не умеют его задавать
+	LastEventId *int64 `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
+
+	// LastEventID id последнего полученного события; важнее параметра last_event_id
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// GetIntegrationsAccountsParams defines parameters for GetIntegrationsAccounts.
+type GetIntegrationsAccountsParams struct {
+	Forge Forge `form:"forge" json:"forge"`
+}
+
+// PostIntegrationsAccountsDeleteJSONBody defines parameters for PostIntegrationsAccountsDelete.
+type PostIntegrationsAccountsDeleteJSONBody struct {
+	// Forge Git-хостинг, присылающий вебхуки
+	Forge Forge  `json:"forge"`
+	Login string `json:"login"`
+}
+
+// PostIntegrationsGithubJSONBody defines parameters for PostIntegrationsGithub.
+type PostIntegrationsGithubJSONBody map[string]interface{}
+
+// PostIntegrationsGithubParams defines parameters for PostIntegrationsGithub.
+type PostIntegrationsGithubParams struct {
+	XGitHubEvent     *string `json:"X-GitHub-Event,omitempty"`
+	XHubSignature256 *string `json:"X-Hub-Signature-256,omitempty"`
+}
+
+// PostIntegrationsGitlabJSONBody defines parameters for PostIntegrationsGitlab.
+type PostIntegrationsGitlabJSONBody map[string]interface{}
+
+// PostIntegrationsGitlabParams defines parameters for PostIntegrationsGitlab.
+type PostIntegrationsGitlabParams struct {
+	XGitlabEvent *string `json:"X-Gitlab-Event,omitempty"`
+	XGitlabToken *string `json:"X-Gitlab-Token,omitempty"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedPaths Изменённые файлы относительно корня репозитория
+	ChangedPaths       *[]string `json:"changed_paths,omitempty"`
+	PullRequestId      string    `json:"pull_request_id"`
+	PullRequestName    string    `json:"pull_request_name"`
+	RequestedReviewers *[]string `json:"requested_reviewers,omitempty"`
+
+	// RequiredTags Теги, каждый из которых должен быть хотя бы у одного ревьювера
+	RequiredTags *[]string `json:"required_tags,omitempty"`
+}
+
+// PostPullRequestCreateBatchJSONBody defines parameters for PostPullRequestCreateBatch.
+type PostPullRequestCreateBatchJSONBody struct {
+	PullRequests []PRBatchCreateItem `json:"pull_requests"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
@@ -130,8 +639,72 @@ type PostPullRequestReassignJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestSetReviewersJSONBody defines parameters for PostPullRequestSetReviewers.
+type PostPullRequestSetReviewersJSONBody struct {
+	PullRequestId string   `json:"pull_request_id"`
+	Reviewers     []string `json:"reviewers"`
+}
+
+// GetPullRequestStaleParams defines parameters for GetPullRequestStale.
+type GetPullRequestStaleParams struct {
+	// TeamName Только PR авторов команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// TeamName Ограничить статистику PR авторов команды
+	TeamName    *StatsTeamQuery   `form:"team_name,omitempty" json:"team_name,omitempty"`
+	CreatedFrom *CreatedFromQuery `form:"created_from,omitempty" json:"created_from,omitempty"`
+	CreatedTo   *CreatedToQuery   `form:"created_to,omitempty" json:"created_to,omitempty"`
+	MergedFrom  *MergedFromQuery  `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+	MergedTo    *MergedToQuery    `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+
+	// Granularity Размер периода для time_series
+	Granularity *GetStatsParamsGranularity `form:"granularity,omitempty" json:"granularity,omitempty"`
+}
+
+// GetStatsParamsGranularity defines parameters for GetStats.
+type GetStatsParamsGranularity string
+
+// GetStatsCycleTimeParams defines parameters for GetStatsCycleTime.
+type GetStatsCycleTimeParams struct {
+	// TeamName Ограничить статистику PR авторов команды
+	TeamName    *StatsTeamQuery   `form:"team_name,omitempty" json:"team_name,omitempty"`
+	CreatedFrom *CreatedFromQuery `form:"created_from,omitempty" json:"created_from,omitempty"`
+	CreatedTo   *CreatedToQuery   `form:"created_to,omitempty" json:"created_to,omitempty"`
+	MergedFrom  *MergedFromQuery  `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+	MergedTo    *MergedToQuery    `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+}
+
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	// TeamName Ограничить статистику PR авторов команды
+	TeamName *StatsTeamQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// IdleDays Через сколько дней без назначений участник считается простаивающим
+	IdleDays *int `form:"idle_days,omitempty" json:"idle_days,omitempty"`
+}
+
+// GetTeamCodeownersParams defines parameters for GetTeamCodeowners.
+type GetTeamCodeownersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamCodeownersImportTextBody defines parameters for PostTeamCodeownersImport.
+type PostTeamCodeownersImportTextBody = string
+
+// PostTeamCodeownersImportParams defines parameters for PostTeamCodeownersImport.
+type PostTeamCodeownersImportParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
 // PostTeamDeactivateMembersJSONBody defines parameters for PostTeamDeactivateMembers.
 type PostTeamDeactivateMembersJSONBody struct {
+	// DryRun Только рассчитать план переназначений, ничего не изменяя
+	DryRun   *bool    `json:"dry_run,omitempty"`
 	TeamName string   `json:"team_name"`
 	UserIds  []string `json:"user_ids"`
 }
@@ -142,32 +715,209 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PostTeamMembersAddJSONBody defines parameters for PostTeamMembersAdd.
+type PostTeamMembersAddJSONBody struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// PostTeamMembersRemoveJSONBody defines parameters for PostTeamMembersRemove.
+type PostTeamMembersRemoveJSONBody struct {
+	TeamName string   `json:"team_name"`
+	UserIds  []string `json:"user_ids"`
+}
+
+// PostTeamMembersUpdateJSONBody defines parameters for PostTeamMembersUpdate.
+type PostTeamMembersUpdateJSONBody struct {
+	IsActive bool `json:"is_active"`
+
+	// Seniority Уровень пользователя
+	Seniority *Seniority `json:"seniority,omitempty"`
+	TeamName  string     `json:"team_name"`
+	UserId    string     `json:"user_id"`
+	Username  string     `json:"username"`
+}
+
+// GetTeamNotificationsParams defines parameters for GetTeamNotifications.
+type GetTeamNotificationsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamRulesParams defines parameters for GetTeamRules.
+type GetTeamRulesParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamRulesJSONBody defines parameters for PostTeamRules.
+type PostTeamRulesJSONBody struct {
+	OtherUserId string         `json:"other_user_id"`
+	TeamName    string         `json:"team_name"`
+	Type        ReviewRuleType `json:"type"`
+	UserId      string         `json:"user_id"`
+}
+
+// PostTeamRulesDeleteJSONBody defines parameters for PostTeamRulesDelete.
+type PostTeamRulesDeleteJSONBody struct {
+	Id int64 `json:"id"`
+}
+
+// GetTeamSettingsParams defines parameters for GetTeamSettings.
+type GetTeamSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetUsersAvailabilityParams defines parameters for GetUsersAvailability.
+type GetUsersAvailabilityParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersAvailabilityJSONBody defines parameters for PostUsersAvailability.
+type PostUsersAvailabilityJSONBody struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// PostUsersAvailabilityDeleteJSONBody defines parameters for PostUsersAvailabilityDelete.
+type PostUsersAvailabilityDeleteJSONBody struct {
+	Id int64 `json:"id"`
+}
+
+// PostUsersAvailabilityUpdateJSONBody defines parameters for PostUsersAvailabilityUpdate.
+type PostUsersAvailabilityUpdateJSONBody struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Id       int64     `json:"id"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// GetUsersPreferencesParams defines parameters for GetUsersPreferences.
+type GetUsersPreferencesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+	IsActive bool `json:"is_active"`
+
+	// Rebalance При реактивации назначить пользователя не более чем на 3 открытых PR команды, где меньше 2 ревьюверов:
+	// сначала PR с наименьшим числом ревьюверов, затем более старые. Во время периода недоступности
+	// пользователь никуда не назначается.
+	Rebalance *bool  `json:"rebalance,omitempty"`
+	UserId    string `json:"user_id"`
+}
+
+// PostUsersSetNotificationsJSONBody defines parameters for PostUsersSetNotifications.
+type PostUsersSetNotificationsJSONBody struct {
+	Enabled bool   `json:"enabled"`
+	UserId  string `json:"user_id"`
+}
+
+// PostUsersSetSeniorityJSONBody defines parameters for PostUsersSetSeniority.
+type PostUsersSetSeniorityJSONBody struct {
+	// Seniority Уровень пользователя
+	Seniority Seniority `json:"seniority"`
+	UserId    string    `json:"user_id"`
+}
+
+// GetUsersTagsParams defines parameters for GetUsersTags.
+type GetUsersTagsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// PostIntegrationsAccountsJSONRequestBody defines body for PostIntegrationsAccounts for application/json ContentType.
+type PostIntegrationsAccountsJSONRequestBody = ForgeAccount
+
+// PostIntegrationsAccountsDeleteJSONRequestBody defines body for PostIntegrationsAccountsDelete for application/json ContentType.
+type PostIntegrationsAccountsDeleteJSONRequestBody PostIntegrationsAccountsDeleteJSONBody
+
+// PostIntegrationsGithubJSONRequestBody defines body for PostIntegrationsGithub for application/json ContentType.
+type PostIntegrationsGithubJSONRequestBody PostIntegrationsGithubJSONBody
+
+// PostIntegrationsGitlabJSONRequestBody defines body for PostIntegrationsGitlab for application/json ContentType.
+type PostIntegrationsGitlabJSONRequestBody PostIntegrationsGitlabJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
+// PostPullRequestCreateBatchJSONRequestBody defines body for PostPullRequestCreateBatch for application/json ContentType.
+type PostPullRequestCreateBatchJSONRequestBody PostPullRequestCreateBatchJSONBody
+
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestSetReviewersJSONRequestBody defines body for PostPullRequestSetReviewers for application/json ContentType.
+type PostPullRequestSetReviewersJSONRequestBody PostPullRequestSetReviewersJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamCodeownersJSONRequestBody defines body for PostTeamCodeowners for application/json ContentType.
+type PostTeamCodeownersJSONRequestBody = TeamCodeOwners
+
+// PostTeamCodeownersImportTextRequestBody defines body for PostTeamCodeownersImport for text/plain ContentType.
+type PostTeamCodeownersImportTextRequestBody = PostTeamCodeownersImportTextBody
+
 // PostTeamDeactivateMembersJSONRequestBody defines body for PostTeamDeactivateMembers for application/json ContentType.
 type PostTeamDeactivateMembersJSONRequestBody PostTeamDeactivateMembersJSONBody
 
+// PostTeamMembersAddJSONRequestBody defines body for PostTeamMembersAdd for application/json ContentType.
+type PostTeamMembersAddJSONRequestBody PostTeamMembersAddJSONBody
+
+// PostTeamMembersRemoveJSONRequestBody defines body for PostTeamMembersRemove for application/json ContentType.
+type PostTeamMembersRemoveJSONRequestBody PostTeamMembersRemoveJSONBody
+
+// PostTeamMembersUpdateJSONRequestBody defines body for PostTeamMembersUpdate for application/json ContentType.
+type PostTeamMembersUpdateJSONRequestBody PostTeamMembersUpdateJSONBody
+
+// PostTeamNotificationsJSONRequestBody defines body for PostTeamNotifications for application/json ContentType.
+type PostTeamNotificationsJSONRequestBody = TeamNotifications
+
+// PostTeamRulesJSONRequestBody defines body for PostTeamRules for application/json ContentType.
+type PostTeamRulesJSONRequestBody PostTeamRulesJSONBody
+
+// PostTeamRulesDeleteJSONRequestBody defines body for PostTeamRulesDelete for application/json ContentType.
+type PostTeamRulesDeleteJSONRequestBody PostTeamRulesDeleteJSONBody
+
+// PostTeamSettingsJSONRequestBody defines body for PostTeamSettings for application/json ContentType.
+type PostTeamSettingsJSONRequestBody = TeamSettings
+
+// PostUsersAvailabilityJSONRequestBody defines body for PostUsersAvailability for application/json ContentType.
+type PostUsersAvailabilityJSONRequestBody PostUsersAvailabilityJSONBody
+
+// PostUsersAvailabilityDeleteJSONRequestBody defines body for PostUsersAvailabilityDelete for application/json ContentType.
+type PostUsersAvailabilityDeleteJSONRequestBody PostUsersAvailabilityDeleteJSONBody
+
+// PostUsersAvailabilityUpdateJSONRequestBody defines body for PostUsersAvailabilityUpdate for application/json ContentType.
+type PostUsersAvailabilityUpdateJSONRequestBody PostUsersAvailabilityUpdateJSONBody
+
+// PostUsersPreferencesJSONRequestBody defines body for PostUsersPreferences for application/json ContentType.
+type PostUsersPreferencesJSONRequestBody = UserPreferences
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetNotificationsJSONRequestBody defines body for PostUsersSetNotifications for application/json ContentType.
+type PostUsersSetNotificationsJSONRequestBody PostUsersSetNotificationsJSONBody
+
+// PostUsersSetSeniorityJSONRequestBody defines body for PostUsersSetSeniority for application/json ContentType.
+type PostUsersSetSeniorityJSONRequestBody PostUsersSetSeniorityJSONBody
+
+// PostUsersTagsJSONRequestBody defines body for PostUsersTags for application/json ContentType.
+type PostUsersTagsJSONRequestBody = UserTags
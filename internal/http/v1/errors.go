@@ -20,6 +20,8 @@ func mapDomainErrorToStatus(code domain.ErrorCode) int {
 		return http.StatusConflict
 	case domain.ErrorCodeNotFound:
 		return http.StatusNotFound
+	case domain.ErrorCodeBadRequest:
+		return http.StatusBadRequest
 	default:
 		return http.StatusInternalServerError
 	}
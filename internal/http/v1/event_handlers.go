@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	applog "github.com/alnoi/pr-reviewer-service/internal/logger"
+)
+
+// sseHeartbeatInterval — как часто в тихую ленту пишется комментарий, чтобы прокси не закрывали соединение.
+const sseHeartbeatInterval = 15 * time.Second
+
+// GET /events/stream
+func (s *ServerHandler) GetEventsStream(ctx echo.Context, params GetEventsStreamParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetEventsStream called",
+		zap.String("team_name", stringValue(params.TeamName)),
+		zap.String("user_id", stringValue(params.UserId)),
+	)
+
+	filter := domain.EventFilter{
+		TeamName: stringValue(params.TeamName),
+		UserID:   stringValue(params.UserId),
+		AfterID:  params.LastEventId,
+	}
+	if params.LastEventID != nil {
+		filter.AfterID = params.LastEventID
+	}
+
+	if filter.AfterID != nil && *filter.AfterID < 0 {
+		log.Warn("invalid last event id in GetEventsStream", zap.Int64("last_event_id", *filter.AfterID))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "last event id must be non-negative")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	w := ctx.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	// nginx иначе буферизует ответ и события приходят пачками
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	streamCtx, cancel := context.WithCancel(ctx.Request().Context())
+
+	var mu sync.Mutex
+	write := func(b []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		w.Flush()
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(sseHeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-streamCtx.Done():
+				return
+			case <-ticker.C:
+				if err := write([]byte(": ping\n\n")); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	sent := 0
+	err := s.eventUC.StreamEvents(streamCtx, filter, func(e domain.Event) error {
+		data, err := json.Marshal(toAPIEvent(e))
+		if err != nil {
+			return err
+		}
+
+		if err := write(fmt.Appendf(nil, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)); err != nil {
+			return err
+		}
+		sent++
+		return nil
+	})
+	if err != nil {
+		// заголовки уже отправлены, клиент переподключится с Last-Event-ID
+		log.Warn("event stream interrupted", zap.Error(err), zap.Int("events", sent))
+		return nil
+	}
+
+	log.Info("event stream closed", zap.Int("events", sent))
+	return nil
+}
@@ -0,0 +1,215 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/forge"
+	applog "github.com/alnoi/pr-reviewer-service/internal/logger"
+)
+
+// maxWebhookSize — ограничение на тело вебхука; GitHub сам не присылает больше 25 МБ.
+const maxWebhookSize = 25 << 20
+
+// POST /integrations/github
+func (s *ServerHandler) PostIntegrationsGithub(ctx echo.Context, params PostIntegrationsGithubParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	eventType := stringValue(params.XGitHubEvent)
+	log.Info("PostIntegrationsGithub called", zap.String("event", eventType))
+
+	if s.webhooks.GitHub == "" {
+		resp := newAPIError(ErrorResponseErrorCode("NOT_FOUND"), "github integration is not configured")
+		return ctx.JSON(http.StatusNotFound, resp)
+	}
+
+	body, ok := readWebhookBody(ctx)
+	if !ok {
+		log.Warn("unreadable body in PostIntegrationsGithub")
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "webhook body is unreadable or too large")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if !forge.VerifyGitHubSignature(s.webhooks.GitHub, stringValue(params.XHubSignature256), body) {
+		log.Warn("invalid signature in PostIntegrationsGithub")
+		resp := newAPIError(ErrorResponseErrorCode("UNAUTHORIZED"), "invalid webhook signature")
+		return ctx.JSON(http.StatusUnauthorized, resp)
+	}
+
+	ev, supported, err := forge.ParseGitHub(eventType, body)
+	return s.handleForgeEvent(ctx, ev, supported, err)
+}
+
+// POST /integrations/gitlab
+func (s *ServerHandler) PostIntegrationsGitlab(ctx echo.Context, params PostIntegrationsGitlabParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	eventType := stringValue(params.XGitlabEvent)
+	log.Info("PostIntegrationsGitlab called", zap.String("event", eventType))
+
+	if s.webhooks.GitLab == "" {
+		resp := newAPIError(ErrorResponseErrorCode("NOT_FOUND"), "gitlab integration is not configured")
+		return ctx.JSON(http.StatusNotFound, resp)
+	}
+
+	if !forge.VerifyGitLabToken(s.webhooks.GitLab, stringValue(params.XGitlabToken)) {
+		log.Warn("invalid token in PostIntegrationsGitlab")
+		resp := newAPIError(ErrorResponseErrorCode("UNAUTHORIZED"), "invalid webhook token")
+		return ctx.JSON(http.StatusUnauthorized, resp)
+	}
+
+	body, ok := readWebhookBody(ctx)
+	if !ok {
+		log.Warn("unreadable body in PostIntegrationsGitlab")
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "webhook body is unreadable or too large")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	ev, supported, err := forge.ParseGitLab(eventType, body)
+	return s.handleForgeEvent(ctx, ev, supported, err)
+}
+
+// GET /integrations/accounts
+func (s *ServerHandler) GetIntegrationsAccounts(ctx echo.Context, params GetIntegrationsAccountsParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetIntegrationsAccounts called", zap.String("forge", string(params.Forge)))
+
+	accounts, err := s.forgeUC.ListForgeAccounts(ctx.Request().Context(), domain.Forge(params.Forge))
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	items := make([]ForgeAccount, 0, len(accounts))
+	for _, a := range accounts {
+		items = append(items, toAPIForgeAccount(a))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"accounts": items,
+	})
+}
+
+// POST /integrations/accounts
+func (s *ServerHandler) PostIntegrationsAccounts(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostIntegrationsAccounts called")
+
+	var body PostIntegrationsAccountsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostIntegrationsAccounts", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.UserId == "" {
+		log.Warn("invalid data in PostIntegrationsAccounts", zap.String("user_id", body.UserId))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "user_id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	account, err := s.forgeUC.SetForgeAccount(ctx.Request().Context(), domain.ForgeAccount{
+		Forge:  domain.Forge(body.Forge),
+		Login:  body.Login,
+		UserID: body.UserId,
+	})
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIForgeAccount(account))
+}
+
+// POST /integrations/accounts/delete
+func (s *ServerHandler) PostIntegrationsAccountsDelete(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostIntegrationsAccountsDelete called")
+
+	var body PostIntegrationsAccountsDeleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostIntegrationsAccountsDelete", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.Login == "" {
+		log.Warn("invalid data in PostIntegrationsAccountsDelete", zap.String("login", body.Login))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "login is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if err := s.forgeUC.DeleteForgeAccount(ctx.Request().Context(), domain.Forge(body.Forge), body.Login); err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"forge": body.Forge,
+		"login": body.Login,
+	})
+}
+
+// --------------------HELPERS----------------------
+
+func readWebhookBody(ctx echo.Context) ([]byte, bool) {
+	body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxWebhookSize+1))
+	if err != nil || len(body) > maxWebhookSize {
+		return nil, false
+	}
+	return body, true
+}
+
+// handleForgeEvent отвечает на уже проверенный и разобранный вебхук.
+func (s *ServerHandler) handleForgeEvent(ctx echo.Context, ev domain.ForgePREvent, supported bool, parseErr error) error {
+	log := applog.FromContext(ctx.Request().Context())
+
+	if parseErr != nil {
+		log.Warn("invalid webhook payload", zap.Error(parseErr))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), parseErr.Error())
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+	if !supported {
+		reason := "event is not handled"
+		return ctx.JSON(http.StatusOK, WebhookResult{Result: WebhookResultResult(domain.ForgeEventIgnored), Reason: &reason})
+	}
+
+	res, err := s.forgeUC.HandleForgeEvent(ctx.Request().Context(), ev)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIWebhookResult(res))
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"strings"
 	"time"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
@@ -15,13 +16,44 @@ func timePtr(t *time.Time) *time.Time {
 	return &tt
 }
 
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// seniorityPtr возвращает nil для неуказанного уровня, чтобы поле не попадало в ответ.
+func seniorityPtr(s domain.Seniority) *Seniority {
+	if s == "" {
+		return nil
+	}
+	v := Seniority(s)
+	return &v
+}
+
+func seniorityValue(s *Seniority) domain.Seniority {
+	if s == nil {
+		return ""
+	}
+	return domain.Seniority(*s)
+}
+
+func stringsValue(s *[]string) []string {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
 func toAPITeam(t domain.Team) Team {
 	members := make([]TeamMember, 0, len(t.Members))
 	for _, m := range t.Members {
 		members = append(members, TeamMember{
-			UserId:   m.UserID,
-			Username: m.Username,
-			IsActive: m.IsActive,
+			UserId:    m.UserID,
+			Username:  m.Username,
+			IsActive:  m.IsActive,
+			Seniority: seniorityPtr(m.Seniority),
 		})
 	}
 
@@ -33,10 +65,11 @@ func toAPITeam(t domain.Team) Team {
 
 func toAPIUser(u domain.User) User {
 	return User{
-		UserId:   u.UserID,
-		Username: u.Username,
-		TeamName: u.TeamName,
-		IsActive: u.IsActive,
+		UserId:    u.UserID,
+		Username:  u.Username,
+		TeamName:  u.TeamName,
+		IsActive:  u.IsActive,
+		Seniority: seniorityPtr(u.Seniority),
 	}
 }
 
@@ -60,3 +93,324 @@ func toAPIPRShort(pr domain.PullRequestShort) PullRequestShort {
 		Status:          PullRequestShortStatus(pr.Status),
 	}
 }
+
+func toAPIAvailability(a domain.Availability) Availability {
+	return Availability{
+		Id:        a.ID,
+		UserId:    a.UserID,
+		StartsAt:  a.StartsAt.UTC(),
+		EndsAt:    a.EndsAt.UTC(),
+		Reason:    a.Reason,
+		AppliedAt: timePtr(a.AppliedAt),
+	}
+}
+
+func toAPIPRReviewersUpdate(u domain.PRReviewersUpdate) PRReviewersUpdate {
+	return PRReviewersUpdate{
+		PullRequestId:     u.PullRequestID,
+		RemovedReviewers:  append([]string{}, u.Removed...),
+		AddedReviewers:    append([]string{}, u.Added...),
+		AssignedReviewers: append([]string{}, u.Reviewers...),
+	}
+}
+
+func toAPITeamSettings(t domain.TeamSettings) TeamSettings {
+	reviewerTeams := append([]string{}, t.ReviewerTeams...)
+	minSeniors := t.MinSeniorReviewers
+	forgeSync := t.ForgeSync
+	return TeamSettings{
+		TeamName:           t.TeamName,
+		StaleAfterSeconds:  int64(t.StaleAfter / time.Second),
+		StaleAutoRotate:    t.StaleAutoRotate,
+		ReviewerTeams:      &reviewerTeams,
+		MinSeniorReviewers: &minSeniors,
+		ForgeSync:          &forgeSync,
+	}
+}
+
+func toAPITeamNotifications(n domain.TeamNotifications) TeamNotifications {
+	res := TeamNotifications{
+		TeamName:   n.TeamName,
+		WebhookUrl: n.WebhookURL,
+	}
+	if n.Channel != "" {
+		channel := n.Channel
+		res.Channel = &channel
+	}
+	if len(n.Templates) > 0 {
+		res.Templates = &NotificationTemplates{}
+		for event, text := range n.Templates {
+			switch event {
+			case domain.NotificationAssigned:
+				res.Templates.Assigned = &text
+			case domain.NotificationReplaced:
+				res.Templates.Replaced = &text
+			case domain.NotificationStale:
+				res.Templates.Stale = &text
+			}
+		}
+	}
+	return res
+}
+
+func fromAPINotificationTemplates(t *NotificationTemplates) map[domain.NotificationEvent]string {
+	if t == nil {
+		return nil
+	}
+
+	res := make(map[domain.NotificationEvent]string)
+	if t.Assigned != nil {
+		res[domain.NotificationAssigned] = *t.Assigned
+	}
+	if t.Replaced != nil {
+		res[domain.NotificationReplaced] = *t.Replaced
+	}
+	if t.Stale != nil {
+		res[domain.NotificationStale] = *t.Stale
+	}
+	return res
+}
+
+func toAPIUserPreferences(p domain.UserPreferences) UserPreferences {
+	email := p.Email
+	hour := p.DigestHour
+	weekday := UserPreferencesDigestWeekday(strings.ToLower(p.DigestWeekday.String()))
+	timezone := p.Timezone
+	return UserPreferences{
+		UserId:        p.UserID,
+		Email:         &email,
+		Digest:        UserPreferencesDigest(p.Digest),
+		DigestHour:    &hour,
+		DigestWeekday: &weekday,
+		Timezone:      &timezone,
+		LastDigestAt:  timePtr(p.LastDigestAt),
+	}
+}
+
+// fromAPIUserPreferences заполняет неуказанные поля значениями по умолчанию.
+// ok == false — неизвестный день недели.
+func fromAPIUserPreferences(p UserPreferences) (res domain.UserPreferences, ok bool) {
+	res = domain.DefaultUserPreferences(p.UserId)
+	res.Email = stringValue(p.Email)
+	res.Digest = domain.DigestSchedule(p.Digest)
+	if p.DigestHour != nil {
+		res.DigestHour = *p.DigestHour
+	}
+	if p.Timezone != nil {
+		res.Timezone = *p.Timezone
+	}
+	if p.DigestWeekday == nil {
+		return res, true
+	}
+
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == string(*p.DigestWeekday) {
+			res.DigestWeekday = d
+			return res, true
+		}
+	}
+	return res, false
+}
+
+func toAPIReviewRule(r domain.ReviewRule) ReviewRule {
+	return ReviewRule{
+		Id:          r.ID,
+		TeamName:    r.TeamName,
+		Type:        ReviewRuleType(r.Type),
+		UserId:      r.UserID,
+		OtherUserId: r.OtherUserID,
+	}
+}
+
+func toAPICodeOwnerRules(rules []domain.CodeOwnerRule) []CodeOwnerRule {
+	res := make([]CodeOwnerRule, 0, len(rules))
+	for _, r := range rules {
+		res = append(res, CodeOwnerRule{
+			Pattern: r.Pattern,
+			Owners:  append([]string{}, r.Owners...),
+		})
+	}
+	return res
+}
+
+func fromAPICodeOwnerRules(rules []CodeOwnerRule) []domain.CodeOwnerRule {
+	res := make([]domain.CodeOwnerRule, 0, len(rules))
+	for _, r := range rules {
+		res = append(res, domain.CodeOwnerRule{
+			Pattern: r.Pattern,
+			Owners:  r.Owners,
+		})
+	}
+	return res
+}
+
+func toAPIStalePR(p domain.StalePR) StalePullRequest {
+	return StalePullRequest{
+		Pr:                toAPIPR(p.PullRequest),
+		TeamName:          p.TeamName,
+		LastActivityAt:    p.LastActivityAt.UTC(),
+		StaleAfterSeconds: int64(p.StaleAfter / time.Second),
+		MarkedAt:          timePtr(p.MarkedAt),
+	}
+}
+
+func fromAPIImportRecord(line int, rec ImportRecord) domain.ImportRecord {
+	res := domain.ImportRecord{
+		Line:     line,
+		Type:     domain.ImportRecordType(rec.Type),
+		TeamName: stringValue(rec.TeamName),
+		User: domain.User{
+			UserID:   stringValue(rec.UserId),
+			Username: stringValue(rec.Username),
+			TeamName: stringValue(rec.TeamName),
+			IsActive: rec.IsActive == nil || *rec.IsActive,
+		},
+		PR: domain.PullRequest{
+			PullRequestID:   stringValue(rec.PullRequestId),
+			PullRequestName: stringValue(rec.PullRequestName),
+			AuthorID:        stringValue(rec.AuthorId),
+			MergedAt:        rec.MergedAt,
+		},
+	}
+
+	if rec.Status != nil {
+		res.PR.Status = domain.PRStatus(*rec.Status)
+	}
+	if rec.AssignedReviewers != nil {
+		res.PR.AssignedReviewers = *rec.AssignedReviewers
+	}
+	if rec.CreatedAt != nil {
+		res.PR.CreatedAt = *rec.CreatedAt
+	}
+
+	return res
+}
+
+func toAPIImportRecord(rec domain.ImportRecord) ImportRecord {
+	res := ImportRecord{Type: ImportRecordType(rec.Type)}
+
+	switch rec.Type {
+	case domain.ImportRecordTeam:
+		res.TeamName = &rec.TeamName
+	case domain.ImportRecordUser:
+		u := rec.User
+		res.UserId = &u.UserID
+		res.Username = &u.Username
+		if u.TeamName != "" {
+			res.TeamName = &u.TeamName
+		}
+		res.IsActive = &u.IsActive
+	case domain.ImportRecordPullRequest:
+		pr := rec.PR
+		status := ImportRecordStatus(pr.Status)
+		reviewers := append([]string{}, pr.AssignedReviewers...)
+		createdAt := pr.CreatedAt.UTC()
+
+		res.PullRequestId = &pr.PullRequestID
+		res.PullRequestName = &pr.PullRequestName
+		res.AuthorId = &pr.AuthorID
+		res.Status = &status
+		res.AssignedReviewers = &reviewers
+		res.CreatedAt = &createdAt
+		res.MergedAt = timePtr(pr.MergedAt)
+	}
+
+	return res
+}
+
+func toAPIImportResult(r domain.ImportResult) ImportResult {
+	errs := make([]ImportLineError, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		errs = append(errs, ImportLineError{
+			Line:    e.Line,
+			Code:    string(e.Code),
+			Message: e.Message,
+		})
+	}
+
+	return ImportResult{
+		Teams:        r.Teams,
+		Users:        r.Users,
+		PullRequests: r.PullRequests,
+		Errors:       errs,
+	}
+}
+
+func fromAPIPRBatchItems(items []PRBatchCreateItem) []domain.NewPullRequest {
+	res := make([]domain.NewPullRequest, 0, len(items))
+	for _, it := range items {
+		res = append(res, domain.NewPullRequest{
+			PullRequestID:   it.PullRequestId,
+			PullRequestName: it.PullRequestName,
+			AuthorID:        it.AuthorId,
+		})
+	}
+	return res
+}
+
+func toAPIPRBatchResult(r domain.PRBatchItemResult) PRBatchCreateResult {
+	res := PRBatchCreateResult{PullRequestId: r.PullRequestID}
+	if r.PR != nil {
+		pr := toAPIPR(*r.PR)
+		res.Pr = &pr
+	}
+	if r.Err != nil {
+		res.Error = &PRBatchCreateError{
+			Code:    string(r.Err.Code),
+			Message: r.Err.Message,
+		}
+	}
+	return res
+}
+
+func toAPIForgeAccount(a domain.ForgeAccount) ForgeAccount {
+	return ForgeAccount{
+		Forge:  Forge(a.Forge),
+		Login:  a.Login,
+		UserId: a.UserID,
+	}
+}
+
+func toAPIWebhookResult(r domain.ForgeEventResult) WebhookResult {
+	res := WebhookResult{Result: WebhookResultResult(r.Outcome)}
+	if r.Reason != "" {
+		reason := r.Reason
+		res.Reason = &reason
+	}
+	if r.PR != nil {
+		pr := toAPIPR(*r.PR)
+		res.Pr = &pr
+	}
+	return res
+}
+
+func toAPIEvent(e domain.Event) Event {
+	res := Event{
+		Id:        e.ID,
+		Type:      EventType(e.Type),
+		TeamName:  e.TeamName,
+		CreatedAt: e.CreatedAt.UTC(),
+	}
+
+	optional := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+	res.PullRequestId = optional(e.PullRequestID)
+	res.PullRequestName = optional(e.PullRequestName)
+	res.AuthorId = optional(e.AuthorID)
+	res.OldReviewerId = optional(e.OldReviewerID)
+	res.NewReviewerId = optional(e.NewReviewerID)
+
+	if e.Type == domain.EventTeamDeactivated {
+		ids := append([]string{}, e.DeactivatedUserIDs...)
+		res.DeactivatedUserIds = &ids
+	} else {
+		reviewers := append([]string{}, e.Reviewers...)
+		res.Reviewers = &reviewers
+	}
+
+	return res
+}
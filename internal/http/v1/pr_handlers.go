@@ -3,6 +3,7 @@ package v1
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -34,11 +35,14 @@ func (s *ServerHandler) PostPullRequestCreate(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, resp)
 	}
 
-	pr, err := s.prUC.CreatePR(
+	pr, unmetTags, err := s.prUC.CreatePR(
 		ctx.Request().Context(),
 		body.PullRequestId,
 		body.PullRequestName,
 		body.AuthorId,
+		stringsValue(body.RequestedReviewers),
+		stringsValue(body.ChangedPaths),
+		stringsValue(body.RequiredTags),
 	)
 	if err != nil {
 		var derr *domain.DomainError
@@ -53,7 +57,49 @@ func (s *ServerHandler) PostPullRequestCreate(ctx echo.Context) error {
 	}
 
 	return ctx.JSON(http.StatusCreated, map[string]any{
-		"pr": toAPIPR(pr),
+		"pr":         toAPIPR(pr),
+		"unmet_tags": append([]string{}, unmetTags...),
+	})
+}
+
+// POST /pullRequest/createBatch
+func (s *ServerHandler) PostPullRequestCreateBatch(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostPullRequestCreateBatch called")
+
+	var body PostPullRequestCreateBatchJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostPullRequestCreateBatch", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	results, err := s.prUC.CreatePRBatch(ctx.Request().Context(), fromAPIPRBatchItems(body.PullRequests))
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	created := 0
+	out := make([]PRBatchCreateResult, 0, len(results))
+	for _, r := range results {
+		if r.PR != nil {
+			created++
+		}
+		out = append(out, toAPIPRBatchResult(r))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"created": created,
+		"results": out,
 	})
 }
 
@@ -138,3 +184,68 @@ func (s *ServerHandler) PostPullRequestReassign(ctx echo.Context) error {
 		"replaced_by": replacedBy,
 	})
 }
+
+// POST /pullRequest/setReviewers
+func (s *ServerHandler) PostPullRequestSetReviewers(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostPullRequestSetReviewers called")
+
+	var body PostPullRequestSetReviewersJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostPullRequestSetReviewers", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.PullRequestId == "" {
+		log.Warn("invalid data in PostPullRequestSetReviewers", zap.String("pull_request_id", body.PullRequestId))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "pull_request_id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	pr, err := s.prUC.SetReviewers(ctx.Request().Context(), body.PullRequestId, body.Reviewers)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"pr": toAPIPR(pr),
+	})
+}
+
+// GET /pullRequest/stale
+func (s *ServerHandler) GetPullRequestStale(ctx echo.Context, params GetPullRequestStaleParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetPullRequestStale called", zap.String("team_name", stringValue(params.TeamName)))
+
+	prs, err := s.prUC.ListStalePRs(ctx.Request().Context(), stringValue(params.TeamName), time.Now())
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	res := make([]StalePullRequest, 0, len(prs))
+	for _, p := range prs {
+		res = append(res, toAPIStalePR(p))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"pull_requests": res,
+	})
+}
@@ -8,10 +8,22 @@ var _ (ServerInterface) = &ServerHandler{}
 
 // ServerHandler — наша реализация ServerInterface из server_gen.go.
 type ServerHandler struct {
-	teamUC  usecase.TeamUseCase
-	userUC  usecase.UserUseCase
-	prUC    usecase.PRUseCase
-	statsUC usecase.StatsUseCase
+	teamUC   usecase.TeamUseCase
+	userUC   usecase.UserUseCase
+	prUC     usecase.PRUseCase
+	statsUC  usecase.StatsUseCase
+	availUC  usecase.AvailabilityUseCase
+	importUC usecase.ImportUseCase
+	forgeUC  usecase.IntegrationUseCase
+	eventUC  usecase.EventUseCase
+
+	webhooks WebhookSecrets
+}
+
+// WebhookSecrets — секреты входящих вебхуков; пустой секрет отключает вебхук хостинга.
+type WebhookSecrets struct {
+	GitHub string
+	GitLab string
 }
 
 // NewServerHandler собирает HTTP-слой поверх юзкейсов.
@@ -20,11 +32,21 @@ func NewServerHandler(
 	userUC usecase.UserUseCase,
 	prUC usecase.PRUseCase,
 	statsUC usecase.StatsUseCase,
+	availUC usecase.AvailabilityUseCase,
+	importUC usecase.ImportUseCase,
+	forgeUC usecase.IntegrationUseCase,
+	eventUC usecase.EventUseCase,
+	webhooks WebhookSecrets,
 ) *ServerHandler {
 	return &ServerHandler{
-		teamUC:  teamUC,
-		userUC:  userUC,
-		prUC:    prUC,
-		statsUC: statsUC,
+		teamUC:   teamUC,
+		userUC:   userUC,
+		prUC:     prUC,
+		statsUC:  statsUC,
+		availUC:  availUC,
+		importUC: importUC,
+		forgeUC:  forgeUC,
+		eventUC:  eventUC,
+		webhooks: webhooks,
 	}
 }
@@ -13,33 +13,141 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Выгрузить все команды, пользователей и PR в NDJSON
+	// (GET /admin/export)
+	GetAdminExport(ctx echo.Context) error
+	// Загрузить команды, пользователей и PR из NDJSON
+	// (POST /admin/import)
+	PostAdminImport(ctx echo.Context) error
+	// Лента событий PR и команд в реальном времени (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(ctx echo.Context, params GetEventsStreamParams) error
+	// Сопоставления логинов хостинга с пользователями
+	// (GET /integrations/accounts)
+	GetIntegrationsAccounts(ctx echo.Context, params GetIntegrationsAccountsParams) error
+	// Привязать логин на хостинге к пользователю
+	// (POST /integrations/accounts)
+	PostIntegrationsAccounts(ctx echo.Context) error
+	// Удалить сопоставление логина
+	// (POST /integrations/accounts/delete)
+	PostIntegrationsAccountsDelete(ctx echo.Context) error
+	// Вебхук GitHub о pull request'ах
+	// (POST /integrations/github)
+	PostIntegrationsGithub(ctx echo.Context, params PostIntegrationsGithubParams) error
+	// Вебхук GitLab о merge request'ах
+	// (POST /integrations/gitlab)
+	PostIntegrationsGitlab(ctx echo.Context, params PostIntegrationsGitlabParams) error
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(ctx echo.Context) error
+	// Создать несколько PR в одной транзакции
+	// (POST /pullRequest/createBatch)
+	PostPullRequestCreateBatch(ctx echo.Context) error
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(ctx echo.Context) error
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(ctx echo.Context) error
+	// Вручную задать ревьюверов открытого PR
+	// (POST /pullRequest/setReviewers)
+	PostPullRequestSetReviewers(ctx echo.Context) error
+	// Получить открытые PR без активности дольше порога команды
+	// (GET /pullRequest/stale)
+	GetPullRequestStale(ctx echo.Context, params GetPullRequestStaleParams) error
 	// Получить статистику по назначению ревьюверов и статусам PR
 	// (GET /stats)
-	GetStats(ctx echo.Context) error
+	GetStats(ctx echo.Context, params GetStatsParams) error
+	// Получить время от создания до мержа PR и возраст открытых PR
+	// (GET /stats/cycleTime)
+	GetStatsCycleTime(ctx echo.Context, params GetStatsCycleTimeParams) error
+	// Получить отчёт о равномерности распределения ревью по командам
+	// (GET /stats/fairness)
+	GetStatsFairness(ctx echo.Context, params GetStatsFairnessParams) error
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(ctx echo.Context) error
+	// Получить правила владения путями (CODEOWNERS) команды
+	// (GET /team/codeowners)
+	GetTeamCodeowners(ctx echo.Context, params GetTeamCodeownersParams) error
+	// Заменить правила владения путями команды
+	// (POST /team/codeowners)
+	PostTeamCodeowners(ctx echo.Context) error
+	// Заменить правила владения путями команды содержимым файла CODEOWNERS
+	// (POST /team/codeowners/import)
+	PostTeamCodeownersImport(ctx echo.Context, params PostTeamCodeownersImportParams) error
 	// Массовая деактивация пользователей команды с безопасной переназначаемостью открытых PR
 	// (POST /team/deactivateMembers)
 	PostTeamDeactivateMembers(ctx echo.Context) error
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
+	// Добавить участников в существующую команду (создаёт/обновляет пользователей)
+	// (POST /team/members/add)
+	PostTeamMembersAdd(ctx echo.Context) error
+	// Удалить участников из команды с переназначением их открытых PR
+	// (POST /team/members/remove)
+	PostTeamMembersRemove(ctx echo.Context) error
+	// Обновить участника команды (username, is_active, seniority) с переназначением PR при деактивации
+	// (POST /team/members/update)
+	PostTeamMembersUpdate(ctx echo.Context) error
+	// Получить настройки уведомлений команды в чат
+	// (GET /team/notifications)
+	GetTeamNotifications(ctx echo.Context, params GetTeamNotificationsParams) error
+	// Обновить настройки уведомлений команды в чат
+	// (POST /team/notifications)
+	PostTeamNotifications(ctx echo.Context) error
+	// Получить правила подбора ревьюверов команды
+	// (GET /team/rules)
+	GetTeamRules(ctx echo.Context, params GetTeamRulesParams) error
+	// Добавить правило подбора ревьюверов
+	// (POST /team/rules)
+	PostTeamRules(ctx echo.Context) error
+	// Удалить правило подбора ревьюверов
+	// (POST /team/rules/delete)
+	PostTeamRulesDelete(ctx echo.Context) error
+	// Получить настройки команды
+	// (GET /team/settings)
+	GetTeamSettings(ctx echo.Context, params GetTeamSettingsParams) error
+	// Обновить настройки команды
+	// (POST /team/settings)
+	PostTeamSettings(ctx echo.Context) error
+	// Получить периоды недоступности пользователя (отпуск, out-of-office)
+	// (GET /users/availability)
+	GetUsersAvailability(ctx echo.Context, params GetUsersAvailabilityParams) error
+	// Запланировать период недоступности; с его началом открытые PR пользователя переназначаются
+	// (POST /users/availability)
+	PostUsersAvailability(ctx echo.Context) error
+	// Удалить период недоступности
+	// (POST /users/availability/delete)
+	PostUsersAvailabilityDelete(ctx echo.Context) error
+	// Изменить период недоступности
+	// (POST /users/availability/update)
+	PostUsersAvailabilityUpdate(ctx echo.Context) error
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
-	// Установить флаг активности пользователя
+	// Получить настройки дайджеста ревью пользователя
+	// (GET /users/preferences)
+	GetUsersPreferences(ctx echo.Context, params GetUsersPreferencesParams) error
+	// Задать настройки дайджеста ревью
+	// (POST /users/preferences)
+	PostUsersPreferences(ctx echo.Context) error
+	// Установить флаг активности пользователя (при деактивации открытые PR переназначаются)
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(ctx echo.Context) error
+	// Включить или отключить пользователю уведомления в чат
+	// (POST /users/setNotifications)
+	PostUsersSetNotifications(ctx echo.Context) error
+	// Установить уровень пользователя
+	// (POST /users/setSeniority)
+	PostUsersSetSeniority(ctx echo.Context) error
+	// Получить теги (навыки) пользователя
+	// (GET /users/tags)
+	GetUsersTags(ctx echo.Context, params GetUsersTagsParams) error
+	// Заменить теги пользователя
+	// (POST /users/tags)
+	PostUsersTags(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -47,6 +155,197 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAdminExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminExport(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminExport(ctx)
+	return err
+}
+
+// PostAdminImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminImport(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminImport(ctx)
+	return err
+}
+
+// GetEventsStream converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEventsStream(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "last_event_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "last_event_id", ctx.QueryParams(), &params.LastEventId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter last_event_id: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEventsStream(ctx, params)
+	return err
+}
+
+// GetIntegrationsAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetIntegrationsAccounts(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIntegrationsAccountsParams
+	// ------------- Required query parameter "forge" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "forge", ctx.QueryParams(), &params.Forge)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter forge: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetIntegrationsAccounts(ctx, params)
+	return err
+}
+
+// PostIntegrationsAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) PostIntegrationsAccounts(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostIntegrationsAccounts(ctx)
+	return err
+}
+
+// PostIntegrationsAccountsDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostIntegrationsAccountsDelete(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostIntegrationsAccountsDelete(ctx)
+	return err
+}
+
+// PostIntegrationsGithub converts echo context to params.
+func (w *ServerInterfaceWrapper) PostIntegrationsGithub(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostIntegrationsGithubParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-GitHub-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Event")]; found {
+		var XGitHubEvent string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-GitHub-Event, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Event", valueList[0], &XGitHubEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-GitHub-Event: %s", err))
+		}
+
+		params.XGitHubEvent = &XGitHubEvent
+	}
+	// ------------- Optional header parameter "X-Hub-Signature-256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Hub-Signature-256")]; found {
+		var XHubSignature256 string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Hub-Signature-256, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Hub-Signature-256", valueList[0], &XHubSignature256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Hub-Signature-256: %s", err))
+		}
+
+		params.XHubSignature256 = &XHubSignature256
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostIntegrationsGithub(ctx, params)
+	return err
+}
+
+// PostIntegrationsGitlab converts echo context to params.
+func (w *ServerInterfaceWrapper) PostIntegrationsGitlab(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostIntegrationsGitlabParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Gitlab-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Event")]; found {
+		var XGitlabEvent string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Gitlab-Event, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Event", valueList[0], &XGitlabEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Gitlab-Event: %s", err))
+		}
+
+		params.XGitlabEvent = &XGitlabEvent
+	}
+	// ------------- Optional header parameter "X-Gitlab-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Token")]; found {
+		var XGitlabToken string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Gitlab-Token, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Token", valueList[0], &XGitlabToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Gitlab-Token: %s", err))
+		}
+
+		params.XGitlabToken = &XGitlabToken
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostIntegrationsGitlab(ctx, params)
+	return err
+}
+
 // PostPullRequestCreate converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	var err error
@@ -56,6 +355,15 @@ func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	return err
 }
 
+// PostPullRequestCreateBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestCreateBatch(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestCreateBatch(ctx)
+	return err
+}
+
 // PostPullRequestMerge converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 	var err error
@@ -74,12 +382,154 @@ func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error
 	return err
 }
 
+// PostPullRequestSetReviewers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestSetReviewers(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestSetReviewers(ctx)
+	return err
+}
+
+// GetPullRequestStale converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestStale(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestStaleParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestStale(ctx, params)
+	return err
+}
+
 // GetStats converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStats(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "merged_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_from", ctx.QueryParams(), &params.MergedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter merged_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "merged_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_to", ctx.QueryParams(), &params.MergedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter merged_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "granularity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "granularity", ctx.QueryParams(), &params.Granularity)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter granularity: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetStats(ctx)
+	err = w.Handler.GetStats(ctx, params)
+	return err
+}
+
+// GetStatsCycleTime converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsCycleTime(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsCycleTimeParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "merged_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_from", ctx.QueryParams(), &params.MergedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter merged_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "merged_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_to", ctx.QueryParams(), &params.MergedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter merged_to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsCycleTime(ctx, params)
+	return err
+}
+
+// GetStatsFairness converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsFairness(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "idle_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "idle_days", ctx.QueryParams(), &params.IdleDays)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter idle_days: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsFairness(ctx, params)
 	return err
 }
 
@@ -92,6 +542,51 @@ func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	return err
 }
 
+// GetTeamCodeowners converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamCodeowners(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCodeownersParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamCodeowners(ctx, params)
+	return err
+}
+
+// PostTeamCodeowners converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamCodeowners(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamCodeowners(ctx)
+	return err
+}
+
+// PostTeamCodeownersImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamCodeownersImport(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamCodeownersImportParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamCodeownersImport(ctx, params)
+	return err
+}
+
 // PostTeamDeactivateMembers converts echo context to params.
 func (w *ServerInterfaceWrapper) PostTeamDeactivateMembers(ctx echo.Context) error {
 	var err error
@@ -119,6 +614,168 @@ func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	return err
 }
 
+// PostTeamMembersAdd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamMembersAdd(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamMembersAdd(ctx)
+	return err
+}
+
+// PostTeamMembersRemove converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamMembersRemove(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamMembersRemove(ctx)
+	return err
+}
+
+// PostTeamMembersUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamMembersUpdate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamMembersUpdate(ctx)
+	return err
+}
+
+// GetTeamNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamNotifications(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamNotificationsParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamNotifications(ctx, params)
+	return err
+}
+
+// PostTeamNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamNotifications(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamNotifications(ctx)
+	return err
+}
+
+// GetTeamRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamRules(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamRulesParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamRules(ctx, params)
+	return err
+}
+
+// PostTeamRules converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamRules(ctx)
+	return err
+}
+
+// PostTeamRulesDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamRulesDelete(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamRulesDelete(ctx)
+	return err
+}
+
+// GetTeamSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamSettings(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSettingsParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamSettings(ctx, params)
+	return err
+}
+
+// PostTeamSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSettings(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSettings(ctx)
+	return err
+}
+
+// GetUsersAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersAvailability(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersAvailabilityParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersAvailability(ctx, params)
+	return err
+}
+
+// PostUsersAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersAvailability(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersAvailability(ctx)
+	return err
+}
+
+// PostUsersAvailabilityDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersAvailabilityDelete(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersAvailabilityDelete(ctx)
+	return err
+}
+
+// PostUsersAvailabilityUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersAvailabilityUpdate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersAvailabilityUpdate(ctx)
+	return err
+}
+
 // GetUsersGetReview converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	var err error
@@ -137,6 +794,33 @@ func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	return err
 }
 
+// GetUsersPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersPreferences(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersPreferencesParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersPreferences(ctx, params)
+	return err
+}
+
+// PostUsersPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersPreferences(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersPreferences(ctx)
+	return err
+}
+
 // PostUsersSetIsActive converts echo context to params.
 func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	var err error
@@ -146,6 +830,51 @@ func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	return err
 }
 
+// PostUsersSetNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetNotifications(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetNotifications(ctx)
+	return err
+}
+
+// PostUsersSetSeniority converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetSeniority(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetSeniority(ctx)
+	return err
+}
+
+// GetUsersTags converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersTags(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersTagsParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersTags(ctx, params)
+	return err
+}
+
+// PostUsersTags converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersTags(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersTags(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -174,14 +903,50 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/admin/export", wrapper.GetAdminExport)
+	router.POST(baseURL+"/admin/import", wrapper.PostAdminImport)
+	router.GET(baseURL+"/events/stream", wrapper.GetEventsStream)
+	router.GET(baseURL+"/integrations/accounts", wrapper.GetIntegrationsAccounts)
+	router.POST(baseURL+"/integrations/accounts", wrapper.PostIntegrationsAccounts)
+	router.POST(baseURL+"/integrations/accounts/delete", wrapper.PostIntegrationsAccountsDelete)
+	router.POST(baseURL+"/integrations/github", wrapper.PostIntegrationsGithub)
+	router.POST(baseURL+"/integrations/gitlab", wrapper.PostIntegrationsGitlab)
 	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.POST(baseURL+"/pullRequest/createBatch", wrapper.PostPullRequestCreateBatch)
 	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
 	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(baseURL+"/pullRequest/setReviewers", wrapper.PostPullRequestSetReviewers)
+	router.GET(baseURL+"/pullRequest/stale", wrapper.GetPullRequestStale)
 	router.GET(baseURL+"/stats", wrapper.GetStats)
+	router.GET(baseURL+"/stats/cycleTime", wrapper.GetStatsCycleTime)
+	router.GET(baseURL+"/stats/fairness", wrapper.GetStatsFairness)
 	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
+	router.GET(baseURL+"/team/codeowners", wrapper.GetTeamCodeowners)
+	router.POST(baseURL+"/team/codeowners", wrapper.PostTeamCodeowners)
+	router.POST(baseURL+"/team/codeowners/import", wrapper.PostTeamCodeownersImport)
 	router.POST(baseURL+"/team/deactivateMembers", wrapper.PostTeamDeactivateMembers)
 	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
+	router.POST(baseURL+"/team/members/add", wrapper.PostTeamMembersAdd)
+	router.POST(baseURL+"/team/members/remove", wrapper.PostTeamMembersRemove)
+	router.POST(baseURL+"/team/members/update", wrapper.PostTeamMembersUpdate)
+	router.GET(baseURL+"/team/notifications", wrapper.GetTeamNotifications)
+	router.POST(baseURL+"/team/notifications", wrapper.PostTeamNotifications)
+	router.GET(baseURL+"/team/rules", wrapper.GetTeamRules)
+	router.POST(baseURL+"/team/rules", wrapper.PostTeamRules)
+	router.POST(baseURL+"/team/rules/delete", wrapper.PostTeamRulesDelete)
+	router.GET(baseURL+"/team/settings", wrapper.GetTeamSettings)
+	router.POST(baseURL+"/team/settings", wrapper.PostTeamSettings)
+	router.GET(baseURL+"/users/availability", wrapper.GetUsersAvailability)
+	router.POST(baseURL+"/users/availability", wrapper.PostUsersAvailability)
+	router.POST(baseURL+"/users/availability/delete", wrapper.PostUsersAvailabilityDelete)
+	router.POST(baseURL+"/users/availability/update", wrapper.PostUsersAvailabilityUpdate)
 	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.GET(baseURL+"/users/preferences", wrapper.GetUsersPreferences)
+	router.POST(baseURL+"/users/preferences", wrapper.PostUsersPreferences)
 	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(baseURL+"/users/setNotifications", wrapper.PostUsersSetNotifications)
+	router.POST(baseURL+"/users/setSeniority", wrapper.PostUsersSetSeniority)
+	router.GET(baseURL+"/users/tags", wrapper.GetUsersTags)
+	router.POST(baseURL+"/users/tags", wrapper.PostUsersTags)
 
 }
@@ -2,17 +2,41 @@ package v1
 
 import (
 	"errors"
-	"github.com/alnoi/pr-reviewer-service/internal/domain"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	applog "github.com/alnoi/pr-reviewer-service/internal/logger"
+	"go.uber.org/zap"
 )
 
 // GetStats handles GET /stats
-func (s *ServerHandler) GetStats(ctx echo.Context) error {
+func (s *ServerHandler) GetStats(ctx echo.Context, params GetStatsParams) error {
+	log := applog.FromContext(ctx.Request().Context())
 	reqCtx := ctx.Request().Context()
 
-	stats, err := s.statsUC.GetStats(reqCtx)
+	filter := newStatsFilter(params.TeamName, params.CreatedFrom, params.CreatedTo, params.MergedFrom, params.MergedTo)
+
+	var granularity domain.StatsGranularity
+	if params.Granularity != nil {
+		granularity = domain.StatsGranularity(*params.Granularity)
+	}
+
+	if granularity != "" && granularity != domain.StatsGranularityDay && granularity != domain.StatsGranularityWeek {
+		log.Warn("invalid granularity in GetStats", zap.String("granularity", string(granularity)))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "granularity must be one of: day, week")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if !validStatsFilter(filter) {
+		log.Warn("invalid range in GetStats")
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "range start must be before range end")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	stats, err := s.statsUC.GetStats(reqCtx, filter, granularity)
 	if err != nil {
 		var derr *domain.DomainError
 		if errors.As(err, &derr) {
@@ -25,13 +49,18 @@ func (s *ServerHandler) GetStats(ctx echo.Context) error {
 		return ctx.JSON(http.StatusInternalServerError, resp)
 	}
 
+	byTeam := make([]TeamStats, 0, len(stats.ByTeam))
+	for _, t := range stats.ByTeam {
+		byTeam = append(byTeam, TeamStats{
+			TeamName:       t.TeamName,
+			PrStatusCounts: toAPIStatusCounts(t.PRStatusCounts),
+		})
+	}
+
 	apiStats := Stats{
 		AssignmentsByUser: make([]UserAssignmentsStat, 0, len(stats.AssignmentsByUser)),
-		PrStatusCounts: PRStatusCounts{
-			Open:   int32(stats.PRStatusCounts.Open),
-			Merged: int32(stats.PRStatusCounts.Merged),
-			Total:  int32(stats.PRStatusCounts.Total),
-		},
+		PrStatusCounts:    toAPIStatusCounts(stats.PRStatusCounts),
+		ByTeam:            &byTeam,
 	}
 
 	for _, sUser := range stats.AssignmentsByUser {
@@ -41,5 +70,175 @@ func (s *ServerHandler) GetStats(ctx echo.Context) error {
 		})
 	}
 
+	if granularity != "" {
+		series := make([]StatsBucket, 0, len(stats.TimeSeries))
+		for _, b := range stats.TimeSeries {
+			series = append(series, StatsBucket{
+				BucketStart: b.Start.UTC(),
+				Created:     int32(b.Created),
+				Merged:      int32(b.Merged),
+			})
+		}
+		apiStats.TimeSeries = &series
+	}
+
 	return ctx.JSON(http.StatusOK, apiStats)
 }
+
+// GetStatsCycleTime handles GET /stats/cycleTime
+func (s *ServerHandler) GetStatsCycleTime(ctx echo.Context, params GetStatsCycleTimeParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	reqCtx := ctx.Request().Context()
+
+	filter := newStatsFilter(params.TeamName, params.CreatedFrom, params.CreatedTo, params.MergedFrom, params.MergedTo)
+
+	if !validStatsFilter(filter) {
+		log.Warn("invalid range in GetStatsCycleTime")
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "range start must be before range end")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	stats, err := s.statsUC.GetCycleTime(reqCtx, filter, time.Now())
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	res := CycleTimeStats{
+		Overall:    toAPIDurationSummary(stats.Overall),
+		ByTeam:     make([]TeamCycleTime, 0, len(stats.ByTeam)),
+		ByReviewer: make([]ReviewerCycleTime, 0, len(stats.ByReviewer)),
+		OpenAge: OpenPRAge{
+			Age:     toAPIDurationSummary(stats.OpenAge.Age),
+			Buckets: make([]AgeBucket, 0, len(stats.OpenAge.Buckets)),
+		},
+	}
+
+	for _, t := range stats.ByTeam {
+		res.ByTeam = append(res.ByTeam, TeamCycleTime{
+			TeamName:  t.TeamName,
+			CycleTime: toAPIDurationSummary(t.CycleTime),
+		})
+	}
+	for _, r := range stats.ByReviewer {
+		res.ByReviewer = append(res.ByReviewer, ReviewerCycleTime{
+			UserId:    r.UserID,
+			CycleTime: toAPIDurationSummary(r.CycleTime),
+		})
+	}
+	for _, b := range stats.OpenAge.Buckets {
+		bucket := AgeBucket{Count: int32(b.Count)}
+		if b.UpperBound > 0 {
+			le := b.UpperBound.Seconds()
+			bucket.LeSeconds = &le
+		}
+		res.OpenAge.Buckets = append(res.OpenAge.Buckets, bucket)
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// GetStatsFairness handles GET /stats/fairness
+func (s *ServerHandler) GetStatsFairness(ctx echo.Context, params GetStatsFairnessParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	reqCtx := ctx.Request().Context()
+
+	idleDays := domain.DefaultFairnessIdleDays
+	if params.IdleDays != nil {
+		idleDays = *params.IdleDays
+	}
+
+	if idleDays < 1 {
+		log.Warn("invalid idle_days in GetStatsFairness", zap.Int("idle_days", idleDays))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "idle_days must be positive")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	report, err := s.statsUC.GetFairness(reqCtx, stringValue(params.TeamName), idleDays, time.Now())
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	res := FairnessReport{
+		IdleSince: report.IdleSince.UTC(),
+		Teams:     make([]TeamFairness, 0, len(report.Teams)),
+	}
+
+	for _, t := range report.Teams {
+		members := make([]MemberLoad, 0, len(t.Members))
+		for _, m := range t.Members {
+			members = append(members, MemberLoad{
+				UserId:          m.UserID,
+				OpenAssignments: int32(m.OpenAssignments),
+				LastAssignedAt:  timePtr(m.LastAssignedAt),
+			})
+		}
+
+		res.Teams = append(res.Teams, TeamFairness{
+			TeamName:        t.TeamName,
+			ActiveMembers:   int32(t.ActiveMembers),
+			OpenAssignments: int32(t.OpenAssignments),
+			MeanLoad:        t.MeanLoad,
+			MinLoad:         int32(t.MinLoad),
+			MaxLoad:         int32(t.MaxLoad),
+			Spread:          int32(t.Spread()),
+			Gini:            t.Gini,
+			Members:         members,
+			IdleMembers:     append([]string{}, t.IdleMembers...),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func newStatsFilter(teamName *string, createdFrom, createdTo, mergedFrom, mergedTo *time.Time) domain.StatsFilter {
+	return domain.StatsFilter{
+		TeamName:    stringValue(teamName),
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		MergedFrom:  mergedFrom,
+		MergedTo:    mergedTo,
+	}
+}
+
+func validStatsFilter(f domain.StatsFilter) bool {
+	return validRange(f.CreatedFrom, f.CreatedTo) && validRange(f.MergedFrom, f.MergedTo)
+}
+
+func toAPIDurationSummary(d domain.DurationSummary) DurationSummary {
+	return DurationSummary{
+		Count:       int32(d.Count),
+		MeanSeconds: d.Mean.Seconds(),
+		P50Seconds:  d.P50.Seconds(),
+		P90Seconds:  d.P90.Seconds(),
+		P99Seconds:  d.P99.Seconds(),
+	}
+}
+
+func toAPIStatusCounts(c domain.PRStatusCounts) PRStatusCounts {
+	return PRStatusCounts{
+		Open:   int32(c.Open),
+		Merged: int32(c.Merged),
+		Total:  int32(c.Total),
+	}
+}
+
+// validRange проверяет, что у полуинтервала [from, to) начало строго раньше конца.
+func validRange(from, to *time.Time) bool {
+	return from == nil || to == nil || from.Before(*to)
+}
@@ -2,7 +2,9 @@ package v1
 
 import (
 	"errors"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -12,6 +14,9 @@ import (
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
 )
 
+// maxCodeOwnersSize — предельный размер загружаемого файла CODEOWNERS.
+const maxCodeOwnersSize = 1 << 20
+
 func (s *ServerHandler) PostTeamAdd(ctx echo.Context) error {
 	log := applog.FromContext(ctx.Request().Context())
 	log.Info("PostTeamAdd called")
@@ -27,9 +32,10 @@ func (s *ServerHandler) PostTeamAdd(ctx echo.Context) error {
 	members := make([]domain.TeamMember, 0, len(body.Members))
 	for _, m := range body.Members {
 		members = append(members, domain.TeamMember{
-			UserID:   m.UserId,
-			Username: m.Username,
-			IsActive: m.IsActive,
+			UserID:    m.UserId,
+			Username:  m.Username,
+			IsActive:  m.IsActive,
+			Seniority: seniorityValue(m.Seniority),
 		})
 	}
 
@@ -80,6 +86,370 @@ func (s *ServerHandler) GetTeamGet(ctx echo.Context, params GetTeamGetParams) er
 	return ctx.JSON(http.StatusOK, toAPITeam(team))
 }
 
+// GET /team/settings
+func (s *ServerHandler) GetTeamSettings(ctx echo.Context, params GetTeamSettingsParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetTeamSettings called", zap.String("team_name", params.TeamName))
+
+	if params.TeamName == "" {
+		log.Warn("invalid data in GetTeamSettings", zap.String("team_name", params.TeamName))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "team_name is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	settings, err := s.teamUC.GetTeamSettings(ctx.Request().Context(), params.TeamName)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPITeamSettings(settings))
+}
+
+// POST /team/settings
+func (s *ServerHandler) PostTeamSettings(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostTeamSettings called")
+
+	var body PostTeamSettingsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostTeamSettings", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.TeamName == "" || body.StaleAfterSeconds < 0 {
+		log.Warn("invalid data in PostTeamSettings",
+			zap.String("team_name", body.TeamName),
+			zap.Int64("stale_after_seconds", body.StaleAfterSeconds),
+		)
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"),
+			"team_name is required and stale_after_seconds must not be negative")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	minSeniors := 0
+	if body.MinSeniorReviewers != nil {
+		minSeniors = *body.MinSeniorReviewers
+	}
+	forgeSync := body.ForgeSync != nil && *body.ForgeSync
+
+	settings, err := s.teamUC.UpdateTeamSettings(ctx.Request().Context(), domain.TeamSettings{
+		TeamName:           body.TeamName,
+		StaleAfter:         time.Duration(body.StaleAfterSeconds) * time.Second,
+		StaleAutoRotate:    body.StaleAutoRotate,
+		ReviewerTeams:      stringsValue(body.ReviewerTeams),
+		MinSeniorReviewers: minSeniors,
+		ForgeSync:          forgeSync,
+	})
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPITeamSettings(settings))
+}
+
+// GET /team/notifications
+func (s *ServerHandler) GetTeamNotifications(ctx echo.Context, params GetTeamNotificationsParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetTeamNotifications called", zap.String("team_name", params.TeamName))
+
+	if params.TeamName == "" {
+		log.Warn("invalid data in GetTeamNotifications", zap.String("team_name", params.TeamName))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "team_name is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	n, err := s.teamUC.GetTeamNotifications(ctx.Request().Context(), params.TeamName)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPITeamNotifications(n))
+}
+
+// POST /team/notifications
+func (s *ServerHandler) PostTeamNotifications(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostTeamNotifications called")
+
+	var body PostTeamNotificationsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostTeamNotifications", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.TeamName == "" {
+		log.Warn("invalid data in PostTeamNotifications", zap.String("team_name", body.TeamName))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "team_name is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	n, err := s.teamUC.UpdateTeamNotifications(ctx.Request().Context(), domain.TeamNotifications{
+		TeamName:   body.TeamName,
+		WebhookURL: body.WebhookUrl,
+		Channel:    stringValue(body.Channel),
+		Templates:  fromAPINotificationTemplates(body.Templates),
+	})
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPITeamNotifications(n))
+}
+
+// GET /team/rules
+func (s *ServerHandler) GetTeamRules(ctx echo.Context, params GetTeamRulesParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetTeamRules called", zap.String("team_name", params.TeamName))
+
+	if params.TeamName == "" {
+		log.Warn("invalid data in GetTeamRules", zap.String("team_name", params.TeamName))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "team_name is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	rules, err := s.teamUC.ListReviewRules(ctx.Request().Context(), params.TeamName)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	items := make([]ReviewRule, 0, len(rules))
+	for _, r := range rules {
+		items = append(items, toAPIReviewRule(r))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"team_name": params.TeamName,
+		"rules":     items,
+	})
+}
+
+// POST /team/rules
+func (s *ServerHandler) PostTeamRules(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostTeamRules called")
+
+	var body PostTeamRulesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostTeamRules", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.TeamName == "" || body.UserId == "" || body.OtherUserId == "" {
+		log.Warn("invalid data in PostTeamRules",
+			zap.String("team_name", body.TeamName),
+			zap.String("user_id", body.UserId),
+			zap.String("other_user_id", body.OtherUserId),
+		)
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "team_name, user_id and other_user_id are required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	created, err := s.teamUC.CreateReviewRule(ctx.Request().Context(), domain.ReviewRule{
+		TeamName:    body.TeamName,
+		Type:        domain.ReviewRuleType(body.Type),
+		UserID:      body.UserId,
+		OtherUserID: body.OtherUserId,
+	})
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusCreated, map[string]any{
+		"rule": toAPIReviewRule(created),
+	})
+}
+
+// POST /team/rules/delete
+func (s *ServerHandler) PostTeamRulesDelete(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostTeamRulesDelete called")
+
+	var body PostTeamRulesDeleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostTeamRulesDelete", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.Id <= 0 {
+		log.Warn("invalid data in PostTeamRulesDelete", zap.Int64("id", body.Id))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if err := s.teamUC.DeleteReviewRule(ctx.Request().Context(), body.Id); err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"id": body.Id,
+	})
+}
+
+// GET /team/codeowners
+func (s *ServerHandler) GetTeamCodeowners(ctx echo.Context, params GetTeamCodeownersParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetTeamCodeowners called", zap.String("team_name", params.TeamName))
+
+	if params.TeamName == "" {
+		log.Warn("invalid data in GetTeamCodeowners", zap.String("team_name", params.TeamName))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "team_name is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	rules, err := s.teamUC.GetCodeOwners(ctx.Request().Context(), params.TeamName)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, TeamCodeOwners{
+		TeamName: params.TeamName,
+		Rules:    toAPICodeOwnerRules(rules),
+	})
+}
+
+// POST /team/codeowners
+func (s *ServerHandler) PostTeamCodeowners(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostTeamCodeowners called")
+
+	var body PostTeamCodeownersJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostTeamCodeowners", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.TeamName == "" {
+		log.Warn("invalid data in PostTeamCodeowners", zap.String("team_name", body.TeamName))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "team_name is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	rules, err := s.teamUC.UpdateCodeOwners(ctx.Request().Context(), body.TeamName, fromAPICodeOwnerRules(body.Rules))
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, TeamCodeOwners{
+		TeamName: body.TeamName,
+		Rules:    toAPICodeOwnerRules(rules),
+	})
+}
+
+// POST /team/codeowners/import
+func (s *ServerHandler) PostTeamCodeownersImport(ctx echo.Context, params PostTeamCodeownersImportParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostTeamCodeownersImport called", zap.String("team_name", params.TeamName))
+
+	if params.TeamName == "" {
+		log.Warn("invalid data in PostTeamCodeownersImport", zap.String("team_name", params.TeamName))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "team_name is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxCodeOwnersSize+1))
+	if err != nil || len(content) > maxCodeOwnersSize {
+		log.Warn("unreadable body in PostTeamCodeownersImport", zap.Error(err), zap.Int("size", len(content)))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "CODEOWNERS file is unreadable or too large")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	res, err := s.teamUC.ImportCodeOwners(ctx.Request().Context(), params.TeamName, string(content))
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"team_name":      params.TeamName,
+		"rules":          toAPICodeOwnerRules(res.Rules),
+		"ignored_owners": append([]string{}, res.IgnoredOwners...),
+	})
+}
+
 func (s *ServerHandler) PostTeamDeactivateMembers(ctx echo.Context) error {
 	log := applog.FromContext(ctx.Request().Context())
 	log.Info("PostTeamDeactivateMembers called")
@@ -101,7 +471,157 @@ func (s *ServerHandler) PostTeamDeactivateMembers(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, resp)
 	}
 
-	updatedTeam, err := s.teamUC.DeactivateTeamMembers(ctx.Request().Context(), body.TeamName, body.UserIds)
+	dryRun := body.DryRun != nil && *body.DryRun
+
+	res, err := s.teamUC.DeactivateTeamMembers(ctx.Request().Context(), body.TeamName, body.UserIds, dryRun)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	updates := make([]PRReviewersUpdate, 0, len(res.Updates))
+	for _, u := range res.Updates {
+		updates = append(updates, toAPIPRReviewersUpdate(u))
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"team":                 toAPITeam(res.Team),
+		"dry_run":              res.DryRun,
+		"deactivated_user_ids": append([]string{}, res.Deactivated...),
+		"pr_updates":           updates,
+	})
+}
+
+// POST /team/members/add
+func (s *ServerHandler) PostTeamMembersAdd(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostTeamMembersAdd called")
+
+	var body PostTeamMembersAddJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostTeamMembersAdd", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.TeamName == "" || len(body.Members) == 0 {
+		log.Warn("invalid data in PostTeamMembersAdd",
+			zap.String("team_name", body.TeamName),
+			zap.Int("members_count", len(body.Members)),
+		)
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"),
+			"team_name and members are required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	members := make([]domain.TeamMember, 0, len(body.Members))
+	for _, m := range body.Members {
+		if m.UserId == "" {
+			log.Warn("invalid member in PostTeamMembersAdd", zap.String("team_name", body.TeamName))
+			resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "user_id is required for every member")
+			return ctx.JSON(http.StatusBadRequest, resp)
+		}
+		members = append(members, domain.TeamMember{
+			UserID:    m.UserId,
+			Username:  m.Username,
+			IsActive:  m.IsActive,
+			Seniority: seniorityValue(m.Seniority),
+		})
+	}
+
+	team, err := s.teamUC.AddTeamMembers(ctx.Request().Context(), body.TeamName, members)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"team": toAPITeam(team),
+	})
+}
+
+// POST /team/members/remove
+func (s *ServerHandler) PostTeamMembersRemove(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostTeamMembersRemove called")
+
+	var body PostTeamMembersRemoveJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostTeamMembersRemove", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.TeamName == "" || len(body.UserIds) == 0 {
+		log.Warn("invalid data in PostTeamMembersRemove",
+			zap.String("team_name", body.TeamName),
+			zap.Int("user_ids_count", len(body.UserIds)),
+		)
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"),
+			"team_name and user_ids are required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	team, err := s.teamUC.RemoveTeamMembers(ctx.Request().Context(), body.TeamName, body.UserIds)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"team": toAPITeam(team),
+	})
+}
+
+// POST /team/members/update
+func (s *ServerHandler) PostTeamMembersUpdate(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostTeamMembersUpdate called")
+
+	var body PostTeamMembersUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostTeamMembersUpdate", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.TeamName == "" || body.UserId == "" || body.Username == "" {
+		log.Warn("invalid data in PostTeamMembersUpdate",
+			zap.String("team_name", body.TeamName),
+			zap.String("user_id", body.UserId),
+		)
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"),
+			"team_name, user_id and username are required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	team, err := s.teamUC.UpdateTeamMember(ctx.Request().Context(), body.TeamName, domain.TeamMember{
+		UserID:    body.UserId,
+		Username:  body.Username,
+		IsActive:  body.IsActive,
+		Seniority: seniorityValue(body.Seniority),
+	})
 	if err != nil {
 		var derr *domain.DomainError
 		if errors.As(err, &derr) {
@@ -115,6 +635,6 @@ func (s *ServerHandler) PostTeamDeactivateMembers(ctx echo.Context) error {
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]any{
-		"team": toAPITeam(updatedTeam),
+		"team": toAPITeam(team),
 	})
 }
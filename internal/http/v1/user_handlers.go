@@ -29,7 +29,148 @@ func (s *ServerHandler) PostUsersSetIsActive(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, resp)
 	}
 
-	user, err := s.userUC.SetUserIsActive(ctx.Request().Context(), body.UserId, body.IsActive)
+	rebalance := body.Rebalance != nil && *body.Rebalance
+
+	user, err := s.userUC.SetUserIsActive(ctx.Request().Context(), body.UserId, body.IsActive, rebalance)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"user": toAPIUser(user),
+	})
+}
+
+// POST /users/setNotifications
+func (s *ServerHandler) PostUsersSetNotifications(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostUsersSetNotifications called")
+	var body PostUsersSetNotificationsJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostUsersSetNotifications", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.UserId == "" {
+		log.Warn("invalid data in PostUsersSetNotifications", zap.String("user_id", body.UserId))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "user_id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if err := s.userUC.SetUserNotifications(ctx.Request().Context(), body.UserId, body.Enabled); err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"user_id":               body.UserId,
+		"notifications_enabled": body.Enabled,
+	})
+}
+
+// GET /users/preferences
+func (s *ServerHandler) GetUsersPreferences(ctx echo.Context, params GetUsersPreferencesParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetUsersPreferences called", zap.String("user_id", params.UserId))
+	if params.UserId == "" {
+		log.Warn("invalid data in GetUsersPreferences", zap.String("user_id", params.UserId))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "user_id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	p, err := s.userUC.GetUserPreferences(ctx.Request().Context(), params.UserId)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIUserPreferences(p))
+}
+
+// POST /users/preferences
+func (s *ServerHandler) PostUsersPreferences(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostUsersPreferences called")
+	var body PostUsersPreferencesJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostUsersPreferences", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.UserId == "" {
+		log.Warn("invalid data in PostUsersPreferences", zap.String("user_id", body.UserId))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "user_id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	prefs, ok := fromAPIUserPreferences(body)
+	if !ok {
+		log.Warn("invalid data in PostUsersPreferences", zap.String("digest_weekday", string(*body.DigestWeekday)))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "unknown digest_weekday")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	p, err := s.userUC.UpdateUserPreferences(ctx.Request().Context(), prefs)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIUserPreferences(p))
+}
+
+// POST /users/setSeniority
+func (s *ServerHandler) PostUsersSetSeniority(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostUsersSetSeniority called")
+	var body PostUsersSetSeniorityJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostUsersSetSeniority", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.UserId == "" {
+		log.Warn("invalid data in PostUsersSetSeniority", zap.String("user_id", body.UserId))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "user_id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	user, err := s.userUC.SetUserSeniority(ctx.Request().Context(), body.UserId, domain.Seniority(body.Seniority))
 	if err != nil {
 		var derr *domain.DomainError
 		if errors.As(err, &derr) {
@@ -80,3 +221,69 @@ func (s *ServerHandler) GetUsersGetReview(ctx echo.Context, params GetUsersGetRe
 		"pull_requests": items,
 	})
 }
+
+// GET /users/tags
+func (s *ServerHandler) GetUsersTags(ctx echo.Context, params GetUsersTagsParams) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("GetUsersTags called", zap.String("user_id", params.UserId))
+	if params.UserId == "" {
+		log.Warn("invalid data in GetUsersTags", zap.String("user_id", params.UserId))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "user_id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	tags, err := s.userUC.GetUserTags(ctx.Request().Context(), params.UserId)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, UserTags{
+		UserId: params.UserId,
+		Tags:   tags,
+	})
+}
+
+// POST /users/tags
+func (s *ServerHandler) PostUsersTags(ctx echo.Context) error {
+	log := applog.FromContext(ctx.Request().Context())
+	log.Info("PostUsersTags called")
+	var body PostUsersTagsJSONRequestBody
+
+	if err := ctx.Bind(&body); err != nil {
+		log.Warn("invalid json in PostUsersTags", zap.Error(err))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "invalid json")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	if body.UserId == "" {
+		log.Warn("invalid data in PostUsersTags", zap.String("user_id", body.UserId))
+		resp := newAPIError(ErrorResponseErrorCode("BAD_REQUEST"), "user_id is required")
+		return ctx.JSON(http.StatusBadRequest, resp)
+	}
+
+	tags, err := s.userUC.SetUserTags(ctx.Request().Context(), body.UserId, body.Tags)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) {
+			status := mapDomainErrorToStatus(derr.Code)
+			resp := newAPIError(ErrorResponseErrorCode(derr.Code), derr.Error())
+			return ctx.JSON(status, resp)
+		}
+
+		resp := newAPIError(ErrorResponseErrorCode("INTERNAL"), "internal server error")
+		return ctx.JSON(http.StatusInternalServerError, resp)
+	}
+
+	return ctx.JSON(http.StatusOK, UserTags{
+		UserId: body.UserId,
+		Tags:   tags,
+	})
+}
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor — аналог Middleware для gRPC: кладёт логгер вызова в контекст и пишет итог вызова.
+func UnaryServerInterceptor(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		callLogger := base.With(zap.String("grpc_method", info.FullMethod))
+
+		resp, err := handler(WithContext(ctx, callLogger), req)
+
+		logCallFinished(callLogger, err, time.Since(start))
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor — то же для потоковых вызовов.
+func StreamServerInterceptor(base *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		callLogger := base.With(zap.String("grpc_method", info.FullMethod))
+
+		err := handler(srv, &loggedStream{ServerStream: ss, ctx: WithContext(ss.Context(), callLogger)})
+
+		logCallFinished(callLogger, err, time.Since(start))
+
+		return err
+	}
+}
+
+func logCallFinished(l *zap.Logger, err error, latency time.Duration) {
+	code := status.Code(err)
+	fields := []zap.Field{
+		zap.Stringer("code", code),
+		zap.Duration("latency", latency),
+	}
+
+	switch code {
+	case codes.OK:
+		l.Info("call finished", fields...)
+	case codes.Internal, codes.Unknown:
+		l.Error("call finished", append(fields, zap.Error(err))...)
+	default:
+		// ошибки клиента и домена, как 4xx в HTTP
+		l.Warn("call finished", append(fields, zap.Error(err))...)
+	}
+}
+
+// loggedStream подменяет контекст потока на контекст с логгером вызова.
+type loggedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggedStream) Context() context.Context {
+	return s.ctx
+}
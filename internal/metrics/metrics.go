@@ -3,6 +3,8 @@ package metrics
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
 )
 
 var (
@@ -25,4 +27,104 @@ var (
 		Name: "pr_reassigned_total",
 		Help: "Total number of PR reviewer reassignments",
 	})
+
+	AvailabilityAppliedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "availability_applied_total",
+		Help: "Total number of started unavailability periods whose open reviews were reassigned",
+	})
+
+	PRCycleTimeSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pr_cycle_time_seconds",
+		Help:    "Time from PR creation to merge by author's team",
+		Buckets: cycleTimeBuckets(),
+	}, []string{"team"})
+
+	PRReviewerCycleTimeSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pr_reviewer_cycle_time_seconds",
+		Help:    "Time from PR creation to merge by assigned reviewer's team, observed once per reviewer",
+		Buckets: cycleTimeBuckets(),
+	}, []string{"team"})
+
+	ReviewLoadGini = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "review_load_gini",
+		Help: "Gini coefficient of open review assignments across active team members",
+	}, []string{"team"})
+
+	ReviewLoadSpread = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "review_load_spread",
+		Help: "Difference between max and min open review assignments across active team members",
+	}, []string{"team"})
+
+	ReviewLoadMean = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "review_load_mean",
+		Help: "Mean open review assignments per active team member",
+	}, []string{"team"})
+
+	ReviewIdleMembers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "review_idle_members",
+		Help: "Active team members without review assignments within the idle period",
+	}, []string{"team"})
+
+	PRStaleTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pr_stale_total",
+		Help: "Total number of times open PRs became stale, by author's team",
+	}, []string{"team"})
+
+	PRStaleOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pr_stale_open",
+		Help: "Currently stale open PRs by author's team",
+	}, []string{"team"})
+
+	PRStaleRotatedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pr_stale_rotated_total",
+		Help: "Total number of reviewers rotated on stale PRs",
+	})
+
+	ForgeSyncSentTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "forge_sync_sent_total",
+		Help: "Total number of reviewer changes pushed to forges",
+	})
+
+	ForgeSyncDroppedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "forge_sync_dropped_total",
+		Help: "Total number of reviewer changes given up on after a forge rejection or too many attempts",
+	})
+
+	NotificationsSentTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "notifications_sent_total",
+		Help: "Total number of chat notifications sent, by event",
+	}, []string{"event"})
+
+	NotificationsFailedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "notifications_failed_total",
+		Help: "Total number of chat notifications that could not be rendered or delivered, by event",
+	}, []string{"event"})
+
+	DigestsSentTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "digests_sent_total",
+		Help: "Total number of review digest emails sent",
+	})
+
+	DigestsFailedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "digests_failed_total",
+		Help: "Total number of review digest emails that could not be sent",
+	})
+
+	EventsPublishedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "events_published_total",
+		Help: "Total number of events written to the event stream, by type",
+	}, []string{"type"})
+
+	EventStreamClients = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "event_stream_clients",
+		Help: "Currently connected event stream clients",
+	})
 )
+
+func cycleTimeBuckets() []float64 {
+	res := make([]float64, 0, len(domain.CycleTimeBuckets))
+	for _, b := range domain.CycleTimeBuckets {
+		res = append(res, b.Seconds())
+	}
+	return res
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/alnoi/pr-reviewer-service/internal/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockTeamRepository) EXPECT() *MockTeamRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateReviewRule mocks base method.
+func (m *MockTeamRepository) CreateReviewRule(ctx context.Context, rule domain.ReviewRule) (domain.ReviewRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewRule", ctx, rule)
+	ret0, _ := ret[0].(domain.ReviewRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReviewRule indicates an expected call of CreateReviewRule.
+func (mr *MockTeamRepositoryMockRecorder) CreateReviewRule(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewRule", reflect.TypeOf((*MockTeamRepository)(nil).CreateReviewRule), ctx, rule)
+}
+
 // CreateTeam mocks base method.
 func (m *MockTeamRepository) CreateTeam(ctx context.Context, teamName string) error {
 	m.ctrl.T.Helper()
@@ -55,6 +71,35 @@ func (mr *MockTeamRepositoryMockRecorder) CreateTeam(ctx, teamName any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockTeamRepository)(nil).CreateTeam), ctx, teamName)
 }
 
+// DeleteReviewRule mocks base method.
+func (m *MockTeamRepository) DeleteReviewRule(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReviewRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReviewRule indicates an expected call of DeleteReviewRule.
+func (mr *MockTeamRepositoryMockRecorder) DeleteReviewRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewRule", reflect.TypeOf((*MockTeamRepository)(nil).DeleteReviewRule), ctx, id)
+}
+
+// GetCodeOwners mocks base method.
+func (m *MockTeamRepository) GetCodeOwners(ctx context.Context, teamName string) ([]domain.CodeOwnerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeOwners", ctx, teamName)
+	ret0, _ := ret[0].([]domain.CodeOwnerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeOwners indicates an expected call of GetCodeOwners.
+func (mr *MockTeamRepositoryMockRecorder) GetCodeOwners(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeOwners", reflect.TypeOf((*MockTeamRepository)(nil).GetCodeOwners), ctx, teamName)
+}
+
 // GetTeam mocks base method.
 func (m *MockTeamRepository) GetTeam(ctx context.Context, teamName string) (domain.Team, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +115,108 @@ func (mr *MockTeamRepositoryMockRecorder) GetTeam(ctx, teamName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockTeamRepository)(nil).GetTeam), ctx, teamName)
 }
 
+// GetTeamNotifications mocks base method.
+func (m *MockTeamRepository) GetTeamNotifications(ctx context.Context, teamName string) (domain.TeamNotifications, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamNotifications", ctx, teamName)
+	ret0, _ := ret[0].(domain.TeamNotifications)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamNotifications indicates an expected call of GetTeamNotifications.
+func (mr *MockTeamRepositoryMockRecorder) GetTeamNotifications(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamNotifications", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamNotifications), ctx, teamName)
+}
+
+// GetTeamSettings mocks base method.
+func (m *MockTeamRepository) GetTeamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamSettings", ctx, teamName)
+	ret0, _ := ret[0].(domain.TeamSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamSettings indicates an expected call of GetTeamSettings.
+func (mr *MockTeamRepositoryMockRecorder) GetTeamSettings(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamSettings", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamSettings), ctx, teamName)
+}
+
+// ListReviewRules mocks base method.
+func (m *MockTeamRepository) ListReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewRules", ctx, teamName)
+	ret0, _ := ret[0].([]domain.ReviewRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviewRules indicates an expected call of ListReviewRules.
+func (mr *MockTeamRepositoryMockRecorder) ListReviewRules(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewRules", reflect.TypeOf((*MockTeamRepository)(nil).ListReviewRules), ctx, teamName)
+}
+
+// ListTeamNames mocks base method.
+func (m *MockTeamRepository) ListTeamNames(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamNames", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamNames indicates an expected call of ListTeamNames.
+func (mr *MockTeamRepositoryMockRecorder) ListTeamNames(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamNames", reflect.TypeOf((*MockTeamRepository)(nil).ListTeamNames), ctx)
+}
+
+// ReplaceCodeOwners mocks base method.
+func (m *MockTeamRepository) ReplaceCodeOwners(ctx context.Context, teamName string, rules []domain.CodeOwnerRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceCodeOwners", ctx, teamName, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceCodeOwners indicates an expected call of ReplaceCodeOwners.
+func (mr *MockTeamRepositoryMockRecorder) ReplaceCodeOwners(ctx, teamName, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceCodeOwners", reflect.TypeOf((*MockTeamRepository)(nil).ReplaceCodeOwners), ctx, teamName, rules)
+}
+
+// UpsertTeamNotifications mocks base method.
+func (m *MockTeamRepository) UpsertTeamNotifications(ctx context.Context, n domain.TeamNotifications) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTeamNotifications", ctx, n)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertTeamNotifications indicates an expected call of UpsertTeamNotifications.
+func (mr *MockTeamRepositoryMockRecorder) UpsertTeamNotifications(ctx, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTeamNotifications", reflect.TypeOf((*MockTeamRepository)(nil).UpsertTeamNotifications), ctx, n)
+}
+
+// UpsertTeamSettings mocks base method.
+func (m *MockTeamRepository) UpsertTeamSettings(ctx context.Context, settings domain.TeamSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTeamSettings", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertTeamSettings indicates an expected call of UpsertTeamSettings.
+func (mr *MockTeamRepositoryMockRecorder) UpsertTeamSettings(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTeamSettings", reflect.TypeOf((*MockTeamRepository)(nil).UpsertTeamSettings), ctx, settings)
+}
+
 // MockUserRepository is a mock of UserRepository interface.
 type MockUserRepository struct {
 	ctrl     *gomock.Controller
@@ -94,6 +241,79 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// DeleteForgeAccount mocks base method.
+func (m *MockUserRepository) DeleteForgeAccount(ctx context.Context, forge domain.Forge, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForgeAccount", ctx, forge, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteForgeAccount indicates an expected call of DeleteForgeAccount.
+func (mr *MockUserRepositoryMockRecorder) DeleteForgeAccount(ctx, forge, login any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForgeAccount", reflect.TypeOf((*MockUserRepository)(nil).DeleteForgeAccount), ctx, forge, login)
+}
+
+// DetachUsers mocks base method.
+func (m *MockUserRepository) DetachUsers(ctx context.Context, userIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachUsers", ctx, userIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachUsers indicates an expected call of DetachUsers.
+func (mr *MockUserRepositoryMockRecorder) DetachUsers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachUsers", reflect.TypeOf((*MockUserRepository)(nil).DetachUsers), ctx, userIDs)
+}
+
+// GetAvailableTeamMembers mocks base method.
+func (m *MockUserRepository) GetAvailableTeamMembers(ctx context.Context, teamName string, at time.Time) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableTeamMembers", ctx, teamName, at)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableTeamMembers indicates an expected call of GetAvailableTeamMembers.
+func (mr *MockUserRepositoryMockRecorder) GetAvailableTeamMembers(ctx, teamName, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableTeamMembers", reflect.TypeOf((*MockUserRepository)(nil).GetAvailableTeamMembers), ctx, teamName, at)
+}
+
+// GetForgeAccountUserID mocks base method.
+func (m *MockUserRepository) GetForgeAccountUserID(ctx context.Context, forge domain.Forge, login string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForgeAccountUserID", ctx, forge, login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForgeAccountUserID indicates an expected call of GetForgeAccountUserID.
+func (mr *MockUserRepositoryMockRecorder) GetForgeAccountUserID(ctx, forge, login any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForgeAccountUserID", reflect.TypeOf((*MockUserRepository)(nil).GetForgeAccountUserID), ctx, forge, login)
+}
+
+// GetMutedUsers mocks base method.
+func (m *MockUserRepository) GetMutedUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMutedUsers", ctx, userIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMutedUsers indicates an expected call of GetMutedUsers.
+func (mr *MockUserRepositoryMockRecorder) GetMutedUsers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutedUsers", reflect.TypeOf((*MockUserRepository)(nil).GetMutedUsers), ctx, userIDs)
+}
+
 // GetTeamMembers mocks base method.
 func (m *MockUserRepository) GetTeamMembers(ctx context.Context, teamName string, onlyActive bool) ([]domain.User, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +344,123 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByID(ctx, userID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepository)(nil).GetUserByID), ctx, userID)
 }
 
+// GetUserPreferences mocks base method.
+func (m *MockUserRepository) GetUserPreferences(ctx context.Context, userID string) (domain.UserPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPreferences", ctx, userID)
+	ret0, _ := ret[0].(domain.UserPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPreferences indicates an expected call of GetUserPreferences.
+func (mr *MockUserRepositoryMockRecorder) GetUserPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPreferences", reflect.TypeOf((*MockUserRepository)(nil).GetUserPreferences), ctx, userID)
+}
+
+// GetUserTags mocks base method.
+func (m *MockUserRepository) GetUserTags(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTags", ctx, userIDs)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTags indicates an expected call of GetUserTags.
+func (mr *MockUserRepositoryMockRecorder) GetUserTags(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTags", reflect.TypeOf((*MockUserRepository)(nil).GetUserTags), ctx, userIDs)
+}
+
+// ListDigestSubscriptions mocks base method.
+func (m *MockUserRepository) ListDigestSubscriptions(ctx context.Context) ([]domain.UserPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDigestSubscriptions", ctx)
+	ret0, _ := ret[0].([]domain.UserPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDigestSubscriptions indicates an expected call of ListDigestSubscriptions.
+func (mr *MockUserRepositoryMockRecorder) ListDigestSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDigestSubscriptions", reflect.TypeOf((*MockUserRepository)(nil).ListDigestSubscriptions), ctx)
+}
+
+// ListForgeAccounts mocks base method.
+func (m *MockUserRepository) ListForgeAccounts(ctx context.Context, forge domain.Forge) ([]domain.ForgeAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForgeAccounts", ctx, forge)
+	ret0, _ := ret[0].([]domain.ForgeAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForgeAccounts indicates an expected call of ListForgeAccounts.
+func (mr *MockUserRepositoryMockRecorder) ListForgeAccounts(ctx, forge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForgeAccounts", reflect.TypeOf((*MockUserRepository)(nil).ListForgeAccounts), ctx, forge)
+}
+
+// ListUsers mocks base method.
+func (m *MockUserRepository) ListUsers(ctx context.Context, afterID string, limit int) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, afterID, limit)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockUserRepositoryMockRecorder) ListUsers(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserRepository)(nil).ListUsers), ctx, afterID, limit)
+}
+
+// ReplaceUserTags mocks base method.
+func (m *MockUserRepository) ReplaceUserTags(ctx context.Context, userID string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceUserTags", ctx, userID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceUserTags indicates an expected call of ReplaceUserTags.
+func (mr *MockUserRepositoryMockRecorder) ReplaceUserTags(ctx, userID, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUserTags", reflect.TypeOf((*MockUserRepository)(nil).ReplaceUserTags), ctx, userID, tags)
+}
+
+// SetLastDigestAt mocks base method.
+func (m *MockUserRepository) SetLastDigestAt(ctx context.Context, userID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLastDigestAt", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLastDigestAt indicates an expected call of SetLastDigestAt.
+func (mr *MockUserRepositoryMockRecorder) SetLastDigestAt(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastDigestAt", reflect.TypeOf((*MockUserRepository)(nil).SetLastDigestAt), ctx, userID, at)
+}
+
+// SetNotificationsMuted mocks base method.
+func (m *MockUserRepository) SetNotificationsMuted(ctx context.Context, userID string, muted bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotificationsMuted", ctx, userID, muted)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNotificationsMuted indicates an expected call of SetNotificationsMuted.
+func (mr *MockUserRepositoryMockRecorder) SetNotificationsMuted(ctx, userID, muted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotificationsMuted", reflect.TypeOf((*MockUserRepository)(nil).SetNotificationsMuted), ctx, userID, muted)
+}
+
 // SetUserIsActive mocks base method.
 func (m *MockUserRepository) SetUserIsActive(ctx context.Context, userID string, isActive bool) (domain.User, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +476,49 @@ func (mr *MockUserRepositoryMockRecorder) SetUserIsActive(ctx, userID, isActive
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserIsActive", reflect.TypeOf((*MockUserRepository)(nil).SetUserIsActive), ctx, userID, isActive)
 }
 
+// SetUserSeniority mocks base method.
+func (m *MockUserRepository) SetUserSeniority(ctx context.Context, userID string, seniority domain.Seniority) (domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserSeniority", ctx, userID, seniority)
+	ret0, _ := ret[0].(domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserSeniority indicates an expected call of SetUserSeniority.
+func (mr *MockUserRepositoryMockRecorder) SetUserSeniority(ctx, userID, seniority any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserSeniority", reflect.TypeOf((*MockUserRepository)(nil).SetUserSeniority), ctx, userID, seniority)
+}
+
+// UpsertForgeAccount mocks base method.
+func (m *MockUserRepository) UpsertForgeAccount(ctx context.Context, account domain.ForgeAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertForgeAccount", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertForgeAccount indicates an expected call of UpsertForgeAccount.
+func (mr *MockUserRepositoryMockRecorder) UpsertForgeAccount(ctx, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertForgeAccount", reflect.TypeOf((*MockUserRepository)(nil).UpsertForgeAccount), ctx, account)
+}
+
+// UpsertUserPreferences mocks base method.
+func (m *MockUserRepository) UpsertUserPreferences(ctx context.Context, p domain.UserPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUserPreferences", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertUserPreferences indicates an expected call of UpsertUserPreferences.
+func (mr *MockUserRepositoryMockRecorder) UpsertUserPreferences(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserPreferences", reflect.TypeOf((*MockUserRepository)(nil).UpsertUserPreferences), ctx, p)
+}
+
 // UpsertUsers mocks base method.
 func (m *MockUserRepository) UpsertUsers(ctx context.Context, teamName string, members []domain.TeamMember) error {
 	m.ctrl.T.Helper()
@@ -191,19 +571,61 @@ func (mr *MockPRRepositoryMockRecorder) CreatePR(ctx, pr any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePR", reflect.TypeOf((*MockPRRepository)(nil).CreatePR), ctx, pr)
 }
 
+// CreatePRs mocks base method.
+func (m *MockPRRepository) CreatePRs(ctx context.Context, prs []domain.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePRs", ctx, prs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePRs indicates an expected call of CreatePRs.
+func (mr *MockPRRepositoryMockRecorder) CreatePRs(ctx, prs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePRs", reflect.TypeOf((*MockPRRepository)(nil).CreatePRs), ctx, prs)
+}
+
+// DeleteForgeSyncJob mocks base method.
+func (m *MockPRRepository) DeleteForgeSyncJob(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForgeSyncJob", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteForgeSyncJob indicates an expected call of DeleteForgeSyncJob.
+func (mr *MockPRRepositoryMockRecorder) DeleteForgeSyncJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForgeSyncJob", reflect.TypeOf((*MockPRRepository)(nil).DeleteForgeSyncJob), ctx, id)
+}
+
+// EnqueueForgeSync mocks base method.
+func (m *MockPRRepository) EnqueueForgeSync(ctx context.Context, job domain.ForgeSyncJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueForgeSync", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueForgeSync indicates an expected call of EnqueueForgeSync.
+func (mr *MockPRRepositoryMockRecorder) EnqueueForgeSync(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueForgeSync", reflect.TypeOf((*MockPRRepository)(nil).EnqueueForgeSync), ctx, job)
+}
+
 // GetAssignmentsCountByUser mocks base method.
-func (m *MockPRRepository) GetAssignmentsCountByUser(ctx context.Context) ([]domain.UserAssignmentsStat, error) {
+func (m *MockPRRepository) GetAssignmentsCountByUser(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentsStat, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAssignmentsCountByUser", ctx)
+	ret := m.ctrl.Call(m, "GetAssignmentsCountByUser", ctx, filter)
 	ret0, _ := ret[0].([]domain.UserAssignmentsStat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAssignmentsCountByUser indicates an expected call of GetAssignmentsCountByUser.
-func (mr *MockPRRepositoryMockRecorder) GetAssignmentsCountByUser(ctx any) *gomock.Call {
+func (mr *MockPRRepositoryMockRecorder) GetAssignmentsCountByUser(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentsCountByUser", reflect.TypeOf((*MockPRRepository)(nil).GetAssignmentsCountByUser), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentsCountByUser", reflect.TypeOf((*MockPRRepository)(nil).GetAssignmentsCountByUser), ctx, filter)
 }
 
 // GetOpenPRsByReviewers mocks base method.
@@ -221,6 +643,21 @@ func (mr *MockPRRepositoryMockRecorder) GetOpenPRsByReviewers(ctx, userIDs any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenPRsByReviewers", reflect.TypeOf((*MockPRRepository)(nil).GetOpenPRsByReviewers), ctx, userIDs)
 }
 
+// GetOpenPRsByTeam mocks base method.
+func (m *MockPRRepository) GetOpenPRsByTeam(ctx context.Context, teamName string) ([]domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenPRsByTeam", ctx, teamName)
+	ret0, _ := ret[0].([]domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenPRsByTeam indicates an expected call of GetOpenPRsByTeam.
+func (mr *MockPRRepositoryMockRecorder) GetOpenPRsByTeam(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenPRsByTeam", reflect.TypeOf((*MockPRRepository)(nil).GetOpenPRsByTeam), ctx, teamName)
+}
+
 // GetPR mocks base method.
 func (m *MockPRRepository) GetPR(ctx context.Context, prID string) (domain.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +673,21 @@ func (mr *MockPRRepositoryMockRecorder) GetPR(ctx, prID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPR", reflect.TypeOf((*MockPRRepository)(nil).GetPR), ctx, prID)
 }
 
+// GetPRCountsByPeriod mocks base method.
+func (m *MockPRRepository) GetPRCountsByPeriod(ctx context.Context, filter domain.StatsFilter, granularity domain.StatsGranularity) ([]domain.StatsBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPRCountsByPeriod", ctx, filter, granularity)
+	ret0, _ := ret[0].([]domain.StatsBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPRCountsByPeriod indicates an expected call of GetPRCountsByPeriod.
+func (mr *MockPRRepositoryMockRecorder) GetPRCountsByPeriod(ctx, filter, granularity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRCountsByPeriod", reflect.TypeOf((*MockPRRepository)(nil).GetPRCountsByPeriod), ctx, filter, granularity)
+}
+
 // GetPRReviewers mocks base method.
 func (m *MockPRRepository) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -252,18 +704,48 @@ func (mr *MockPRRepositoryMockRecorder) GetPRReviewers(ctx, prID any) *gomock.Ca
 }
 
 // GetPRStatusCounts mocks base method.
-func (m *MockPRRepository) GetPRStatusCounts(ctx context.Context) (domain.PRStatusCounts, error) {
+func (m *MockPRRepository) GetPRStatusCounts(ctx context.Context, filter domain.StatsFilter) (domain.PRStatusCounts, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPRStatusCounts", ctx)
+	ret := m.ctrl.Call(m, "GetPRStatusCounts", ctx, filter)
 	ret0, _ := ret[0].(domain.PRStatusCounts)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPRStatusCounts indicates an expected call of GetPRStatusCounts.
-func (mr *MockPRRepositoryMockRecorder) GetPRStatusCounts(ctx any) *gomock.Call {
+func (mr *MockPRRepositoryMockRecorder) GetPRStatusCounts(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRStatusCounts", reflect.TypeOf((*MockPRRepository)(nil).GetPRStatusCounts), ctx, filter)
+}
+
+// GetPRStatusCountsByTeam mocks base method.
+func (m *MockPRRepository) GetPRStatusCountsByTeam(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPRStatusCountsByTeam", ctx, filter)
+	ret0, _ := ret[0].([]domain.TeamStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPRStatusCountsByTeam indicates an expected call of GetPRStatusCountsByTeam.
+func (mr *MockPRRepositoryMockRecorder) GetPRStatusCountsByTeam(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRStatusCountsByTeam", reflect.TypeOf((*MockPRRepository)(nil).GetPRStatusCountsByTeam), ctx, filter)
+}
+
+// GetPRTimings mocks base method.
+func (m *MockPRRepository) GetPRTimings(ctx context.Context, filter domain.StatsFilter) ([]domain.PRTiming, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPRTimings", ctx, filter)
+	ret0, _ := ret[0].([]domain.PRTiming)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPRTimings indicates an expected call of GetPRTimings.
+func (mr *MockPRRepositoryMockRecorder) GetPRTimings(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRStatusCounts", reflect.TypeOf((*MockPRRepository)(nil).GetPRStatusCounts), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRTimings", reflect.TypeOf((*MockPRRepository)(nil).GetPRTimings), ctx, filter)
 }
 
 // GetPRsWhereReviewer mocks base method.
@@ -281,6 +763,94 @@ func (mr *MockPRRepositoryMockRecorder) GetPRsWhereReviewer(ctx, userID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRsWhereReviewer", reflect.TypeOf((*MockPRRepository)(nil).GetPRsWhereReviewer), ctx, userID)
 }
 
+// GetReviewerLoad mocks base method.
+func (m *MockPRRepository) GetReviewerLoad(ctx context.Context, teamName string) ([]domain.ReviewerLoad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewerLoad", ctx, teamName)
+	ret0, _ := ret[0].([]domain.ReviewerLoad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewerLoad indicates an expected call of GetReviewerLoad.
+func (mr *MockPRRepositoryMockRecorder) GetReviewerLoad(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewerLoad", reflect.TypeOf((*MockPRRepository)(nil).GetReviewerLoad), ctx, teamName)
+}
+
+// GetStalePRs mocks base method.
+func (m *MockPRRepository) GetStalePRs(ctx context.Context, teamName string, defaultAfter time.Duration, now time.Time) ([]domain.StalePR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStalePRs", ctx, teamName, defaultAfter, now)
+	ret0, _ := ret[0].([]domain.StalePR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStalePRs indicates an expected call of GetStalePRs.
+func (mr *MockPRRepositoryMockRecorder) GetStalePRs(ctx, teamName, defaultAfter, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStalePRs", reflect.TypeOf((*MockPRRepository)(nil).GetStalePRs), ctx, teamName, defaultAfter, now)
+}
+
+// ImportPR mocks base method.
+func (m *MockPRRepository) ImportPR(ctx context.Context, pr domain.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPR", ctx, pr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportPR indicates an expected call of ImportPR.
+func (mr *MockPRRepositoryMockRecorder) ImportPR(ctx, pr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPR", reflect.TypeOf((*MockPRRepository)(nil).ImportPR), ctx, pr)
+}
+
+// ListDueForgeSyncJobs mocks base method.
+func (m *MockPRRepository) ListDueForgeSyncJobs(ctx context.Context, now time.Time, limit int) ([]domain.ForgeSyncJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueForgeSyncJobs", ctx, now, limit)
+	ret0, _ := ret[0].([]domain.ForgeSyncJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueForgeSyncJobs indicates an expected call of ListDueForgeSyncJobs.
+func (mr *MockPRRepositoryMockRecorder) ListDueForgeSyncJobs(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueForgeSyncJobs", reflect.TypeOf((*MockPRRepository)(nil).ListDueForgeSyncJobs), ctx, now, limit)
+}
+
+// ListPRs mocks base method.
+func (m *MockPRRepository) ListPRs(ctx context.Context, afterID string, limit int) ([]domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPRs", ctx, afterID, limit)
+	ret0, _ := ret[0].([]domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPRs indicates an expected call of ListPRs.
+func (mr *MockPRRepositoryMockRecorder) ListPRs(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPRs", reflect.TypeOf((*MockPRRepository)(nil).ListPRs), ctx, afterID, limit)
+}
+
+// MarkPRStale mocks base method.
+func (m *MockPRRepository) MarkPRStale(ctx context.Context, prID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPRStale", ctx, prID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPRStale indicates an expected call of MarkPRStale.
+func (mr *MockPRRepositoryMockRecorder) MarkPRStale(ctx, prID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPRStale", reflect.TypeOf((*MockPRRepository)(nil).MarkPRStale), ctx, prID, at)
+}
+
 // PRExists mocks base method.
 func (m *MockPRRepository) PRExists(ctx context.Context, prID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +880,20 @@ func (mr *MockPRRepositoryMockRecorder) SetPRReviewers(ctx, prID, reviewers any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPRReviewers", reflect.TypeOf((*MockPRRepository)(nil).SetPRReviewers), ctx, prID, reviewers)
 }
 
+// UpdateForgeSyncJob mocks base method.
+func (m *MockPRRepository) UpdateForgeSyncJob(ctx context.Context, job domain.ForgeSyncJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateForgeSyncJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateForgeSyncJob indicates an expected call of UpdateForgeSyncJob.
+func (mr *MockPRRepositoryMockRecorder) UpdateForgeSyncJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateForgeSyncJob", reflect.TypeOf((*MockPRRepository)(nil).UpdateForgeSyncJob), ctx, job)
+}
+
 // UpdatePR mocks base method.
 func (m *MockPRRepository) UpdatePR(ctx context.Context, pr domain.PullRequest) error {
 	m.ctrl.T.Helper()
@@ -323,3 +907,184 @@ func (mr *MockPRRepositoryMockRecorder) UpdatePR(ctx, pr any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePR", reflect.TypeOf((*MockPRRepository)(nil).UpdatePR), ctx, pr)
 }
+
+// MockAvailabilityRepository is a mock of AvailabilityRepository interface.
+type MockAvailabilityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvailabilityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAvailabilityRepositoryMockRecorder is the mock recorder for MockAvailabilityRepository.
+type MockAvailabilityRepositoryMockRecorder struct {
+	mock *MockAvailabilityRepository
+}
+
+// NewMockAvailabilityRepository creates a new mock instance.
+func NewMockAvailabilityRepository(ctrl *gomock.Controller) *MockAvailabilityRepository {
+	mock := &MockAvailabilityRepository{ctrl: ctrl}
+	mock.recorder = &MockAvailabilityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvailabilityRepository) EXPECT() *MockAvailabilityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAvailability mocks base method.
+func (m *MockAvailabilityRepository) CreateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAvailability", ctx, a)
+	ret0, _ := ret[0].(domain.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAvailability indicates an expected call of CreateAvailability.
+func (mr *MockAvailabilityRepositoryMockRecorder) CreateAvailability(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAvailability", reflect.TypeOf((*MockAvailabilityRepository)(nil).CreateAvailability), ctx, a)
+}
+
+// DeleteAvailability mocks base method.
+func (m *MockAvailabilityRepository) DeleteAvailability(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAvailability", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAvailability indicates an expected call of DeleteAvailability.
+func (mr *MockAvailabilityRepositoryMockRecorder) DeleteAvailability(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAvailability", reflect.TypeOf((*MockAvailabilityRepository)(nil).DeleteAvailability), ctx, id)
+}
+
+// GetStartedAvailability mocks base method.
+func (m *MockAvailabilityRepository) GetStartedAvailability(ctx context.Context, at time.Time) ([]domain.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStartedAvailability", ctx, at)
+	ret0, _ := ret[0].([]domain.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStartedAvailability indicates an expected call of GetStartedAvailability.
+func (mr *MockAvailabilityRepositoryMockRecorder) GetStartedAvailability(ctx, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStartedAvailability", reflect.TypeOf((*MockAvailabilityRepository)(nil).GetStartedAvailability), ctx, at)
+}
+
+// ListAvailability mocks base method.
+func (m *MockAvailabilityRepository) ListAvailability(ctx context.Context, userID string) ([]domain.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAvailability", ctx, userID)
+	ret0, _ := ret[0].([]domain.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailability indicates an expected call of ListAvailability.
+func (mr *MockAvailabilityRepositoryMockRecorder) ListAvailability(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailability", reflect.TypeOf((*MockAvailabilityRepository)(nil).ListAvailability), ctx, userID)
+}
+
+// MarkAvailabilityApplied mocks base method.
+func (m *MockAvailabilityRepository) MarkAvailabilityApplied(ctx context.Context, id int64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAvailabilityApplied", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAvailabilityApplied indicates an expected call of MarkAvailabilityApplied.
+func (mr *MockAvailabilityRepositoryMockRecorder) MarkAvailabilityApplied(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAvailabilityApplied", reflect.TypeOf((*MockAvailabilityRepository)(nil).MarkAvailabilityApplied), ctx, id, at)
+}
+
+// UpdateAvailability mocks base method.
+func (m *MockAvailabilityRepository) UpdateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAvailability", ctx, a)
+	ret0, _ := ret[0].(domain.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAvailability indicates an expected call of UpdateAvailability.
+func (mr *MockAvailabilityRepositoryMockRecorder) UpdateAvailability(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvailability", reflect.TypeOf((*MockAvailabilityRepository)(nil).UpdateAvailability), ctx, a)
+}
+
+// MockEventRepository is a mock of EventRepository interface.
+type MockEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEventRepositoryMockRecorder is the mock recorder for MockEventRepository.
+type MockEventRepositoryMockRecorder struct {
+	mock *MockEventRepository
+}
+
+// NewMockEventRepository creates a new mock instance.
+func NewMockEventRepository(ctrl *gomock.Controller) *MockEventRepository {
+	mock := &MockEventRepository{ctrl: ctrl}
+	mock.recorder = &MockEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendEvent mocks base method.
+func (m *MockEventRepository) AppendEvent(ctx context.Context, ev domain.Event) (domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendEvent", ctx, ev)
+	ret0, _ := ret[0].(domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendEvent indicates an expected call of AppendEvent.
+func (mr *MockEventRepositoryMockRecorder) AppendEvent(ctx, ev any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendEvent", reflect.TypeOf((*MockEventRepository)(nil).AppendEvent), ctx, ev)
+}
+
+// LastEventID mocks base method.
+func (m *MockEventRepository) LastEventID(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastEventID", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastEventID indicates an expected call of LastEventID.
+func (mr *MockEventRepositoryMockRecorder) LastEventID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastEventID", reflect.TypeOf((*MockEventRepository)(nil).LastEventID), ctx)
+}
+
+// ListEvents mocks base method.
+func (m *MockEventRepository) ListEvents(ctx context.Context, filter domain.EventFilter, limit int) ([]domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, filter, limit)
+	ret0, _ := ret[0].([]domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockEventRepositoryMockRecorder) ListEvents(ctx, filter, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventRepository)(nil).ListEvents), ctx, filter, limit)
+}
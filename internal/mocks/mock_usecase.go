@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/alnoi/pr-reviewer-service/internal/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -42,18 +43,49 @@ func (m *MockPRUseCase) EXPECT() *MockPRUseCaseMockRecorder {
 }
 
 // CreatePR mocks base method.
-func (m *MockPRUseCase) CreatePR(ctx context.Context, prID, prName, authorID string) (domain.PullRequest, error) {
+func (m *MockPRUseCase) CreatePR(ctx context.Context, prID, prName, authorID string, requestedReviewers, changedPaths, requiredTags []string) (domain.PullRequest, []string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePR", ctx, prID, prName, authorID)
+	ret := m.ctrl.Call(m, "CreatePR", ctx, prID, prName, authorID, requestedReviewers, changedPaths, requiredTags)
 	ret0, _ := ret[0].(domain.PullRequest)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePR indicates an expected call of CreatePR.
+func (mr *MockPRUseCaseMockRecorder) CreatePR(ctx, prID, prName, authorID, requestedReviewers, changedPaths, requiredTags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePR", reflect.TypeOf((*MockPRUseCase)(nil).CreatePR), ctx, prID, prName, authorID, requestedReviewers, changedPaths, requiredTags)
+}
+
+// CreatePRBatch mocks base method.
+func (m *MockPRUseCase) CreatePRBatch(ctx context.Context, items []domain.NewPullRequest) ([]domain.PRBatchItemResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePRBatch", ctx, items)
+	ret0, _ := ret[0].([]domain.PRBatchItemResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePR indicates an expected call of CreatePR.
-func (mr *MockPRUseCaseMockRecorder) CreatePR(ctx, prID, prName, authorID any) *gomock.Call {
+// CreatePRBatch indicates an expected call of CreatePRBatch.
+func (mr *MockPRUseCaseMockRecorder) CreatePRBatch(ctx, items any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePR", reflect.TypeOf((*MockPRUseCase)(nil).CreatePR), ctx, prID, prName, authorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePRBatch", reflect.TypeOf((*MockPRUseCase)(nil).CreatePRBatch), ctx, items)
+}
+
+// ListStalePRs mocks base method.
+func (m *MockPRUseCase) ListStalePRs(ctx context.Context, teamName string, now time.Time) ([]domain.StalePR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStalePRs", ctx, teamName, now)
+	ret0, _ := ret[0].([]domain.StalePR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStalePRs indicates an expected call of ListStalePRs.
+func (mr *MockPRUseCaseMockRecorder) ListStalePRs(ctx, teamName, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStalePRs", reflect.TypeOf((*MockPRUseCase)(nil).ListStalePRs), ctx, teamName, now)
 }
 
 // MergePR mocks base method.
@@ -71,6 +103,21 @@ func (mr *MockPRUseCaseMockRecorder) MergePR(ctx, prID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePR", reflect.TypeOf((*MockPRUseCase)(nil).MergePR), ctx, prID)
 }
 
+// ProcessStalePRs mocks base method.
+func (m *MockPRUseCase) ProcessStalePRs(ctx context.Context, now time.Time) (domain.StaleRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessStalePRs", ctx, now)
+	ret0, _ := ret[0].(domain.StaleRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessStalePRs indicates an expected call of ProcessStalePRs.
+func (mr *MockPRUseCaseMockRecorder) ProcessStalePRs(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessStalePRs", reflect.TypeOf((*MockPRUseCase)(nil).ProcessStalePRs), ctx, now)
+}
+
 // ReassignReviewer mocks base method.
 func (m *MockPRUseCase) ReassignReviewer(ctx context.Context, prID, oldUserID string) (domain.PullRequest, string, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +134,21 @@ func (mr *MockPRUseCaseMockRecorder) ReassignReviewer(ctx, prID, oldUserID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignReviewer", reflect.TypeOf((*MockPRUseCase)(nil).ReassignReviewer), ctx, prID, oldUserID)
 }
 
+// SetReviewers mocks base method.
+func (m *MockPRUseCase) SetReviewers(ctx context.Context, prID string, reviewers []string) (domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewers", ctx, prID, reviewers)
+	ret0, _ := ret[0].(domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReviewers indicates an expected call of SetReviewers.
+func (mr *MockPRUseCaseMockRecorder) SetReviewers(ctx, prID, reviewers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewers", reflect.TypeOf((*MockPRUseCase)(nil).SetReviewers), ctx, prID, reviewers)
+}
+
 // MockStatsUseCase is a mock of StatsUseCase interface.
 type MockStatsUseCase struct {
 	ctrl     *gomock.Controller
@@ -111,19 +173,49 @@ func (m *MockStatsUseCase) EXPECT() *MockStatsUseCaseMockRecorder {
 	return m.recorder
 }
 
+// GetCycleTime mocks base method.
+func (m *MockStatsUseCase) GetCycleTime(ctx context.Context, filter domain.StatsFilter, now time.Time) (domain.CycleTimeStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCycleTime", ctx, filter, now)
+	ret0, _ := ret[0].(domain.CycleTimeStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCycleTime indicates an expected call of GetCycleTime.
+func (mr *MockStatsUseCaseMockRecorder) GetCycleTime(ctx, filter, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCycleTime", reflect.TypeOf((*MockStatsUseCase)(nil).GetCycleTime), ctx, filter, now)
+}
+
+// GetFairness mocks base method.
+func (m *MockStatsUseCase) GetFairness(ctx context.Context, teamName string, idleDays int, now time.Time) (domain.FairnessReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFairness", ctx, teamName, idleDays, now)
+	ret0, _ := ret[0].(domain.FairnessReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFairness indicates an expected call of GetFairness.
+func (mr *MockStatsUseCaseMockRecorder) GetFairness(ctx, teamName, idleDays, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFairness", reflect.TypeOf((*MockStatsUseCase)(nil).GetFairness), ctx, teamName, idleDays, now)
+}
+
 // GetStats mocks base method.
-func (m *MockStatsUseCase) GetStats(ctx context.Context) (domain.Stats, error) {
+func (m *MockStatsUseCase) GetStats(ctx context.Context, filter domain.StatsFilter, granularity domain.StatsGranularity) (domain.Stats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStats", ctx)
+	ret := m.ctrl.Call(m, "GetStats", ctx, filter, granularity)
 	ret0, _ := ret[0].(domain.Stats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStats indicates an expected call of GetStats.
-func (mr *MockStatsUseCaseMockRecorder) GetStats(ctx any) *gomock.Call {
+func (mr *MockStatsUseCaseMockRecorder) GetStats(ctx, filter, granularity any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockStatsUseCase)(nil).GetStats), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockStatsUseCase)(nil).GetStats), ctx, filter, granularity)
 }
 
 // MockTeamUseCase is a mock of TeamUseCase interface.
@@ -150,6 +242,36 @@ func (m *MockTeamUseCase) EXPECT() *MockTeamUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AddTeamMembers mocks base method.
+func (m *MockTeamUseCase) AddTeamMembers(ctx context.Context, teamName string, members []domain.TeamMember) (domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamMembers", ctx, teamName, members)
+	ret0, _ := ret[0].(domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTeamMembers indicates an expected call of AddTeamMembers.
+func (mr *MockTeamUseCaseMockRecorder) AddTeamMembers(ctx, teamName, members any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMembers", reflect.TypeOf((*MockTeamUseCase)(nil).AddTeamMembers), ctx, teamName, members)
+}
+
+// CreateReviewRule mocks base method.
+func (m *MockTeamUseCase) CreateReviewRule(ctx context.Context, rule domain.ReviewRule) (domain.ReviewRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewRule", ctx, rule)
+	ret0, _ := ret[0].(domain.ReviewRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReviewRule indicates an expected call of CreateReviewRule.
+func (mr *MockTeamUseCaseMockRecorder) CreateReviewRule(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewRule", reflect.TypeOf((*MockTeamUseCase)(nil).CreateReviewRule), ctx, rule)
+}
+
 // CreateTeam mocks base method.
 func (m *MockTeamUseCase) CreateTeam(ctx context.Context, team domain.Team) (domain.Team, error) {
 	m.ctrl.T.Helper()
@@ -166,18 +288,47 @@ func (mr *MockTeamUseCaseMockRecorder) CreateTeam(ctx, team any) *gomock.Call {
 }
 
 // DeactivateTeamMembers mocks base method.
-func (m *MockTeamUseCase) DeactivateTeamMembers(ctx context.Context, teamName string, userIDs []string) (domain.Team, error) {
+func (m *MockTeamUseCase) DeactivateTeamMembers(ctx context.Context, teamName string, userIDs []string, dryRun bool) (domain.DeactivationResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeactivateTeamMembers", ctx, teamName, userIDs)
-	ret0, _ := ret[0].(domain.Team)
+	ret := m.ctrl.Call(m, "DeactivateTeamMembers", ctx, teamName, userIDs, dryRun)
+	ret0, _ := ret[0].(domain.DeactivationResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeactivateTeamMembers indicates an expected call of DeactivateTeamMembers.
-func (mr *MockTeamUseCaseMockRecorder) DeactivateTeamMembers(ctx, teamName, userIDs any) *gomock.Call {
+func (mr *MockTeamUseCaseMockRecorder) DeactivateTeamMembers(ctx, teamName, userIDs, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateTeamMembers", reflect.TypeOf((*MockTeamUseCase)(nil).DeactivateTeamMembers), ctx, teamName, userIDs, dryRun)
+}
+
+// DeleteReviewRule mocks base method.
+func (m *MockTeamUseCase) DeleteReviewRule(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReviewRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReviewRule indicates an expected call of DeleteReviewRule.
+func (mr *MockTeamUseCaseMockRecorder) DeleteReviewRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewRule", reflect.TypeOf((*MockTeamUseCase)(nil).DeleteReviewRule), ctx, id)
+}
+
+// GetCodeOwners mocks base method.
+func (m *MockTeamUseCase) GetCodeOwners(ctx context.Context, teamName string) ([]domain.CodeOwnerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeOwners", ctx, teamName)
+	ret0, _ := ret[0].([]domain.CodeOwnerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeOwners indicates an expected call of GetCodeOwners.
+func (mr *MockTeamUseCaseMockRecorder) GetCodeOwners(ctx, teamName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateTeamMembers", reflect.TypeOf((*MockTeamUseCase)(nil).DeactivateTeamMembers), ctx, teamName, userIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeOwners", reflect.TypeOf((*MockTeamUseCase)(nil).GetCodeOwners), ctx, teamName)
 }
 
 // GetTeam mocks base method.
@@ -195,6 +346,141 @@ func (mr *MockTeamUseCaseMockRecorder) GetTeam(ctx, teamName any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockTeamUseCase)(nil).GetTeam), ctx, teamName)
 }
 
+// GetTeamNotifications mocks base method.
+func (m *MockTeamUseCase) GetTeamNotifications(ctx context.Context, teamName string) (domain.TeamNotifications, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamNotifications", ctx, teamName)
+	ret0, _ := ret[0].(domain.TeamNotifications)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamNotifications indicates an expected call of GetTeamNotifications.
+func (mr *MockTeamUseCaseMockRecorder) GetTeamNotifications(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamNotifications", reflect.TypeOf((*MockTeamUseCase)(nil).GetTeamNotifications), ctx, teamName)
+}
+
+// GetTeamSettings mocks base method.
+func (m *MockTeamUseCase) GetTeamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamSettings", ctx, teamName)
+	ret0, _ := ret[0].(domain.TeamSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamSettings indicates an expected call of GetTeamSettings.
+func (mr *MockTeamUseCaseMockRecorder) GetTeamSettings(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamSettings", reflect.TypeOf((*MockTeamUseCase)(nil).GetTeamSettings), ctx, teamName)
+}
+
+// ImportCodeOwners mocks base method.
+func (m *MockTeamUseCase) ImportCodeOwners(ctx context.Context, teamName, content string) (domain.CodeOwnersImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCodeOwners", ctx, teamName, content)
+	ret0, _ := ret[0].(domain.CodeOwnersImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCodeOwners indicates an expected call of ImportCodeOwners.
+func (mr *MockTeamUseCaseMockRecorder) ImportCodeOwners(ctx, teamName, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCodeOwners", reflect.TypeOf((*MockTeamUseCase)(nil).ImportCodeOwners), ctx, teamName, content)
+}
+
+// ListReviewRules mocks base method.
+func (m *MockTeamUseCase) ListReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewRules", ctx, teamName)
+	ret0, _ := ret[0].([]domain.ReviewRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviewRules indicates an expected call of ListReviewRules.
+func (mr *MockTeamUseCaseMockRecorder) ListReviewRules(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewRules", reflect.TypeOf((*MockTeamUseCase)(nil).ListReviewRules), ctx, teamName)
+}
+
+// RemoveTeamMembers mocks base method.
+func (m *MockTeamUseCase) RemoveTeamMembers(ctx context.Context, teamName string, userIDs []string) (domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTeamMembers", ctx, teamName, userIDs)
+	ret0, _ := ret[0].(domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTeamMembers indicates an expected call of RemoveTeamMembers.
+func (mr *MockTeamUseCaseMockRecorder) RemoveTeamMembers(ctx, teamName, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamMembers", reflect.TypeOf((*MockTeamUseCase)(nil).RemoveTeamMembers), ctx, teamName, userIDs)
+}
+
+// UpdateCodeOwners mocks base method.
+func (m *MockTeamUseCase) UpdateCodeOwners(ctx context.Context, teamName string, rules []domain.CodeOwnerRule) ([]domain.CodeOwnerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCodeOwners", ctx, teamName, rules)
+	ret0, _ := ret[0].([]domain.CodeOwnerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCodeOwners indicates an expected call of UpdateCodeOwners.
+func (mr *MockTeamUseCaseMockRecorder) UpdateCodeOwners(ctx, teamName, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCodeOwners", reflect.TypeOf((*MockTeamUseCase)(nil).UpdateCodeOwners), ctx, teamName, rules)
+}
+
+// UpdateTeamMember mocks base method.
+func (m *MockTeamUseCase) UpdateTeamMember(ctx context.Context, teamName string, member domain.TeamMember) (domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamMember", ctx, teamName, member)
+	ret0, _ := ret[0].(domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamMember indicates an expected call of UpdateTeamMember.
+func (mr *MockTeamUseCaseMockRecorder) UpdateTeamMember(ctx, teamName, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamMember", reflect.TypeOf((*MockTeamUseCase)(nil).UpdateTeamMember), ctx, teamName, member)
+}
+
+// UpdateTeamNotifications mocks base method.
+func (m *MockTeamUseCase) UpdateTeamNotifications(ctx context.Context, n domain.TeamNotifications) (domain.TeamNotifications, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamNotifications", ctx, n)
+	ret0, _ := ret[0].(domain.TeamNotifications)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamNotifications indicates an expected call of UpdateTeamNotifications.
+func (mr *MockTeamUseCaseMockRecorder) UpdateTeamNotifications(ctx, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamNotifications", reflect.TypeOf((*MockTeamUseCase)(nil).UpdateTeamNotifications), ctx, n)
+}
+
+// UpdateTeamSettings mocks base method.
+func (m *MockTeamUseCase) UpdateTeamSettings(ctx context.Context, settings domain.TeamSettings) (domain.TeamSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamSettings", ctx, settings)
+	ret0, _ := ret[0].(domain.TeamSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamSettings indicates an expected call of UpdateTeamSettings.
+func (mr *MockTeamUseCaseMockRecorder) UpdateTeamSettings(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamSettings", reflect.TypeOf((*MockTeamUseCase)(nil).UpdateTeamSettings), ctx, settings)
+}
+
 // MockUserUseCase is a mock of UserUseCase interface.
 type MockUserUseCase struct {
 	ctrl     *gomock.Controller
@@ -219,6 +505,21 @@ func (m *MockUserUseCase) EXPECT() *MockUserUseCaseMockRecorder {
 	return m.recorder
 }
 
+// GetUserPreferences mocks base method.
+func (m *MockUserUseCase) GetUserPreferences(ctx context.Context, userID string) (domain.UserPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPreferences", ctx, userID)
+	ret0, _ := ret[0].(domain.UserPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPreferences indicates an expected call of GetUserPreferences.
+func (mr *MockUserUseCaseMockRecorder) GetUserPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPreferences", reflect.TypeOf((*MockUserUseCase)(nil).GetUserPreferences), ctx, userID)
+}
+
 // GetUserReviewPRs mocks base method.
 func (m *MockUserUseCase) GetUserReviewPRs(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
 	m.ctrl.T.Helper()
@@ -234,19 +535,537 @@ func (mr *MockUserUseCaseMockRecorder) GetUserReviewPRs(ctx, userID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReviewPRs", reflect.TypeOf((*MockUserUseCase)(nil).GetUserReviewPRs), ctx, userID)
 }
 
+// GetUserTags mocks base method.
+func (m *MockUserUseCase) GetUserTags(ctx context.Context, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTags", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTags indicates an expected call of GetUserTags.
+func (mr *MockUserUseCaseMockRecorder) GetUserTags(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTags", reflect.TypeOf((*MockUserUseCase)(nil).GetUserTags), ctx, userID)
+}
+
+// ProcessDigests mocks base method.
+func (m *MockUserUseCase) ProcessDigests(ctx context.Context, now time.Time) (domain.DigestRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessDigests", ctx, now)
+	ret0, _ := ret[0].(domain.DigestRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessDigests indicates an expected call of ProcessDigests.
+func (mr *MockUserUseCaseMockRecorder) ProcessDigests(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessDigests", reflect.TypeOf((*MockUserUseCase)(nil).ProcessDigests), ctx, now)
+}
+
 // SetUserIsActive mocks base method.
-func (m *MockUserUseCase) SetUserIsActive(ctx context.Context, userID string, isActive bool) (domain.User, error) {
+func (m *MockUserUseCase) SetUserIsActive(ctx context.Context, userID string, isActive, rebalance bool) (domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetUserIsActive", ctx, userID, isActive)
+	ret := m.ctrl.Call(m, "SetUserIsActive", ctx, userID, isActive, rebalance)
 	ret0, _ := ret[0].(domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetUserIsActive indicates an expected call of SetUserIsActive.
-func (mr *MockUserUseCaseMockRecorder) SetUserIsActive(ctx, userID, isActive any) *gomock.Call {
+func (mr *MockUserUseCaseMockRecorder) SetUserIsActive(ctx, userID, isActive, rebalance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserIsActive", reflect.TypeOf((*MockUserUseCase)(nil).SetUserIsActive), ctx, userID, isActive, rebalance)
+}
+
+// SetUserNotifications mocks base method.
+func (m *MockUserUseCase) SetUserNotifications(ctx context.Context, userID string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserNotifications", ctx, userID, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserNotifications indicates an expected call of SetUserNotifications.
+func (mr *MockUserUseCaseMockRecorder) SetUserNotifications(ctx, userID, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserNotifications", reflect.TypeOf((*MockUserUseCase)(nil).SetUserNotifications), ctx, userID, enabled)
+}
+
+// SetUserSeniority mocks base method.
+func (m *MockUserUseCase) SetUserSeniority(ctx context.Context, userID string, seniority domain.Seniority) (domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserSeniority", ctx, userID, seniority)
+	ret0, _ := ret[0].(domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserSeniority indicates an expected call of SetUserSeniority.
+func (mr *MockUserUseCaseMockRecorder) SetUserSeniority(ctx, userID, seniority any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserSeniority", reflect.TypeOf((*MockUserUseCase)(nil).SetUserSeniority), ctx, userID, seniority)
+}
+
+// SetUserTags mocks base method.
+func (m *MockUserUseCase) SetUserTags(ctx context.Context, userID string, tags []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserTags", ctx, userID, tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserTags indicates an expected call of SetUserTags.
+func (mr *MockUserUseCaseMockRecorder) SetUserTags(ctx, userID, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserTags", reflect.TypeOf((*MockUserUseCase)(nil).SetUserTags), ctx, userID, tags)
+}
+
+// UpdateUserPreferences mocks base method.
+func (m *MockUserUseCase) UpdateUserPreferences(ctx context.Context, p domain.UserPreferences) (domain.UserPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPreferences", ctx, p)
+	ret0, _ := ret[0].(domain.UserPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserPreferences indicates an expected call of UpdateUserPreferences.
+func (mr *MockUserUseCaseMockRecorder) UpdateUserPreferences(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPreferences", reflect.TypeOf((*MockUserUseCase)(nil).UpdateUserPreferences), ctx, p)
+}
+
+// MockAvailabilityUseCase is a mock of AvailabilityUseCase interface.
+type MockAvailabilityUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvailabilityUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAvailabilityUseCaseMockRecorder is the mock recorder for MockAvailabilityUseCase.
+type MockAvailabilityUseCaseMockRecorder struct {
+	mock *MockAvailabilityUseCase
+}
+
+// NewMockAvailabilityUseCase creates a new mock instance.
+func NewMockAvailabilityUseCase(ctrl *gomock.Controller) *MockAvailabilityUseCase {
+	mock := &MockAvailabilityUseCase{ctrl: ctrl}
+	mock.recorder = &MockAvailabilityUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvailabilityUseCase) EXPECT() *MockAvailabilityUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ApplyStartedAvailability mocks base method.
+func (m *MockAvailabilityUseCase) ApplyStartedAvailability(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyStartedAvailability", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyStartedAvailability indicates an expected call of ApplyStartedAvailability.
+func (mr *MockAvailabilityUseCaseMockRecorder) ApplyStartedAvailability(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyStartedAvailability", reflect.TypeOf((*MockAvailabilityUseCase)(nil).ApplyStartedAvailability), ctx, now)
+}
+
+// CreateAvailability mocks base method.
+func (m *MockAvailabilityUseCase) CreateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAvailability", ctx, a)
+	ret0, _ := ret[0].(domain.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAvailability indicates an expected call of CreateAvailability.
+func (mr *MockAvailabilityUseCaseMockRecorder) CreateAvailability(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAvailability", reflect.TypeOf((*MockAvailabilityUseCase)(nil).CreateAvailability), ctx, a)
+}
+
+// DeleteAvailability mocks base method.
+func (m *MockAvailabilityUseCase) DeleteAvailability(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAvailability", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAvailability indicates an expected call of DeleteAvailability.
+func (mr *MockAvailabilityUseCaseMockRecorder) DeleteAvailability(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAvailability", reflect.TypeOf((*MockAvailabilityUseCase)(nil).DeleteAvailability), ctx, id)
+}
+
+// ListAvailability mocks base method.
+func (m *MockAvailabilityUseCase) ListAvailability(ctx context.Context, userID string) ([]domain.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAvailability", ctx, userID)
+	ret0, _ := ret[0].([]domain.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailability indicates an expected call of ListAvailability.
+func (mr *MockAvailabilityUseCaseMockRecorder) ListAvailability(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailability", reflect.TypeOf((*MockAvailabilityUseCase)(nil).ListAvailability), ctx, userID)
+}
+
+// UpdateAvailability mocks base method.
+func (m *MockAvailabilityUseCase) UpdateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAvailability", ctx, a)
+	ret0, _ := ret[0].(domain.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAvailability indicates an expected call of UpdateAvailability.
+func (mr *MockAvailabilityUseCaseMockRecorder) UpdateAvailability(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvailability", reflect.TypeOf((*MockAvailabilityUseCase)(nil).UpdateAvailability), ctx, a)
+}
+
+// MockImportUseCase is a mock of ImportUseCase interface.
+type MockImportUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockImportUseCaseMockRecorder is the mock recorder for MockImportUseCase.
+type MockImportUseCaseMockRecorder struct {
+	mock *MockImportUseCase
+}
+
+// NewMockImportUseCase creates a new mock instance.
+func NewMockImportUseCase(ctrl *gomock.Controller) *MockImportUseCase {
+	mock := &MockImportUseCase{ctrl: ctrl}
+	mock.recorder = &MockImportUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportUseCase) EXPECT() *MockImportUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ExportData mocks base method.
+func (m *MockImportUseCase) ExportData(ctx context.Context, fn func(domain.ImportRecord) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportData", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportData indicates an expected call of ExportData.
+func (mr *MockImportUseCaseMockRecorder) ExportData(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportData", reflect.TypeOf((*MockImportUseCase)(nil).ExportData), ctx, fn)
+}
+
+// ImportData mocks base method.
+func (m *MockImportUseCase) ImportData(ctx context.Context, records []domain.ImportRecord) (domain.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportData", ctx, records)
+	ret0, _ := ret[0].(domain.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportData indicates an expected call of ImportData.
+func (mr *MockImportUseCaseMockRecorder) ImportData(ctx, records any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportData", reflect.TypeOf((*MockImportUseCase)(nil).ImportData), ctx, records)
+}
+
+// MockIntegrationUseCase is a mock of IntegrationUseCase interface.
+type MockIntegrationUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIntegrationUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIntegrationUseCaseMockRecorder is the mock recorder for MockIntegrationUseCase.
+type MockIntegrationUseCaseMockRecorder struct {
+	mock *MockIntegrationUseCase
+}
+
+// NewMockIntegrationUseCase creates a new mock instance.
+func NewMockIntegrationUseCase(ctrl *gomock.Controller) *MockIntegrationUseCase {
+	mock := &MockIntegrationUseCase{ctrl: ctrl}
+	mock.recorder = &MockIntegrationUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntegrationUseCase) EXPECT() *MockIntegrationUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteForgeAccount mocks base method.
+func (m *MockIntegrationUseCase) DeleteForgeAccount(ctx context.Context, forge domain.Forge, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForgeAccount", ctx, forge, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteForgeAccount indicates an expected call of DeleteForgeAccount.
+func (mr *MockIntegrationUseCaseMockRecorder) DeleteForgeAccount(ctx, forge, login any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForgeAccount", reflect.TypeOf((*MockIntegrationUseCase)(nil).DeleteForgeAccount), ctx, forge, login)
+}
+
+// HandleForgeEvent mocks base method.
+func (m *MockIntegrationUseCase) HandleForgeEvent(ctx context.Context, ev domain.ForgePREvent) (domain.ForgeEventResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleForgeEvent", ctx, ev)
+	ret0, _ := ret[0].(domain.ForgeEventResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleForgeEvent indicates an expected call of HandleForgeEvent.
+func (mr *MockIntegrationUseCaseMockRecorder) HandleForgeEvent(ctx, ev any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleForgeEvent", reflect.TypeOf((*MockIntegrationUseCase)(nil).HandleForgeEvent), ctx, ev)
+}
+
+// ListForgeAccounts mocks base method.
+func (m *MockIntegrationUseCase) ListForgeAccounts(ctx context.Context, forge domain.Forge) ([]domain.ForgeAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForgeAccounts", ctx, forge)
+	ret0, _ := ret[0].([]domain.ForgeAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForgeAccounts indicates an expected call of ListForgeAccounts.
+func (mr *MockIntegrationUseCaseMockRecorder) ListForgeAccounts(ctx, forge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForgeAccounts", reflect.TypeOf((*MockIntegrationUseCase)(nil).ListForgeAccounts), ctx, forge)
+}
+
+// ProcessForgeSyncJobs mocks base method.
+func (m *MockIntegrationUseCase) ProcessForgeSyncJobs(ctx context.Context, now time.Time) (domain.ForgeSyncRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessForgeSyncJobs", ctx, now)
+	ret0, _ := ret[0].(domain.ForgeSyncRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessForgeSyncJobs indicates an expected call of ProcessForgeSyncJobs.
+func (mr *MockIntegrationUseCaseMockRecorder) ProcessForgeSyncJobs(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessForgeSyncJobs", reflect.TypeOf((*MockIntegrationUseCase)(nil).ProcessForgeSyncJobs), ctx, now)
+}
+
+// SetForgeAccount mocks base method.
+func (m *MockIntegrationUseCase) SetForgeAccount(ctx context.Context, account domain.ForgeAccount) (domain.ForgeAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetForgeAccount", ctx, account)
+	ret0, _ := ret[0].(domain.ForgeAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetForgeAccount indicates an expected call of SetForgeAccount.
+func (mr *MockIntegrationUseCaseMockRecorder) SetForgeAccount(ctx, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetForgeAccount", reflect.TypeOf((*MockIntegrationUseCase)(nil).SetForgeAccount), ctx, account)
+}
+
+// MockEventUseCase is a mock of EventUseCase interface.
+type MockEventUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockEventUseCaseMockRecorder is the mock recorder for MockEventUseCase.
+type MockEventUseCaseMockRecorder struct {
+	mock *MockEventUseCase
+}
+
+// NewMockEventUseCase creates a new mock instance.
+func NewMockEventUseCase(ctrl *gomock.Controller) *MockEventUseCase {
+	mock := &MockEventUseCase{ctrl: ctrl}
+	mock.recorder = &MockEventUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventUseCase) EXPECT() *MockEventUseCaseMockRecorder {
+	return m.recorder
+}
+
+// StreamEvents mocks base method.
+func (m *MockEventUseCase) StreamEvents(ctx context.Context, filter domain.EventFilter, fn func(domain.Event) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamEvents", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamEvents indicates an expected call of StreamEvents.
+func (mr *MockEventUseCaseMockRecorder) StreamEvents(ctx, filter, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEvents", reflect.TypeOf((*MockEventUseCase)(nil).StreamEvents), ctx, filter, fn)
+}
+
+// MockForgeClient is a mock of ForgeClient interface.
+type MockForgeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockForgeClientMockRecorder
+	isgomock struct{}
+}
+
+// MockForgeClientMockRecorder is the mock recorder for MockForgeClient.
+type MockForgeClientMockRecorder struct {
+	mock *MockForgeClient
+}
+
+// NewMockForgeClient creates a new mock instance.
+func NewMockForgeClient(ctrl *gomock.Controller) *MockForgeClient {
+	mock := &MockForgeClient{ctrl: ctrl}
+	mock.recorder = &MockForgeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForgeClient) EXPECT() *MockForgeClientMockRecorder {
+	return m.recorder
+}
+
+// Forge mocks base method.
+func (m *MockForgeClient) Forge() domain.Forge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Forge")
+	ret0, _ := ret[0].(domain.Forge)
+	return ret0
+}
+
+// Forge indicates an expected call of Forge.
+func (mr *MockForgeClientMockRecorder) Forge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Forge", reflect.TypeOf((*MockForgeClient)(nil).Forge))
+}
+
+// RemoveReviewers mocks base method.
+func (m *MockForgeClient) RemoveReviewers(ctx context.Context, repo string, number int64, logins []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReviewers", ctx, repo, number, logins)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReviewers indicates an expected call of RemoveReviewers.
+func (mr *MockForgeClientMockRecorder) RemoveReviewers(ctx, repo, number, logins any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReviewers", reflect.TypeOf((*MockForgeClient)(nil).RemoveReviewers), ctx, repo, number, logins)
+}
+
+// RequestReviewers mocks base method.
+func (m *MockForgeClient) RequestReviewers(ctx context.Context, repo string, number int64, logins []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReviewers", ctx, repo, number, logins)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestReviewers indicates an expected call of RequestReviewers.
+func (mr *MockForgeClientMockRecorder) RequestReviewers(ctx, repo, number, logins any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReviewers", reflect.TypeOf((*MockForgeClient)(nil).RequestReviewers), ctx, repo, number, logins)
+}
+
+// MockChatSender is a mock of ChatSender interface.
+type MockChatSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockChatSenderMockRecorder is the mock recorder for MockChatSender.
+type MockChatSenderMockRecorder struct {
+	mock *MockChatSender
+}
+
+// NewMockChatSender creates a new mock instance.
+func NewMockChatSender(ctrl *gomock.Controller) *MockChatSender {
+	mock := &MockChatSender{ctrl: ctrl}
+	mock.recorder = &MockChatSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatSender) EXPECT() *MockChatSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockChatSender) Send(ctx context.Context, msg domain.ChatMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockChatSenderMockRecorder) Send(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockChatSender)(nil).Send), ctx, msg)
+}
+
+// MockMailSender is a mock of MailSender interface.
+type MockMailSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockMailSenderMockRecorder is the mock recorder for MockMailSender.
+type MockMailSenderMockRecorder struct {
+	mock *MockMailSender
+}
+
+// NewMockMailSender creates a new mock instance.
+func NewMockMailSender(ctrl *gomock.Controller) *MockMailSender {
+	mock := &MockMailSender{ctrl: ctrl}
+	mock.recorder = &MockMailSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailSender) EXPECT() *MockMailSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockMailSender) Send(ctx context.Context, email domain.Email) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMailSenderMockRecorder) Send(ctx, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserIsActive", reflect.TypeOf((*MockUserUseCase)(nil).SetUserIsActive), ctx, userID, isActive)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailSender)(nil).Send), ctx, email)
 }
 
 // MockTransactor is a mock of Transactor interface.
@@ -0,0 +1,90 @@
+// Package notifytest содержит заглушку входящего вебхука Slack для тестов уведомлений.
+package notifytest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+)
+
+// Message — сообщение, принятое заглушкой.
+type Message struct {
+	// Path — путь вебхука, по нему в тестах различают команды
+	Path    string
+	Text    string
+	Channel string
+}
+
+// SlackServer принимает POST с JSON {"text", "channel"} на любой путь и отвечает "ok", как Slack.
+type SlackServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	messages []Message
+	failures []int
+}
+
+// NewSlackServer запускает заглушку; остановить её нужно через Close.
+func NewSlackServer() *SlackServer {
+	s := &SlackServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Fail заставляет заглушку ответить status на n следующих сообщений; такие сообщения не записываются.
+func (s *SlackServer) Fail(n, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for range n {
+		s.failures = append(s.failures, status)
+	}
+}
+
+// Messages возвращает принятые сообщения в порядке поступления.
+func (s *SlackServer) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.messages)
+}
+
+// Reset забывает принятые сообщения.
+func (s *SlackServer) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = nil
+	s.failures = nil
+}
+
+func (s *SlackServer) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "invalid_method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body struct {
+		Text    string `json:"text"`
+		Channel string `json:"channel"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Text == "" {
+		http.Error(w, "invalid_payload", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.failures) > 0 {
+		status := s.failures[0]
+		s.failures = s.failures[1:]
+		http.Error(w, "injected_failure", status)
+		return
+	}
+
+	s.messages = append(s.messages, Message{Path: r.URL.Path, Text: body.Text, Channel: body.Channel})
+	_, _ = w.Write([]byte("ok"))
+}
@@ -0,0 +1,180 @@
+package notifytest
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Mail — письмо, принятое заглушкой SMTP.
+type Mail struct {
+	From    string
+	To      []string
+	Subject string
+	Body    string
+}
+
+// SMTPServer — SMTP-сервер для тестов: принимает письма без TLS и авторизации и запоминает их.
+type SMTPServer struct {
+	// Addr — адрес host:port, на котором слушает сервер
+	Addr string
+
+	ln net.Listener
+	wg sync.WaitGroup
+
+	mu       sync.Mutex
+	mails    []Mail
+	failures []int
+}
+
+// NewSMTPServer запускает заглушку на свободном локальном порту; остановить её нужно через Close.
+func NewSMTPServer() *SMTPServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic("notifytest: failed to listen: " + err.Error())
+	}
+
+	s := &SMTPServer{Addr: ln.Addr().String(), ln: ln}
+	s.wg.Add(1)
+	go s.accept()
+	return s
+}
+
+// Close останавливает сервер и ждёт завершения открытых соединений.
+func (s *SMTPServer) Close() {
+	_ = s.ln.Close()
+	s.wg.Wait()
+}
+
+// Fail заставляет сервер отклонить n следующих писем с кодом code (например, 451 или 550).
+func (s *SMTPServer) Fail(n, code int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for range n {
+		s.failures = append(s.failures, code)
+	}
+}
+
+// Mails возвращает принятые письма в порядке поступления.
+func (s *SMTPServer) Mails() []Mail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.mails)
+}
+
+// Reset забывает принятые письма.
+func (s *SMTPServer) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mails = nil
+	s.failures = nil
+}
+
+func (s *SMTPServer) accept() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.serve(conn)
+		}()
+	}
+}
+
+func (s *SMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost notifytest SMTP")
+
+	var m Mail
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			_ = tp.PrintfLine("250 localhost")
+		case "MAIL":
+			m = Mail{From: pathArg(arg)}
+			_ = tp.PrintfLine("250 OK")
+		case "RCPT":
+			m.To = append(m.To, pathArg(arg))
+			_ = tp.PrintfLine("250 OK")
+		case "DATA":
+			_ = tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			_ = tp.PrintfLine("%s", s.receive(m, data))
+			m = Mail{}
+		case "RSET":
+			m = Mail{}
+			_ = tp.PrintfLine("250 OK")
+		case "NOOP":
+			_ = tp.PrintfLine("250 OK")
+		case "QUIT":
+			_ = tp.PrintfLine("221 Bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+// receive запоминает письмо и возвращает ответ сервера на DATA.
+func (s *SMTPServer) receive(m Mail, data []byte) string {
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return "554 Invalid message"
+	}
+	body, err := io.ReadAll(msg.Body)
+	if err != nil {
+		return "554 Invalid message"
+	}
+
+	var dec mime.WordDecoder
+	m.Subject, err = dec.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		return "554 Invalid subject"
+	}
+	m.Body = string(body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.failures) > 0 {
+		code := s.failures[0]
+		s.failures = s.failures[1:]
+		return strconv.Itoa(code) + " Injected failure"
+	}
+
+	s.mails = append(s.mails, m)
+	return "250 OK"
+}
+
+// pathArg достаёт адрес из аргумента MAIL FROM:<a@b> или RCPT TO:<a@b>.
+func pathArg(arg string) string {
+	_, path, _ := strings.Cut(arg, ":")
+	path, _, _ = strings.Cut(strings.TrimSpace(path), " ")
+	return strings.Trim(path, "<>")
+}
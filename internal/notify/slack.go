@@ -0,0 +1,55 @@
+// Package notify отправляет уведомления в чаты через входящие вебхуки в формате Slack
+// (тот же формат принимают Mattermost и Rocket.Chat) и письма через SMTP.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+// SlackSender отправляет сообщение POST-запросом на адрес входящего вебхука.
+type SlackSender struct {
+	http *http.Client
+}
+
+func NewSlackSender() *SlackSender {
+	return &SlackSender{http: &http.Client{Timeout: 5 * time.Second}}
+}
+
+// slackMessage — тело входящего вебхука; channel Slack учитывает только у legacy-вебхуков, Mattermost — всегда.
+type slackMessage struct {
+	Text    string `json:"text"`
+	Channel string `json:"channel,omitempty"`
+}
+
+func (s *SlackSender) Send(ctx context.Context, msg domain.ChatMessage) error {
+	body, err := json.Marshal(slackMessage{Text: msg.Text, Channel: msg.Channel})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, msg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		text, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("chat webhook: %s: %s", resp.Status, bytes.TrimSpace(text))
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/notify/notifytest"
+)
+
+func TestSlackSender_Send(t *testing.T) {
+	srv := notifytest.NewSlackServer()
+	t.Cleanup(srv.Close)
+
+	sender := NewSlackSender()
+	ctx := context.Background()
+
+	require.NoError(t, sender.Send(ctx, domain.ChatMessage{
+		WebhookURL: srv.URL + "/services/T0/B0/backend",
+		Channel:    "#reviews",
+		Text:       "review pr-1",
+	}))
+	require.NoError(t, sender.Send(ctx, domain.ChatMessage{WebhookURL: srv.URL + "/hooks/abc", Text: "review pr-2"}))
+
+	require.Equal(t, []notifytest.Message{
+		{Path: "/services/T0/B0/backend", Text: "review pr-1", Channel: "#reviews"},
+		{Path: "/hooks/abc", Text: "review pr-2"},
+	}, srv.Messages())
+
+	srv.Fail(1, http.StatusNotFound)
+	err := sender.Send(ctx, domain.ChatMessage{WebhookURL: srv.URL + "/hooks/abc", Text: "lost"})
+	require.ErrorContains(t, err, "404")
+	require.Len(t, srv.Messages(), 2)
+}
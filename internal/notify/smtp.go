@@ -0,0 +1,116 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+// SMTPSender отправляет письма через SMTP-сервер, переходя на TLS, если сервер поддерживает STARTTLS.
+type SMTPSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+
+	timeout time.Duration
+}
+
+// NewSMTPSender создаёт отправителя через сервер addr (host:port). Пустой username — без авторизации.
+func NewSMTPSender(addr, from, username, password string) *SMTPSender {
+	s := &SMTPSender{addr: addr, from: from, timeout: 10 * time.Second}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		s.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return s
+}
+
+func (s *SMTPSender) Send(ctx context.Context, email domain.Email) error {
+	host, _, err := net.SplitHostPort(s.addr)
+	if err != nil {
+		return fmt.Errorf("smtp: invalid address %q: %w", s.addr, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if s.auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err := c.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(s.from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(email.To); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(buildMessage(s.from, email, time.Now())); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// buildMessage собирает текстовое письмо в UTF-8 с переводами строк CRLF.
+func buildMessage(from string, email domain.Email, now time.Time) []byte {
+	var sb strings.Builder
+
+	sb.WriteString("From: " + from + "\r\n")
+	sb.WriteString("To: " + email.To + "\r\n")
+	sb.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", email.Subject) + "\r\n")
+	sb.WriteString("Date: " + now.Format(time.RFC1123Z) + "\r\n")
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	sb.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	sb.WriteString("\r\n")
+
+	body := strings.ReplaceAll(email.Body, "\r\n", "\n")
+	sb.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	if !strings.HasSuffix(body, "\n") {
+		sb.WriteString("\r\n")
+	}
+
+	return []byte(sb.String())
+}
@@ -0,0 +1,60 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/notify/notifytest"
+)
+
+func TestSMTPSender_Send(t *testing.T) {
+	srv := notifytest.NewSMTPServer()
+	t.Cleanup(srv.Close)
+
+	sender := NewSMTPSender(srv.Addr, "reviews@example.com", "", "")
+	ctx := context.Background()
+
+	require.NoError(t, sender.Send(ctx, domain.Email{
+		To:      "bob@example.com",
+		Subject: "2 pull requests — ждут ревью",
+		Body:    "line 1\nline 2",
+	}))
+
+	require.Equal(t, []notifytest.Mail{{
+		From:    "reviews@example.com",
+		To:      []string{"bob@example.com"},
+		Subject: "2 pull requests — ждут ревью",
+		Body:    "line 1\nline 2\n",
+	}}, srv.Mails())
+
+	srv.Fail(1, 550)
+	err := sender.Send(ctx, domain.Email{To: "bob@example.com", Subject: "lost", Body: "lost"})
+	require.ErrorContains(t, err, "550")
+	require.Len(t, srv.Mails(), 1)
+
+	// сервер без AUTH не принимает письма от отправителя с логином
+	auth := NewSMTPSender(srv.Addr, "reviews@example.com", "user", "secret")
+	require.Error(t, auth.Send(ctx, domain.Email{To: "bob@example.com", Subject: "s", Body: "b"}))
+}
+
+func TestBuildMessage(t *testing.T) {
+	msg := buildMessage("reviews@example.com", domain.Email{
+		To:      "bob@example.com",
+		Subject: "Reviews",
+		Body:    "a\r\nb\n",
+	}, time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC))
+
+	require.Equal(t, "From: reviews@example.com\r\n"+
+		"To: bob@example.com\r\n"+
+		"Subject: Reviews\r\n"+
+		"Date: Mon, 04 Mar 2024 09:00:00 +0000\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=utf-8\r\n"+
+		"Content-Transfer-Encoding: 8bit\r\n"+
+		"\r\n"+
+		"a\r\nb\r\n", string(msg))
+}
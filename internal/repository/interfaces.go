@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
 )
@@ -10,28 +11,128 @@ type (
 	TeamRepository interface {
 		CreateTeam(ctx context.Context, teamName string) error
 		GetTeam(ctx context.Context, teamName string) (domain.Team, error)
+		// ListTeamNames возвращает имена всех команд по алфавиту.
+		ListTeamNames(ctx context.Context) ([]string, error)
+
+		// GetTeamSettings возвращает настройки команды; если они не задавались — нулевые.
+		GetTeamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error)
+		UpsertTeamSettings(ctx context.Context, settings domain.TeamSettings) error
+		// GetTeamNotifications возвращает настройки уведомлений команды; если они не задавались — пустые.
+		GetTeamNotifications(ctx context.Context, teamName string) (domain.TeamNotifications, error)
+		UpsertTeamNotifications(ctx context.Context, n domain.TeamNotifications) error
+
+		CreateReviewRule(ctx context.Context, rule domain.ReviewRule) (domain.ReviewRule, error)
+		DeleteReviewRule(ctx context.Context, id int64) error
+		// ListReviewRules возвращает правила команды в порядке создания.
+		ListReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error)
+
+		// GetCodeOwners возвращает правила владения путями в порядке следования в CODEOWNERS.
+		GetCodeOwners(ctx context.Context, teamName string) ([]domain.CodeOwnerRule, error)
+		// ReplaceCodeOwners целиком заменяет правила владения путями команды.
+		ReplaceCodeOwners(ctx context.Context, teamName string, rules []domain.CodeOwnerRule) error
 	}
 
 	UserRepository interface {
+		// UpsertUsers создаёт или обновляет пользователей команды. Пустой teamName — пользователи вне команд.
 		UpsertUsers(ctx context.Context, teamName string, members []domain.TeamMember) error
 		GetUserByID(ctx context.Context, userID string) (domain.User, error)
 		SetUserIsActive(ctx context.Context, userID string, isActive bool) (domain.User, error)
+		SetUserSeniority(ctx context.Context, userID string, seniority domain.Seniority) (domain.User, error)
 		GetTeamMembers(ctx context.Context, teamName string, onlyActive bool) ([]domain.User, error)
+		DetachUsers(ctx context.Context, userIDs []string) error
+		GetAvailableTeamMembers(ctx context.Context, teamName string, at time.Time) ([]domain.User, error)
+		// ListUsers возвращает до limit пользователей с id больше afterID по возрастанию id.
+		ListUsers(ctx context.Context, afterID string, limit int) ([]domain.User, error)
+
+		// GetUserTags возвращает теги пользователей по алфавиту; пользователи без тегов в результат не попадают.
+		GetUserTags(ctx context.Context, userIDs []string) (map[string][]string, error)
+		// ReplaceUserTags целиком заменяет теги пользователя.
+		ReplaceUserTags(ctx context.Context, userID string, tags []string) error
+
+		// ListForgeAccounts возвращает сопоставления логинов хостинга forge по возрастанию логина.
+		ListForgeAccounts(ctx context.Context, forge domain.Forge) ([]domain.ForgeAccount, error)
+		// UpsertForgeAccount создаёт сопоставление логина или перепривязывает его к другому пользователю.
+		UpsertForgeAccount(ctx context.Context, account domain.ForgeAccount) error
+		DeleteForgeAccount(ctx context.Context, forge domain.Forge, login string) error
+		// GetForgeAccountUserID возвращает id пользователя, сопоставленного логину, или NOT_FOUND.
+		GetForgeAccountUserID(ctx context.Context, forge domain.Forge, login string) (string, error)
+
+		// GetMutedUsers возвращает тех из userIDs, кто отказался от уведомлений в чат, по возрастанию id.
+		GetMutedUsers(ctx context.Context, userIDs []string) ([]string, error)
+		SetNotificationsMuted(ctx context.Context, userID string, muted bool) error
+
+		// GetUserPreferences возвращает настройки дайджеста пользователя, значения по умолчанию, если он их не менял,
+		// или NOT_FOUND, если пользователя нет.
+		GetUserPreferences(ctx context.Context, userID string) (domain.UserPreferences, error)
+		// UpsertUserPreferences сохраняет настройки, не трогая время последнего дайджеста.
+		UpsertUserPreferences(ctx context.Context, p domain.UserPreferences) error
+		// ListDigestSubscriptions возвращает настройки активных пользователей с включённым дайджестом и адресом,
+		// по возрастанию id.
+		ListDigestSubscriptions(ctx context.Context) ([]domain.UserPreferences, error)
+		SetLastDigestAt(ctx context.Context, userID string, at time.Time) error
 	}
 
 	PRRepository interface {
 		CreatePR(ctx context.Context, pr domain.PullRequest) error
+		// CreatePRs создаёт открытые PR вместе с ревьюверами из AssignedReviewers.
+		// Время создания и назначения берётся из CreatedAt.
+		CreatePRs(ctx context.Context, prs []domain.PullRequest) error
 		PRExists(ctx context.Context, prID string) (bool, error)
 		GetPR(ctx context.Context, prID string) (domain.PullRequest, error)
 		UpdatePR(ctx context.Context, pr domain.PullRequest) error
+		// ImportPR создаёт PR или целиком перезаписывает существующий, сохраняя время создания и мержа из pr.
+		// Ревьюверы считаются назначенными в момент создания PR.
+		ImportPR(ctx context.Context, pr domain.PullRequest) error
+		// ListPRs возвращает до limit PR с id больше afterID по возрастанию id.
+		ListPRs(ctx context.Context, afterID string, limit int) ([]domain.PullRequest, error)
 
 		GetPRReviewers(ctx context.Context, prID string) ([]string, error)
 		SetPRReviewers(ctx context.Context, prID string, reviewers []string) error
 
 		GetPRsWhereReviewer(ctx context.Context, userID string) ([]domain.PullRequestShort, error)
 		GetOpenPRsByReviewers(ctx context.Context, userIDs []string) ([]domain.PullRequest, error)
+		GetOpenPRsByTeam(ctx context.Context, teamName string) ([]domain.PullRequest, error)
+
+		GetAssignmentsCountByUser(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentsStat, error)
+		GetPRStatusCounts(ctx context.Context, filter domain.StatsFilter) (domain.PRStatusCounts, error)
+		GetPRStatusCountsByTeam(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error)
+		GetPRCountsByPeriod(ctx context.Context, filter domain.StatsFilter, granularity domain.StatsGranularity) ([]domain.StatsBucket, error)
+		GetPRTimings(ctx context.Context, filter domain.StatsFilter) ([]domain.PRTiming, error)
+		// GetReviewerLoad возвращает нагрузку участников команды (всех команд, если teamName пуст).
+		GetReviewerLoad(ctx context.Context, teamName string) ([]domain.ReviewerLoad, error)
+
+		// GetStalePRs возвращает открытые PR без активности дольше порога команды (defaultAfter, если он не задан)
+		// на момент now, начиная с самых давних.
+		GetStalePRs(ctx context.Context, teamName string, defaultAfter time.Duration, now time.Time) ([]domain.StalePR, error)
+		MarkPRStale(ctx context.Context, prID string, at time.Time) error
+
+		// EnqueueForgeSync ставит в очередь отправку ревьюверов на git-хостинг; первая попытка — в job.NextAttemptAt.
+		EnqueueForgeSync(ctx context.Context, job domain.ForgeSyncJob) error
+		// ListDueForgeSyncJobs возвращает до limit заданий, время попытки которых наступило к now, начиная с самых ранних.
+		ListDueForgeSyncJobs(ctx context.Context, now time.Time, limit int) ([]domain.ForgeSyncJob, error)
+		// UpdateForgeSyncJob сохраняет счётчик попыток, время следующей попытки и последнюю ошибку.
+		UpdateForgeSyncJob(ctx context.Context, job domain.ForgeSyncJob) error
+		DeleteForgeSyncJob(ctx context.Context, id int64) error
+	}
+
+	AvailabilityRepository interface {
+		CreateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error)
+		UpdateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error)
+		DeleteAvailability(ctx context.Context, id int64) error
+		ListAvailability(ctx context.Context, userID string) ([]domain.Availability, error)
+
+		GetStartedAvailability(ctx context.Context, at time.Time) ([]domain.Availability, error)
+		MarkAvailabilityApplied(ctx context.Context, id int64, at time.Time) error
+	}
 
-		GetAssignmentsCountByUser(ctx context.Context) ([]domain.UserAssignmentsStat, error)
-		GetPRStatusCounts(ctx context.Context) (domain.PRStatusCounts, error)
+	EventRepository interface {
+		// AppendEvent сохраняет событие и возвращает его с присвоенными ID и CreatedAt.
+		// ID растут в порядке фиксации записи, поэтому читатель по возрастанию id не пропускает событий.
+		AppendEvent(ctx context.Context, ev domain.Event) (domain.Event, error)
+		// ListEvents возвращает до limit событий, прошедших фильтр, с id больше filter.AfterID по возрастанию id.
+		// Пустой AfterID — с самого начала.
+		ListEvents(ctx context.Context, filter domain.EventFilter, limit int) ([]domain.Event, error)
+		// LastEventID возвращает id последнего события или 0, если событий нет.
+		LastEventID(ctx context.Context) (int64, error)
 	}
 )
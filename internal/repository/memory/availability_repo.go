@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type AvailabilityRepository struct {
+	store *Store
+}
+
+func NewAvailabilityRepository(store *Store) *AvailabilityRepository {
+	return &AvailabilityRepository{store: store}
+}
+
+var errInvalidPeriod = errors.New("availability period must end after it starts")
+
+func (r *AvailabilityRepository) CreateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	err := r.store.write(ctx, tableAvail, func(st *state) error {
+		if _, ok := st.users[a.UserID]; !ok {
+			return fmt.Errorf("%w: user %q does not exist", ErrForeignKey, a.UserID)
+		}
+		if !a.EndsAt.After(a.StartsAt) {
+			return errInvalidPeriod
+		}
+
+		st.availSeq++
+		a.ID = st.availSeq
+		a.AppliedAt = nil
+		st.avail[a.ID] = a
+		return nil
+	})
+	if err != nil {
+		return domain.Availability{}, err
+	}
+
+	return a, nil
+}
+
+// UpdateAvailability меняет границы и причину периода.
+// Если новый период ещё не начался, отметка о применении сбрасывается.
+func (r *AvailabilityRepository) UpdateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	var res domain.Availability
+
+	err := r.store.write(ctx, tableAvail, func(st *state) error {
+		cur, ok := st.avail[a.ID]
+		if !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "availability period not found")
+		}
+		if !a.EndsAt.After(a.StartsAt) {
+			return errInvalidPeriod
+		}
+
+		cur.StartsAt = a.StartsAt
+		cur.EndsAt = a.EndsAt
+		cur.Reason = a.Reason
+		if a.StartsAt.After(time.Now()) {
+			cur.AppliedAt = nil
+		}
+		st.avail[a.ID] = cur
+		res = copyAvailability(cur)
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *AvailabilityRepository) DeleteAvailability(ctx context.Context, id int64) error {
+	return r.store.write(ctx, tableAvail, func(st *state) error {
+		if _, ok := st.avail[id]; !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "availability period not found")
+		}
+		delete(st.avail, id)
+		return nil
+	})
+}
+
+func (r *AvailabilityRepository) ListAvailability(ctx context.Context, userID string) ([]domain.Availability, error) {
+	return r.query(ctx, func(a domain.Availability) bool {
+		return a.UserID == userID
+	})
+}
+
+// GetStartedAvailability возвращает уже начавшиеся, но ещё не применённые периоды недоступности.
+func (r *AvailabilityRepository) GetStartedAvailability(ctx context.Context, at time.Time) ([]domain.Availability, error) {
+	return r.query(ctx, func(a domain.Availability) bool {
+		return a.AppliedAt == nil && a.Covers(at)
+	})
+}
+
+func (r *AvailabilityRepository) MarkAvailabilityApplied(ctx context.Context, id int64, at time.Time) error {
+	return r.store.write(ctx, tableAvail, func(st *state) error {
+		a, ok := st.avail[id]
+		if !ok {
+			return nil
+		}
+		a.AppliedAt = &at
+		st.avail[id] = a
+		return nil
+	})
+}
+
+func (r *AvailabilityRepository) query(ctx context.Context, match func(a domain.Availability) bool) ([]domain.Availability, error) {
+	res := make([]domain.Availability, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, a := range st.avail {
+			if match(a) {
+				res = append(res, copyAvailability(a))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].StartsAt.Equal(res[j].StartsAt) {
+			return res[i].StartsAt.Before(res[j].StartsAt)
+		}
+		return res[i].ID < res[j].ID
+	})
+
+	return res, nil
+}
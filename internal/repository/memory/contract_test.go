@@ -0,0 +1,22 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/alnoi/pr-reviewer-service/internal/repository/memory"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/repotest"
+)
+
+func TestMemoryRepositoriesContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repos {
+		store := memory.NewStore()
+		return repotest.Repos{
+			Teams:        memory.NewTeamRepository(store),
+			Users:        memory.NewUserRepository(store),
+			PRs:          memory.NewPRRepository(store),
+			Availability: memory.NewAvailabilityRepository(store),
+			Events:       memory.NewEventRepository(store),
+			Transactor:   memory.NewTransactor(store),
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type EventRepository struct {
+	store *Store
+}
+
+func NewEventRepository(store *Store) *EventRepository {
+	return &EventRepository{store: store}
+}
+
+func (r *EventRepository) AppendEvent(ctx context.Context, ev domain.Event) (domain.Event, error) {
+	err := r.store.write(ctx, tableEvents, func(st *state) error {
+		st.eventSeq++
+		ev.ID = st.eventSeq
+		ev.CreatedAt = time.Now().UTC()
+		st.events[ev.ID] = copyEvent(ev)
+		return nil
+	})
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	return ev, nil
+}
+
+func (r *EventRepository) ListEvents(ctx context.Context, filter domain.EventFilter, limit int) ([]domain.Event, error) {
+	var afterID int64
+	if filter.AfterID != nil {
+		afterID = *filter.AfterID
+	}
+
+	res := make([]domain.Event, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for id, e := range st.events {
+			if id > afterID && filter.Matches(e) {
+				res = append(res, copyEvent(e))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+
+	if len(res) > limit {
+		res = res[:limit]
+	}
+
+	return res, nil
+}
+
+func (r *EventRepository) LastEventID(ctx context.Context) (int64, error) {
+	var id int64
+
+	err := r.store.read(ctx, func(st *state) error {
+		id = st.eventSeq
+		return nil
+	})
+
+	return id, err
+}
@@ -0,0 +1,719 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type PRRepository struct {
+	store *Store
+}
+
+func NewPRRepository(store *Store) *PRRepository {
+	return &PRRepository{store: store}
+}
+
+func (r *PRRepository) CreatePR(ctx context.Context, pr domain.PullRequest) error {
+	return r.store.write(ctx, tablePRs, func(st *state) error {
+		if _, ok := st.prs[pr.PullRequestID]; ok {
+			return fmt.Errorf("pull request %q already exists", pr.PullRequestID)
+		}
+		if _, ok := st.users[pr.AuthorID]; !ok {
+			return fmt.Errorf("%w: author %q does not exist", ErrForeignKey, pr.AuthorID)
+		}
+
+		st.prSeq++
+		st.prs[pr.PullRequestID] = prRow{
+			pr: domain.PullRequest{
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				Status:          pr.Status,
+				CreatedAt:       time.Now(),
+			},
+			seq: st.prSeq,
+		}
+		return nil
+	})
+}
+
+// CreatePRs создаёт открытые PR вместе с ревьюверами из AssignedReviewers.
+// Время создания и назначения берётся из CreatedAt.
+func (r *PRRepository) CreatePRs(ctx context.Context, prs []domain.PullRequest) error {
+	return r.store.write(ctx, tablePRs, func(st *state) error {
+		ids := make(map[string]struct{}, len(prs))
+		for _, pr := range prs {
+			_, exists := st.prs[pr.PullRequestID]
+			_, dup := ids[pr.PullRequestID]
+			if exists || dup {
+				return fmt.Errorf("pull request %q already exists", pr.PullRequestID)
+			}
+			ids[pr.PullRequestID] = struct{}{}
+
+			if _, ok := st.users[pr.AuthorID]; !ok {
+				return fmt.Errorf("%w: author %q does not exist", ErrForeignKey, pr.AuthorID)
+			}
+
+			seen := make(map[string]struct{}, len(pr.AssignedReviewers))
+			for _, id := range pr.AssignedReviewers {
+				if _, ok := st.users[id]; !ok {
+					return fmt.Errorf("%w: reviewer %q does not exist", ErrForeignKey, id)
+				}
+				if _, dup := seen[id]; dup {
+					return fmt.Errorf("reviewer %q is duplicated for pull request %q", id, pr.PullRequestID)
+				}
+				seen[id] = struct{}{}
+			}
+		}
+
+		for _, pr := range prs {
+			row := prRow{pr: copyPR(pr)}
+			row.pr.MergedAt = nil
+			if len(row.pr.AssignedReviewers) == 0 {
+				row.pr.AssignedReviewers = nil
+			}
+			sort.Strings(row.pr.AssignedReviewers)
+			row.assignedAt = make(map[string]time.Time, len(pr.AssignedReviewers))
+			for _, id := range pr.AssignedReviewers {
+				row.assignedAt[id] = pr.CreatedAt
+			}
+
+			st.prSeq++
+			row.seq = st.prSeq
+			st.prs[pr.PullRequestID] = row
+		}
+		return nil
+	})
+}
+
+func (r *PRRepository) PRExists(ctx context.Context, prID string) (bool, error) {
+	var exists bool
+
+	err := r.store.read(ctx, func(st *state) error {
+		_, exists = st.prs[prID]
+		return nil
+	})
+
+	return exists, err
+}
+
+func (r *PRRepository) GetPR(ctx context.Context, prID string) (domain.PullRequest, error) {
+	var res domain.PullRequest
+
+	err := r.store.read(ctx, func(st *state) error {
+		row, ok := st.prs[prID]
+		if !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "PR not found")
+		}
+		res = copyPR(row.pr)
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *PRRepository) UpdatePR(ctx context.Context, pr domain.PullRequest) error {
+	return r.store.write(ctx, tablePRs, func(st *state) error {
+		row, ok := st.prs[pr.PullRequestID]
+		if !ok {
+			return nil
+		}
+		if _, ok := st.users[pr.AuthorID]; !ok {
+			return fmt.Errorf("%w: author %q does not exist", ErrForeignKey, pr.AuthorID)
+		}
+
+		updated := copyPR(pr)
+		row.pr.PullRequestName = updated.PullRequestName
+		row.pr.AuthorID = updated.AuthorID
+		row.pr.Status = updated.Status
+		row.pr.MergedAt = updated.MergedAt
+		st.prs[pr.PullRequestID] = row
+		return nil
+	})
+}
+
+// ImportPR создаёт PR или целиком перезаписывает существующий, сохраняя время создания и мержа из pr.
+// Ревьюверы считаются назначенными в момент создания PR.
+func (r *PRRepository) ImportPR(ctx context.Context, pr domain.PullRequest) error {
+	return r.store.write(ctx, tablePRs, func(st *state) error {
+		if _, ok := st.users[pr.AuthorID]; !ok {
+			return fmt.Errorf("%w: author %q does not exist", ErrForeignKey, pr.AuthorID)
+		}
+
+		seen := make(map[string]struct{}, len(pr.AssignedReviewers))
+		for _, id := range pr.AssignedReviewers {
+			if _, ok := st.users[id]; !ok {
+				return fmt.Errorf("%w: reviewer %q does not exist", ErrForeignKey, id)
+			}
+			if _, dup := seen[id]; dup {
+				return fmt.Errorf("reviewer %q is duplicated for pull request %q", id, pr.PullRequestID)
+			}
+			seen[id] = struct{}{}
+		}
+
+		row, ok := st.prs[pr.PullRequestID]
+		if !ok {
+			st.prSeq++
+			row.seq = st.prSeq
+		}
+
+		row.pr = copyPR(pr)
+		if len(row.pr.AssignedReviewers) == 0 {
+			row.pr.AssignedReviewers = nil
+		}
+		sort.Strings(row.pr.AssignedReviewers)
+		row.assignedAt = make(map[string]time.Time, len(pr.AssignedReviewers))
+		for _, id := range pr.AssignedReviewers {
+			row.assignedAt[id] = pr.CreatedAt
+		}
+		row.staleAt = nil
+
+		st.prs[pr.PullRequestID] = row
+		return nil
+	})
+}
+
+// ListPRs возвращает до limit PR с id больше afterID по возрастанию id.
+func (r *PRRepository) ListPRs(ctx context.Context, afterID string, limit int) ([]domain.PullRequest, error) {
+	res := make([]domain.PullRequest, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for id, row := range st.prs {
+			if id > afterID {
+				res = append(res, copyPR(row.pr))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].PullRequestID < res[j].PullRequestID
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+
+	return res, nil
+}
+
+func (r *PRRepository) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	var res []string
+
+	err := r.store.read(ctx, func(st *state) error {
+		if row, ok := st.prs[prID]; ok {
+			res = copyPR(row.pr).AssignedReviewers
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *PRRepository) SetPRReviewers(ctx context.Context, prID string, reviewers []string) error {
+	return r.store.write(ctx, tablePRs, func(st *state) error {
+		row, ok := st.prs[prID]
+		if !ok {
+			return nil
+		}
+
+		seen := make(map[string]struct{}, len(reviewers))
+		var sorted []string
+		for _, id := range reviewers {
+			if _, ok := st.users[id]; !ok {
+				return fmt.Errorf("%w: reviewer %q does not exist", ErrForeignKey, id)
+			}
+			if _, dup := seen[id]; dup {
+				return fmt.Errorf("reviewer %q is duplicated for pull request %q", id, prID)
+			}
+			seen[id] = struct{}{}
+			sorted = append(sorted, id)
+		}
+		sort.Strings(sorted)
+
+		// оставшимся ревьюверам сохраняется время назначения
+		now := time.Now()
+		assignedAt := make(map[string]time.Time, len(sorted))
+		for _, id := range sorted {
+			at, ok := row.assignedAt[id]
+			if !ok {
+				at = now
+			}
+			assignedAt[id] = at
+		}
+
+		row.pr.AssignedReviewers = sorted
+		row.assignedAt = assignedAt
+		st.prs[prID] = row
+		return nil
+	})
+}
+
+func (r *PRRepository) GetPRsWhereReviewer(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+	var res []domain.PullRequestShort
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, pr := range sortedPRs(st, func(pr domain.PullRequest) bool {
+			return hasReviewer(pr, userID)
+		}) {
+			res = append(res, domain.PullRequestShort{
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				Status:          pr.Status,
+			})
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *PRRepository) GetOpenPRsByReviewers(ctx context.Context, userIDs []string) ([]domain.PullRequest, error) {
+	if len(userIDs) == 0 {
+		return []domain.PullRequest{}, nil
+	}
+
+	var res []domain.PullRequest
+
+	err := r.store.read(ctx, func(st *state) error {
+		res = sortedPRs(st, func(pr domain.PullRequest) bool {
+			if pr.Status != domain.PRStatusOpen {
+				return false
+			}
+			for _, id := range userIDs {
+				if hasReviewer(pr, id) {
+					return true
+				}
+			}
+			return false
+		})
+		return nil
+	})
+
+	return res, err
+}
+
+// GetOpenPRsByTeam возвращает открытые PR, авторы которых состоят в команде teamName.
+func (r *PRRepository) GetOpenPRsByTeam(ctx context.Context, teamName string) ([]domain.PullRequest, error) {
+	var res []domain.PullRequest
+
+	err := r.store.read(ctx, func(st *state) error {
+		res = sortedPRs(st, func(pr domain.PullRequest) bool {
+			author, ok := st.users[pr.AuthorID]
+			return ok && teamName != "" && author.TeamName == teamName && pr.Status == domain.PRStatusOpen
+		})
+		return nil
+	})
+
+	return res, err
+}
+
+// GetAssignmentsCountByUser считает назначения по PR, попавшим под фильтр.
+// При заданной команде возвращаются только её участники.
+func (r *PRRepository) GetAssignmentsCountByUser(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentsStat, error) {
+	var res []domain.UserAssignmentsStat
+
+	err := r.store.read(ctx, func(st *state) error {
+		counts := make(map[string]int, len(st.users))
+		for id, u := range st.users {
+			if filter.TeamName != "" && u.TeamName != filter.TeamName {
+				continue
+			}
+			counts[id] = 0
+		}
+		for _, row := range st.prs {
+			if !matchStatsFilter(st, row.pr, filter) {
+				continue
+			}
+			for _, id := range row.pr.AssignedReviewers {
+				if _, ok := counts[id]; ok {
+					counts[id]++
+				}
+			}
+		}
+
+		for id, c := range counts {
+			res = append(res, domain.UserAssignmentsStat{
+				UserID:                 id,
+				ReviewAssignmentsCount: c,
+			})
+		}
+		sort.Slice(res, func(i, j int) bool {
+			if res[i].ReviewAssignmentsCount != res[j].ReviewAssignmentsCount {
+				return res[i].ReviewAssignmentsCount > res[j].ReviewAssignmentsCount
+			}
+			return res[i].UserID < res[j].UserID
+		})
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *PRRepository) GetPRStatusCounts(ctx context.Context, filter domain.StatsFilter) (domain.PRStatusCounts, error) {
+	var res domain.PRStatusCounts
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, row := range st.prs {
+			if matchStatsFilter(st, row.pr, filter) {
+				countStatus(&res, row.pr.Status)
+			}
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+// GetPRStatusCountsByTeam группирует PR по текущей команде автора. PR авторов вне команд не учитываются.
+func (r *PRRepository) GetPRStatusCountsByTeam(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	res := make([]domain.TeamStats, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		byTeam := make(map[string]*domain.PRStatusCounts)
+		for _, row := range st.prs {
+			if !matchStatsFilter(st, row.pr, filter) {
+				continue
+			}
+			team := st.users[row.pr.AuthorID].TeamName
+			if team == "" {
+				continue
+			}
+			if byTeam[team] == nil {
+				byTeam[team] = &domain.PRStatusCounts{}
+			}
+			countStatus(byTeam[team], row.pr.Status)
+		}
+
+		for team, counts := range byTeam {
+			res = append(res, domain.TeamStats{TeamName: team, PRStatusCounts: *counts})
+		}
+		sort.Slice(res, func(i, j int) bool {
+			return res[i].TeamName < res[j].TeamName
+		})
+		return nil
+	})
+
+	return res, err
+}
+
+// GetPRCountsByPeriod возвращает непустые периоды (в UTC) с числом созданных и смерженных PR.
+func (r *PRRepository) GetPRCountsByPeriod(ctx context.Context, filter domain.StatsFilter, granularity domain.StatsGranularity) ([]domain.StatsBucket, error) {
+	res := make([]domain.StatsBucket, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		buckets := make(map[time.Time]*domain.StatsBucket)
+		bucket := func(t time.Time) *domain.StatsBucket {
+			start := granularity.BucketStart(t)
+			if buckets[start] == nil {
+				buckets[start] = &domain.StatsBucket{Start: start}
+			}
+			return buckets[start]
+		}
+
+		for _, row := range st.prs {
+			if !matchStatsFilter(st, row.pr, filter) {
+				continue
+			}
+			bucket(row.pr.CreatedAt).Created++
+			if row.pr.MergedAt != nil {
+				bucket(*row.pr.MergedAt).Merged++
+			}
+		}
+
+		for _, b := range buckets {
+			res = append(res, *b)
+		}
+		sort.Slice(res, func(i, j int) bool {
+			return res[i].Start.Before(res[j].Start)
+		})
+		return nil
+	})
+
+	return res, err
+}
+
+// GetPRTimings возвращает сроки жизни PR под фильтром, упорядоченные по ID. Ревьюверы отсортированы.
+func (r *PRRepository) GetPRTimings(ctx context.Context, filter domain.StatsFilter) ([]domain.PRTiming, error) {
+	res := make([]domain.PRTiming, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, row := range st.prs {
+			if !matchStatsFilter(st, row.pr, filter) {
+				continue
+			}
+
+			pr := copyPR(row.pr)
+			reviewers := make([]string, 0, len(pr.AssignedReviewers))
+			reviewers = append(reviewers, pr.AssignedReviewers...)
+			sort.Strings(reviewers)
+
+			res = append(res, domain.PRTiming{
+				PullRequestID: pr.PullRequestID,
+				TeamName:      st.users[pr.AuthorID].TeamName,
+				Reviewers:     reviewers,
+				CreatedAt:     pr.CreatedAt,
+				MergedAt:      pr.MergedAt,
+			})
+		}
+
+		sort.Slice(res, func(i, j int) bool {
+			return res[i].PullRequestID < res[j].PullRequestID
+		})
+		return nil
+	})
+
+	return res, err
+}
+
+// GetReviewerLoad считает открытые назначения и время последнего назначения. Пользователи вне команд не учитываются.
+func (r *PRRepository) GetReviewerLoad(ctx context.Context, teamName string) ([]domain.ReviewerLoad, error) {
+	res := make([]domain.ReviewerLoad, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		loads := make(map[string]*domain.ReviewerLoad)
+		for _, u := range st.users {
+			if u.TeamName == "" || (teamName != "" && u.TeamName != teamName) {
+				continue
+			}
+			loads[u.UserID] = &domain.ReviewerLoad{
+				UserID:   u.UserID,
+				TeamName: u.TeamName,
+				IsActive: u.IsActive,
+			}
+		}
+
+		for _, row := range st.prs {
+			for id, at := range row.assignedAt {
+				l, ok := loads[id]
+				if !ok {
+					continue
+				}
+				if row.pr.Status == domain.PRStatusOpen {
+					l.OpenAssignments++
+				}
+				if l.LastAssignedAt == nil || at.After(*l.LastAssignedAt) {
+					at := at
+					l.LastAssignedAt = &at
+				}
+			}
+		}
+
+		for _, l := range loads {
+			res = append(res, *l)
+		}
+		sort.Slice(res, func(i, j int) bool {
+			if res[i].TeamName != res[j].TeamName {
+				return res[i].TeamName < res[j].TeamName
+			}
+			return res[i].UserID < res[j].UserID
+		})
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *PRRepository) GetStalePRs(ctx context.Context, teamName string, defaultAfter time.Duration, now time.Time) ([]domain.StalePR, error) {
+	var res []domain.StalePR
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, row := range st.prs {
+			if row.pr.Status != domain.PRStatusOpen {
+				continue
+			}
+
+			team := st.users[row.pr.AuthorID].TeamName
+			if team == "" || (teamName != "" && team != teamName) {
+				continue
+			}
+
+			p := domain.StalePR{
+				PullRequest:    copyPR(row.pr),
+				TeamName:       team,
+				LastActivityAt: row.pr.CreatedAt,
+				StaleAfter:     defaultAfter,
+				AutoRotate:     st.teamSettings[team].StaleAutoRotate,
+			}
+			if after := st.teamSettings[team].StaleAfter; after > 0 {
+				p.StaleAfter = after
+			}
+
+			var oldest time.Time
+			for _, id := range row.pr.AssignedReviewers {
+				at := row.assignedAt[id]
+				if at.After(p.LastActivityAt) {
+					p.LastActivityAt = at
+				}
+				if p.OldestReviewerID == "" || at.Before(oldest) {
+					p.OldestReviewerID, oldest = id, at
+				}
+			}
+
+			if p.LastActivityAt.Add(p.StaleAfter).After(now) {
+				continue
+			}
+
+			if row.staleAt != nil {
+				markedAt := *row.staleAt
+				p.MarkedAt = &markedAt
+			}
+			res = append(res, p)
+		}
+
+		sort.Slice(res, func(i, j int) bool {
+			if !res[i].LastActivityAt.Equal(res[j].LastActivityAt) {
+				return res[i].LastActivityAt.Before(res[j].LastActivityAt)
+			}
+			return res[i].PullRequest.PullRequestID < res[j].PullRequest.PullRequestID
+		})
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *PRRepository) MarkPRStale(ctx context.Context, prID string, at time.Time) error {
+	return r.store.write(ctx, tablePRs, func(st *state) error {
+		row, ok := st.prs[prID]
+		if !ok {
+			return nil
+		}
+		row.staleAt = &at
+		st.prs[prID] = row
+		return nil
+	})
+}
+
+func matchStatsFilter(st *state, pr domain.PullRequest, filter domain.StatsFilter) bool {
+	if filter.TeamName != "" && st.users[pr.AuthorID].TeamName != filter.TeamName {
+		return false
+	}
+	if filter.CreatedFrom != nil && pr.CreatedAt.Before(*filter.CreatedFrom) {
+		return false
+	}
+	if filter.CreatedTo != nil && !pr.CreatedAt.Before(*filter.CreatedTo) {
+		return false
+	}
+	if filter.MergedFrom != nil && (pr.MergedAt == nil || pr.MergedAt.Before(*filter.MergedFrom)) {
+		return false
+	}
+	if filter.MergedTo != nil && (pr.MergedAt == nil || !pr.MergedAt.Before(*filter.MergedTo)) {
+		return false
+	}
+	return true
+}
+
+func countStatus(counts *domain.PRStatusCounts, status domain.PRStatus) {
+	switch status {
+	case domain.PRStatusOpen:
+		counts.Open++
+	case domain.PRStatusMerged:
+		counts.Merged++
+	}
+	counts.Total++
+}
+
+// sortedPRs возвращает копии подходящих PR в порядке создания.
+func sortedPRs(st *state, match func(pr domain.PullRequest) bool) []domain.PullRequest {
+	rows := make([]prRow, 0)
+	for _, row := range st.prs {
+		if match(row.pr) {
+			rows = append(rows, row)
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].seq < rows[j].seq
+	})
+
+	res := make([]domain.PullRequest, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, copyPR(row.pr))
+	}
+	return res
+}
+
+func hasReviewer(pr domain.PullRequest, userID string) bool {
+	for _, id := range pr.AssignedReviewers {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// EnqueueForgeSync ставит в очередь отправку ревьюверов на git-хостинг.
+func (r *PRRepository) EnqueueForgeSync(ctx context.Context, job domain.ForgeSyncJob) error {
+	return r.store.write(ctx, tableSyncJobs, func(st *state) error {
+		if _, ok := st.prs[job.PullRequestID]; !ok {
+			return fmt.Errorf("%w: pull request %q does not exist", ErrForeignKey, job.PullRequestID)
+		}
+
+		st.syncSeq++
+		job = copyForgeSyncJob(job)
+		job.ID = st.syncSeq
+		job.Attempts = 0
+		job.LastError = ""
+		job.CreatedAt = time.Now()
+		st.syncJobs[job.ID] = job
+		return nil
+	})
+}
+
+// ListDueForgeSyncJobs возвращает до limit заданий, время попытки которых наступило к now.
+func (r *PRRepository) ListDueForgeSyncJobs(ctx context.Context, now time.Time, limit int) ([]domain.ForgeSyncJob, error) {
+	res := make([]domain.ForgeSyncJob, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, j := range st.syncJobs {
+			if !j.NextAttemptAt.After(now) {
+				res = append(res, copyForgeSyncJob(j))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].NextAttemptAt.Equal(res[j].NextAttemptAt) {
+			return res[i].NextAttemptAt.Before(res[j].NextAttemptAt)
+		}
+		return res[i].ID < res[j].ID
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+// UpdateForgeSyncJob сохраняет счётчик попыток, время следующей попытки и последнюю ошибку.
+func (r *PRRepository) UpdateForgeSyncJob(ctx context.Context, job domain.ForgeSyncJob) error {
+	return r.store.write(ctx, tableSyncJobs, func(st *state) error {
+		stored, ok := st.syncJobs[job.ID]
+		if !ok {
+			return nil
+		}
+		stored.Attempts = job.Attempts
+		stored.NextAttemptAt = job.NextAttemptAt
+		stored.LastError = job.LastError
+		st.syncJobs[job.ID] = stored
+		return nil
+	})
+}
+
+func (r *PRRepository) DeleteForgeSyncJob(ctx context.Context, id int64) error {
+	return r.store.write(ctx, tableSyncJobs, func(st *state) error {
+		delete(st.syncJobs, id)
+		return nil
+	})
+}
@@ -0,0 +1,369 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/repository"
+	"github.com/alnoi/pr-reviewer-service/internal/usecase"
+)
+
+var (
+	_ repository.TeamRepository         = (*TeamRepository)(nil)
+	_ repository.UserRepository         = (*UserRepository)(nil)
+	_ repository.PRRepository           = (*PRRepository)(nil)
+	_ repository.AvailabilityRepository = (*AvailabilityRepository)(nil)
+	_ repository.EventRepository        = (*EventRepository)(nil)
+	_ usecase.Transactor                = (*Transactor)(nil)
+)
+
+// ErrForeignKey — аналог нарушения внешнего ключа в Postgres.
+var ErrForeignKey = errors.New("foreign key violation")
+
+// Store — потокобезопасное in-memory хранилище, общее для всех репозиториев пакета.
+//
+// Одиночные записи и транзакции сериализуются через writeMu. Транзакция работает
+// со своим состоянием, в котором копируются только таблицы, которые она меняет
+// (copy-on-write), и публикует его при успехе, поэтому откат — это просто отказ от копий.
+type Store struct {
+	writeMu sync.Mutex
+
+	mu sync.RWMutex
+	st *state
+}
+
+func NewStore() *Store {
+	return &Store{st: newState()}
+}
+
+type state struct {
+	teams        map[string]struct{}
+	teamSettings map[string]domain.TeamSettings
+	users        map[string]domain.User
+	prs          map[string]prRow
+	avail        map[int64]domain.Availability
+	rules        map[int64]domain.ReviewRule
+	codeOwners   map[string][]domain.CodeOwnerRule
+	tags         map[string][]string
+	// forgeAccounts[логин на хостинге] — id пользователя
+	forgeAccounts map[forgeLogin]string
+	syncJobs      map[int64]domain.ForgeSyncJob
+	notifications map[string]domain.TeamNotifications
+	// muted — пользователи, отказавшиеся от уведомлений в чат
+	muted map[string]struct{}
+	// preferences — настройки дайджеста тех, кто их менял или уже получал дайджест
+	preferences map[string]domain.UserPreferences
+	events      map[int64]domain.Event
+
+	prSeq    int64
+	availSeq int64
+	ruleSeq  int64
+	syncSeq  int64
+	eventSeq int64
+}
+
+// table — набор таблиц state, которые меняет запись; по нему транзакция решает, что копировать.
+type table uint16
+
+const (
+	tableTeams table = 1 << iota
+	tableTeamSettings
+	tableUsers
+	tablePRs
+	tableAvail
+	tableRules
+	tableCodeOwners
+	tableTags
+	tableForgeAccounts
+	tableSyncJobs
+	tableNotifications
+	tableMuted
+	tablePreferences
+	tableEvents
+)
+
+type forgeLogin struct {
+	forge domain.Forge
+	login string
+}
+
+type prRow struct {
+	pr  domain.PullRequest
+	seq int64
+
+	// время назначения каждого текущего ревьювера
+	assignedAt map[string]time.Time
+	staleAt    *time.Time
+}
+
+func newState() *state {
+	return &state{
+		teams:         make(map[string]struct{}),
+		teamSettings:  make(map[string]domain.TeamSettings),
+		users:         make(map[string]domain.User),
+		prs:           make(map[string]prRow),
+		avail:         make(map[int64]domain.Availability),
+		rules:         make(map[int64]domain.ReviewRule),
+		codeOwners:    make(map[string][]domain.CodeOwnerRule),
+		tags:          make(map[string][]string),
+		forgeAccounts: make(map[forgeLogin]string),
+		syncJobs:      make(map[int64]domain.ForgeSyncJob),
+		notifications: make(map[string]domain.TeamNotifications),
+		muted:         make(map[string]struct{}),
+		preferences:   make(map[string]domain.UserPreferences),
+		events:        make(map[int64]domain.Event),
+	}
+}
+
+// copyTables заменяет таблицы из tables копиями, чтобы их можно было менять, не трогая
+// зафиксированное состояние. Остальные таблицы остаются общими с ним.
+func (st *state) copyTables(tables table) {
+	if tables&tableTeams != 0 {
+		st.teams = maps.Clone(st.teams)
+	}
+	if tables&tableTeamSettings != 0 {
+		st.teamSettings = maps.Clone(st.teamSettings)
+	}
+	if tables&tableUsers != 0 {
+		st.users = maps.Clone(st.users)
+	}
+	if tables&tablePRs != 0 {
+		prs := make(map[string]prRow, len(st.prs))
+		for k, v := range st.prs {
+			row := prRow{pr: copyPR(v.pr), seq: v.seq, assignedAt: maps.Clone(v.assignedAt)}
+			if v.staleAt != nil {
+				staleAt := *v.staleAt
+				row.staleAt = &staleAt
+			}
+			prs[k] = row
+		}
+		st.prs = prs
+	}
+	if tables&tableAvail != 0 {
+		avail := make(map[int64]domain.Availability, len(st.avail))
+		for k, v := range st.avail {
+			avail[k] = copyAvailability(v)
+		}
+		st.avail = avail
+	}
+	if tables&tableRules != 0 {
+		st.rules = maps.Clone(st.rules)
+	}
+	if tables&tableCodeOwners != 0 {
+		codeOwners := make(map[string][]domain.CodeOwnerRule, len(st.codeOwners))
+		for k, v := range st.codeOwners {
+			codeOwners[k] = copyCodeOwners(v)
+		}
+		st.codeOwners = codeOwners
+	}
+	if tables&tableTags != 0 {
+		tags := make(map[string][]string, len(st.tags))
+		for k, v := range st.tags {
+			tags[k] = append([]string(nil), v...)
+		}
+		st.tags = tags
+	}
+	if tables&tableForgeAccounts != 0 {
+		st.forgeAccounts = maps.Clone(st.forgeAccounts)
+	}
+	if tables&tableSyncJobs != 0 {
+		syncJobs := make(map[int64]domain.ForgeSyncJob, len(st.syncJobs))
+		for k, v := range st.syncJobs {
+			syncJobs[k] = copyForgeSyncJob(v)
+		}
+		st.syncJobs = syncJobs
+	}
+	if tables&tableNotifications != 0 {
+		notifications := make(map[string]domain.TeamNotifications, len(st.notifications))
+		for k, v := range st.notifications {
+			notifications[k] = copyTeamNotifications(v)
+		}
+		st.notifications = notifications
+	}
+	if tables&tableMuted != 0 {
+		st.muted = maps.Clone(st.muted)
+	}
+	if tables&tablePreferences != 0 {
+		preferences := make(map[string]domain.UserPreferences, len(st.preferences))
+		for k, v := range st.preferences {
+			preferences[k] = copyUserPreferences(v)
+		}
+		st.preferences = preferences
+	}
+	if tables&tableEvents != 0 {
+		// события только добавляются и не меняются, поэтому хватает копии самой таблицы
+		st.events = maps.Clone(st.events)
+	}
+}
+
+type txKey struct{}
+
+type tx struct {
+	store *Store
+
+	mu sync.Mutex
+	st *state
+	// copied — таблицы st, уже скопированные этой транзакцией
+	copied table
+}
+
+func (s *Store) txFromContext(ctx context.Context) (*tx, bool) {
+	t, ok := ctx.Value(txKey{}).(*tx)
+	if !ok || t.store != s {
+		return nil, false
+	}
+	return t, true
+}
+
+// read выполняет fn над состоянием транзакции из ctx либо над зафиксированным состоянием.
+func (s *Store) read(ctx context.Context, fn func(st *state) error) error {
+	if t, ok := s.txFromContext(ctx); ok {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		return fn(t.st)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fn(s.st)
+}
+
+// write выполняет fn над состоянием транзакции из ctx либо как одиночную запись.
+// fn должна сначала проверить все условия и только потом менять состояние, и менять
+// только таблицы из tables.
+func (s *Store) write(ctx context.Context, tables table, fn func(st *state) error) error {
+	if t, ok := s.txFromContext(ctx); ok {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		if missing := tables &^ t.copied; missing != 0 {
+			t.st.copyTables(missing)
+			t.copied |= missing
+		}
+		return fn(t.st)
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.st)
+}
+
+type Transactor struct {
+	store *Store
+}
+
+func NewTransactor(store *Store) *Transactor {
+	return &Transactor{store: store}
+}
+
+func (t *Transactor) WithTx(ctx context.Context, function func(ctx context.Context) error) error {
+	// вложенный вызов выполняется в уже открытой транзакции, фиксирует её внешний WithTx
+	if _, ok := t.store.txFromContext(ctx); ok {
+		return function(ctx)
+	}
+
+	s := t.store
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	// зафиксированное состояние меняет только держатель writeMu, поэтому неизменённые
+	// таблицы можно разделять с ним до конца транзакции
+	s.mu.RLock()
+	st := *s.st
+	s.mu.RUnlock()
+	current := &tx{store: s, st: &st}
+
+	if err := function(context.WithValue(ctx, txKey{}, current)); err != nil {
+		return fmt.Errorf("function execution error: %w", err)
+	}
+
+	current.mu.Lock()
+	defer current.mu.Unlock()
+
+	s.mu.Lock()
+	s.st = current.st
+	s.mu.Unlock()
+
+	return nil
+}
+
+func copyPR(pr domain.PullRequest) domain.PullRequest {
+	if pr.AssignedReviewers != nil {
+		pr.AssignedReviewers = append([]string(nil), pr.AssignedReviewers...)
+	}
+	if pr.MergedAt != nil {
+		mergedAt := *pr.MergedAt
+		pr.MergedAt = &mergedAt
+	}
+	return pr
+}
+
+func copyAvailability(a domain.Availability) domain.Availability {
+	if a.AppliedAt != nil {
+		appliedAt := *a.AppliedAt
+		a.AppliedAt = &appliedAt
+	}
+	return a
+}
+
+func copyTeamSettings(s domain.TeamSettings) domain.TeamSettings {
+	if len(s.ReviewerTeams) == 0 {
+		s.ReviewerTeams = nil
+	} else {
+		s.ReviewerTeams = append([]string(nil), s.ReviewerTeams...)
+	}
+	return s
+}
+
+func copyCodeOwners(rules []domain.CodeOwnerRule) []domain.CodeOwnerRule {
+	res := make([]domain.CodeOwnerRule, len(rules))
+	for i, r := range rules {
+		res[i] = domain.CodeOwnerRule{
+			Pattern: r.Pattern,
+			Owners:  append([]string{}, r.Owners...),
+		}
+	}
+	return res
+}
+
+func copyForgeSyncJob(j domain.ForgeSyncJob) domain.ForgeSyncJob {
+	j.Add = append([]string(nil), j.Add...)
+	j.Remove = append([]string(nil), j.Remove...)
+	return j
+}
+
+func copyTeamNotifications(n domain.TeamNotifications) domain.TeamNotifications {
+	if len(n.Templates) == 0 {
+		n.Templates = nil
+		return n
+	}
+	templates := make(map[domain.NotificationEvent]string, len(n.Templates))
+	for k, v := range n.Templates {
+		templates[k] = v
+	}
+	n.Templates = templates
+	return n
+}
+
+func copyUserPreferences(p domain.UserPreferences) domain.UserPreferences {
+	if p.LastDigestAt != nil {
+		at := *p.LastDigestAt
+		p.LastDigestAt = &at
+	}
+	return p
+}
+
+func copyEvent(e domain.Event) domain.Event {
+	e.Reviewers = append([]string(nil), e.Reviewers...)
+	e.DeactivatedUserIDs = append([]string(nil), e.DeactivatedUserIDs...)
+	return e
+}
@@ -0,0 +1,81 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/memory"
+)
+
+func TestStore_ConcurrentTransactions(t *testing.T) {
+	ctx := context.Background()
+
+	store := memory.NewStore()
+	teams := memory.NewTeamRepository(store)
+	users := memory.NewUserRepository(store)
+	tr := memory.NewTransactor(store)
+
+	require.NoError(t, teams.CreateTeam(ctx, "backend"))
+
+	const workers = 20
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			id := fmt.Sprintf("u%d", i)
+			_ = tr.WithTx(ctx, func(txCtx context.Context) error {
+				if err := users.UpsertUsers(txCtx, "backend", []domain.TeamMember{
+					{UserID: id, Username: id, IsActive: true},
+				}); err != nil {
+					return err
+				}
+				// нечётные транзакции откатываются
+				if i%2 == 1 {
+					return errors.New("rollback")
+				}
+				return nil
+			})
+
+			_, _ = teams.GetTeam(ctx, "backend")
+		}(i)
+	}
+	wg.Wait()
+
+	members, err := users.GetTeamMembers(ctx, "backend", false)
+	require.NoError(t, err)
+	require.Len(t, members, workers/2)
+}
+
+func TestStore_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+
+	store := memory.NewStore()
+	teams := memory.NewTeamRepository(store)
+	users := memory.NewUserRepository(store)
+	prs := memory.NewPRRepository(store)
+
+	require.NoError(t, teams.CreateTeam(ctx, "backend"))
+	require.NoError(t, users.UpsertUsers(ctx, "backend", []domain.TeamMember{
+		{UserID: "u1", Username: "Alice", IsActive: true},
+		{UserID: "u2", Username: "Bob", IsActive: true},
+	}))
+	require.NoError(t, prs.CreatePR(ctx, domain.PullRequest{PullRequestID: "pr-1", AuthorID: "u1", Status: domain.PRStatusOpen}))
+	require.NoError(t, prs.SetPRReviewers(ctx, "pr-1", []string{"u2"}))
+
+	pr, err := prs.GetPR(ctx, "pr-1")
+	require.NoError(t, err)
+	pr.AssignedReviewers[0] = "u1"
+
+	reviewers, err := prs.GetPRReviewers(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, reviewers)
+}
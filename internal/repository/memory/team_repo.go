@@ -0,0 +1,231 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type TeamRepository struct {
+	store *Store
+}
+
+func NewTeamRepository(store *Store) *TeamRepository {
+	return &TeamRepository{store: store}
+}
+
+func (r *TeamRepository) CreateTeam(ctx context.Context, teamName string) error {
+	return r.store.write(ctx, tableTeams, func(st *state) error {
+		if _, ok := st.teams[teamName]; ok {
+			return domain.NewDomainError(domain.ErrorCodeTeamExists, "team already exists")
+		}
+		st.teams[teamName] = struct{}{}
+		return nil
+	})
+}
+
+func (r *TeamRepository) GetTeam(ctx context.Context, teamName string) (domain.Team, error) {
+	var res domain.Team
+
+	err := r.store.read(ctx, func(st *state) error {
+		if _, ok := st.teams[teamName]; !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")
+		}
+
+		var members []domain.TeamMember
+		for _, u := range teamUsers(st, teamName, false) {
+			members = append(members, domain.TeamMember{
+				UserID:    u.UserID,
+				Username:  u.Username,
+				IsActive:  u.IsActive,
+				Seniority: u.Seniority,
+			})
+		}
+
+		res = domain.Team{
+			TeamName: teamName,
+			Members:  members,
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+// ListTeamNames возвращает имена всех команд по алфавиту.
+func (r *TeamRepository) ListTeamNames(ctx context.Context) ([]string, error) {
+	names := make([]string, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for name := range st.teams {
+			names = append(names, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+func (r *TeamRepository) GetTeamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	var res domain.TeamSettings
+
+	err := r.store.read(ctx, func(st *state) error {
+		if _, ok := st.teams[teamName]; !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")
+		}
+
+		res = copyTeamSettings(st.teamSettings[teamName])
+		res.TeamName = teamName
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *TeamRepository) UpsertTeamSettings(ctx context.Context, settings domain.TeamSettings) error {
+	return r.store.write(ctx, tableTeamSettings, func(st *state) error {
+		if _, ok := st.teams[settings.TeamName]; !ok {
+			return fmt.Errorf("%w: team %q does not exist", ErrForeignKey, settings.TeamName)
+		}
+		st.teamSettings[settings.TeamName] = copyTeamSettings(settings)
+		return nil
+	})
+}
+
+// teamUsers возвращает участников команды, отсортированных по username, как в Postgres-реализации.
+func teamUsers(st *state, teamName string, onlyActive bool) []domain.User {
+	users := make([]domain.User, 0)
+	if teamName == "" {
+		return users
+	}
+	for _, u := range st.users {
+		if u.TeamName != teamName {
+			continue
+		}
+		if onlyActive && !u.IsActive {
+			continue
+		}
+		users = append(users, u)
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Username != users[j].Username {
+			return users[i].Username < users[j].Username
+		}
+		return users[i].UserID < users[j].UserID
+	})
+
+	return users
+}
+
+func (r *TeamRepository) CreateReviewRule(ctx context.Context, rule domain.ReviewRule) (domain.ReviewRule, error) {
+	err := r.store.write(ctx, tableRules, func(st *state) error {
+		if _, ok := st.teams[rule.TeamName]; !ok {
+			return fmt.Errorf("%w: team %q does not exist", ErrForeignKey, rule.TeamName)
+		}
+		for _, id := range []string{rule.UserID, rule.OtherUserID} {
+			if _, ok := st.users[id]; !ok {
+				return fmt.Errorf("%w: user %q does not exist", ErrForeignKey, id)
+			}
+		}
+
+		st.ruleSeq++
+		rule.ID = st.ruleSeq
+		st.rules[rule.ID] = rule
+		return nil
+	})
+	if err != nil {
+		return domain.ReviewRule{}, err
+	}
+
+	return rule, nil
+}
+
+func (r *TeamRepository) DeleteReviewRule(ctx context.Context, id int64) error {
+	return r.store.write(ctx, tableRules, func(st *state) error {
+		if _, ok := st.rules[id]; !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "rule not found")
+		}
+		delete(st.rules, id)
+		return nil
+	})
+}
+
+// ListReviewRules возвращает правила команды в порядке создания.
+func (r *TeamRepository) ListReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error) {
+	rules := make([]domain.ReviewRule, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, rule := range st.rules {
+			if rule.TeamName == teamName {
+				rules = append(rules, rule)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules, nil
+}
+
+// GetCodeOwners возвращает правила владения путями в порядке следования в CODEOWNERS.
+func (r *TeamRepository) GetCodeOwners(ctx context.Context, teamName string) ([]domain.CodeOwnerRule, error) {
+	var res []domain.CodeOwnerRule
+
+	err := r.store.read(ctx, func(st *state) error {
+		res = copyCodeOwners(st.codeOwners[teamName])
+		return nil
+	})
+
+	return res, err
+}
+
+// ReplaceCodeOwners целиком заменяет правила владения путями команды.
+func (r *TeamRepository) ReplaceCodeOwners(ctx context.Context, teamName string, rules []domain.CodeOwnerRule) error {
+	return r.store.write(ctx, tableCodeOwners, func(st *state) error {
+		if _, ok := st.teams[teamName]; !ok {
+			return fmt.Errorf("%w: team %q does not exist", ErrForeignKey, teamName)
+		}
+		if len(rules) == 0 {
+			delete(st.codeOwners, teamName)
+			return nil
+		}
+		st.codeOwners[teamName] = copyCodeOwners(rules)
+		return nil
+	})
+}
+
+func (r *TeamRepository) GetTeamNotifications(ctx context.Context, teamName string) (domain.TeamNotifications, error) {
+	var res domain.TeamNotifications
+
+	err := r.store.read(ctx, func(st *state) error {
+		if _, ok := st.teams[teamName]; !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")
+		}
+
+		res = copyTeamNotifications(st.notifications[teamName])
+		res.TeamName = teamName
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *TeamRepository) UpsertTeamNotifications(ctx context.Context, n domain.TeamNotifications) error {
+	return r.store.write(ctx, tableNotifications, func(st *state) error {
+		if _, ok := st.teams[n.TeamName]; !ok {
+			return fmt.Errorf("%w: team %q does not exist", ErrForeignKey, n.TeamName)
+		}
+		st.notifications[n.TeamName] = copyTeamNotifications(n)
+		return nil
+	})
+}
@@ -0,0 +1,381 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type UserRepository struct {
+	store *Store
+}
+
+func NewUserRepository(store *Store) *UserRepository {
+	return &UserRepository{store: store}
+}
+
+// UpsertUsers — создаёт пользователей или обновляет username / is_active.
+// Пустой Seniority не затирает уже сохранённый уровень.
+func (r *UserRepository) UpsertUsers(ctx context.Context, teamName string, members []domain.TeamMember) error {
+	return r.store.write(ctx, tableUsers, func(st *state) error {
+		if len(members) == 0 {
+			return nil
+		}
+		if _, ok := st.teams[teamName]; teamName != "" && !ok {
+			return fmt.Errorf("%w: team %q does not exist", ErrForeignKey, teamName)
+		}
+
+		for _, m := range members {
+			seniority := m.Seniority
+			if seniority == "" {
+				seniority = st.users[m.UserID].Seniority
+			}
+			st.users[m.UserID] = domain.User{
+				UserID:    m.UserID,
+				Username:  m.Username,
+				TeamName:  teamName,
+				IsActive:  m.IsActive,
+				Seniority: seniority,
+			}
+		}
+		return nil
+	})
+}
+
+// GetUserByID возвращает пользователя по его id.
+func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (domain.User, error) {
+	var res domain.User
+
+	err := r.store.read(ctx, func(st *state) error {
+		u, ok := st.users[userID]
+		if !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		res = u
+		return nil
+	})
+
+	return res, err
+}
+
+// SetUserIsActive переключает active-флаг и возвращает обновлённого пользователя.
+func (r *UserRepository) SetUserIsActive(ctx context.Context, userID string, active bool) (domain.User, error) {
+	var res domain.User
+
+	err := r.store.write(ctx, tableUsers, func(st *state) error {
+		u, ok := st.users[userID]
+		if !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		u.IsActive = active
+		st.users[userID] = u
+		res = u
+		return nil
+	})
+
+	return res, err
+}
+
+// SetUserSeniority меняет уровень пользователя и возвращает обновлённого пользователя.
+func (r *UserRepository) SetUserSeniority(ctx context.Context, userID string, seniority domain.Seniority) (domain.User, error) {
+	var res domain.User
+
+	err := r.store.write(ctx, tableUsers, func(st *state) error {
+		u, ok := st.users[userID]
+		if !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		u.Seniority = seniority
+		st.users[userID] = u
+		res = u
+		return nil
+	})
+
+	return res, err
+}
+
+// GetTeamMembers возвращает участников команды. Если onlyActive = true, то только активных.
+func (r *UserRepository) GetTeamMembers(ctx context.Context, teamName string, onlyActive bool) ([]domain.User, error) {
+	var res []domain.User
+
+	err := r.store.read(ctx, func(st *state) error {
+		res = teamUsers(st, teamName, onlyActive)
+		return nil
+	})
+
+	return res, err
+}
+
+// DetachUsers выводит пользователей из команды: команда обнуляется, пользователь деактивируется.
+func (r *UserRepository) DetachUsers(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	return r.store.write(ctx, tableUsers, func(st *state) error {
+		for _, id := range userIDs {
+			u, ok := st.users[id]
+			if !ok {
+				continue
+			}
+			u.TeamName = ""
+			u.IsActive = false
+			st.users[id] = u
+		}
+		return nil
+	})
+}
+
+// GetAvailableTeamMembers возвращает активных участников команды, у которых нет периода недоступности на момент at.
+func (r *UserRepository) GetAvailableTeamMembers(ctx context.Context, teamName string, at time.Time) ([]domain.User, error) {
+	var res []domain.User
+
+	err := r.store.read(ctx, func(st *state) error {
+		unavailable := make(map[string]struct{})
+		for _, a := range st.avail {
+			if a.Covers(at) {
+				unavailable[a.UserID] = struct{}{}
+			}
+		}
+
+		res = make([]domain.User, 0)
+		for _, u := range teamUsers(st, teamName, true) {
+			if _, skip := unavailable[u.UserID]; skip {
+				continue
+			}
+			res = append(res, u)
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+// ListUsers возвращает до limit пользователей с id больше afterID по возрастанию id.
+func (r *UserRepository) ListUsers(ctx context.Context, afterID string, limit int) ([]domain.User, error) {
+	res := make([]domain.User, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, u := range st.users {
+			if u.UserID > afterID {
+				res = append(res, u)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].UserID < res[j].UserID
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+
+	return res, nil
+}
+
+// GetUserTags возвращает теги пользователей по алфавиту; пользователи без тегов в результат не попадают.
+func (r *UserRepository) GetUserTags(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	res := make(map[string][]string)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, id := range userIDs {
+			if tags := st.tags[id]; len(tags) > 0 {
+				res[id] = append([]string(nil), tags...)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ReplaceUserTags целиком заменяет теги пользователя.
+func (r *UserRepository) ReplaceUserTags(ctx context.Context, userID string, tags []string) error {
+	return r.store.write(ctx, tableTags, func(st *state) error {
+		if _, ok := st.users[userID]; !ok {
+			return fmt.Errorf("%w: user %q does not exist", ErrForeignKey, userID)
+		}
+
+		set := make(map[string]struct{}, len(tags))
+		res := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			if _, dup := set[tag]; dup {
+				continue
+			}
+			set[tag] = struct{}{}
+			res = append(res, tag)
+		}
+		sort.Strings(res)
+
+		if len(res) == 0 {
+			delete(st.tags, userID)
+			return nil
+		}
+		st.tags[userID] = res
+		return nil
+	})
+}
+
+// ListForgeAccounts возвращает сопоставления логинов хостинга forge по возрастанию логина.
+func (r *UserRepository) ListForgeAccounts(ctx context.Context, forge domain.Forge) ([]domain.ForgeAccount, error) {
+	res := make([]domain.ForgeAccount, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for key, userID := range st.forgeAccounts {
+			if key.forge == forge {
+				res = append(res, domain.ForgeAccount{Forge: key.forge, Login: key.login, UserID: userID})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Login < res[j].Login })
+	return res, nil
+}
+
+// UpsertForgeAccount создаёт сопоставление логина или перепривязывает его к другому пользователю.
+func (r *UserRepository) UpsertForgeAccount(ctx context.Context, account domain.ForgeAccount) error {
+	return r.store.write(ctx, tableForgeAccounts, func(st *state) error {
+		if _, ok := st.users[account.UserID]; !ok {
+			return fmt.Errorf("%w: user %q does not exist", ErrForeignKey, account.UserID)
+		}
+		st.forgeAccounts[forgeLogin{forge: account.Forge, login: account.Login}] = account.UserID
+		return nil
+	})
+}
+
+func (r *UserRepository) DeleteForgeAccount(ctx context.Context, forge domain.Forge, login string) error {
+	return r.store.write(ctx, tableForgeAccounts, func(st *state) error {
+		key := forgeLogin{forge: forge, login: login}
+		if _, ok := st.forgeAccounts[key]; !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "forge account not found")
+		}
+		delete(st.forgeAccounts, key)
+		return nil
+	})
+}
+
+// GetForgeAccountUserID возвращает id пользователя, сопоставленного логину.
+func (r *UserRepository) GetForgeAccountUserID(ctx context.Context, forge domain.Forge, login string) (string, error) {
+	var userID string
+
+	err := r.store.read(ctx, func(st *state) error {
+		id, ok := st.forgeAccounts[forgeLogin{forge: forge, login: login}]
+		if !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "forge account not found")
+		}
+		userID = id
+		return nil
+	})
+
+	return userID, err
+}
+
+// GetMutedUsers возвращает тех из userIDs, кто отказался от уведомлений в чат, по возрастанию id.
+func (r *UserRepository) GetMutedUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	res := make([]string, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for _, id := range userIDs {
+			if _, ok := st.muted[id]; ok {
+				res = append(res, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(res)
+	return slices.Compact(res), nil
+}
+
+func (r *UserRepository) SetNotificationsMuted(ctx context.Context, userID string, muted bool) error {
+	return r.store.write(ctx, tableMuted, func(st *state) error {
+		if !muted {
+			delete(st.muted, userID)
+			return nil
+		}
+		if _, ok := st.users[userID]; !ok {
+			return fmt.Errorf("%w: user %q does not exist", ErrForeignKey, userID)
+		}
+		st.muted[userID] = struct{}{}
+		return nil
+	})
+}
+
+func (r *UserRepository) GetUserPreferences(ctx context.Context, userID string) (domain.UserPreferences, error) {
+	var res domain.UserPreferences
+
+	err := r.store.read(ctx, func(st *state) error {
+		if _, ok := st.users[userID]; !ok {
+			return domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		p, ok := st.preferences[userID]
+		if !ok {
+			p = domain.DefaultUserPreferences(userID)
+		}
+		res = copyUserPreferences(p)
+		return nil
+	})
+
+	return res, err
+}
+
+func (r *UserRepository) UpsertUserPreferences(ctx context.Context, p domain.UserPreferences) error {
+	return r.store.write(ctx, tablePreferences, func(st *state) error {
+		if _, ok := st.users[p.UserID]; !ok {
+			return fmt.Errorf("%w: user %q does not exist", ErrForeignKey, p.UserID)
+		}
+		p.LastDigestAt = st.preferences[p.UserID].LastDigestAt
+		st.preferences[p.UserID] = p
+		return nil
+	})
+}
+
+func (r *UserRepository) ListDigestSubscriptions(ctx context.Context) ([]domain.UserPreferences, error) {
+	res := make([]domain.UserPreferences, 0)
+
+	err := r.store.read(ctx, func(st *state) error {
+		for id, p := range st.preferences {
+			if !st.users[id].IsActive || p.Digest == domain.DigestOff || p.Email == "" {
+				continue
+			}
+			res = append(res, copyUserPreferences(p))
+		}
+		return nil
+	})
+
+	sort.Slice(res, func(i, j int) bool { return res[i].UserID < res[j].UserID })
+	return res, err
+}
+
+func (r *UserRepository) SetLastDigestAt(ctx context.Context, userID string, at time.Time) error {
+	return r.store.write(ctx, tablePreferences, func(st *state) error {
+		if _, ok := st.users[userID]; !ok {
+			return fmt.Errorf("%w: user %q does not exist", ErrForeignKey, userID)
+		}
+		p, ok := st.preferences[userID]
+		if !ok {
+			p = domain.DefaultUserPreferences(userID)
+		}
+		p.LastDigestAt = &at
+		st.preferences[userID] = p
+		return nil
+	})
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type AvailabilityRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAvailabilityRepository(pool *pgxpool.Pool) *AvailabilityRepository {
+	return &AvailabilityRepository{pool: pool}
+}
+
+func (r *AvailabilityRepository) CreateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	const q = `
+		INSERT INTO user_availability (user_id, starts_at, ends_at, reason)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	if err := conn(ctx, r.pool).QueryRow(ctx, q, a.UserID, a.StartsAt, a.EndsAt, a.Reason).Scan(&a.ID); err != nil {
+		return domain.Availability{}, err
+	}
+
+	a.AppliedAt = nil
+	return a, nil
+}
+
+// UpdateAvailability меняет границы и причину периода.
+// Если новый период ещё не начался, отметка о применении сбрасывается.
+func (r *AvailabilityRepository) UpdateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	const q = `
+		UPDATE user_availability
+		SET starts_at = $2,
+		    ends_at = $3,
+		    reason = $4,
+		    applied_at = CASE WHEN $2 > now() THEN NULL ELSE applied_at END
+		WHERE id = $1
+		RETURNING id, user_id, starts_at, ends_at, reason, applied_at
+	`
+
+	var res domain.Availability
+	err := conn(ctx, r.pool).QueryRow(ctx, q, a.ID, a.StartsAt, a.EndsAt, a.Reason).Scan(
+		&res.ID, &res.UserID, &res.StartsAt, &res.EndsAt, &res.Reason, &res.AppliedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Availability{}, domain.NewDomainError(domain.ErrorCodeNotFound, "availability period not found")
+		}
+		return domain.Availability{}, err
+	}
+
+	return res, nil
+}
+
+func (r *AvailabilityRepository) DeleteAvailability(ctx context.Context, id int64) error {
+	const q = `DELETE FROM user_availability WHERE id = $1`
+
+	tag, err := conn(ctx, r.pool).Exec(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.NewDomainError(domain.ErrorCodeNotFound, "availability period not found")
+	}
+
+	return nil
+}
+
+func (r *AvailabilityRepository) ListAvailability(ctx context.Context, userID string) ([]domain.Availability, error) {
+	const q = `
+		SELECT id, user_id, starts_at, ends_at, reason, applied_at
+		FROM user_availability
+		WHERE user_id = $1
+		ORDER BY starts_at
+	`
+
+	return r.query(ctx, q, userID)
+}
+
+// GetStartedAvailability возвращает уже начавшиеся, но ещё не применённые периоды недоступности.
+func (r *AvailabilityRepository) GetStartedAvailability(ctx context.Context, at time.Time) ([]domain.Availability, error) {
+	const q = `
+		SELECT id, user_id, starts_at, ends_at, reason, applied_at
+		FROM user_availability
+		WHERE applied_at IS NULL
+		  AND starts_at <= $1
+		  AND ends_at > $1
+		ORDER BY starts_at
+	`
+
+	return r.query(ctx, q, at)
+}
+
+func (r *AvailabilityRepository) MarkAvailabilityApplied(ctx context.Context, id int64, at time.Time) error {
+	const q = `UPDATE user_availability SET applied_at = $2 WHERE id = $1`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q, id, at)
+	return err
+}
+
+func (r *AvailabilityRepository) query(ctx context.Context, q string, args ...any) ([]domain.Availability, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.Availability, 0)
+
+	for rows.Next() {
+		var a domain.Availability
+		if err := rows.Scan(&a.ID, &a.UserID, &a.StartsAt, &a.EndsAt, &a.Reason, &a.AppliedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+
+	return res, rows.Err()
+}
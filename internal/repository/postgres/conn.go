@@ -0,0 +1,14 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	dbpkg "github.com/alnoi/pr-reviewer-service/db"
+)
+
+// conn возвращает транзакцию из контекста, если запрос выполняется внутри Transactor.WithTx, иначе пул.
+func conn(ctx context.Context, pool *pgxpool.Pool) dbpkg.Querier {
+	return dbpkg.QuerierFromContext(ctx, pool)
+}
@@ -0,0 +1,86 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.uber.org/zap"
+
+	dbpkg "github.com/alnoi/pr-reviewer-service/db"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/postgres"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/repotest"
+)
+
+var dbPool *pgxpool.Pool
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:16-alpine",
+			Env:          map[string]string{"POSTGRES_DB": "prreviewer", "POSTGRES_USER": "test", "POSTGRES_PASSWORD": "test"},
+			ExposedPorts: []string{"5432/tcp"},
+			WaitingFor:   wait.ForListeningPort("5432/tcp"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start postgres container: %v\n", err)
+		os.Exit(1)
+	}
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to get container host: %v\n", err)
+		os.Exit(1)
+	}
+
+	port, err := container.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to get container port: %v\n", err)
+		os.Exit(1)
+	}
+
+	dsn := fmt.Sprintf("postgres://test:test@%s:%s/prreviewer?sslmode=disable", host, port.Port())
+
+	dbPool, err = pgxpool.New(ctx, dsn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create pgx pool: %v\n", err)
+		os.Exit(1)
+	}
+
+	dbpkg.SetupPostgres(dbPool, zap.NewNop())
+
+	code := m.Run()
+
+	dbPool.Close()
+	_ = container.Terminate(ctx)
+
+	os.Exit(code)
+}
+
+func TestPostgresRepositoriesContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repos {
+		_, err := dbPool.Exec(context.Background(),
+			`TRUNCATE TABLE events, user_preferences, notification_opt_outs, team_notifications, forge_sync_jobs, forge_accounts, user_tags, team_code_owners, team_rules, user_availability, pr_reviewers, pull_requests, users, teams RESTART IDENTITY CASCADE`)
+		require.NoError(t, err)
+
+		return repotest.Repos{
+			Teams:        postgres.NewTeamRepository(dbPool),
+			Users:        postgres.NewUserRepository(dbPool),
+			PRs:          postgres.NewPRRepository(dbPool),
+			Availability: postgres.NewAvailabilityRepository(dbPool),
+			Events:       postgres.NewEventRepository(dbPool),
+			Transactor:   dbpkg.NewTransactor(dbPool),
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type EventRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewEventRepository(pool *pgxpool.Pool) *EventRepository {
+	return &EventRepository{pool: pool}
+}
+
+// AppendEvent сохраняет событие.
+// Значения BIGSERIAL выдаются до фиксации, и без блокировки событие с меньшим id могло бы стать видимым позже
+// соседнего с большим, а читатель ленты — пропустить его. Транзакционная advisory-блокировка упорядочивает
+// записи, поэтому id становятся видимыми по возрастанию.
+func (r *EventRepository) AppendEvent(ctx context.Context, ev domain.Event) (domain.Event, error) {
+	const q = `
+		INSERT INTO events (
+			type, team_name, pr_id, pr_name, author_id, reviewers,
+			old_reviewer_id, new_reviewer_id, deactivated_user_ids, user_ids
+		)
+		SELECT $1, $2, $3, $4, $5, COALESCE($6::text[], '{}'),
+		       $7, $8, COALESCE($9::text[], '{}'), COALESCE($10::text[], '{}')
+		FROM (SELECT pg_advisory_xact_lock(hashtext('events'))) AS l
+		RETURNING id, created_at
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, q,
+		string(ev.Type), ev.TeamName, ev.PullRequestID, ev.PullRequestName, ev.AuthorID, ev.Reviewers,
+		ev.OldReviewerID, ev.NewReviewerID, ev.DeactivatedUserIDs, ev.UserIDs(),
+	).Scan(&ev.ID, &ev.CreatedAt)
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	return ev, nil
+}
+
+func (r *EventRepository) ListEvents(ctx context.Context, filter domain.EventFilter, limit int) ([]domain.Event, error) {
+	var (
+		sb   strings.Builder
+		args []any
+	)
+
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		fmt.Fprintf(&sb, " AND "+cond, len(args))
+	}
+
+	if filter.AfterID != nil {
+		add("id > $%d", *filter.AfterID)
+	}
+	if filter.TeamName != "" {
+		add("team_name = $%d", filter.TeamName)
+	}
+	if filter.UserID != "" {
+		add("$%d = ANY(user_ids)", filter.UserID)
+	}
+
+	args = append(args, limit)
+	q := fmt.Sprintf(`
+		SELECT id, type, team_name, pr_id, pr_name, author_id, reviewers,
+		       old_reviewer_id, new_reviewer_id, deactivated_user_ids, created_at
+		FROM events
+		WHERE TRUE%s
+		ORDER BY id
+		LIMIT $%d
+	`, sb.String(), len(args))
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.Event, 0)
+	for rows.Next() {
+		var (
+			e       domain.Event
+			evtType string
+		)
+		err := rows.Scan(&e.ID, &evtType, &e.TeamName, &e.PullRequestID, &e.PullRequestName, &e.AuthorID, &e.Reviewers,
+			&e.OldReviewerID, &e.NewReviewerID, &e.DeactivatedUserIDs, &e.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		e.Type = domain.EventType(evtType)
+		if len(e.Reviewers) == 0 {
+			e.Reviewers = nil
+		}
+		if len(e.DeactivatedUserIDs) == 0 {
+			e.DeactivatedUserIDs = nil
+		}
+		res = append(res, e)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *EventRepository) LastEventID(ctx context.Context) (int64, error) {
+	var id int64
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT COALESCE(MAX(id), 0) FROM events`).Scan(&id)
+	return id, err
+}
@@ -3,6 +3,8 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -29,7 +31,7 @@ func (r *PRRepository) CreatePR(ctx context.Context, pr domain.PullRequest) erro
 		)
 		VALUES ($1, $2, $3, $4)
 	`
-	_, err := r.pool.Exec(ctx, query,
+	_, err := conn(ctx, r.pool).Exec(ctx, query,
 		pr.PullRequestID,
 		pr.PullRequestName,
 		pr.AuthorID,
@@ -38,11 +40,42 @@ func (r *PRRepository) CreatePR(ctx context.Context, pr domain.PullRequest) erro
 	return err
 }
 
+// CreatePRs создаёт открытые PR вместе с ревьюверами из AssignedReviewers.
+// Время создания и назначения берётся из CreatedAt.
+func (r *PRRepository) CreatePRs(ctx context.Context, prs []domain.PullRequest) error {
+	const prQ = `
+		INSERT INTO pull_requests (id, pull_request_name, author_id, status, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	const reviewersQ = `
+		INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at)
+		SELECT $1, id, $3
+		FROM unnest($2::text[]) AS id
+	`
+
+	batch := &pgx.Batch{}
+	for _, pr := range prs {
+		batch.Queue(prQ, pr.PullRequestID, pr.PullRequestName, pr.AuthorID, string(pr.Status), pr.CreatedAt)
+		batch.Queue(reviewersQ, pr.PullRequestID, pr.AssignedReviewers, pr.CreatedAt)
+	}
+
+	br := conn(ctx, r.pool).SendBatch(ctx, batch)
+	defer br.Close()
+
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := br.Exec(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *PRRepository) PRExists(ctx context.Context, prID string) (bool, error) {
 	const q = `SELECT 1 FROM pull_requests WHERE id = $1`
 
 	var x int
-	err := r.pool.QueryRow(ctx, q, prID).Scan(&x)
+	err := conn(ctx, r.pool).QueryRow(ctx, q, prID).Scan(&x)
 
 	if err == nil {
 		return true, nil
@@ -70,7 +103,7 @@ func (r *PRRepository) GetPR(ctx context.Context, prID string) (domain.PullReque
 		mergedAt  *time.Time
 	)
 
-	err := r.pool.QueryRow(ctx, q, prID).Scan(
+	err := conn(ctx, r.pool).QueryRow(ctx, q, prID).Scan(
 		&id, &name, &authorID, &status, &createdAt, &mergedAt,
 	)
 
@@ -108,7 +141,7 @@ func (r *PRRepository) UpdatePR(ctx context.Context, pr domain.PullRequest) erro
 		    merged_at = $5
 		WHERE id = $1
 	`
-	_, err := r.pool.Exec(ctx, q,
+	_, err := conn(ctx, r.pool).Exec(ctx, q,
 		pr.PullRequestID,
 		pr.PullRequestName,
 		pr.AuthorID,
@@ -118,6 +151,86 @@ func (r *PRRepository) UpdatePR(ctx context.Context, pr domain.PullRequest) erro
 	return err
 }
 
+// ImportPR создаёт PR или целиком перезаписывает существующий, сохраняя время создания и мержа из pr.
+// Ревьюверы считаются назначенными в момент создания PR.
+func (r *PRRepository) ImportPR(ctx context.Context, pr domain.PullRequest) error {
+	const upsertQ = `
+		INSERT INTO pull_requests (id, pull_request_name, author_id, status, created_at, merged_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE
+		SET pull_request_name = EXCLUDED.pull_request_name,
+		    author_id = EXCLUDED.author_id,
+		    status = EXCLUDED.status,
+		    created_at = EXCLUDED.created_at,
+		    merged_at = EXCLUDED.merged_at,
+		    stale_at = NULL
+	`
+	if _, err := conn(ctx, r.pool).Exec(ctx, upsertQ,
+		pr.PullRequestID,
+		pr.PullRequestName,
+		pr.AuthorID,
+		string(pr.Status),
+		pr.CreatedAt,
+		pr.MergedAt,
+	); err != nil {
+		return err
+	}
+
+	const deleteQ = `DELETE FROM pr_reviewers WHERE pr_id = $1`
+	if _, err := conn(ctx, r.pool).Exec(ctx, deleteQ, pr.PullRequestID); err != nil {
+		return err
+	}
+
+	const insertQ = `
+		INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at)
+		SELECT $1, id, $3
+		FROM unnest($2::text[]) AS id
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, insertQ, pr.PullRequestID, pr.AssignedReviewers, pr.CreatedAt)
+	return err
+}
+
+// ListPRs возвращает до limit PR с id больше afterID по возрастанию id.
+func (r *PRRepository) ListPRs(ctx context.Context, afterID string, limit int) ([]domain.PullRequest, error) {
+	const q = `
+		SELECT id, pull_request_name, author_id, status, created_at, merged_at
+		FROM pull_requests
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prs := make([]domain.PullRequest, 0)
+
+	for rows.Next() {
+		var (
+			pr     domain.PullRequest
+			status string
+		)
+
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &status, &pr.CreatedAt, &pr.MergedAt); err != nil {
+			return nil, err
+		}
+		pr.Status = domain.PRStatus(status)
+
+		prs = append(prs, pr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return r.withReviewers(ctx, prs)
+}
+
 func (r *PRRepository) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
 	const q = `
 		SELECT reviewer_id
@@ -126,7 +239,7 @@ func (r *PRRepository) GetPRReviewers(ctx context.Context, prID string) ([]strin
 		ORDER BY reviewer_id
 	`
 
-	rows, err := r.pool.Query(ctx, q, prID)
+	rows, err := conn(ctx, r.pool).Query(ctx, q, prID)
 	if err != nil {
 		return nil, err
 	}
@@ -144,18 +257,25 @@ func (r *PRRepository) GetPRReviewers(ctx context.Context, prID string) ([]strin
 	return reviewers, rows.Err()
 }
 
+// SetPRReviewers заменяет состав ревьюверов. Оставшимся ревьюверам сохраняется время назначения.
 func (r *PRRepository) SetPRReviewers(ctx context.Context, prID string, reviewers []string) error {
-	const deleteQ = `DELETE FROM pr_reviewers WHERE pr_id = $1`
-	if _, err := r.pool.Exec(ctx, deleteQ, prID); err != nil {
+	const deleteQ = `
+		DELETE FROM pr_reviewers
+		WHERE pr_id = $1
+		  AND NOT (reviewer_id = ANY(COALESCE($2::text[], '{}')))
+	`
+	if _, err := conn(ctx, r.pool).Exec(ctx, deleteQ, prID, reviewers); err != nil {
 		return err
 	}
 
 	const insertQ = `
         INSERT INTO pr_reviewers (pr_id, reviewer_id)
-        SELECT $1, unnest($2::text[])
+        SELECT $1, id
+        FROM unnest($2::text[]) AS id
+        WHERE id NOT IN (SELECT reviewer_id FROM pr_reviewers WHERE pr_id = $1)
     `
 
-	_, err := r.pool.Exec(ctx, insertQ, prID, reviewers)
+	_, err := conn(ctx, r.pool).Exec(ctx, insertQ, prID, reviewers)
 	return err
 }
 
@@ -168,7 +288,7 @@ func (r *PRRepository) GetPRsWhereReviewer(ctx context.Context, userID string) (
 		ORDER BY pr.created_at
 	`
 
-	rows, err := r.pool.Query(ctx, q, userID)
+	rows, err := conn(ctx, r.pool).Query(ctx, q, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +336,7 @@ func (r *PRRepository) GetOpenPRsByReviewers(ctx context.Context, userIDs []stri
 		  AND pr.status = 'OPEN'
 	`
 
-	rows, err := r.pool.Query(ctx, q, userIDs)
+	rows, err := conn(ctx, r.pool).Query(ctx, q, userIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -238,37 +358,118 @@ func (r *PRRepository) GetOpenPRsByReviewers(ctx context.Context, userIDs []stri
 			return nil, err
 		}
 
-		reviewers, err := r.GetPRReviewers(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-
 		pr := domain.PullRequest{
-			PullRequestID:     id,
-			PullRequestName:   name,
-			AuthorID:          author,
-			Status:            domain.PRStatus(status),
-			AssignedReviewers: reviewers,
-			CreatedAt:         createdAt,
-			MergedAt:          mergedAt,
+			PullRequestID:   id,
+			PullRequestName: name,
+			AuthorID:        author,
+			Status:          domain.PRStatus(status),
+			CreatedAt:       createdAt,
+			MergedAt:        mergedAt,
 		}
 
 		prs = append(prs, pr)
 	}
 
-	return prs, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return r.withReviewers(ctx, prs)
 }
 
-func (r *PRRepository) GetAssignmentsCountByUser(ctx context.Context) ([]domain.UserAssignmentsStat, error) {
+// GetOpenPRsByTeam возвращает открытые PR, авторы которых состоят в команде teamName.
+func (r *PRRepository) GetOpenPRsByTeam(ctx context.Context, teamName string) ([]domain.PullRequest, error) {
 	const q = `
-		SELECT u.id, COALESCE(COUNT(r.pr_id), 0) AS assignments_count
+		SELECT pr.id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+		FROM pull_requests pr
+		JOIN users u ON u.id = pr.author_id
+		WHERE u.team_name = $1
+		  AND pr.status = 'OPEN'
+		ORDER BY pr.created_at
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []domain.PullRequest
+
+	for rows.Next() {
+		var (
+			id        string
+			name      string
+			author    string
+			status    string
+			createdAt time.Time
+			mergedAt  *time.Time
+		)
+
+		if err := rows.Scan(&id, &name, &author, &status, &createdAt, &mergedAt); err != nil {
+			return nil, err
+		}
+
+		prs = append(prs, domain.PullRequest{
+			PullRequestID:   id,
+			PullRequestName: name,
+			AuthorID:        author,
+			Status:          domain.PRStatus(status),
+			CreatedAt:       createdAt,
+			MergedAt:        mergedAt,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return r.withReviewers(ctx, prs)
+}
+
+// withReviewers догружает ревьюверов. Вызывается после закрытия rows:
+// внутри транзакции соединение одно, и вложенный запрос по открытому курсору завершится ошибкой.
+func (r *PRRepository) withReviewers(ctx context.Context, prs []domain.PullRequest) ([]domain.PullRequest, error) {
+	for i := range prs {
+		reviewers, err := r.GetPRReviewers(ctx, prs[i].PullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		prs[i].AssignedReviewers = reviewers
+	}
+
+	return prs, nil
+}
+
+// GetAssignmentsCountByUser считает назначения по PR, попавшим под фильтр.
+// При заданной команде возвращаются только её участники.
+func (r *PRRepository) GetAssignmentsCountByUser(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentsStat, error) {
+	conds, args := statsConditions(filter)
+
+	q := `
+		WITH prs AS (
+			SELECT pr.id
+			FROM pull_requests pr
+			JOIN users a ON a.id = pr.author_id
+			WHERE TRUE` + conds + `
+		)
+		SELECT u.id, COUNT(p.id) AS assignments_count
 		FROM users u
 		LEFT JOIN pr_reviewers r ON u.id = r.reviewer_id
+		LEFT JOIN prs p ON p.id = r.pr_id
+	`
+	if filter.TeamName != "" {
+		args = append(args, filter.TeamName)
+		q += fmt.Sprintf(` WHERE u.team_name = $%d`, len(args))
+	}
+	q += `
 		GROUP BY u.id
 		ORDER BY assignments_count DESC
 	`
 
-	rows, err := r.pool.Query(ctx, q)
+	rows, err := conn(ctx, r.pool).Query(ctx, q, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -293,19 +494,366 @@ func (r *PRRepository) GetAssignmentsCountByUser(ctx context.Context) ([]domain.
 	return stats, rows.Err()
 }
 
-func (r *PRRepository) GetPRStatusCounts(ctx context.Context) (domain.PRStatusCounts, error) {
-	const q = `
+func (r *PRRepository) GetPRStatusCounts(ctx context.Context, filter domain.StatsFilter) (domain.PRStatusCounts, error) {
+	conds, args := statsConditions(filter)
+
+	q := `
 		SELECT
-			COUNT(*) FILTER (WHERE status = 'OPEN')   AS open_count,
-			COUNT(*) FILTER (WHERE status = 'MERGED') AS merged_count,
-			COUNT(*)                                  AS total_count
-		FROM pull_requests
-	`
+			COUNT(*) FILTER (WHERE pr.status = 'OPEN')   AS open_count,
+			COUNT(*) FILTER (WHERE pr.status = 'MERGED') AS merged_count,
+			COUNT(*)                                     AS total_count
+		FROM pull_requests pr
+		JOIN users a ON a.id = pr.author_id
+		WHERE TRUE` + conds
 
 	var res domain.PRStatusCounts
-	if err := r.pool.QueryRow(ctx, q).Scan(&res.Open, &res.Merged, &res.Total); err != nil {
+	if err := conn(ctx, r.pool).QueryRow(ctx, q, args...).Scan(&res.Open, &res.Merged, &res.Total); err != nil {
 		return domain.PRStatusCounts{}, err
 	}
 
 	return res, nil
 }
+
+// GetPRStatusCountsByTeam группирует PR по текущей команде автора. PR авторов вне команд не учитываются.
+func (r *PRRepository) GetPRStatusCountsByTeam(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	conds, args := statsConditions(filter)
+
+	q := `
+		SELECT
+			a.team_name,
+			COUNT(*) FILTER (WHERE pr.status = 'OPEN')   AS open_count,
+			COUNT(*) FILTER (WHERE pr.status = 'MERGED') AS merged_count,
+			COUNT(*)                                     AS total_count
+		FROM pull_requests pr
+		JOIN users a ON a.id = pr.author_id
+		WHERE a.team_name IS NOT NULL` + conds + `
+		GROUP BY a.team_name
+		ORDER BY a.team_name
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.TeamStats, 0)
+
+	for rows.Next() {
+		var ts domain.TeamStats
+		if err := rows.Scan(&ts.TeamName, &ts.PRStatusCounts.Open, &ts.PRStatusCounts.Merged, &ts.PRStatusCounts.Total); err != nil {
+			return nil, err
+		}
+		res = append(res, ts)
+	}
+
+	return res, rows.Err()
+}
+
+// GetPRCountsByPeriod возвращает непустые периоды (в UTC) с числом созданных и смерженных PR.
+func (r *PRRepository) GetPRCountsByPeriod(ctx context.Context, filter domain.StatsFilter, granularity domain.StatsGranularity) ([]domain.StatsBucket, error) {
+	conds, args := statsConditions(filter)
+
+	args = append(args, string(granularity))
+	g := fmt.Sprintf("$%d", len(args))
+
+	q := `
+		SELECT bucket, SUM(created)::int, SUM(merged)::int
+		FROM (
+			SELECT date_trunc(` + g + `, pr.created_at AT TIME ZONE 'UTC') AS bucket, 1 AS created, 0 AS merged
+			FROM pull_requests pr
+			JOIN users a ON a.id = pr.author_id
+			WHERE TRUE` + conds + `
+			UNION ALL
+			SELECT date_trunc(` + g + `, pr.merged_at AT TIME ZONE 'UTC') AS bucket, 0 AS created, 1 AS merged
+			FROM pull_requests pr
+			JOIN users a ON a.id = pr.author_id
+			WHERE pr.merged_at IS NOT NULL` + conds + `
+		) t
+		GROUP BY bucket
+		ORDER BY bucket
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.StatsBucket, 0)
+
+	for rows.Next() {
+		var b domain.StatsBucket
+		if err := rows.Scan(&b.Start, &b.Created, &b.Merged); err != nil {
+			return nil, err
+		}
+		b.Start = b.Start.UTC()
+		res = append(res, b)
+	}
+
+	return res, rows.Err()
+}
+
+// GetPRTimings возвращает сроки жизни PR под фильтром, упорядоченные по ID. Ревьюверы отсортированы.
+func (r *PRRepository) GetPRTimings(ctx context.Context, filter domain.StatsFilter) ([]domain.PRTiming, error) {
+	conds, args := statsConditions(filter)
+
+	q := `
+		SELECT
+			pr.id,
+			COALESCE(a.team_name, ''),
+			pr.created_at,
+			pr.merged_at,
+			COALESCE(array_agg(r.reviewer_id ORDER BY r.reviewer_id) FILTER (WHERE r.reviewer_id IS NOT NULL), '{}')
+		FROM pull_requests pr
+		JOIN users a ON a.id = pr.author_id
+		LEFT JOIN pr_reviewers r ON r.pr_id = pr.id
+		WHERE TRUE` + conds + `
+		GROUP BY pr.id, a.team_name
+		ORDER BY pr.id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.PRTiming, 0)
+
+	for rows.Next() {
+		var t domain.PRTiming
+		if err := rows.Scan(&t.PullRequestID, &t.TeamName, &t.CreatedAt, &t.MergedAt, &t.Reviewers); err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+	}
+
+	return res, rows.Err()
+}
+
+// GetReviewerLoad считает открытые назначения и время последнего назначения. Пользователи вне команд не учитываются.
+func (r *PRRepository) GetReviewerLoad(ctx context.Context, teamName string) ([]domain.ReviewerLoad, error) {
+	const q = `
+		SELECT
+			u.id,
+			u.team_name,
+			u.is_active,
+			COUNT(*) FILTER (WHERE pr.status = 'OPEN') AS open_count,
+			MAX(r.assigned_at)                         AS last_assigned_at
+		FROM users u
+		LEFT JOIN pr_reviewers r ON r.reviewer_id = u.id
+		LEFT JOIN pull_requests pr ON pr.id = r.pr_id
+		WHERE u.team_name IS NOT NULL
+		  AND ($1 = '' OR u.team_name = $1)
+		GROUP BY u.id, u.team_name, u.is_active
+		ORDER BY u.team_name, u.id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.ReviewerLoad, 0)
+
+	for rows.Next() {
+		var l domain.ReviewerLoad
+		if err := rows.Scan(&l.UserID, &l.TeamName, &l.IsActive, &l.OpenAssignments, &l.LastAssignedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, l)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *PRRepository) GetStalePRs(ctx context.Context, teamName string, defaultAfter time.Duration, now time.Time) ([]domain.StalePR, error) {
+	const q = `
+		WITH activity AS (
+			SELECT
+				pr.id,
+				pr.pull_request_name,
+				pr.author_id,
+				pr.status,
+				pr.created_at,
+				pr.merged_at,
+				pr.stale_at,
+				a.team_name,
+				GREATEST(pr.created_at, MAX(r.assigned_at))         AS last_activity_at,
+				COALESCE(NULLIF(s.stale_after_seconds, 0), $2)      AS stale_after_seconds,
+				COALESCE(s.stale_auto_rotate, FALSE)                AS auto_rotate
+			FROM pull_requests pr
+			JOIN users a ON a.id = pr.author_id
+			LEFT JOIN team_settings s ON s.team_name = a.team_name
+			LEFT JOIN pr_reviewers r ON r.pr_id = pr.id
+			WHERE pr.status = 'OPEN'
+			  AND a.team_name IS NOT NULL
+			  AND ($1 = '' OR a.team_name = $1)
+			GROUP BY pr.id, a.team_name, s.stale_after_seconds, s.stale_auto_rotate
+		)
+		SELECT
+			id, pull_request_name, author_id, status, created_at, merged_at,
+			team_name, last_activity_at, stale_after_seconds, auto_rotate, stale_at,
+			COALESCE((
+				SELECT r.reviewer_id
+				FROM pr_reviewers r
+				WHERE r.pr_id = activity.id
+				ORDER BY r.assigned_at, r.reviewer_id
+				LIMIT 1
+			), '')
+		FROM activity
+		WHERE last_activity_at + stale_after_seconds * INTERVAL '1 second' <= $3
+		ORDER BY last_activity_at, id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, teamName, int64(defaultAfter/time.Second), now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		res []domain.StalePR
+		prs []domain.PullRequest
+	)
+
+	for rows.Next() {
+		var (
+			p          domain.StalePR
+			status     string
+			staleAfter int64
+		)
+
+		if err := rows.Scan(
+			&p.PullRequest.PullRequestID, &p.PullRequest.PullRequestName, &p.PullRequest.AuthorID, &status,
+			&p.PullRequest.CreatedAt, &p.PullRequest.MergedAt,
+			&p.TeamName, &p.LastActivityAt, &staleAfter, &p.AutoRotate, &p.MarkedAt, &p.OldestReviewerID,
+		); err != nil {
+			return nil, err
+		}
+
+		p.PullRequest.Status = domain.PRStatus(status)
+		p.StaleAfter = time.Duration(staleAfter) * time.Second
+
+		res = append(res, p)
+		prs = append(prs, p.PullRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	prs, err = r.withReviewers(ctx, prs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range res {
+		res[i].PullRequest = prs[i]
+	}
+
+	return res, nil
+}
+
+func (r *PRRepository) MarkPRStale(ctx context.Context, prID string, at time.Time) error {
+	const q = `UPDATE pull_requests SET stale_at = $2 WHERE id = $1`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q, prID, at)
+	return err
+}
+
+// statsConditions строит условия " AND ..." по фильтру для запроса с алиасами pr (PR) и a (автор).
+func statsConditions(filter domain.StatsFilter) (string, []any) {
+	var (
+		sb   strings.Builder
+		args []any
+	)
+
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		fmt.Fprintf(&sb, " AND "+cond, len(args))
+	}
+
+	if filter.TeamName != "" {
+		add("a.team_name = $%d", filter.TeamName)
+	}
+	if filter.CreatedFrom != nil {
+		add("pr.created_at >= $%d", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		add("pr.created_at < $%d", *filter.CreatedTo)
+	}
+	if filter.MergedFrom != nil {
+		add("pr.merged_at >= $%d", *filter.MergedFrom)
+	}
+	if filter.MergedTo != nil {
+		add("pr.merged_at < $%d", *filter.MergedTo)
+	}
+
+	return sb.String(), args
+}
+
+// EnqueueForgeSync ставит в очередь отправку ревьюверов на git-хостинг.
+func (r *PRRepository) EnqueueForgeSync(ctx context.Context, job domain.ForgeSyncJob) error {
+	const q = `
+		INSERT INTO forge_sync_jobs (pr_id, add_reviewers, remove_reviewers, next_attempt_at)
+		VALUES ($1, COALESCE($2::text[], '{}'), COALESCE($3::text[], '{}'), $4)
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q, job.PullRequestID, job.Add, job.Remove, job.NextAttemptAt)
+	return err
+}
+
+// ListDueForgeSyncJobs возвращает до limit заданий, время попытки которых наступило к now.
+func (r *PRRepository) ListDueForgeSyncJobs(ctx context.Context, now time.Time, limit int) ([]domain.ForgeSyncJob, error) {
+	const q = `
+		SELECT id, pr_id, add_reviewers, remove_reviewers, attempts, next_attempt_at, last_error, created_at
+		FROM forge_sync_jobs
+		WHERE next_attempt_at <= $1
+		ORDER BY next_attempt_at, id
+		LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.ForgeSyncJob, 0)
+	for rows.Next() {
+		var j domain.ForgeSyncJob
+		err := rows.Scan(&j.ID, &j.PullRequestID, &j.Add, &j.Remove, &j.Attempts, &j.NextAttemptAt, &j.LastError, &j.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if len(j.Add) == 0 {
+			j.Add = nil
+		}
+		if len(j.Remove) == 0 {
+			j.Remove = nil
+		}
+		res = append(res, j)
+	}
+
+	return res, rows.Err()
+}
+
+// UpdateForgeSyncJob сохраняет счётчик попыток, время следующей попытки и последнюю ошибку.
+func (r *PRRepository) UpdateForgeSyncJob(ctx context.Context, job domain.ForgeSyncJob) error {
+	const q = `
+		UPDATE forge_sync_jobs
+		SET attempts = $2, next_attempt_at = $3, last_error = $4
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q, job.ID, job.Attempts, job.NextAttemptAt, job.LastError)
+	return err
+}
+
+func (r *PRRepository) DeleteForgeSyncJob(ctx context.Context, id int64) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM forge_sync_jobs WHERE id = $1`, id)
+	return err
+}
@@ -2,9 +2,12 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	"github.com/jackc/pgx/v5/pgconn"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
@@ -25,7 +28,7 @@ func (r *TeamRepository) CreateTeam(ctx context.Context, teamName string) error
 		INSERT INTO teams (team_name)
 		VALUES ($1)
 	`
-	_, err := r.pool.Exec(ctx, query, teamName)
+	_, err := conn(ctx, r.pool).Exec(ctx, query, teamName)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -39,7 +42,7 @@ func (r *TeamRepository) CreateTeam(ctx context.Context, teamName string) error
 
 func (r *TeamRepository) GetTeam(ctx context.Context, teamName string) (domain.Team, error) {
 	const q = `
-		SELECT u.id, u.username, u.is_active
+		SELECT u.id, u.username, u.is_active, u.seniority
 		FROM teams t
 		LEFT JOIN users u ON u.team_name = t.team_name
 		WHERE t.team_name = $1
@@ -48,7 +51,7 @@ func (r *TeamRepository) GetTeam(ctx context.Context, teamName string) (domain.T
 
 	var res domain.Team
 
-	rows, err := r.pool.Query(ctx, q, teamName)
+	rows, err := conn(ctx, r.pool).Query(ctx, q, teamName)
 	if err != nil {
 		return res, err
 	}
@@ -63,12 +66,13 @@ func (r *TeamRepository) GetTeam(ctx context.Context, teamName string) (domain.T
 		foundTeam = true
 
 		var (
-			userID   *string
-			username *string
-			isActive *bool
+			userID    *string
+			username  *string
+			isActive  *bool
+			seniority *string
 		)
 
-		if err := rows.Scan(&userID, &username, &isActive); err != nil {
+		if err := rows.Scan(&userID, &username, &isActive, &seniority); err != nil {
 			return res, err
 		}
 
@@ -77,9 +81,10 @@ func (r *TeamRepository) GetTeam(ctx context.Context, teamName string) (domain.T
 		}
 
 		members = append(members, domain.TeamMember{
-			UserID:   *userID,
-			Username: *username,
-			IsActive: *isActive,
+			UserID:    *userID,
+			Username:  *username,
+			IsActive:  *isActive,
+			Seniority: domain.Seniority(*seniority),
 		})
 	}
 
@@ -96,3 +101,254 @@ func (r *TeamRepository) GetTeam(ctx context.Context, teamName string) (domain.T
 		Members:  members,
 	}, nil
 }
+
+// ListTeamNames возвращает имена всех команд по алфавиту.
+func (r *TeamRepository) ListTeamNames(ctx context.Context) ([]string, error) {
+	const q = `SELECT team_name FROM teams ORDER BY team_name`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := make([]string, 0)
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+
+	return names, rows.Err()
+}
+
+func (r *TeamRepository) GetTeamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	const q = `
+		SELECT COALESCE(s.stale_after_seconds, 0), COALESCE(s.stale_auto_rotate, FALSE), COALESCE(s.reviewer_teams, '{}'),
+		       COALESCE(s.min_senior_reviewers, 0), COALESCE(s.forge_sync, FALSE)
+		FROM teams t
+		LEFT JOIN team_settings s ON s.team_name = t.team_name
+		WHERE t.team_name = $1
+	`
+
+	var (
+		staleAfter    int64
+		autoRotate    bool
+		reviewerTeams []string
+		minSeniors    int
+		forgeSync     bool
+	)
+
+	err := conn(ctx, r.pool).QueryRow(ctx, q, teamName).Scan(&staleAfter, &autoRotate, &reviewerTeams, &minSeniors, &forgeSync)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TeamSettings{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")
+		}
+		return domain.TeamSettings{}, err
+	}
+	if len(reviewerTeams) == 0 {
+		reviewerTeams = nil
+	}
+
+	return domain.TeamSettings{
+		TeamName:           teamName,
+		StaleAfter:         time.Duration(staleAfter) * time.Second,
+		StaleAutoRotate:    autoRotate,
+		ReviewerTeams:      reviewerTeams,
+		MinSeniorReviewers: minSeniors,
+		ForgeSync:          forgeSync,
+	}, nil
+}
+
+func (r *TeamRepository) UpsertTeamSettings(ctx context.Context, settings domain.TeamSettings) error {
+	const q = `
+		INSERT INTO team_settings (team_name, stale_after_seconds, stale_auto_rotate, reviewer_teams, min_senior_reviewers, forge_sync)
+		VALUES ($1, $2, $3, COALESCE($4::text[], '{}'), $5, $6)
+		ON CONFLICT (team_name) DO UPDATE
+		SET stale_after_seconds = EXCLUDED.stale_after_seconds,
+		    stale_auto_rotate = EXCLUDED.stale_auto_rotate,
+		    reviewer_teams = EXCLUDED.reviewer_teams,
+		    min_senior_reviewers = EXCLUDED.min_senior_reviewers,
+		    forge_sync = EXCLUDED.forge_sync,
+		    updated_at = now()
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q,
+		settings.TeamName,
+		int64(settings.StaleAfter/time.Second),
+		settings.StaleAutoRotate,
+		settings.ReviewerTeams,
+		settings.MinSeniorReviewers,
+		settings.ForgeSync,
+	)
+	return err
+}
+
+func (r *TeamRepository) CreateReviewRule(ctx context.Context, rule domain.ReviewRule) (domain.ReviewRule, error) {
+	const q = `
+		INSERT INTO team_rules (team_name, rule_type, user_id, other_user_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	err := conn(ctx, r.pool).QueryRow(ctx, q, rule.TeamName, string(rule.Type), rule.UserID, rule.OtherUserID).Scan(&rule.ID)
+	if err != nil {
+		return domain.ReviewRule{}, err
+	}
+
+	return rule, nil
+}
+
+func (r *TeamRepository) DeleteReviewRule(ctx context.Context, id int64) error {
+	const q = `DELETE FROM team_rules WHERE id = $1`
+
+	tag, err := conn(ctx, r.pool).Exec(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.NewDomainError(domain.ErrorCodeNotFound, "rule not found")
+	}
+
+	return nil
+}
+
+// ListReviewRules возвращает правила команды в порядке создания.
+func (r *TeamRepository) ListReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error) {
+	const q = `
+		SELECT id, team_name, rule_type, user_id, other_user_id
+		FROM team_rules
+		WHERE team_name = $1
+		ORDER BY id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]domain.ReviewRule, 0)
+	for rows.Next() {
+		var (
+			rule     domain.ReviewRule
+			ruleType string
+		)
+		if err := rows.Scan(&rule.ID, &rule.TeamName, &ruleType, &rule.UserID, &rule.OtherUserID); err != nil {
+			return nil, err
+		}
+		rule.Type = domain.ReviewRuleType(ruleType)
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// GetCodeOwners возвращает правила владения путями в порядке следования в CODEOWNERS.
+func (r *TeamRepository) GetCodeOwners(ctx context.Context, teamName string) ([]domain.CodeOwnerRule, error) {
+	const q = `
+		SELECT pattern, owners
+		FROM team_code_owners
+		WHERE team_name = $1
+		ORDER BY position
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]domain.CodeOwnerRule, 0)
+	for rows.Next() {
+		var rule domain.CodeOwnerRule
+		if err := rows.Scan(&rule.Pattern, &rule.Owners); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// ReplaceCodeOwners целиком заменяет правила владения путями команды.
+func (r *TeamRepository) ReplaceCodeOwners(ctx context.Context, teamName string, rules []domain.CodeOwnerRule) error {
+	c := conn(ctx, r.pool)
+
+	if _, err := c.Exec(ctx, `DELETE FROM team_code_owners WHERE team_name = $1`, teamName); err != nil {
+		return err
+	}
+
+	const q = `
+		INSERT INTO team_code_owners (team_name, position, pattern, owners)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	for i, rule := range rules {
+		owners := rule.Owners
+		if owners == nil {
+			owners = []string{}
+		}
+		if _, err := c.Exec(ctx, q, teamName, i, rule.Pattern, owners); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) GetTeamNotifications(ctx context.Context, teamName string) (domain.TeamNotifications, error) {
+	const q = `
+		SELECT COALESCE(n.webhook_url, ''), COALESCE(n.channel, ''), COALESCE(n.templates, '{}')
+		FROM teams t
+		LEFT JOIN team_notifications n ON n.team_name = t.team_name
+		WHERE t.team_name = $1
+	`
+
+	res := domain.TeamNotifications{TeamName: teamName}
+	var templates []byte
+
+	err := conn(ctx, r.pool).QueryRow(ctx, q, teamName).Scan(&res.WebhookURL, &res.Channel, &templates)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TeamNotifications{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")
+		}
+		return domain.TeamNotifications{}, err
+	}
+
+	if err := json.Unmarshal(templates, &res.Templates); err != nil {
+		return domain.TeamNotifications{}, err
+	}
+	if len(res.Templates) == 0 {
+		res.Templates = nil
+	}
+
+	return res, nil
+}
+
+func (r *TeamRepository) UpsertTeamNotifications(ctx context.Context, n domain.TeamNotifications) error {
+	const q = `
+		INSERT INTO team_notifications (team_name, webhook_url, channel, templates)
+		VALUES ($1, $2, $3, $4::jsonb)
+		ON CONFLICT (team_name) DO UPDATE
+		SET webhook_url = EXCLUDED.webhook_url,
+		    channel = EXCLUDED.channel,
+		    templates = EXCLUDED.templates,
+		    updated_at = now()
+	`
+
+	templates := n.Templates
+	if templates == nil {
+		templates = map[domain.NotificationEvent]string{}
+	}
+	encoded, err := json.Marshal(templates)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.pool).Exec(ctx, q, n.TeamName, n.WebhookURL, n.Channel, string(encoded))
+	return err
+}
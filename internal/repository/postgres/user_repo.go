@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -20,24 +21,26 @@ func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 	}
 }
 
-// UpsertUsers — создаёт пользователей или обновляет username / is_active
+// UpsertUsers — создаёт пользователей или обновляет username / is_active.
+// Пустой Seniority не затирает уже сохранённый уровень.
 func (r *UserRepository) UpsertUsers(ctx context.Context, teamName string, members []domain.TeamMember) error {
 	const query = `
-		INSERT INTO users (id, team_name, username, is_active)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO users (id, team_name, username, is_active, seniority)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5)
 		ON CONFLICT (id) DO UPDATE
 		SET
 			team_name = EXCLUDED.team_name,
 			username = EXCLUDED.username,
-			is_active = EXCLUDED.is_active
+			is_active = EXCLUDED.is_active,
+			seniority = COALESCE(NULLIF(EXCLUDED.seniority, ''), users.seniority)
 	`
 
 	batch := &pgx.Batch{}
 	for _, m := range members {
-		batch.Queue(query, m.UserID, teamName, m.Username, m.IsActive)
+		batch.Queue(query, m.UserID, teamName, m.Username, m.IsActive, string(m.Seniority))
 	}
 
-	br := r.pool.SendBatch(ctx, batch)
+	br := conn(ctx, r.pool).SendBatch(ctx, batch)
 	defer br.Close()
 
 	for range members {
@@ -52,20 +55,21 @@ func (r *UserRepository) UpsertUsers(ctx context.Context, teamName string, membe
 // GetUserByID возвращает пользователя по его id.
 func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (domain.User, error) {
 	const q = `
-		SELECT id, username, is_active, team_name
+		SELECT id, username, is_active, COALESCE(team_name, ''), seniority
 		FROM users
 		WHERE id = $1
 	`
 
 	var (
-		id       string
-		username string
-		isActive bool
-		teamName string
+		id        string
+		username  string
+		isActive  bool
+		teamName  string
+		seniority string
 	)
 
-	err := r.pool.QueryRow(ctx, q, userID).Scan(
-		&id, &username, &isActive, &teamName,
+	err := conn(ctx, r.pool).QueryRow(ctx, q, userID).Scan(
+		&id, &username, &isActive, &teamName, &seniority,
 	)
 
 	if err != nil {
@@ -76,10 +80,11 @@ func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (domain
 	}
 
 	return domain.User{
-		UserID:   id,
-		Username: username,
-		IsActive: isActive,
-		TeamName: teamName,
+		UserID:    id,
+		Username:  username,
+		IsActive:  isActive,
+		TeamName:  teamName,
+		Seniority: domain.Seniority(seniority),
 	}, nil
 }
 
@@ -89,17 +94,18 @@ func (r *UserRepository) SetUserIsActive(ctx context.Context, userID string, act
 		UPDATE users
 		SET is_active = $2
 		WHERE id = $1
-		RETURNING id, username, is_active, team_name
+		RETURNING id, username, is_active, COALESCE(team_name, ''), seniority
 	`
 
 	var (
-		id       string
-		username string
-		isActive bool
-		teamName string
+		id        string
+		username  string
+		isActive  bool
+		teamName  string
+		seniority string
 	)
 
-	err := r.pool.QueryRow(ctx, q, userID, active).Scan(&id, &username, &isActive, &teamName)
+	err := conn(ctx, r.pool).QueryRow(ctx, q, userID, active).Scan(&id, &username, &isActive, &teamName, &seniority)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.User{}, domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
@@ -108,17 +114,42 @@ func (r *UserRepository) SetUserIsActive(ctx context.Context, userID string, act
 	}
 
 	return domain.User{
-		UserID:   id,
-		Username: username,
-		IsActive: isActive,
-		TeamName: teamName,
+		UserID:    id,
+		Username:  username,
+		IsActive:  isActive,
+		TeamName:  teamName,
+		Seniority: domain.Seniority(seniority),
 	}, nil
 }
 
+// SetUserSeniority меняет уровень пользователя и возвращает обновлённого пользователя.
+func (r *UserRepository) SetUserSeniority(ctx context.Context, userID string, seniority domain.Seniority) (domain.User, error) {
+	const q = `
+		UPDATE users
+		SET seniority = $2
+		WHERE id = $1
+		RETURNING id, username, is_active, COALESCE(team_name, ''), seniority
+	`
+
+	var u domain.User
+
+	err := conn(ctx, r.pool).QueryRow(ctx, q, userID, string(seniority)).Scan(
+		&u.UserID, &u.Username, &u.IsActive, &u.TeamName, &u.Seniority,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.User{}, domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		return domain.User{}, err
+	}
+
+	return u, nil
+}
+
 // GetTeamMembers возвращает участников команды. Если onlyActive = true, то только активных.
 func (r *UserRepository) GetTeamMembers(ctx context.Context, teamName string, onlyActive bool) ([]domain.User, error) {
 	query := `
-		SELECT id, username, is_active
+		SELECT id, username, is_active, seniority
 		FROM users
 		WHERE team_name = $1
 	`
@@ -127,7 +158,74 @@ func (r *UserRepository) GetTeamMembers(ctx context.Context, teamName string, on
 	}
 	query += ` ORDER BY username`
 
-	rows, err := r.pool.Query(ctx, query, teamName)
+	rows, err := conn(ctx, r.pool).Query(ctx, query, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+
+	for rows.Next() {
+		var (
+			id        string
+			username  string
+			isActive  bool
+			seniority string
+		)
+
+		if err := rows.Scan(&id, &username, &isActive, &seniority); err != nil {
+			return nil, err
+		}
+
+		users = append(users, domain.User{
+			UserID:    id,
+			Username:  username,
+			IsActive:  isActive,
+			TeamName:  teamName,
+			Seniority: domain.Seniority(seniority),
+		})
+	}
+
+	return users, rows.Err()
+}
+
+// DetachUsers выводит пользователей из команды: team_name обнуляется, пользователь деактивируется.
+// История PR (авторство и ревью) при этом сохраняется.
+func (r *UserRepository) DetachUsers(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	const q = `
+		UPDATE users
+		SET team_name = NULL,
+		    is_active = false
+		WHERE id = ANY($1)
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q, userIDs)
+	return err
+}
+
+// GetAvailableTeamMembers возвращает активных участников команды, у которых нет периода недоступности на момент at.
+func (r *UserRepository) GetAvailableTeamMembers(ctx context.Context, teamName string, at time.Time) ([]domain.User, error) {
+	const q = `
+		SELECT u.id, u.username, u.is_active, u.seniority
+		FROM users u
+		WHERE u.team_name = $1
+		  AND u.is_active = true
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM user_availability a
+			WHERE a.user_id = u.id
+			  AND a.starts_at <= $2
+			  AND a.ends_at > $2
+		  )
+		ORDER BY u.username
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, teamName, at)
 	if err != nil {
 		return nil, err
 	}
@@ -137,22 +235,303 @@ func (r *UserRepository) GetTeamMembers(ctx context.Context, teamName string, on
 
 	for rows.Next() {
 		var (
-			id       string
-			username string
-			isActive bool
+			id        string
+			username  string
+			isActive  bool
+			seniority string
 		)
 
-		if err := rows.Scan(&id, &username, &isActive); err != nil {
+		if err := rows.Scan(&id, &username, &isActive, &seniority); err != nil {
 			return nil, err
 		}
 
 		users = append(users, domain.User{
-			UserID:   id,
-			Username: username,
-			IsActive: isActive,
-			TeamName: teamName,
+			UserID:    id,
+			Username:  username,
+			IsActive:  isActive,
+			TeamName:  teamName,
+			Seniority: domain.Seniority(seniority),
 		})
 	}
 
 	return users, rows.Err()
 }
+
+// ListUsers возвращает до limit пользователей с id больше afterID по возрастанию id.
+func (r *UserRepository) ListUsers(ctx context.Context, afterID string, limit int) ([]domain.User, error) {
+	const q = `
+		SELECT id, username, is_active, COALESCE(team_name, ''), seniority
+		FROM users
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(&u.UserID, &u.Username, &u.IsActive, &u.TeamName, &u.Seniority); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	return users, rows.Err()
+}
+
+// GetUserTags возвращает теги пользователей по алфавиту; пользователи без тегов в результат не попадают.
+func (r *UserRepository) GetUserTags(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	res := make(map[string][]string)
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+
+	const q = `
+		SELECT user_id, tag
+		FROM user_tags
+		WHERE user_id = ANY($1)
+		ORDER BY user_id, tag
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID, tag string
+		if err := rows.Scan(&userID, &tag); err != nil {
+			return nil, err
+		}
+		res[userID] = append(res[userID], tag)
+	}
+
+	return res, rows.Err()
+}
+
+// ReplaceUserTags целиком заменяет теги пользователя.
+func (r *UserRepository) ReplaceUserTags(ctx context.Context, userID string, tags []string) error {
+	q := conn(ctx, r.pool)
+
+	if _, err := q.Exec(ctx, `DELETE FROM user_tags WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	const insert = `
+		INSERT INTO user_tags (user_id, tag)
+		SELECT $1, t
+		FROM unnest($2::text[]) AS t
+		ON CONFLICT DO NOTHING
+	`
+
+	_, err := q.Exec(ctx, insert, userID, tags)
+	return err
+}
+
+// ListForgeAccounts возвращает сопоставления логинов хостинга forge по возрастанию логина.
+func (r *UserRepository) ListForgeAccounts(ctx context.Context, forge domain.Forge) ([]domain.ForgeAccount, error) {
+	const q = `
+		SELECT forge, login, user_id
+		FROM forge_accounts
+		WHERE forge = $1
+		ORDER BY login
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q, string(forge))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.ForgeAccount, 0)
+	for rows.Next() {
+		var (
+			a     domain.ForgeAccount
+			forge string
+		)
+		if err := rows.Scan(&forge, &a.Login, &a.UserID); err != nil {
+			return nil, err
+		}
+		a.Forge = domain.Forge(forge)
+		res = append(res, a)
+	}
+
+	return res, rows.Err()
+}
+
+// UpsertForgeAccount создаёт сопоставление логина или перепривязывает его к другому пользователю.
+func (r *UserRepository) UpsertForgeAccount(ctx context.Context, account domain.ForgeAccount) error {
+	const q = `
+		INSERT INTO forge_accounts (forge, login, user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (forge, login) DO UPDATE
+		SET user_id = EXCLUDED.user_id
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q, string(account.Forge), account.Login, account.UserID)
+	return err
+}
+
+func (r *UserRepository) DeleteForgeAccount(ctx context.Context, forge domain.Forge, login string) error {
+	const q = `DELETE FROM forge_accounts WHERE forge = $1 AND login = $2`
+
+	tag, err := conn(ctx, r.pool).Exec(ctx, q, string(forge), login)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.NewDomainError(domain.ErrorCodeNotFound, "forge account not found")
+	}
+
+	return nil
+}
+
+// GetForgeAccountUserID возвращает id пользователя, сопоставленного логину.
+func (r *UserRepository) GetForgeAccountUserID(ctx context.Context, forge domain.Forge, login string) (string, error) {
+	const q = `SELECT user_id FROM forge_accounts WHERE forge = $1 AND login = $2`
+
+	var userID string
+	if err := conn(ctx, r.pool).QueryRow(ctx, q, string(forge), login).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.NewDomainError(domain.ErrorCodeNotFound, "forge account not found")
+		}
+		return "", err
+	}
+
+	return userID, nil
+}
+
+// GetMutedUsers возвращает тех из userIDs, кто отказался от уведомлений в чат, по возрастанию id.
+func (r *UserRepository) GetMutedUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	res := make([]string, 0)
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx,
+		`SELECT user_id FROM notification_opt_outs WHERE user_id = ANY($1) ORDER BY user_id`, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		res = append(res, id)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *UserRepository) SetNotificationsMuted(ctx context.Context, userID string, muted bool) error {
+	q := `DELETE FROM notification_opt_outs WHERE user_id = $1`
+	if muted {
+		q = `INSERT INTO notification_opt_outs (user_id) VALUES ($1) ON CONFLICT DO NOTHING`
+	}
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q, userID)
+	return err
+}
+
+func (r *UserRepository) GetUserPreferences(ctx context.Context, userID string) (domain.UserPreferences, error) {
+	const q = `
+		SELECT COALESCE(p.email, ''), COALESCE(p.digest, 'off'), COALESCE(p.digest_hour, 9),
+		       COALESCE(p.digest_weekday, 1), COALESCE(p.timezone, 'UTC'), p.last_digest_at
+		FROM users u
+		LEFT JOIN user_preferences p ON p.user_id = u.id
+		WHERE u.id = $1
+	`
+
+	var (
+		p       = domain.UserPreferences{UserID: userID}
+		digest  string
+		weekday int
+	)
+
+	err := conn(ctx, r.pool).QueryRow(ctx, q, userID).Scan(&p.Email, &digest, &p.DigestHour, &weekday, &p.Timezone, &p.LastDigestAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.UserPreferences{}, domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		return domain.UserPreferences{}, err
+	}
+	p.Digest = domain.DigestSchedule(digest)
+	p.DigestWeekday = time.Weekday(weekday)
+
+	return p, nil
+}
+
+func (r *UserRepository) UpsertUserPreferences(ctx context.Context, p domain.UserPreferences) error {
+	const q = `
+		INSERT INTO user_preferences (user_id, email, digest, digest_hour, digest_weekday, timezone)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id) DO UPDATE
+		SET email = EXCLUDED.email,
+		    digest = EXCLUDED.digest,
+		    digest_hour = EXCLUDED.digest_hour,
+		    digest_weekday = EXCLUDED.digest_weekday,
+		    timezone = EXCLUDED.timezone
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q,
+		p.UserID, p.Email, string(p.Digest), p.DigestHour, int(p.DigestWeekday), p.Timezone,
+	)
+	return err
+}
+
+func (r *UserRepository) ListDigestSubscriptions(ctx context.Context) ([]domain.UserPreferences, error) {
+	const q = `
+		SELECT p.user_id, p.email, p.digest, p.digest_hour, p.digest_weekday, p.timezone, p.last_digest_at
+		FROM user_preferences p
+		JOIN users u ON u.id = p.user_id
+		WHERE u.is_active AND p.digest <> 'off' AND p.email <> ''
+		ORDER BY p.user_id
+	`
+
+	rows, err := conn(ctx, r.pool).Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.UserPreferences, 0)
+	for rows.Next() {
+		var (
+			p       domain.UserPreferences
+			digest  string
+			weekday int
+		)
+		if err := rows.Scan(&p.UserID, &p.Email, &digest, &p.DigestHour, &weekday, &p.Timezone, &p.LastDigestAt); err != nil {
+			return nil, err
+		}
+		p.Digest = domain.DigestSchedule(digest)
+		p.DigestWeekday = time.Weekday(weekday)
+		res = append(res, p)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *UserRepository) SetLastDigestAt(ctx context.Context, userID string, at time.Time) error {
+	const q = `
+		INSERT INTO user_preferences (user_id, last_digest_at) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET last_digest_at = EXCLUDED.last_digest_at
+	`
+
+	_, err := conn(ctx, r.pool).Exec(ctx, q, userID, at)
+	return err
+}
@@ -0,0 +1,1662 @@
+// Package repotest содержит общий контрактный набор тестов для реализаций хранилища.
+// Каждая реализация (Postgres, in-memory) должна проходить его без изменений.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/repository"
+	"github.com/alnoi/pr-reviewer-service/internal/usecase"
+)
+
+// Repos — набор репозиториев одной реализации, разделяющих общее хранилище.
+type Repos struct {
+	Teams        repository.TeamRepository
+	Users        repository.UserRepository
+	PRs          repository.PRRepository
+	Availability repository.AvailabilityRepository
+	Events       repository.EventRepository
+	Transactor   usecase.Transactor
+}
+
+// Factory возвращает репозитории поверх пустого хранилища.
+type Factory func(t *testing.T) Repos
+
+// Run прогоняет контрактные тесты. newRepos вызывается для каждого подтеста.
+func Run(t *testing.T, newRepos Factory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r Repos)
+	}{
+		{"TeamCreateAndGet", testTeamCreateAndGet},
+		{"TeamDuplicate", testTeamDuplicate},
+		{"TeamNotFound", testTeamNotFound},
+		{"TeamSettings", testTeamSettings},
+		{"TeamNotifications", testTeamNotifications},
+		{"ListTeamNames", testListTeamNames},
+		{"ReviewRules", testReviewRules},
+		{"CodeOwners", testCodeOwners},
+		{"UsersUpsertAndGet", testUsersUpsertAndGet},
+		{"UserSeniority", testUserSeniority},
+		{"UsersWithoutTeam", testUsersWithoutTeam},
+		{"ListUsers", testListUsers},
+		{"UserTags", testUserTags},
+		{"ForgeAccounts", testForgeAccounts},
+		{"NotificationOptOuts", testNotificationOptOuts},
+		{"UserPreferences", testUserPreferences},
+		{"UserNotFound", testUserNotFound},
+		{"SetUserIsActive", testSetUserIsActive},
+		{"TeamMembers", testTeamMembers},
+		{"DetachUsers", testDetachUsers},
+		{"AvailableTeamMembers", testAvailableTeamMembers},
+		{"PRCreateAndGet", testPRCreateAndGet},
+		{"PRReviewers", testPRReviewers},
+		{"PRUpdate", testPRUpdate},
+		{"CreatePRs", testCreatePRs},
+		{"ImportPR", testImportPR},
+		{"ListPRs", testListPRs},
+		{"PRsWhereReviewer", testPRsWhereReviewer},
+		{"OpenPRsByReviewersAndTeam", testOpenPRsByReviewersAndTeam},
+		{"Stats", testStats},
+		{"StatsFiltered", testStatsFiltered},
+		{"StatsByPeriod", testStatsByPeriod},
+		{"PRTimings", testPRTimings},
+		{"ReviewerLoad", testReviewerLoad},
+		{"ReviewersKeepAssignedAt", testReviewersKeepAssignedAt},
+		{"StalePRs", testStalePRs},
+		{"ForgeSyncJobs", testForgeSyncJobs},
+		{"AvailabilityCRUD", testAvailabilityCRUD},
+		{"StartedAvailability", testStartedAvailability},
+		{"Events", testEvents},
+		{"TxCommit", testTxCommit},
+		{"TxRollback", testTxRollback},
+		{"TxNestedRollback", testTxNestedRollback},
+		{"DeactivationRollback", testDeactivationRollback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepos(t))
+		})
+	}
+}
+
+// ----------HELPERS----------
+
+func requireDomainCode(t *testing.T, err error, code domain.ErrorCode) {
+	t.Helper()
+
+	var derr *domain.DomainError
+	require.True(t, errors.As(err, &derr), "expected domain error %s, got %v", code, err)
+	require.Equal(t, code, derr.Code)
+}
+
+func seedTeam(t *testing.T, r Repos, teamName string, members ...domain.TeamMember) {
+	t.Helper()
+
+	ctx := context.Background()
+	require.NoError(t, r.Teams.CreateTeam(ctx, teamName))
+	require.NoError(t, r.Users.UpsertUsers(ctx, teamName, members))
+}
+
+func seedPR(t *testing.T, r Repos, prID, authorID string, reviewers ...string) {
+	t.Helper()
+
+	ctx := context.Background()
+	require.NoError(t, r.PRs.CreatePR(ctx, domain.PullRequest{
+		PullRequestID:   prID,
+		PullRequestName: "name " + prID,
+		AuthorID:        authorID,
+		Status:          domain.PRStatusOpen,
+	}))
+	require.NoError(t, r.PRs.SetPRReviewers(ctx, prID, reviewers))
+}
+
+func backendTeam(t *testing.T, r Repos) {
+	t.Helper()
+
+	seedTeam(t, r, "backend",
+		domain.TeamMember{UserID: "u1", Username: "Alice", IsActive: true},
+		domain.TeamMember{UserID: "u2", Username: "Bob", IsActive: true},
+		domain.TeamMember{UserID: "u3", Username: "Charlie", IsActive: false},
+	)
+}
+
+func userIDs(users []domain.User) []string {
+	res := make([]string, 0, len(users))
+	for _, u := range users {
+		res = append(res, u.UserID)
+	}
+	return res
+}
+
+func prIDs(prs []domain.PullRequest) []string {
+	res := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		res = append(res, pr.PullRequestID)
+	}
+	return res
+}
+
+// ----------TEAMS----------
+
+func testTeamCreateAndGet(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	team, err := r.Teams.GetTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, "backend", team.TeamName)
+	require.Equal(t, []domain.TeamMember{
+		{UserID: "u1", Username: "Alice", IsActive: true},
+		{UserID: "u2", Username: "Bob", IsActive: true},
+		{UserID: "u3", Username: "Charlie", IsActive: false},
+	}, team.Members)
+
+	require.NoError(t, r.Teams.CreateTeam(ctx, "empty"))
+
+	empty, err := r.Teams.GetTeam(ctx, "empty")
+	require.NoError(t, err)
+	require.Equal(t, "empty", empty.TeamName)
+	require.Empty(t, empty.Members)
+}
+
+func testTeamDuplicate(t *testing.T, r Repos) {
+	ctx := context.Background()
+
+	require.NoError(t, r.Teams.CreateTeam(ctx, "backend"))
+	requireDomainCode(t, r.Teams.CreateTeam(ctx, "backend"), domain.ErrorCodeTeamExists)
+}
+
+func testTeamNotFound(t *testing.T, r Repos) {
+	_, err := r.Teams.GetTeam(context.Background(), "missing")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+}
+
+func testTeamSettings(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	settings, err := r.Teams.GetTeamSettings(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, domain.TeamSettings{TeamName: "backend"}, settings)
+
+	require.NoError(t, r.Teams.CreateTeam(ctx, "platform"))
+
+	want := domain.TeamSettings{
+		TeamName:           "backend",
+		StaleAfter:         36 * time.Hour,
+		StaleAutoRotate:    true,
+		ReviewerTeams:      []string{"platform"},
+		MinSeniorReviewers: 1,
+		ForgeSync:          true,
+	}
+	require.NoError(t, r.Teams.UpsertTeamSettings(ctx, want))
+	require.NoError(t, r.Teams.UpsertTeamSettings(ctx, want))
+
+	settings, err = r.Teams.GetTeamSettings(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, want, settings)
+
+	want.ReviewerTeams = nil
+	require.NoError(t, r.Teams.UpsertTeamSettings(ctx, want))
+
+	settings, err = r.Teams.GetTeamSettings(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, want, settings)
+
+	_, err = r.Teams.GetTeamSettings(ctx, "missing")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+
+	require.Error(t, r.Teams.UpsertTeamSettings(ctx, domain.TeamSettings{TeamName: "missing"}))
+}
+
+func testTeamNotifications(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	n, err := r.Teams.GetTeamNotifications(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, domain.TeamNotifications{TeamName: "backend"}, n)
+
+	want := domain.TeamNotifications{
+		TeamName:   "backend",
+		WebhookURL: "https://hooks.slack.com/services/T0/B0/x",
+		Channel:    "#backend-reviews",
+		Templates: map[domain.NotificationEvent]string{
+			domain.NotificationAssigned: "review {{.PullRequestID}}",
+		},
+	}
+	require.NoError(t, r.Teams.UpsertTeamNotifications(ctx, want))
+	require.NoError(t, r.Teams.UpsertTeamNotifications(ctx, want))
+
+	n, err = r.Teams.GetTeamNotifications(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, want, n)
+
+	want.Channel = ""
+	want.Templates = nil
+	require.NoError(t, r.Teams.UpsertTeamNotifications(ctx, want))
+
+	n, err = r.Teams.GetTeamNotifications(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, want, n)
+
+	_, err = r.Teams.GetTeamNotifications(ctx, "missing")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+
+	require.Error(t, r.Teams.UpsertTeamNotifications(ctx, domain.TeamNotifications{TeamName: "missing"}))
+}
+
+func testListTeamNames(t *testing.T, r Repos) {
+	ctx := context.Background()
+
+	names, err := r.Teams.ListTeamNames(ctx)
+	require.NoError(t, err)
+	require.Empty(t, names)
+
+	require.NoError(t, r.Teams.CreateTeam(ctx, "frontend"))
+	require.NoError(t, r.Teams.CreateTeam(ctx, "backend"))
+
+	names, err = r.Teams.ListTeamNames(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "frontend"}, names)
+}
+
+func testReviewRules(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	list, err := r.Teams.ListReviewRules(ctx, "backend")
+	require.NoError(t, err)
+	require.NotNil(t, list)
+	require.Empty(t, list)
+
+	conflict, err := r.Teams.CreateReviewRule(ctx, domain.ReviewRule{
+		TeamName:    "backend",
+		Type:        domain.ReviewRuleConflict,
+		UserID:      "u1",
+		OtherUserID: "u2",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, conflict.ID)
+
+	pair, err := r.Teams.CreateReviewRule(ctx, domain.ReviewRule{
+		TeamName:    "backend",
+		Type:        domain.ReviewRulePair,
+		UserID:      "u3",
+		OtherUserID: "u1",
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, conflict.ID, pair.ID)
+
+	list, err = r.Teams.ListReviewRules(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, []domain.ReviewRule{conflict, pair}, list)
+
+	_, err = r.Teams.CreateReviewRule(ctx, domain.ReviewRule{
+		TeamName:    "backend",
+		Type:        domain.ReviewRuleConflict,
+		UserID:      "u1",
+		OtherUserID: "ghost",
+	})
+	require.Error(t, err)
+
+	require.NoError(t, r.Teams.DeleteReviewRule(ctx, conflict.ID))
+	requireDomainCode(t, r.Teams.DeleteReviewRule(ctx, conflict.ID), domain.ErrorCodeNotFound)
+
+	list, err = r.Teams.ListReviewRules(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, []domain.ReviewRule{pair}, list)
+
+	list, err = r.Teams.ListReviewRules(ctx, "missing")
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
+
+func testCodeOwners(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	rules, err := r.Teams.GetCodeOwners(ctx, "backend")
+	require.NoError(t, err)
+	require.Empty(t, rules)
+
+	want := []domain.CodeOwnerRule{
+		{Pattern: "*", Owners: []string{"u1"}},
+		{Pattern: "/internal/db/", Owners: []string{"u2", "u3"}},
+		{Pattern: "*.md", Owners: []string{}},
+	}
+	require.NoError(t, r.Teams.ReplaceCodeOwners(ctx, "backend", want))
+
+	rules, err = r.Teams.GetCodeOwners(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, want, rules)
+
+	require.NoError(t, r.Teams.ReplaceCodeOwners(ctx, "backend", want[1:2]))
+
+	rules, err = r.Teams.GetCodeOwners(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, want[1:2], rules)
+
+	require.NoError(t, r.Teams.ReplaceCodeOwners(ctx, "backend", nil))
+
+	rules, err = r.Teams.GetCodeOwners(ctx, "backend")
+	require.NoError(t, err)
+	require.Empty(t, rules)
+
+	require.Error(t, r.Teams.ReplaceCodeOwners(ctx, "missing", want))
+}
+
+// ----------USERS----------
+
+func testUsersUpsertAndGet(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	require.NoError(t, r.Teams.CreateTeam(ctx, "frontend"))
+
+	u, err := r.Users.GetUserByID(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, domain.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}, u)
+
+	require.NoError(t, r.Users.UpsertUsers(ctx, "frontend", []domain.TeamMember{
+		{UserID: "u1", Username: "Alice Smith", IsActive: false},
+	}))
+
+	u, err = r.Users.GetUserByID(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, domain.User{UserID: "u1", Username: "Alice Smith", TeamName: "frontend", IsActive: false}, u)
+}
+
+func testUserSeniority(t *testing.T, r Repos) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend",
+		domain.TeamMember{UserID: "u1", Username: "Alice", IsActive: true, Seniority: domain.SenioritySenior},
+		domain.TeamMember{UserID: "u2", Username: "Bob", IsActive: true},
+	)
+
+	u, err := r.Users.GetUserByID(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, domain.SenioritySenior, u.Seniority)
+
+	// пустой уровень при повторном добавлении не затирает сохранённый
+	require.NoError(t, r.Users.UpsertUsers(ctx, "backend", []domain.TeamMember{
+		{UserID: "u1", Username: "Alice", IsActive: true},
+	}))
+
+	u, err = r.Users.SetUserSeniority(ctx, "u2", domain.SeniorityJunior)
+	require.NoError(t, err)
+	require.Equal(t, domain.User{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true, Seniority: domain.SeniorityJunior}, u)
+
+	team, err := r.Teams.GetTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, []domain.TeamMember{
+		{UserID: "u1", Username: "Alice", IsActive: true, Seniority: domain.SenioritySenior},
+		{UserID: "u2", Username: "Bob", IsActive: true, Seniority: domain.SeniorityJunior},
+	}, team.Members)
+
+	members, err := r.Users.GetAvailableTeamMembers(ctx, "backend", time.Now())
+	require.NoError(t, err)
+	require.Equal(t, domain.SenioritySenior, members[0].Seniority)
+
+	members, err = r.Users.GetTeamMembers(ctx, "backend", true)
+	require.NoError(t, err)
+	require.Equal(t, domain.SeniorityJunior, members[1].Seniority)
+
+	_, err = r.Users.SetUserSeniority(ctx, "missing", domain.SenioritySenior)
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+}
+
+func testUsersWithoutTeam(t *testing.T, r Repos) {
+	ctx := context.Background()
+
+	require.NoError(t, r.Users.UpsertUsers(ctx, "", []domain.TeamMember{
+		{UserID: "x1", Username: "Former", IsActive: false},
+	}))
+
+	u, err := r.Users.GetUserByID(ctx, "x1")
+	require.NoError(t, err)
+	require.Equal(t, domain.User{UserID: "x1", Username: "Former"}, u)
+
+	require.Error(t, r.Users.UpsertUsers(ctx, "missing", []domain.TeamMember{
+		{UserID: "x2", Username: "Nobody", IsActive: true},
+	}))
+}
+
+func testListUsers(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	require.NoError(t, r.Users.DetachUsers(ctx, []string{"u3"}))
+
+	page, err := r.Users.ListUsers(ctx, "", 2)
+	require.NoError(t, err)
+	require.Equal(t, []domain.User{
+		{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+	}, page)
+
+	page, err = r.Users.ListUsers(ctx, "u2", 2)
+	require.NoError(t, err)
+	require.Equal(t, []domain.User{{UserID: "u3", Username: "Charlie"}}, page)
+
+	page, err = r.Users.ListUsers(ctx, "u3", 2)
+	require.NoError(t, err)
+	require.Empty(t, page)
+}
+
+func testUserTags(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	tags, err := r.Users.GetUserTags(ctx, []string{"u1", "u2"})
+	require.NoError(t, err)
+	require.Empty(t, tags)
+
+	require.NoError(t, r.Users.ReplaceUserTags(ctx, "u1", []string{"security", "backend", "db"}))
+	require.NoError(t, r.Users.ReplaceUserTags(ctx, "u2", []string{"db"}))
+
+	tags, err = r.Users.GetUserTags(ctx, []string{"u1", "u2", "u3", "missing"})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"u1": {"backend", "db", "security"},
+		"u2": {"db"},
+	}, tags)
+
+	require.NoError(t, r.Users.ReplaceUserTags(ctx, "u1", []string{"frontend"}))
+	require.NoError(t, r.Users.ReplaceUserTags(ctx, "u2", nil))
+
+	tags, err = r.Users.GetUserTags(ctx, []string{"u1", "u2"})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{"u1": {"frontend"}}, tags)
+
+	tags, err = r.Users.GetUserTags(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, tags)
+
+	require.Error(t, r.Users.ReplaceUserTags(ctx, "missing", []string{"db"}))
+}
+
+func testForgeAccounts(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	accounts, err := r.Users.ListForgeAccounts(ctx, domain.ForgeGitHub)
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+
+	require.NoError(t, r.Users.UpsertForgeAccount(ctx, domain.ForgeAccount{Forge: domain.ForgeGitHub, Login: "bob", UserID: "u2"}))
+	require.NoError(t, r.Users.UpsertForgeAccount(ctx, domain.ForgeAccount{Forge: domain.ForgeGitHub, Login: "alice", UserID: "u1"}))
+	require.NoError(t, r.Users.UpsertForgeAccount(ctx, domain.ForgeAccount{Forge: domain.ForgeGitLab, Login: "alice", UserID: "u3"}))
+
+	// повторная привязка логина переносит его на другого пользователя
+	require.NoError(t, r.Users.UpsertForgeAccount(ctx, domain.ForgeAccount{Forge: domain.ForgeGitHub, Login: "bob", UserID: "u3"}))
+
+	accounts, err = r.Users.ListForgeAccounts(ctx, domain.ForgeGitHub)
+	require.NoError(t, err)
+	require.Equal(t, []domain.ForgeAccount{
+		{Forge: domain.ForgeGitHub, Login: "alice", UserID: "u1"},
+		{Forge: domain.ForgeGitHub, Login: "bob", UserID: "u3"},
+	}, accounts)
+
+	userID, err := r.Users.GetForgeAccountUserID(ctx, domain.ForgeGitLab, "alice")
+	require.NoError(t, err)
+	require.Equal(t, "u3", userID)
+
+	_, err = r.Users.GetForgeAccountUserID(ctx, domain.ForgeGitLab, "bob")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+
+	require.NoError(t, r.Users.DeleteForgeAccount(ctx, domain.ForgeGitHub, "alice"))
+	requireDomainCode(t, r.Users.DeleteForgeAccount(ctx, domain.ForgeGitHub, "alice"), domain.ErrorCodeNotFound)
+
+	_, err = r.Users.GetForgeAccountUserID(ctx, domain.ForgeGitHub, "alice")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+
+	require.Error(t, r.Users.UpsertForgeAccount(ctx, domain.ForgeAccount{Forge: domain.ForgeGitHub, Login: "ghost", UserID: "missing"}))
+}
+
+func testNotificationOptOuts(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	muted, err := r.Users.GetMutedUsers(ctx, []string{"u1", "u2"})
+	require.NoError(t, err)
+	require.Empty(t, muted)
+
+	require.NoError(t, r.Users.SetNotificationsMuted(ctx, "u2", true))
+	require.NoError(t, r.Users.SetNotificationsMuted(ctx, "u2", true))
+	require.NoError(t, r.Users.SetNotificationsMuted(ctx, "u1", true))
+
+	muted, err = r.Users.GetMutedUsers(ctx, []string{"u3", "u2", "u1"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"u1", "u2"}, muted)
+
+	muted, err = r.Users.GetMutedUsers(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, muted)
+
+	require.NoError(t, r.Users.SetNotificationsMuted(ctx, "u1", false))
+	require.NoError(t, r.Users.SetNotificationsMuted(ctx, "u1", false))
+
+	muted, err = r.Users.GetMutedUsers(ctx, []string{"u1", "u2"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, muted)
+
+	require.Error(t, r.Users.SetNotificationsMuted(ctx, "missing", true))
+}
+
+func testUserPreferences(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	_, err := r.Users.GetUserPreferences(ctx, "missing")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+
+	p, err := r.Users.GetUserPreferences(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, domain.DefaultUserPreferences("u1"), p)
+
+	subs, err := r.Users.ListDigestSubscriptions(ctx)
+	require.NoError(t, err)
+	require.Empty(t, subs)
+
+	daily := domain.UserPreferences{
+		UserID:        "u2",
+		Email:         "bob@example.com",
+		Digest:        domain.DigestDaily,
+		DigestHour:    8,
+		DigestWeekday: time.Friday,
+		Timezone:      "Europe/Moscow",
+	}
+	require.NoError(t, r.Users.UpsertUserPreferences(ctx, daily))
+
+	// без адреса, выключенный дайджест и неактивный пользователь в рассылку не попадают
+	require.NoError(t, r.Users.UpsertUserPreferences(ctx, domain.UserPreferences{
+		UserID: "u1", Digest: domain.DigestWeekly, Timezone: "UTC",
+	}))
+	require.NoError(t, r.Users.UpsertUserPreferences(ctx, domain.UserPreferences{
+		UserID: "u3", Email: "charlie@example.com", Digest: domain.DigestDaily, Timezone: "UTC",
+	}))
+
+	got, err := r.Users.GetUserPreferences(ctx, "u2")
+	require.NoError(t, err)
+	require.Equal(t, daily, got)
+
+	subs, err = r.Users.ListDigestSubscriptions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []domain.UserPreferences{daily}, subs)
+
+	at := time.Date(2024, 3, 4, 5, 0, 0, 0, time.UTC)
+	require.NoError(t, r.Users.SetLastDigestAt(ctx, "u2", at))
+
+	// сохранение настроек не сбрасывает время последнего дайджеста
+	daily.DigestHour = 10
+	require.NoError(t, r.Users.UpsertUserPreferences(ctx, daily))
+
+	got, err = r.Users.GetUserPreferences(ctx, "u2")
+	require.NoError(t, err)
+	require.NotNil(t, got.LastDigestAt)
+	require.True(t, at.Equal(*got.LastDigestAt))
+	require.Equal(t, 10, got.DigestHour)
+
+	// время дайджеста можно записать и пользователю без настроек
+	require.NoError(t, r.Users.SetLastDigestAt(ctx, "u1", at))
+	require.Error(t, r.Users.UpsertUserPreferences(ctx, domain.UserPreferences{UserID: "missing", Digest: domain.DigestOff}))
+}
+
+func testUserNotFound(t *testing.T, r Repos) {
+	ctx := context.Background()
+
+	_, err := r.Users.GetUserByID(ctx, "missing")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+
+	_, err = r.Users.SetUserIsActive(ctx, "missing", true)
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+}
+
+func testSetUserIsActive(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	u, err := r.Users.SetUserIsActive(ctx, "u3", true)
+	require.NoError(t, err)
+	require.Equal(t, domain.User{UserID: "u3", Username: "Charlie", TeamName: "backend", IsActive: true}, u)
+
+	got, err := r.Users.GetUserByID(ctx, "u3")
+	require.NoError(t, err)
+	require.True(t, got.IsActive)
+}
+
+func testTeamMembers(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	all, err := r.Users.GetTeamMembers(ctx, "backend", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"u1", "u2", "u3"}, userIDs(all))
+	require.Equal(t, "backend", all[0].TeamName)
+
+	active, err := r.Users.GetTeamMembers(ctx, "backend", true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"u1", "u2"}, userIDs(active))
+
+	none, err := r.Users.GetTeamMembers(ctx, "missing", false)
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+func testDetachUsers(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	require.NoError(t, r.Users.DetachUsers(ctx, []string{"u2"}))
+	require.NoError(t, r.Users.DetachUsers(ctx, nil))
+
+	u, err := r.Users.GetUserByID(ctx, "u2")
+	require.NoError(t, err)
+	require.Equal(t, domain.User{UserID: "u2", Username: "Bob", TeamName: "", IsActive: false}, u)
+
+	team, err := r.Teams.GetTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, team.Members, 2)
+}
+
+func testAvailableTeamMembers(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	now := time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := r.Availability.CreateAvailability(ctx, domain.Availability{
+		UserID:   "u2",
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	available, err := r.Users.GetAvailableTeamMembers(ctx, "backend", now)
+	require.NoError(t, err)
+	require.Equal(t, []string{"u1"}, userIDs(available))
+
+	later, err := r.Users.GetAvailableTeamMembers(ctx, "backend", now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []string{"u1", "u2"}, userIDs(later))
+}
+
+// ----------PULL REQUESTS----------
+
+func testPRCreateAndGet(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	exists, err := r.PRs.PRExists(ctx, "pr-1")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = r.PRs.GetPR(ctx, "pr-1")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+
+	before := time.Now().Add(-time.Minute)
+	seedPR(t, r, "pr-1", "u1")
+
+	exists, err = r.PRs.PRExists(ctx, "pr-1")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	pr, err := r.PRs.GetPR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, "pr-1", pr.PullRequestID)
+	require.Equal(t, "name pr-1", pr.PullRequestName)
+	require.Equal(t, "u1", pr.AuthorID)
+	require.Equal(t, domain.PRStatusOpen, pr.Status)
+	require.Empty(t, pr.AssignedReviewers)
+	require.True(t, pr.CreatedAt.After(before))
+	require.Nil(t, pr.MergedAt)
+
+	require.Error(t, r.PRs.CreatePR(ctx, domain.PullRequest{
+		PullRequestID: "pr-1",
+		AuthorID:      "u1",
+		Status:        domain.PRStatusOpen,
+	}))
+}
+
+func testPRReviewers(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedPR(t, r, "pr-1", "u1", "u3", "u2")
+
+	reviewers, err := r.PRs.GetPRReviewers(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2", "u3"}, reviewers)
+
+	require.NoError(t, r.PRs.SetPRReviewers(ctx, "pr-1", []string{"u2"}))
+
+	pr, err := r.PRs.GetPR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, pr.AssignedReviewers)
+
+	require.Error(t, r.PRs.SetPRReviewers(ctx, "pr-1", []string{"missing"}))
+}
+
+func testPRUpdate(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedPR(t, r, "pr-1", "u1", "u2")
+
+	pr, err := r.PRs.GetPR(ctx, "pr-1")
+	require.NoError(t, err)
+
+	mergedAt := time.Now().UTC().Truncate(time.Millisecond)
+	pr.Status = domain.PRStatusMerged
+	pr.MergedAt = &mergedAt
+	require.NoError(t, r.PRs.UpdatePR(ctx, pr))
+
+	got, err := r.PRs.GetPR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, domain.PRStatusMerged, got.Status)
+	require.NotNil(t, got.MergedAt)
+	require.True(t, got.MergedAt.Equal(mergedAt))
+	require.Equal(t, []string{"u2"}, got.AssignedReviewers)
+}
+
+func testCreatePRs(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	require.NoError(t, r.PRs.CreatePRs(ctx, []domain.PullRequest{
+		{
+			PullRequestID:     "pr-1",
+			PullRequestName:   "First",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u3", "u2"},
+			CreatedAt:         createdAt,
+		},
+		{
+			PullRequestID:   "pr-2",
+			PullRequestName: "Second",
+			AuthorID:        "u2",
+			Status:          domain.PRStatusOpen,
+			CreatedAt:       createdAt,
+		},
+	}))
+
+	got, err := r.PRs.GetPR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, "First", got.PullRequestName)
+	require.Equal(t, domain.PRStatusOpen, got.Status)
+	require.Equal(t, []string{"u2", "u3"}, got.AssignedReviewers)
+	require.True(t, createdAt.Equal(got.CreatedAt))
+	require.Nil(t, got.MergedAt)
+
+	got, err = r.PRs.GetPR(ctx, "pr-2")
+	require.NoError(t, err)
+	require.Empty(t, got.AssignedReviewers)
+
+	// пачка применяется целиком: ошибка на втором PR откатывает первый
+	err = r.Transactor.WithTx(ctx, func(txCtx context.Context) error {
+		return r.PRs.CreatePRs(txCtx, []domain.PullRequest{
+			{PullRequestID: "pr-3", PullRequestName: "Third", AuthorID: "u1", Status: domain.PRStatusOpen, CreatedAt: createdAt},
+			{PullRequestID: "pr-1", PullRequestName: "Again", AuthorID: "u1", Status: domain.PRStatusOpen, CreatedAt: createdAt},
+		})
+	})
+	require.Error(t, err)
+
+	exists, err := r.PRs.PRExists(ctx, "pr-3")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.Error(t, r.PRs.CreatePRs(ctx, []domain.PullRequest{{
+		PullRequestID:     "pr-4",
+		PullRequestName:   "Orphan reviewer",
+		AuthorID:          "u1",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"missing"},
+		CreatedAt:         createdAt,
+	}}))
+}
+
+func testImportPR(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mergedAt := createdAt.Add(26 * time.Hour)
+
+	pr := domain.PullRequest{
+		PullRequestID:     "old-1",
+		PullRequestName:   "Historic",
+		AuthorID:          "u1",
+		Status:            domain.PRStatusMerged,
+		AssignedReviewers: []string{"u3", "u2"},
+		CreatedAt:         createdAt,
+		MergedAt:          &mergedAt,
+	}
+	require.NoError(t, r.PRs.ImportPR(ctx, pr))
+
+	got, err := r.PRs.GetPR(ctx, "old-1")
+	require.NoError(t, err)
+	require.Equal(t, domain.PRStatusMerged, got.Status)
+	require.Equal(t, []string{"u2", "u3"}, got.AssignedReviewers)
+	require.True(t, createdAt.Equal(got.CreatedAt))
+	require.NotNil(t, got.MergedAt)
+	require.True(t, mergedAt.Equal(*got.MergedAt))
+
+	// назначения импортированы вместе с PR и относятся ко времени его создания
+	load, err := r.PRs.GetReviewerLoad(ctx, "backend")
+	require.NoError(t, err)
+	require.NotEmpty(t, load)
+	for _, l := range load {
+		if l.UserID == "u1" {
+			continue
+		}
+		require.NotNil(t, l.LastAssignedAt, l.UserID)
+		require.True(t, createdAt.Equal(*l.LastAssignedAt), l.UserID)
+	}
+
+	// повторный импорт перезаписывает PR целиком
+	pr.PullRequestName = "Historic, reopened"
+	pr.Status = domain.PRStatusOpen
+	pr.MergedAt = nil
+	pr.AssignedReviewers = []string{"u2"}
+	require.NoError(t, r.PRs.ImportPR(ctx, pr))
+
+	got, err = r.PRs.GetPR(ctx, "old-1")
+	require.NoError(t, err)
+	require.Equal(t, "Historic, reopened", got.PullRequestName)
+	require.Equal(t, domain.PRStatusOpen, got.Status)
+	require.Nil(t, got.MergedAt)
+	require.Equal(t, []string{"u2"}, got.AssignedReviewers)
+
+	pr.AssignedReviewers = nil
+	require.NoError(t, r.PRs.ImportPR(ctx, pr))
+
+	got, err = r.PRs.GetPR(ctx, "old-1")
+	require.NoError(t, err)
+	require.Empty(t, got.AssignedReviewers)
+
+	require.Error(t, r.PRs.ImportPR(ctx, domain.PullRequest{
+		PullRequestID:   "old-2",
+		PullRequestName: "Orphan",
+		AuthorID:        "missing",
+		Status:          domain.PRStatusOpen,
+		CreatedAt:       createdAt,
+	}))
+}
+
+func testListPRs(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	seedPR(t, r, "pr-b", "u1", "u2")
+	seedPR(t, r, "pr-a", "u2")
+	seedPR(t, r, "pr-c", "u1", "u2", "u3")
+
+	page, err := r.PRs.ListPRs(ctx, "", 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"pr-a", "pr-b"}, prIDs(page))
+	require.Empty(t, page[0].AssignedReviewers)
+	require.Equal(t, []string{"u2"}, page[1].AssignedReviewers)
+	require.False(t, page[1].CreatedAt.IsZero())
+
+	page, err = r.PRs.ListPRs(ctx, "pr-b", 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"pr-c"}, prIDs(page))
+	require.Equal(t, []string{"u2", "u3"}, page[0].AssignedReviewers)
+
+	page, err = r.PRs.ListPRs(ctx, "pr-c", 2)
+	require.NoError(t, err)
+	require.Empty(t, page)
+}
+
+func testPRsWhereReviewer(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedPR(t, r, "pr-1", "u1", "u2")
+	seedPR(t, r, "pr-2", "u1", "u3")
+	seedPR(t, r, "pr-3", "u3", "u2")
+
+	prs, err := r.PRs.GetPRsWhereReviewer(ctx, "u2")
+	require.NoError(t, err)
+	require.Equal(t, []domain.PullRequestShort{
+		{PullRequestID: "pr-1", PullRequestName: "name pr-1", AuthorID: "u1", Status: domain.PRStatusOpen},
+		{PullRequestID: "pr-3", PullRequestName: "name pr-3", AuthorID: "u3", Status: domain.PRStatusOpen},
+	}, prs)
+
+	none, err := r.PRs.GetPRsWhereReviewer(ctx, "u1")
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+func testOpenPRsByReviewersAndTeam(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedTeam(t, r, "frontend", domain.TeamMember{UserID: "f1", Username: "Frank", IsActive: true})
+
+	seedPR(t, r, "pr-1", "u1", "u2", "u3")
+	seedPR(t, r, "pr-2", "u2", "u1")
+	seedPR(t, r, "pr-3", "f1", "u2")
+	seedPR(t, r, "pr-4", "u1", "u2")
+
+	merged, err := r.PRs.GetPR(ctx, "pr-4")
+	require.NoError(t, err)
+	merged.Status = domain.PRStatusMerged
+	require.NoError(t, r.PRs.UpdatePR(ctx, merged))
+
+	byReviewers, err := r.PRs.GetOpenPRsByReviewers(ctx, []string{"u2", "u3"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"pr-1", "pr-3"}, prIDs(byReviewers))
+	for _, pr := range byReviewers {
+		if pr.PullRequestID == "pr-1" {
+			require.Equal(t, []string{"u2", "u3"}, pr.AssignedReviewers)
+		}
+	}
+
+	empty, err := r.PRs.GetOpenPRsByReviewers(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, empty)
+
+	byTeam, err := r.PRs.GetOpenPRsByTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Equal(t, []string{"pr-1", "pr-2"}, prIDs(byTeam))
+	require.Equal(t, []string{"u1"}, byTeam[1].AssignedReviewers)
+}
+
+func testStats(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedPR(t, r, "pr-1", "u1", "u2", "u3")
+	seedPR(t, r, "pr-2", "u1", "u2")
+
+	merged, err := r.PRs.GetPR(ctx, "pr-2")
+	require.NoError(t, err)
+	merged.Status = domain.PRStatusMerged
+	require.NoError(t, r.PRs.UpdatePR(ctx, merged))
+
+	counts, err := r.PRs.GetPRStatusCounts(ctx, domain.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, domain.PRStatusCounts{Open: 1, Merged: 1, Total: 2}, counts)
+
+	assignments, err := r.PRs.GetAssignmentsCountByUser(ctx, domain.StatsFilter{})
+	require.NoError(t, err)
+	require.Len(t, assignments, 3)
+	require.Equal(t, domain.UserAssignmentsStat{UserID: "u2", ReviewAssignmentsCount: 2}, assignments[0])
+	require.ElementsMatch(t, []domain.UserAssignmentsStat{
+		{UserID: "u1", ReviewAssignmentsCount: 0},
+		{UserID: "u2", ReviewAssignmentsCount: 2},
+		{UserID: "u3", ReviewAssignmentsCount: 1},
+	}, assignments)
+}
+
+func mergePR(t *testing.T, r Repos, prID string, at time.Time) {
+	t.Helper()
+
+	ctx := context.Background()
+	pr, err := r.PRs.GetPR(ctx, prID)
+	require.NoError(t, err)
+
+	pr.Status = domain.PRStatusMerged
+	pr.MergedAt = &at
+	require.NoError(t, r.PRs.UpdatePR(ctx, pr))
+}
+
+func testStatsFiltered(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedTeam(t, r, "frontend",
+		domain.TeamMember{UserID: "f1", Username: "Frank", IsActive: true},
+		domain.TeamMember{UserID: "f2", Username: "Fiona", IsActive: true},
+	)
+
+	seedPR(t, r, "pr-1", "u1", "u2")
+	seedPR(t, r, "pr-2", "u1", "u2", "u3")
+	seedPR(t, r, "pr-3", "f1", "f2")
+
+	mergedAt := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	mergePR(t, r, "pr-2", mergedAt)
+
+	team := domain.StatsFilter{TeamName: "backend"}
+
+	counts, err := r.PRs.GetPRStatusCounts(ctx, team)
+	require.NoError(t, err)
+	require.Equal(t, domain.PRStatusCounts{Open: 1, Merged: 1, Total: 2}, counts)
+
+	assignments, err := r.PRs.GetAssignmentsCountByUser(ctx, team)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []domain.UserAssignmentsStat{
+		{UserID: "u1", ReviewAssignmentsCount: 0},
+		{UserID: "u2", ReviewAssignmentsCount: 2},
+		{UserID: "u3", ReviewAssignmentsCount: 1},
+	}, assignments)
+
+	byTeam, err := r.PRs.GetPRStatusCountsByTeam(ctx, domain.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []domain.TeamStats{
+		{TeamName: "backend", PRStatusCounts: domain.PRStatusCounts{Open: 1, Merged: 1, Total: 2}},
+		{TeamName: "frontend", PRStatusCounts: domain.PRStatusCounts{Open: 1, Total: 1}},
+	}, byTeam)
+
+	from, to := mergedAt.Add(-time.Hour), mergedAt.Add(time.Hour)
+	merged := domain.StatsFilter{MergedFrom: &from, MergedTo: &to}
+
+	counts, err = r.PRs.GetPRStatusCounts(ctx, merged)
+	require.NoError(t, err)
+	require.Equal(t, domain.PRStatusCounts{Merged: 1, Total: 1}, counts)
+
+	assignments, err = r.PRs.GetAssignmentsCountByUser(ctx, merged)
+	require.NoError(t, err)
+	require.Equal(t, domain.UserAssignmentsStat{UserID: "u2", ReviewAssignmentsCount: 1}, assignments[0])
+	require.Len(t, assignments, 5)
+
+	future := time.Now().Add(time.Hour)
+	counts, err = r.PRs.GetPRStatusCounts(ctx, domain.StatsFilter{CreatedFrom: &future})
+	require.NoError(t, err)
+	require.Equal(t, domain.PRStatusCounts{}, counts)
+
+	past := time.Now().Add(-time.Hour)
+	counts, err = r.PRs.GetPRStatusCounts(ctx, domain.StatsFilter{CreatedFrom: &past, CreatedTo: &future})
+	require.NoError(t, err)
+	require.Equal(t, 3, counts.Total)
+}
+
+func testStatsByPeriod(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	seedPR(t, r, "pr-1", "u1", "u2")
+	seedPR(t, r, "pr-2", "u1", "u2")
+	seedPR(t, r, "pr-3", "u2", "u1")
+
+	// вторник и четверг одной недели, понедельник следующей
+	mergePR(t, r, "pr-1", time.Date(2025, 3, 11, 10, 0, 0, 0, time.UTC))
+	mergePR(t, r, "pr-2", time.Date(2025, 3, 13, 23, 30, 0, 0, time.UTC))
+	mergePR(t, r, "pr-3", time.Date(2025, 3, 17, 0, 15, 0, 0, time.UTC))
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	filter := domain.StatsFilter{MergedFrom: &from, MergedTo: &to}
+
+	today := domain.StatsGranularityDay.BucketStart(time.Now())
+
+	days, err := r.PRs.GetPRCountsByPeriod(ctx, filter, domain.StatsGranularityDay)
+	require.NoError(t, err)
+	require.Equal(t, []domain.StatsBucket{
+		{Start: time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC), Merged: 1},
+		{Start: time.Date(2025, 3, 13, 0, 0, 0, 0, time.UTC), Merged: 1},
+		{Start: time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC), Merged: 1},
+		{Start: today, Created: 3},
+	}, days)
+
+	weeks, err := r.PRs.GetPRCountsByPeriod(ctx, filter, domain.StatsGranularityWeek)
+	require.NoError(t, err)
+	require.Equal(t, []domain.StatsBucket{
+		{Start: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), Merged: 2},
+		{Start: time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC), Merged: 1},
+		{Start: domain.StatsGranularityWeek.BucketStart(time.Now()), Created: 3},
+	}, weeks)
+
+	none, err := r.PRs.GetPRCountsByPeriod(ctx, domain.StatsFilter{TeamName: "missing"}, domain.StatsGranularityDay)
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+func testPRTimings(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedTeam(t, r, "frontend",
+		domain.TeamMember{UserID: "f1", Username: "Frank", IsActive: true},
+	)
+
+	seedPR(t, r, "pr-2", "u1", "u3", "u2")
+	seedPR(t, r, "pr-1", "u2")
+	seedPR(t, r, "pr-3", "f1")
+
+	mergedAt := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	mergePR(t, r, "pr-2", mergedAt)
+
+	timings, err := r.PRs.GetPRTimings(ctx, domain.StatsFilter{})
+	require.NoError(t, err)
+	require.Len(t, timings, 3)
+
+	require.Equal(t, "pr-1", timings[0].PullRequestID)
+	require.Equal(t, "backend", timings[0].TeamName)
+	require.Empty(t, timings[0].Reviewers)
+	require.Nil(t, timings[0].MergedAt)
+	require.WithinDuration(t, time.Now(), timings[0].CreatedAt, time.Minute)
+
+	require.Equal(t, "pr-2", timings[1].PullRequestID)
+	require.Equal(t, []string{"u2", "u3"}, timings[1].Reviewers)
+	require.NotNil(t, timings[1].MergedAt)
+	require.True(t, mergedAt.Equal(*timings[1].MergedAt))
+
+	require.Equal(t, "frontend", timings[2].TeamName)
+
+	from := mergedAt.Add(-time.Hour)
+	merged, err := r.PRs.GetPRTimings(ctx, domain.StatsFilter{TeamName: "backend", MergedFrom: &from})
+	require.NoError(t, err)
+	require.Len(t, merged, 1)
+	require.Equal(t, "pr-2", merged[0].PullRequestID)
+}
+
+func testReviewerLoad(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedTeam(t, r, "frontend",
+		domain.TeamMember{UserID: "f1", Username: "Frank", IsActive: true},
+	)
+
+	seedPR(t, r, "pr-1", "u1", "u2", "u3")
+	seedPR(t, r, "pr-2", "f1", "u2")
+	mergePR(t, r, "pr-2", time.Now())
+
+	loads, err := r.PRs.GetReviewerLoad(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, loads, 3)
+
+	require.Equal(t, "u1", loads[0].UserID)
+	require.Equal(t, "backend", loads[0].TeamName)
+	require.True(t, loads[0].IsActive)
+	require.Equal(t, 0, loads[0].OpenAssignments)
+	require.Nil(t, loads[0].LastAssignedAt)
+
+	require.Equal(t, "u2", loads[1].UserID)
+	require.Equal(t, 1, loads[1].OpenAssignments)
+	require.NotNil(t, loads[1].LastAssignedAt)
+
+	require.Equal(t, "u3", loads[2].UserID)
+	require.False(t, loads[2].IsActive)
+	require.Equal(t, 1, loads[2].OpenAssignments)
+
+	all, err := r.PRs.GetReviewerLoad(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+	require.Equal(t, "f1", all[3].UserID)
+	require.Equal(t, "frontend", all[3].TeamName)
+}
+
+func testReviewersKeepAssignedAt(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	seedPR(t, r, "pr-1", "u3", "u2")
+
+	before, err := r.PRs.GetReviewerLoad(ctx, "backend")
+	require.NoError(t, err)
+	require.NotNil(t, before[1].LastAssignedAt)
+	assignedAt := *before[1].LastAssignedAt
+
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, r.PRs.SetPRReviewers(ctx, "pr-1", []string{"u1", "u2"}))
+
+	after, err := r.PRs.GetReviewerLoad(ctx, "backend")
+	require.NoError(t, err)
+	require.True(t, assignedAt.Equal(*after[1].LastAssignedAt), "kept reviewer must keep assignment time")
+	require.NotNil(t, after[0].LastAssignedAt)
+	require.True(t, after[0].LastAssignedAt.After(assignedAt))
+
+	reviewers, err := r.PRs.GetPRReviewers(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"u1", "u2"}, reviewers)
+
+	require.NoError(t, r.PRs.SetPRReviewers(ctx, "pr-1", nil))
+	reviewers, err = r.PRs.GetPRReviewers(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Empty(t, reviewers)
+}
+
+func testStalePRs(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedTeam(t, r, "frontend",
+		domain.TeamMember{UserID: "f1", Username: "Frank", IsActive: true},
+		domain.TeamMember{UserID: "f2", Username: "Fiona", IsActive: true},
+	)
+
+	seedPR(t, r, "pr-1", "u1", "u2")
+	seedPR(t, r, "pr-2", "f1", "f2")
+	seedPR(t, r, "pr-3", "u2", "u1")
+	mergePR(t, r, "pr-3", time.Now())
+
+	require.NoError(t, r.Teams.UpsertTeamSettings(ctx, domain.TeamSettings{
+		TeamName:        "frontend",
+		StaleAfter:      3 * time.Hour,
+		StaleAutoRotate: true,
+	}))
+
+	none, err := r.PRs.GetStalePRs(ctx, "", time.Hour, time.Now())
+	require.NoError(t, err)
+	require.Empty(t, none)
+
+	later := time.Now().Add(2 * time.Hour)
+	stale, err := r.PRs.GetStalePRs(ctx, "", time.Hour, later)
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+
+	p := stale[0]
+	require.Equal(t, "pr-1", p.PullRequest.PullRequestID)
+	require.Equal(t, []string{"u2"}, p.PullRequest.AssignedReviewers)
+	require.Equal(t, "backend", p.TeamName)
+	require.Equal(t, time.Hour, p.StaleAfter)
+	require.False(t, p.AutoRotate)
+	require.Equal(t, "u2", p.OldestReviewerID)
+	require.Nil(t, p.MarkedAt)
+	require.True(t, p.IsNewlyStale())
+
+	stale, err = r.PRs.GetStalePRs(ctx, "frontend", time.Hour, time.Now().Add(4*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	require.Equal(t, "pr-2", stale[0].PullRequest.PullRequestID)
+	require.Equal(t, 3*time.Hour, stale[0].StaleAfter)
+	require.True(t, stale[0].AutoRotate)
+
+	require.NoError(t, r.PRs.MarkPRStale(ctx, "pr-1", later))
+
+	stale, err = r.PRs.GetStalePRs(ctx, "backend", time.Hour, later)
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	require.NotNil(t, stale[0].MarkedAt)
+	require.True(t, later.Equal(*stale[0].MarkedAt))
+	require.False(t, stale[0].IsNewlyStale())
+
+	// новое назначение — это активность, отсчёт начинается заново
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, r.PRs.SetPRReviewers(ctx, "pr-1", []string{"u2", "u3"}))
+
+	stale, err = r.PRs.GetStalePRs(ctx, "backend", time.Hour, time.Now().Add(30*time.Minute))
+	require.NoError(t, err)
+	require.Empty(t, stale)
+
+	stale, err = r.PRs.GetStalePRs(ctx, "backend", time.Hour, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	require.Equal(t, "u2", stale[0].OldestReviewerID)
+}
+
+// ----------AVAILABILITY----------
+
+func testForgeSyncJobs(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedPR(t, r, "pr-1", "u1", "u2")
+	seedPR(t, r, "pr-2", "u2", "u1")
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, r.PRs.EnqueueForgeSync(ctx, domain.ForgeSyncJob{
+		PullRequestID: "pr-1",
+		Add:           []string{"u2", "u3"},
+		NextAttemptAt: now,
+	}))
+	require.NoError(t, r.PRs.EnqueueForgeSync(ctx, domain.ForgeSyncJob{
+		PullRequestID: "pr-2",
+		Add:           []string{"u3"},
+		Remove:        []string{"u1"},
+		NextAttemptAt: now.Add(-time.Minute),
+	}))
+	require.NoError(t, r.PRs.EnqueueForgeSync(ctx, domain.ForgeSyncJob{
+		PullRequestID: "pr-1",
+		Add:           []string{"u1"},
+		NextAttemptAt: now.Add(time.Hour),
+	}))
+
+	require.Error(t, r.PRs.EnqueueForgeSync(ctx, domain.ForgeSyncJob{PullRequestID: "missing", NextAttemptAt: now}))
+
+	jobs, err := r.PRs.ListDueForgeSyncJobs(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	require.Equal(t, "pr-2", jobs[0].PullRequestID)
+	require.Equal(t, []string{"u3"}, jobs[0].Add)
+	require.Equal(t, []string{"u1"}, jobs[0].Remove)
+	require.Equal(t, "pr-1", jobs[1].PullRequestID)
+	require.Equal(t, []string{"u2", "u3"}, jobs[1].Add)
+	require.Empty(t, jobs[1].Remove)
+	require.Zero(t, jobs[1].Attempts)
+	require.False(t, jobs[1].CreatedAt.IsZero())
+
+	jobs, err = r.PRs.ListDueForgeSyncJobs(ctx, now, 1)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	job := jobs[0]
+	job.Attempts = 1
+	job.NextAttemptAt = now.Add(30 * time.Second)
+	job.LastError = "boom"
+	require.NoError(t, r.PRs.UpdateForgeSyncJob(ctx, job))
+
+	jobs, err = r.PRs.ListDueForgeSyncJobs(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "pr-1", jobs[0].PullRequestID)
+
+	jobs, err = r.PRs.ListDueForgeSyncJobs(ctx, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	require.Equal(t, "pr-2", jobs[1].PullRequestID)
+	require.Equal(t, 1, jobs[1].Attempts)
+	require.Equal(t, "boom", jobs[1].LastError)
+	require.True(t, jobs[1].NextAttemptAt.Equal(now.Add(30*time.Second)))
+
+	require.NoError(t, r.PRs.DeleteForgeSyncJob(ctx, jobs[0].ID))
+
+	jobs, err = r.PRs.ListDueForgeSyncJobs(ctx, now.Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	require.Equal(t, "pr-2", jobs[0].PullRequestID)
+	require.Equal(t, []string{"u1"}, jobs[1].Add)
+}
+
+func testAvailabilityCRUD(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	start := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	second, err := r.Availability.CreateAvailability(ctx, domain.Availability{
+		UserID:   "u1",
+		StartsAt: start.Add(30 * 24 * time.Hour),
+		EndsAt:   start.Add(31 * 24 * time.Hour),
+		Reason:   "conference",
+	})
+	require.NoError(t, err)
+
+	first, err := r.Availability.CreateAvailability(ctx, domain.Availability{
+		UserID:   "u1",
+		StartsAt: start,
+		EndsAt:   start.Add(7 * 24 * time.Hour),
+		Reason:   "vacation",
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, first.ID, second.ID)
+	require.Nil(t, first.AppliedAt)
+
+	_, err = r.Availability.CreateAvailability(ctx, domain.Availability{
+		UserID:   "u1",
+		StartsAt: start,
+		EndsAt:   start,
+	})
+	require.Error(t, err)
+
+	list, err := r.Availability.ListAvailability(ctx, "u1")
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, first.ID, list[0].ID)
+	require.Equal(t, "vacation", list[0].Reason)
+	require.True(t, list[0].StartsAt.Equal(start))
+
+	first.Reason = "sick leave"
+	first.EndsAt = start.Add(3 * 24 * time.Hour)
+	updated, err := r.Availability.UpdateAvailability(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, "sick leave", updated.Reason)
+	require.Equal(t, "u1", updated.UserID)
+	require.True(t, updated.EndsAt.Equal(first.EndsAt))
+
+	_, err = r.Availability.UpdateAvailability(ctx, domain.Availability{
+		ID:       first.ID + second.ID + 100,
+		StartsAt: start,
+		EndsAt:   start.Add(time.Hour),
+	})
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+
+	require.NoError(t, r.Availability.DeleteAvailability(ctx, second.ID))
+	requireDomainCode(t, r.Availability.DeleteAvailability(ctx, second.ID), domain.ErrorCodeNotFound)
+
+	list, err = r.Availability.ListAvailability(ctx, "u1")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
+func testStartedAvailability(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+
+	now := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	started, err := r.Availability.CreateAvailability(ctx, domain.Availability{
+		UserID:   "u1",
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = r.Availability.CreateAvailability(ctx, domain.Availability{
+		UserID:   "u2",
+		StartsAt: now.Add(time.Hour),
+		EndsAt:   now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	got, err := r.Availability.GetStartedAvailability(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, started.ID, got[0].ID)
+
+	require.NoError(t, r.Availability.MarkAvailabilityApplied(ctx, started.ID, now))
+
+	got, err = r.Availability.GetStartedAvailability(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	list, err := r.Availability.ListAvailability(ctx, "u1")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.NotNil(t, list[0].AppliedAt)
+	require.True(t, list[0].AppliedAt.Equal(now))
+}
+
+func testEvents(t *testing.T, r Repos) {
+	ctx := context.Background()
+
+	last, err := r.Events.LastEventID(ctx)
+	require.NoError(t, err)
+	require.Zero(t, last)
+
+	created, err := r.Events.AppendEvent(ctx, domain.Event{
+		Type:            domain.EventPRCreated,
+		TeamName:        "backend",
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+		Reviewers:       []string{"u2", "u3"},
+	})
+	require.NoError(t, err)
+	require.Positive(t, created.ID)
+	require.False(t, created.CreatedAt.IsZero())
+
+	reassigned, err := r.Events.AppendEvent(ctx, domain.Event{
+		Type:          domain.EventPRReassigned,
+		TeamName:      "backend",
+		PullRequestID: "pr-1",
+		AuthorID:      "u1",
+		Reviewers:     []string{"u2", "u4"},
+		OldReviewerID: "u3",
+		NewReviewerID: "u4",
+	})
+	require.NoError(t, err)
+	require.Greater(t, reassigned.ID, created.ID)
+
+	deactivated, err := r.Events.AppendEvent(ctx, domain.Event{
+		Type:               domain.EventTeamDeactivated,
+		TeamName:           "frontend",
+		DeactivatedUserIDs: []string{"u5"},
+	})
+	require.NoError(t, err)
+
+	last, err = r.Events.LastEventID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, deactivated.ID, last)
+
+	all, err := r.Events.ListEvents(ctx, domain.EventFilter{}, 10)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, created.ID, all[0].ID)
+	require.Equal(t, domain.EventPRCreated, all[0].Type)
+	require.Equal(t, "Add search", all[0].PullRequestName)
+	require.Equal(t, []string{"u2", "u3"}, all[0].Reviewers)
+	require.Nil(t, all[0].DeactivatedUserIDs)
+	require.Equal(t, "u3", all[1].OldReviewerID)
+	require.Equal(t, "u4", all[1].NewReviewerID)
+	require.Equal(t, []string{"u5"}, all[2].DeactivatedUserIDs)
+	require.Nil(t, all[2].Reviewers)
+
+	page, err := r.Events.ListEvents(ctx, domain.EventFilter{AfterID: &created.ID}, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, reassigned.ID, page[0].ID)
+
+	byTeam, err := r.Events.ListEvents(ctx, domain.EventFilter{TeamName: "frontend"}, 10)
+	require.NoError(t, err)
+	require.Len(t, byTeam, 1)
+	require.Equal(t, deactivated.ID, byTeam[0].ID)
+
+	// u3 — ревьювер созданного PR и снятый ревьювер при переназначении
+	byUser, err := r.Events.ListEvents(ctx, domain.EventFilter{UserID: "u3"}, 10)
+	require.NoError(t, err)
+	require.Len(t, byUser, 2)
+
+	byUser, err = r.Events.ListEvents(ctx, domain.EventFilter{UserID: "u4", AfterID: &reassigned.ID}, 10)
+	require.NoError(t, err)
+	require.Empty(t, byUser)
+}
+
+// ----------TRANSACTIONS----------
+
+func testTxCommit(t *testing.T, r Repos) {
+	ctx := context.Background()
+
+	err := r.Transactor.WithTx(ctx, func(txCtx context.Context) error {
+		if err := r.Teams.CreateTeam(txCtx, "backend"); err != nil {
+			return err
+		}
+		if err := r.Users.UpsertUsers(txCtx, "backend", []domain.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+		}); err != nil {
+			return err
+		}
+
+		// внутри транзакции собственные изменения видны
+		team, err := r.Teams.GetTeam(txCtx, "backend")
+		if err != nil {
+			return err
+		}
+		require.Len(t, team.Members, 1)
+		return nil
+	})
+	require.NoError(t, err)
+
+	team, err := r.Teams.GetTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, team.Members, 1)
+}
+
+func testTxRollback(t *testing.T, r Repos) {
+	ctx := context.Background()
+	backendTeam(t, r)
+	seedPR(t, r, "pr-1", "u1", "u2")
+
+	wantErr := errors.New("boom")
+
+	err := r.Transactor.WithTx(ctx, func(txCtx context.Context) error {
+		if err := r.Teams.CreateTeam(txCtx, "frontend"); err != nil {
+			return err
+		}
+		if _, err := r.Users.SetUserIsActive(txCtx, "u2", false); err != nil {
+			return err
+		}
+		if err := r.PRs.SetPRReviewers(txCtx, "pr-1", []string{"u3"}); err != nil {
+			return err
+		}
+		if err := r.Users.DetachUsers(txCtx, []string{"u1"}); err != nil {
+			return err
+		}
+		return wantErr
+	})
+	require.ErrorIs(t, err, wantErr)
+
+	_, err = r.Teams.GetTeam(ctx, "frontend")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+
+	u2, err := r.Users.GetUserByID(ctx, "u2")
+	require.NoError(t, err)
+	require.True(t, u2.IsActive)
+
+	u1, err := r.Users.GetUserByID(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, "backend", u1.TeamName)
+
+	reviewers, err := r.PRs.GetPRReviewers(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, reviewers)
+}
+
+func testTxNestedRollback(t *testing.T, r Repos) {
+	ctx := context.Background()
+
+	wantErr := errors.New("outer failed")
+
+	err := r.Transactor.WithTx(ctx, func(txCtx context.Context) error {
+		innerErr := r.Transactor.WithTx(txCtx, func(innerCtx context.Context) error {
+			return r.Teams.CreateTeam(innerCtx, "backend")
+		})
+		if innerErr != nil {
+			return innerErr
+		}
+		return wantErr
+	})
+	require.ErrorIs(t, err, wantErr)
+
+	_, err = r.Teams.GetTeam(ctx, "backend")
+	requireDomainCode(t, err, domain.ErrorCodeNotFound)
+}
+
+// failingPRs — хранилище PR, в котором не удаётся сменить ревьюверов PR failPR.
+type failingPRs struct {
+	repository.PRRepository
+	failPR string
+	err    error
+}
+
+func (f failingPRs) SetPRReviewers(ctx context.Context, prID string, reviewers []string) error {
+	if prID == f.failPR {
+		return f.err
+	}
+	return f.PRRepository.SetPRReviewers(ctx, prID, reviewers)
+}
+
+// testDeactivationRollback проверяет, что сбой посреди DeactivateTeamMembers откатывает
+// и деактивацию, и уже сделанные замены ревьюверов: все репозитории работают в транзакции.
+func testDeactivationRollback(t *testing.T, r Repos) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend",
+		domain.TeamMember{UserID: "u1", Username: "Alice", IsActive: true},
+		domain.TeamMember{UserID: "u2", Username: "Bob", IsActive: true},
+		domain.TeamMember{UserID: "u4", Username: "Dave", IsActive: true},
+		domain.TeamMember{UserID: "u5", Username: "Eve", IsActive: true},
+	)
+	seedPR(t, r, "pr-1", "u1", "u2")
+	seedPR(t, r, "pr-2", "u1", "u4")
+
+	wantErr := errors.New("boom")
+	svc := usecase.NewService(r.Teams, r.Users, failingPRs{PRRepository: r.PRs, failPR: "pr-2", err: wantErr},
+		r.Availability, r.Events, r.Transactor, 1, time.Hour, nil, nil)
+
+	_, err := svc.DeactivateTeamMembers(ctx, "backend", []string{"u2", "u4"}, false)
+	require.ErrorIs(t, err, wantErr)
+
+	for _, id := range []string{"u2", "u4"} {
+		u, err := r.Users.GetUserByID(ctx, id)
+		require.NoError(t, err)
+		require.True(t, u.IsActive, id)
+	}
+
+	reviewers, err := r.PRs.GetPRReviewers(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"u2"}, reviewers)
+}
@@ -0,0 +1,164 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type AvailabilityRepository struct {
+	db *sql.DB
+}
+
+func NewAvailabilityRepository(db *sql.DB) *AvailabilityRepository {
+	return &AvailabilityRepository{db: db}
+}
+
+const availabilityColumns = `id, user_id, starts_at, ends_at, reason, applied_at`
+
+func (r *AvailabilityRepository) CreateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	const q = `
+		INSERT INTO user_availability (user_id, starts_at, ends_at, reason)
+		VALUES (?, ?, ?, ?)
+		RETURNING id
+	`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, q,
+		a.UserID, formatTime(a.StartsAt), formatTime(a.EndsAt), a.Reason,
+	).Scan(&a.ID)
+	if err != nil {
+		return domain.Availability{}, err
+	}
+
+	a.AppliedAt = nil
+	return a, nil
+}
+
+// UpdateAvailability меняет границы и причину периода.
+// Если новый период ещё не начался, отметка о применении сбрасывается.
+func (r *AvailabilityRepository) UpdateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	const q = `
+		UPDATE user_availability
+		SET starts_at = ?1,
+		    ends_at = ?2,
+		    reason = ?3,
+		    applied_at = CASE WHEN ?1 > ?4 THEN NULL ELSE applied_at END
+		WHERE id = ?5
+		RETURNING ` + availabilityColumns
+
+	res, err := scanAvailability(conn(ctx, r.db).QueryRowContext(ctx, q,
+		formatTime(a.StartsAt), formatTime(a.EndsAt), a.Reason, formatTime(time.Now()), a.ID,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Availability{}, domain.NewDomainError(domain.ErrorCodeNotFound, "availability period not found")
+		}
+		return domain.Availability{}, err
+	}
+
+	return res, nil
+}
+
+func (r *AvailabilityRepository) DeleteAvailability(ctx context.Context, id int64) error {
+	const q = `DELETE FROM user_availability WHERE id = ?`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, q, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.NewDomainError(domain.ErrorCodeNotFound, "availability period not found")
+	}
+
+	return nil
+}
+
+func (r *AvailabilityRepository) ListAvailability(ctx context.Context, userID string) ([]domain.Availability, error) {
+	const q = `
+		SELECT ` + availabilityColumns + `
+		FROM user_availability
+		WHERE user_id = ?
+		ORDER BY starts_at, id
+	`
+
+	return r.query(ctx, q, userID)
+}
+
+// GetStartedAvailability возвращает уже начавшиеся, но ещё не применённые периоды недоступности.
+func (r *AvailabilityRepository) GetStartedAvailability(ctx context.Context, at time.Time) ([]domain.Availability, error) {
+	const q = `
+		SELECT ` + availabilityColumns + `
+		FROM user_availability
+		WHERE applied_at IS NULL
+		  AND starts_at <= ?1
+		  AND ends_at > ?1
+		ORDER BY starts_at, id
+	`
+
+	return r.query(ctx, q, formatTime(at))
+}
+
+func (r *AvailabilityRepository) MarkAvailabilityApplied(ctx context.Context, id int64, at time.Time) error {
+	const q = `UPDATE user_availability SET applied_at = ? WHERE id = ?`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, q, formatTime(at), id)
+	return err
+}
+
+func (r *AvailabilityRepository) query(ctx context.Context, q string, args ...any) ([]domain.Availability, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.Availability, 0)
+
+	for rows.Next() {
+		a, err := scanAvailability(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+
+	return res, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAvailability(row rowScanner) (domain.Availability, error) {
+	var (
+		a         domain.Availability
+		startsAt  string
+		endsAt    string
+		appliedAt sql.NullString
+	)
+
+	if err := row.Scan(&a.ID, &a.UserID, &startsAt, &endsAt, &a.Reason, &appliedAt); err != nil {
+		return domain.Availability{}, err
+	}
+
+	var err error
+	if a.StartsAt, err = parseTime(startsAt); err != nil {
+		return domain.Availability{}, err
+	}
+	if a.EndsAt, err = parseTime(endsAt); err != nil {
+		return domain.Availability{}, err
+	}
+	if a.AppliedAt, err = parseNullTime(appliedAt); err != nil {
+		return domain.Availability{}, err
+	}
+
+	return a, nil
+}
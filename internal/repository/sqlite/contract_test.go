@@ -0,0 +1,34 @@
+//go:build cgo
+
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	dbpkg "github.com/alnoi/pr-reviewer-service/db"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/repotest"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/sqlite"
+)
+
+func TestSQLiteRepositoriesContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repos {
+		db, err := sqlite.Open(filepath.Join(t.TempDir(), "test.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		dbpkg.SetupSQLite(db, zap.NewNop())
+
+		return repotest.Repos{
+			Teams:        sqlite.NewTeamRepository(db),
+			Users:        sqlite.NewUserRepository(db),
+			PRs:          sqlite.NewPRRepository(db),
+			Availability: sqlite.NewAvailabilityRepository(db),
+			Events:       sqlite.NewEventRepository(db),
+			Transactor:   sqlite.NewTransactor(db),
+		}
+	})
+}
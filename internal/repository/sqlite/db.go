@@ -0,0 +1,128 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/alnoi/pr-reviewer-service/internal/repository"
+	"github.com/alnoi/pr-reviewer-service/internal/usecase"
+)
+
+var (
+	_ repository.TeamRepository         = (*TeamRepository)(nil)
+	_ repository.UserRepository         = (*UserRepository)(nil)
+	_ repository.PRRepository           = (*PRRepository)(nil)
+	_ repository.AvailabilityRepository = (*AvailabilityRepository)(nil)
+	_ repository.EventRepository        = (*EventRepository)(nil)
+	_ usecase.Transactor                = (*Transactor)(nil)
+)
+
+// Open открывает базу SQLite по пути path.
+// Соединение одно: SQLite всё равно сериализует запись, а так транзакции не получают SQLITE_BUSY.
+// Драйвер требует сборки с CGO_ENABLED=1.
+func Open(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite3", "file:"+path+"?_foreign_keys=on&_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+
+	db.SetMaxOpenConns(1)
+
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+// conn возвращает транзакцию из контекста, если запрос выполняется внутри Transactor.WithTx, иначе базу.
+func conn(ctx context.Context, db *sql.DB) querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+type Transactor struct {
+	db *sql.DB
+}
+
+func NewTransactor(db *sql.DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+func (t *Transactor) WithTx(ctx context.Context, function func(ctx context.Context) error) error {
+	// вложенный вызов выполняется в уже открытой транзакции, фиксирует её внешний WithTx
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return function(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("can not begin transaction, error: %w", err)
+	}
+
+	if err := function(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("function execution error: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("can not commit transaction, error: %w", err)
+	}
+
+	return nil
+}
+
+// Время хранится текстом в UTC с фиксированной длиной, поэтому сравнение строк в SQL
+// совпадает со сравнением моментов времени.
+const timeLayout = "2006-01-02T15:04:05.000000000Z"
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+func formatNullTime(t *time.Time) sql.NullString {
+	if t == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: formatTime(*t), Valid: true}
+}
+
+func parseTime(s string) (time.Time, error) {
+	return time.Parse(timeLayout, s)
+}
+
+func parseNullTime(s sql.NullString) (*time.Time, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	t, err := parseTime(s.String)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// inClause возвращает плейсхолдеры "?, ?, ?" и аргументы для условия IN.
+func inClause(values []string) (string, []any) {
+	args := make([]any, 0, len(values))
+	for _, v := range values {
+		args = append(args, v)
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", "), args
+}
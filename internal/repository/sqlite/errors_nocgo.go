@@ -0,0 +1,8 @@
+//go:build !cgo
+
+package sqlite
+
+// Без cgo драйвер — заглушка, и Open всегда завершается ошибкой, так что до запросов дело не доходит.
+func isUniqueViolation(error) bool {
+	return false
+}
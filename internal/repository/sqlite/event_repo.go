@@ -0,0 +1,128 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type EventRepository struct {
+	db *sql.DB
+}
+
+func NewEventRepository(db *sql.DB) *EventRepository {
+	return &EventRepository{db: db}
+}
+
+// AppendEvent сохраняет событие. Запись в SQLite сериализована, поэтому id становятся видимыми по возрастанию.
+func (r *EventRepository) AppendEvent(ctx context.Context, ev domain.Event) (domain.Event, error) {
+	const q = `
+		INSERT INTO events (
+			type, team_name, pr_id, pr_name, author_id, reviewers,
+			old_reviewer_id, new_reviewer_id, deactivated_user_ids, user_ids, created_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`
+
+	reviewers, err := encodeIDs(ev.Reviewers)
+	if err != nil {
+		return domain.Event{}, err
+	}
+	deactivated, err := encodeIDs(ev.DeactivatedUserIDs)
+	if err != nil {
+		return domain.Event{}, err
+	}
+	userIDs, err := encodeIDs(ev.UserIDs())
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	createdAt := time.Now().UTC()
+	err = conn(ctx, r.db).QueryRowContext(ctx, q,
+		string(ev.Type), ev.TeamName, ev.PullRequestID, ev.PullRequestName, ev.AuthorID, reviewers,
+		ev.OldReviewerID, ev.NewReviewerID, deactivated, userIDs, formatTime(createdAt),
+	).Scan(&ev.ID)
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	ev.CreatedAt = createdAt
+	return ev, nil
+}
+
+func (r *EventRepository) ListEvents(ctx context.Context, filter domain.EventFilter, limit int) ([]domain.Event, error) {
+	var (
+		conds []string
+		args  []any
+	)
+
+	if filter.AfterID != nil {
+		conds = append(conds, "id > ?")
+		args = append(args, *filter.AfterID)
+	}
+	if filter.TeamName != "" {
+		conds = append(conds, "team_name = ?")
+		args = append(args, filter.TeamName)
+	}
+	if filter.UserID != "" {
+		conds = append(conds, "EXISTS (SELECT 1 FROM json_each(events.user_ids) WHERE value = ?)")
+		args = append(args, filter.UserID)
+	}
+
+	q := `
+		SELECT id, type, team_name, pr_id, pr_name, author_id, reviewers,
+		       old_reviewer_id, new_reviewer_id, deactivated_user_ids, created_at
+		FROM events
+	`
+	if len(conds) > 0 {
+		q += " WHERE " + strings.Join(conds, " AND ")
+	}
+	q += " ORDER BY id LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.Event, 0)
+	for rows.Next() {
+		var (
+			e           domain.Event
+			evtType     string
+			reviewers   string
+			deactivated string
+			createdAt   string
+		)
+		err := rows.Scan(&e.ID, &evtType, &e.TeamName, &e.PullRequestID, &e.PullRequestName, &e.AuthorID, &reviewers,
+			&e.OldReviewerID, &e.NewReviewerID, &deactivated, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Type = domain.EventType(evtType)
+		if e.Reviewers, err = decodeIDs(reviewers); err != nil {
+			return nil, err
+		}
+		if e.DeactivatedUserIDs, err = decodeIDs(deactivated); err != nil {
+			return nil, err
+		}
+		if e.CreatedAt, err = parseTime(createdAt); err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *EventRepository) LastEventID(ctx context.Context) (int64, error) {
+	var id int64
+	err := conn(ctx, r.db).QueryRowContext(ctx, `SELECT COALESCE(MAX(id), 0) FROM events`).Scan(&id)
+	return id, err
+}
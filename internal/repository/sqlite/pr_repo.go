@@ -0,0 +1,880 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type PRRepository struct {
+	db *sql.DB
+}
+
+func NewPRRepository(db *sql.DB) *PRRepository {
+	return &PRRepository{db: db}
+}
+
+const prColumns = `pr.id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at`
+
+func (r *PRRepository) CreatePR(ctx context.Context, pr domain.PullRequest) error {
+	const query = `
+		INSERT INTO pull_requests (
+			id,
+			pull_request_name,
+			author_id,
+			status,
+			created_at
+		)
+		VALUES (?, ?, ?, ?, ?)
+	`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		pr.PullRequestID,
+		pr.PullRequestName,
+		pr.AuthorID,
+		string(pr.Status),
+		formatTime(time.Now()),
+	)
+	return err
+}
+
+// CreatePRs создаёт открытые PR вместе с ревьюверами из AssignedReviewers.
+// Время создания и назначения берётся из CreatedAt.
+func (r *PRRepository) CreatePRs(ctx context.Context, prs []domain.PullRequest) error {
+	q := conn(ctx, r.db)
+
+	const prQ = `
+		INSERT INTO pull_requests (id, pull_request_name, author_id, status, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+	const reviewerQ = `INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at) VALUES (?, ?, ?)`
+
+	for _, pr := range prs {
+		createdAt := formatTime(pr.CreatedAt)
+
+		if _, err := q.ExecContext(ctx, prQ,
+			pr.PullRequestID,
+			pr.PullRequestName,
+			pr.AuthorID,
+			string(pr.Status),
+			createdAt,
+		); err != nil {
+			return err
+		}
+
+		for _, id := range pr.AssignedReviewers {
+			if _, err := q.ExecContext(ctx, reviewerQ, pr.PullRequestID, id, createdAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *PRRepository) PRExists(ctx context.Context, prID string) (bool, error) {
+	const q = `SELECT 1 FROM pull_requests WHERE id = ?`
+
+	var x int
+	err := conn(ctx, r.db).QueryRowContext(ctx, q, prID).Scan(&x)
+
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return false, err
+}
+
+func (r *PRRepository) GetPR(ctx context.Context, prID string) (domain.PullRequest, error) {
+	const q = `SELECT ` + prColumns + ` FROM pull_requests pr WHERE pr.id = ?`
+
+	prs, err := r.queryPRs(ctx, q, prID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	if len(prs) == 0 {
+		return domain.PullRequest{}, domain.NewDomainError(domain.ErrorCodeNotFound, "PR not found")
+	}
+
+	return prs[0], nil
+}
+
+func (r *PRRepository) UpdatePR(ctx context.Context, pr domain.PullRequest) error {
+	const q = `
+		UPDATE pull_requests
+		SET pull_request_name = ?,
+		    author_id = ?,
+		    status = ?,
+		    merged_at = ?
+		WHERE id = ?
+	`
+	_, err := conn(ctx, r.db).ExecContext(ctx, q,
+		pr.PullRequestName,
+		pr.AuthorID,
+		string(pr.Status),
+		formatNullTime(pr.MergedAt),
+		pr.PullRequestID,
+	)
+	return err
+}
+
+// ImportPR создаёт PR или целиком перезаписывает существующий, сохраняя время создания и мержа из pr.
+// Ревьюверы считаются назначенными в момент создания PR.
+func (r *PRRepository) ImportPR(ctx context.Context, pr domain.PullRequest) error {
+	q := conn(ctx, r.db)
+
+	const upsertQ = `
+		INSERT INTO pull_requests (id, pull_request_name, author_id, status, created_at, merged_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE
+		SET pull_request_name = excluded.pull_request_name,
+		    author_id = excluded.author_id,
+		    status = excluded.status,
+		    created_at = excluded.created_at,
+		    merged_at = excluded.merged_at,
+		    stale_at = NULL
+	`
+	createdAt := formatTime(pr.CreatedAt)
+	if _, err := q.ExecContext(ctx, upsertQ,
+		pr.PullRequestID,
+		pr.PullRequestName,
+		pr.AuthorID,
+		string(pr.Status),
+		createdAt,
+		formatNullTime(pr.MergedAt),
+	); err != nil {
+		return err
+	}
+
+	const deleteQ = `DELETE FROM pr_reviewers WHERE pr_id = ?`
+	if _, err := q.ExecContext(ctx, deleteQ, pr.PullRequestID); err != nil {
+		return err
+	}
+
+	const insertQ = `INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at) VALUES (?, ?, ?)`
+	for _, id := range pr.AssignedReviewers {
+		if _, err := q.ExecContext(ctx, insertQ, pr.PullRequestID, id, createdAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListPRs возвращает до limit PR с id больше afterID по возрастанию id.
+func (r *PRRepository) ListPRs(ctx context.Context, afterID string, limit int) ([]domain.PullRequest, error) {
+	const q = `
+		SELECT ` + prColumns + `
+		FROM pull_requests pr
+		WHERE pr.id > ?
+		ORDER BY pr.id
+		LIMIT ?
+	`
+
+	prs, err := r.queryPRs(ctx, q, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	if prs == nil {
+		prs = make([]domain.PullRequest, 0)
+	}
+
+	return prs, nil
+}
+
+func (r *PRRepository) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	const q = `
+		SELECT reviewer_id
+		FROM pr_reviewers
+		WHERE pr_id = ?
+		ORDER BY reviewer_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviewers []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		reviewers = append(reviewers, userID)
+	}
+
+	return reviewers, rows.Err()
+}
+
+// SetPRReviewers заменяет состав ревьюверов. Оставшимся ревьюверам сохраняется время назначения.
+func (r *PRRepository) SetPRReviewers(ctx context.Context, prID string, reviewers []string) error {
+	current, err := r.GetPRReviewers(ctx, prID)
+	if err != nil {
+		return err
+	}
+
+	keep := make(map[string]struct{}, len(reviewers))
+	for _, id := range reviewers {
+		keep[id] = struct{}{}
+	}
+
+	q := conn(ctx, r.db)
+
+	existing := make(map[string]struct{}, len(current))
+	const deleteQ = `DELETE FROM pr_reviewers WHERE pr_id = ? AND reviewer_id = ?`
+	for _, id := range current {
+		if _, ok := keep[id]; ok {
+			existing[id] = struct{}{}
+			continue
+		}
+		if _, err := q.ExecContext(ctx, deleteQ, prID, id); err != nil {
+			return err
+		}
+	}
+
+	now := formatTime(time.Now())
+
+	const insertQ = `INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at) VALUES (?, ?, ?)`
+	for _, id := range reviewers {
+		if _, ok := existing[id]; ok {
+			continue
+		}
+		if _, err := q.ExecContext(ctx, insertQ, prID, id, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PRRepository) GetPRsWhereReviewer(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+	const q = `
+		SELECT pr.id, pr.pull_request_name, pr.author_id, pr.status
+		FROM pull_requests pr
+		JOIN pr_reviewers r ON pr.id = r.pr_id
+		WHERE r.reviewer_id = ?
+		ORDER BY pr.created_at
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []domain.PullRequestShort
+
+	for rows.Next() {
+		var (
+			item   domain.PullRequestShort
+			status string
+		)
+
+		if err := rows.Scan(&item.PullRequestID, &item.PullRequestName, &item.AuthorID, &status); err != nil {
+			return nil, err
+		}
+		item.Status = domain.PRStatus(status)
+
+		prs = append(prs, item)
+	}
+
+	return prs, rows.Err()
+}
+
+func (r *PRRepository) GetOpenPRsByReviewers(ctx context.Context, userIDs []string) ([]domain.PullRequest, error) {
+	if len(userIDs) == 0 {
+		return []domain.PullRequest{}, nil
+	}
+
+	placeholders, args := inClause(userIDs)
+	q := `
+		SELECT DISTINCT ` + prColumns + `
+		FROM pull_requests pr
+		JOIN pr_reviewers r ON pr.id = r.pr_id
+		WHERE r.reviewer_id IN (` + placeholders + `)
+		  AND pr.status = 'OPEN'
+		ORDER BY pr.created_at
+	`
+
+	return r.queryPRs(ctx, q, args...)
+}
+
+// GetOpenPRsByTeam возвращает открытые PR, авторы которых состоят в команде teamName.
+func (r *PRRepository) GetOpenPRsByTeam(ctx context.Context, teamName string) ([]domain.PullRequest, error) {
+	const q = `
+		SELECT ` + prColumns + `
+		FROM pull_requests pr
+		JOIN users u ON u.id = pr.author_id
+		WHERE u.team_name = ?
+		  AND pr.status = 'OPEN'
+		ORDER BY pr.created_at
+	`
+
+	return r.queryPRs(ctx, q, teamName)
+}
+
+// GetAssignmentsCountByUser считает назначения по PR, попавшим под фильтр.
+// При заданной команде возвращаются только её участники.
+func (r *PRRepository) GetAssignmentsCountByUser(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentsStat, error) {
+	conds, args := statsConditions(filter)
+
+	q := `
+		WITH prs AS (
+			SELECT pr.id
+			FROM pull_requests pr
+			JOIN users a ON a.id = pr.author_id
+			WHERE 1 = 1` + conds + `
+		)
+		SELECT u.id, COUNT(p.id) AS assignments_count
+		FROM users u
+		LEFT JOIN pr_reviewers r ON u.id = r.reviewer_id
+		LEFT JOIN prs p ON p.id = r.pr_id
+	`
+	if filter.TeamName != "" {
+		args = append(args, filter.TeamName)
+		q += fmt.Sprintf(` WHERE u.team_name = ?%d`, len(args))
+	}
+	q += `
+		GROUP BY u.id
+		ORDER BY assignments_count DESC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []domain.UserAssignmentsStat
+
+	for rows.Next() {
+		var s domain.UserAssignmentsStat
+		if err := rows.Scan(&s.UserID, &s.ReviewAssignmentsCount); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, rows.Err()
+}
+
+func (r *PRRepository) GetPRStatusCounts(ctx context.Context, filter domain.StatsFilter) (domain.PRStatusCounts, error) {
+	conds, args := statsConditions(filter)
+
+	q := `
+		SELECT
+			COUNT(*) FILTER (WHERE pr.status = 'OPEN')   AS open_count,
+			COUNT(*) FILTER (WHERE pr.status = 'MERGED') AS merged_count,
+			COUNT(*)                                     AS total_count
+		FROM pull_requests pr
+		JOIN users a ON a.id = pr.author_id
+		WHERE 1 = 1` + conds
+
+	var res domain.PRStatusCounts
+	if err := conn(ctx, r.db).QueryRowContext(ctx, q, args...).Scan(&res.Open, &res.Merged, &res.Total); err != nil {
+		return domain.PRStatusCounts{}, err
+	}
+
+	return res, nil
+}
+
+// GetPRStatusCountsByTeam группирует PR по текущей команде автора. PR авторов вне команд не учитываются.
+func (r *PRRepository) GetPRStatusCountsByTeam(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	conds, args := statsConditions(filter)
+
+	q := `
+		SELECT
+			a.team_name,
+			COUNT(*) FILTER (WHERE pr.status = 'OPEN')   AS open_count,
+			COUNT(*) FILTER (WHERE pr.status = 'MERGED') AS merged_count,
+			COUNT(*)                                     AS total_count
+		FROM pull_requests pr
+		JOIN users a ON a.id = pr.author_id
+		WHERE a.team_name IS NOT NULL` + conds + `
+		GROUP BY a.team_name
+		ORDER BY a.team_name
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.TeamStats, 0)
+
+	for rows.Next() {
+		var ts domain.TeamStats
+		if err := rows.Scan(&ts.TeamName, &ts.PRStatusCounts.Open, &ts.PRStatusCounts.Merged, &ts.PRStatusCounts.Total); err != nil {
+			return nil, err
+		}
+		res = append(res, ts)
+	}
+
+	return res, rows.Err()
+}
+
+// GetPRCountsByPeriod возвращает непустые периоды (в UTC) с числом созданных и смерженных PR.
+func (r *PRRepository) GetPRCountsByPeriod(ctx context.Context, filter domain.StatsFilter, granularity domain.StatsGranularity) ([]domain.StatsBucket, error) {
+	conds, args := statsConditions(filter)
+
+	// date() понимает хранимый формат времени; 'weekday 0', '-6 days' сдвигает к понедельнику
+	bucket := func(col string) string {
+		if granularity == domain.StatsGranularityWeek {
+			return `date(` + col + `, 'weekday 0', '-6 days')`
+		}
+		return `date(` + col + `)`
+	}
+
+	q := `
+		SELECT bucket, SUM(created), SUM(merged)
+		FROM (
+			SELECT ` + bucket("pr.created_at") + ` AS bucket, 1 AS created, 0 AS merged
+			FROM pull_requests pr
+			JOIN users a ON a.id = pr.author_id
+			WHERE 1 = 1` + conds + `
+			UNION ALL
+			SELECT ` + bucket("pr.merged_at") + ` AS bucket, 0 AS created, 1 AS merged
+			FROM pull_requests pr
+			JOIN users a ON a.id = pr.author_id
+			WHERE pr.merged_at IS NOT NULL` + conds + `
+		) t
+		GROUP BY bucket
+		ORDER BY bucket
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.StatsBucket, 0)
+
+	for rows.Next() {
+		var (
+			b     domain.StatsBucket
+			start string
+		)
+		if err := rows.Scan(&start, &b.Created, &b.Merged); err != nil {
+			return nil, err
+		}
+		if b.Start, err = time.Parse(time.DateOnly, start); err != nil {
+			return nil, err
+		}
+		res = append(res, b)
+	}
+
+	return res, rows.Err()
+}
+
+// GetPRTimings возвращает сроки жизни PR под фильтром, упорядоченные по ID. Ревьюверы отсортированы.
+func (r *PRRepository) GetPRTimings(ctx context.Context, filter domain.StatsFilter) ([]domain.PRTiming, error) {
+	conds, args := statsConditions(filter)
+
+	q := `
+		SELECT
+			pr.id,
+			COALESCE(a.team_name, ''),
+			pr.created_at,
+			pr.merged_at,
+			COALESCE(group_concat(r.reviewer_id, char(31)), '')
+		FROM pull_requests pr
+		JOIN users a ON a.id = pr.author_id
+		LEFT JOIN pr_reviewers r ON r.pr_id = pr.id
+		WHERE 1 = 1` + conds + `
+		GROUP BY pr.id
+		ORDER BY pr.id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.PRTiming, 0)
+
+	for rows.Next() {
+		var (
+			t         domain.PRTiming
+			createdAt string
+			mergedAt  sql.NullString
+			reviewers string
+		)
+		if err := rows.Scan(&t.PullRequestID, &t.TeamName, &createdAt, &mergedAt, &reviewers); err != nil {
+			return nil, err
+		}
+
+		if t.CreatedAt, err = parseTime(createdAt); err != nil {
+			return nil, err
+		}
+		if t.MergedAt, err = parseNullTime(mergedAt); err != nil {
+			return nil, err
+		}
+
+		// group_concat не гарантирует порядок
+		t.Reviewers = []string{}
+		if reviewers != "" {
+			t.Reviewers = strings.Split(reviewers, "\x1f")
+			sort.Strings(t.Reviewers)
+		}
+
+		res = append(res, t)
+	}
+
+	return res, rows.Err()
+}
+
+// GetReviewerLoad считает открытые назначения и время последнего назначения. Пользователи вне команд не учитываются.
+func (r *PRRepository) GetReviewerLoad(ctx context.Context, teamName string) ([]domain.ReviewerLoad, error) {
+	const q = `
+		SELECT
+			u.id,
+			u.team_name,
+			u.is_active,
+			COUNT(*) FILTER (WHERE pr.status = 'OPEN') AS open_count,
+			MAX(r.assigned_at)                         AS last_assigned_at
+		FROM users u
+		LEFT JOIN pr_reviewers r ON r.reviewer_id = u.id
+		LEFT JOIN pull_requests pr ON pr.id = r.pr_id
+		WHERE u.team_name IS NOT NULL
+		  AND (?1 = '' OR u.team_name = ?1)
+		GROUP BY u.id, u.team_name, u.is_active
+		ORDER BY u.team_name, u.id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.ReviewerLoad, 0)
+
+	for rows.Next() {
+		var (
+			l            domain.ReviewerLoad
+			lastAssigned sql.NullString
+		)
+		if err := rows.Scan(&l.UserID, &l.TeamName, &l.IsActive, &l.OpenAssignments, &lastAssigned); err != nil {
+			return nil, err
+		}
+		if l.LastAssignedAt, err = parseNullTime(lastAssigned); err != nil {
+			return nil, err
+		}
+		res = append(res, l)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *PRRepository) GetStalePRs(ctx context.Context, teamName string, defaultAfter time.Duration, now time.Time) ([]domain.StalePR, error) {
+	// время хранится строкой, поэтому порог сравнивается уже в Go
+	const q = `
+		SELECT
+			pr.id,
+			pr.pull_request_name,
+			pr.author_id,
+			pr.status,
+			pr.created_at,
+			pr.merged_at,
+			pr.stale_at,
+			a.team_name,
+			MAX(pr.created_at, COALESCE(MAX(r.assigned_at), pr.created_at)),
+			COALESCE(s.stale_after_seconds, 0),
+			COALESCE(s.stale_auto_rotate, 0),
+			COALESCE((
+				SELECT o.reviewer_id
+				FROM pr_reviewers o
+				WHERE o.pr_id = pr.id
+				ORDER BY o.assigned_at, o.reviewer_id
+				LIMIT 1
+			), '')
+		FROM pull_requests pr
+		JOIN users a ON a.id = pr.author_id
+		LEFT JOIN team_settings s ON s.team_name = a.team_name
+		LEFT JOIN pr_reviewers r ON r.pr_id = pr.id
+		WHERE pr.status = 'OPEN'
+		  AND a.team_name IS NOT NULL
+		  AND (?1 = '' OR a.team_name = ?1)
+		GROUP BY pr.id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.StalePR
+
+	for rows.Next() {
+		var (
+			p            domain.StalePR
+			status       string
+			createdAt    string
+			mergedAt     sql.NullString
+			staleAt      sql.NullString
+			lastActivity string
+			staleAfter   int64
+		)
+
+		if err := rows.Scan(
+			&p.PullRequest.PullRequestID, &p.PullRequest.PullRequestName, &p.PullRequest.AuthorID, &status,
+			&createdAt, &mergedAt, &staleAt,
+			&p.TeamName, &lastActivity, &staleAfter, &p.AutoRotate, &p.OldestReviewerID,
+		); err != nil {
+			return nil, err
+		}
+
+		p.PullRequest.Status = domain.PRStatus(status)
+		if p.PullRequest.CreatedAt, err = parseTime(createdAt); err != nil {
+			return nil, err
+		}
+		if p.PullRequest.MergedAt, err = parseNullTime(mergedAt); err != nil {
+			return nil, err
+		}
+		if p.MarkedAt, err = parseNullTime(staleAt); err != nil {
+			return nil, err
+		}
+		if p.LastActivityAt, err = parseTime(lastActivity); err != nil {
+			return nil, err
+		}
+
+		p.StaleAfter = time.Duration(staleAfter) * time.Second
+		if p.StaleAfter == 0 {
+			p.StaleAfter = defaultAfter
+		}
+
+		if p.LastActivityAt.Add(p.StaleAfter).After(now) {
+			continue
+		}
+		res = append(res, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for i := range res {
+		reviewers, err := r.GetPRReviewers(ctx, res[i].PullRequest.PullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		res[i].PullRequest.AssignedReviewers = reviewers
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].LastActivityAt.Equal(res[j].LastActivityAt) {
+			return res[i].LastActivityAt.Before(res[j].LastActivityAt)
+		}
+		return res[i].PullRequest.PullRequestID < res[j].PullRequest.PullRequestID
+	})
+
+	return res, nil
+}
+
+func (r *PRRepository) MarkPRStale(ctx context.Context, prID string, at time.Time) error {
+	const q = `UPDATE pull_requests SET stale_at = ? WHERE id = ?`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, q, formatTime(at), prID)
+	return err
+}
+
+// statsConditions строит условия " AND ..." по фильтру для запроса с алиасами pr (PR) и a (автор).
+// Параметры нумерованные, поэтому условия можно повторить в нескольких частях запроса.
+func statsConditions(filter domain.StatsFilter) (string, []any) {
+	var (
+		sb   strings.Builder
+		args []any
+	)
+
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		fmt.Fprintf(&sb, " AND "+cond, len(args))
+	}
+
+	if filter.TeamName != "" {
+		add("a.team_name = ?%d", filter.TeamName)
+	}
+	if filter.CreatedFrom != nil {
+		add("pr.created_at >= ?%d", formatTime(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		add("pr.created_at < ?%d", formatTime(*filter.CreatedTo))
+	}
+	if filter.MergedFrom != nil {
+		add("pr.merged_at >= ?%d", formatTime(*filter.MergedFrom))
+	}
+	if filter.MergedTo != nil {
+		add("pr.merged_at < ?%d", formatTime(*filter.MergedTo))
+	}
+
+	return sb.String(), args
+}
+
+// queryPRs читает PR и затем догружает ревьюверов. Соединение с базой одно,
+// поэтому вложенные запросы выполняются только после закрытия rows.
+func (r *PRRepository) queryPRs(ctx context.Context, q string, args ...any) ([]domain.PullRequest, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []domain.PullRequest
+
+	for rows.Next() {
+		var (
+			pr        domain.PullRequest
+			status    string
+			createdAt string
+			mergedAt  sql.NullString
+		)
+
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &status, &createdAt, &mergedAt); err != nil {
+			return nil, err
+		}
+
+		pr.Status = domain.PRStatus(status)
+
+		if pr.CreatedAt, err = parseTime(createdAt); err != nil {
+			return nil, err
+		}
+		if pr.MergedAt, err = parseNullTime(mergedAt); err != nil {
+			return nil, err
+		}
+
+		prs = append(prs, pr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for i := range prs {
+		reviewers, err := r.GetPRReviewers(ctx, prs[i].PullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		prs[i].AssignedReviewers = reviewers
+	}
+
+	return prs, nil
+}
+
+// EnqueueForgeSync ставит в очередь отправку ревьюверов на git-хостинг.
+func (r *PRRepository) EnqueueForgeSync(ctx context.Context, job domain.ForgeSyncJob) error {
+	const q = `
+		INSERT INTO forge_sync_jobs (pr_id, add_reviewers, remove_reviewers, next_attempt_at, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	add, err := encodeIDs(job.Add)
+	if err != nil {
+		return err
+	}
+	remove, err := encodeIDs(job.Remove)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, q,
+		job.PullRequestID, add, remove, formatTime(job.NextAttemptAt), formatTime(time.Now()),
+	)
+	return err
+}
+
+// ListDueForgeSyncJobs возвращает до limit заданий, время попытки которых наступило к now.
+func (r *PRRepository) ListDueForgeSyncJobs(ctx context.Context, now time.Time, limit int) ([]domain.ForgeSyncJob, error) {
+	const q = `
+		SELECT id, pr_id, add_reviewers, remove_reviewers, attempts, next_attempt_at, last_error, created_at
+		FROM forge_sync_jobs
+		WHERE next_attempt_at <= ?
+		ORDER BY next_attempt_at, id
+		LIMIT ?
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, formatTime(now), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.ForgeSyncJob, 0)
+	for rows.Next() {
+		var (
+			j                    domain.ForgeSyncJob
+			add, remove          string
+			nextAttempt, created string
+		)
+		if err := rows.Scan(&j.ID, &j.PullRequestID, &add, &remove, &j.Attempts, &nextAttempt, &j.LastError, &created); err != nil {
+			return nil, err
+		}
+		if j.Add, err = decodeIDs(add); err != nil {
+			return nil, err
+		}
+		if j.Remove, err = decodeIDs(remove); err != nil {
+			return nil, err
+		}
+		if j.NextAttemptAt, err = parseTime(nextAttempt); err != nil {
+			return nil, err
+		}
+		if j.CreatedAt, err = parseTime(created); err != nil {
+			return nil, err
+		}
+		res = append(res, j)
+	}
+
+	return res, rows.Err()
+}
+
+// UpdateForgeSyncJob сохраняет счётчик попыток, время следующей попытки и последнюю ошибку.
+func (r *PRRepository) UpdateForgeSyncJob(ctx context.Context, job domain.ForgeSyncJob) error {
+	const q = `
+		UPDATE forge_sync_jobs
+		SET attempts = ?, next_attempt_at = ?, last_error = ?
+		WHERE id = ?
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, q, job.Attempts, formatTime(job.NextAttemptAt), job.LastError, job.ID)
+	return err
+}
+
+func (r *PRRepository) DeleteForgeSyncJob(ctx context.Context, id int64) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM forge_sync_jobs WHERE id = ?`, id)
+	return err
+}
+
+// encodeIDs и decodeIDs хранят список id как JSON-массив.
+func encodeIDs(ids []string) (string, error) {
+	if ids == nil {
+		ids = []string{}
+	}
+	b, err := json.Marshal(ids)
+	return string(b), err
+}
+
+func decodeIDs(s string) ([]string, error) {
+	var ids []string
+	if err := json.Unmarshal([]byte(s), &ids); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return ids, nil
+}
@@ -0,0 +1,377 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type TeamRepository struct {
+	db *sql.DB
+}
+
+func NewTeamRepository(db *sql.DB) *TeamRepository {
+	return &TeamRepository{db: db}
+}
+
+func (r *TeamRepository) CreateTeam(ctx context.Context, teamName string) error {
+	const query = `
+		INSERT INTO teams (team_name)
+		VALUES (?)
+	`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, teamName)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.NewDomainError(domain.ErrorCodeTeamExists, "team already exists")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) GetTeam(ctx context.Context, teamName string) (domain.Team, error) {
+	const q = `
+		SELECT u.id, u.username, u.is_active, u.seniority
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.team_name
+		WHERE t.team_name = ?
+		ORDER BY u.username
+	`
+
+	var res domain.Team
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, teamName)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	var (
+		foundTeam bool
+		members   []domain.TeamMember
+	)
+
+	for rows.Next() {
+		foundTeam = true
+
+		var (
+			userID    sql.NullString
+			username  sql.NullString
+			isActive  sql.NullBool
+			seniority sql.NullString
+		)
+
+		if err := rows.Scan(&userID, &username, &isActive, &seniority); err != nil {
+			return res, err
+		}
+
+		if !userID.Valid {
+			continue
+		}
+
+		members = append(members, domain.TeamMember{
+			UserID:    userID.String,
+			Username:  username.String,
+			IsActive:  isActive.Bool,
+			Seniority: domain.Seniority(seniority.String),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return res, err
+	}
+
+	if !foundTeam {
+		return res, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")
+	}
+
+	return domain.Team{
+		TeamName: teamName,
+		Members:  members,
+	}, nil
+}
+
+// ListTeamNames возвращает имена всех команд по алфавиту.
+func (r *TeamRepository) ListTeamNames(ctx context.Context) ([]string, error) {
+	const q = `SELECT team_name FROM teams ORDER BY team_name`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := make([]string, 0)
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+
+	return names, rows.Err()
+}
+
+func (r *TeamRepository) GetTeamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	const q = `
+		SELECT COALESCE(s.stale_after_seconds, 0), COALESCE(s.stale_auto_rotate, 0), COALESCE(s.reviewer_teams, '[]'),
+		       COALESCE(s.min_senior_reviewers, 0), COALESCE(s.forge_sync, 0)
+		FROM teams t
+		LEFT JOIN team_settings s ON s.team_name = t.team_name
+		WHERE t.team_name = ?
+	`
+
+	var (
+		staleAfter    int64
+		autoRotate    bool
+		reviewerTeams string
+		minSeniors    int
+		forgeSync     bool
+	)
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, q, teamName).Scan(&staleAfter, &autoRotate, &reviewerTeams, &minSeniors, &forgeSync)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TeamSettings{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")
+		}
+		return domain.TeamSettings{}, err
+	}
+
+	var teams []string
+	if err := json.Unmarshal([]byte(reviewerTeams), &teams); err != nil {
+		return domain.TeamSettings{}, err
+	}
+	if len(teams) == 0 {
+		teams = nil
+	}
+
+	return domain.TeamSettings{
+		TeamName:           teamName,
+		StaleAfter:         time.Duration(staleAfter) * time.Second,
+		StaleAutoRotate:    autoRotate,
+		ReviewerTeams:      teams,
+		MinSeniorReviewers: minSeniors,
+		ForgeSync:          forgeSync,
+	}, nil
+}
+
+func (r *TeamRepository) UpsertTeamSettings(ctx context.Context, settings domain.TeamSettings) error {
+	const q = `
+		INSERT INTO team_settings (team_name, stale_after_seconds, stale_auto_rotate, reviewer_teams, min_senior_reviewers, forge_sync)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (team_name) DO UPDATE
+		SET stale_after_seconds = excluded.stale_after_seconds,
+		    stale_auto_rotate = excluded.stale_auto_rotate,
+		    reviewer_teams = excluded.reviewer_teams,
+		    min_senior_reviewers = excluded.min_senior_reviewers,
+		    forge_sync = excluded.forge_sync,
+		    updated_at = strftime('%Y-%m-%dT%H:%M:%fZ', 'now')
+	`
+
+	reviewerTeams := settings.ReviewerTeams
+	if reviewerTeams == nil {
+		reviewerTeams = []string{}
+	}
+	encoded, err := json.Marshal(reviewerTeams)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, q,
+		settings.TeamName,
+		int64(settings.StaleAfter/time.Second),
+		settings.StaleAutoRotate,
+		string(encoded),
+		settings.MinSeniorReviewers,
+		settings.ForgeSync,
+	)
+	return err
+}
+
+func (r *TeamRepository) CreateReviewRule(ctx context.Context, rule domain.ReviewRule) (domain.ReviewRule, error) {
+	const q = `
+		INSERT INTO team_rules (team_name, rule_type, user_id, other_user_id)
+		VALUES (?, ?, ?, ?)
+		RETURNING id
+	`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, q, rule.TeamName, string(rule.Type), rule.UserID, rule.OtherUserID).Scan(&rule.ID)
+	if err != nil {
+		return domain.ReviewRule{}, err
+	}
+
+	return rule, nil
+}
+
+func (r *TeamRepository) DeleteReviewRule(ctx context.Context, id int64) error {
+	const q = `DELETE FROM team_rules WHERE id = ?`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, q, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.NewDomainError(domain.ErrorCodeNotFound, "rule not found")
+	}
+
+	return nil
+}
+
+// ListReviewRules возвращает правила команды в порядке создания.
+func (r *TeamRepository) ListReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error) {
+	const q = `
+		SELECT id, team_name, rule_type, user_id, other_user_id
+		FROM team_rules
+		WHERE team_name = ?
+		ORDER BY id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]domain.ReviewRule, 0)
+	for rows.Next() {
+		var (
+			rule     domain.ReviewRule
+			ruleType string
+		)
+		if err := rows.Scan(&rule.ID, &rule.TeamName, &ruleType, &rule.UserID, &rule.OtherUserID); err != nil {
+			return nil, err
+		}
+		rule.Type = domain.ReviewRuleType(ruleType)
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// GetCodeOwners возвращает правила владения путями в порядке следования в CODEOWNERS.
+func (r *TeamRepository) GetCodeOwners(ctx context.Context, teamName string) ([]domain.CodeOwnerRule, error) {
+	const q = `
+		SELECT pattern, owners
+		FROM team_code_owners
+		WHERE team_name = ?
+		ORDER BY position
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]domain.CodeOwnerRule, 0)
+	for rows.Next() {
+		var (
+			rule   domain.CodeOwnerRule
+			owners string
+		)
+		if err := rows.Scan(&rule.Pattern, &owners); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(owners), &rule.Owners); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// ReplaceCodeOwners целиком заменяет правила владения путями команды.
+func (r *TeamRepository) ReplaceCodeOwners(ctx context.Context, teamName string, rules []domain.CodeOwnerRule) error {
+	c := conn(ctx, r.db)
+
+	if _, err := c.ExecContext(ctx, `DELETE FROM team_code_owners WHERE team_name = ?`, teamName); err != nil {
+		return err
+	}
+
+	const q = `
+		INSERT INTO team_code_owners (team_name, position, pattern, owners)
+		VALUES (?, ?, ?, ?)
+	`
+
+	for i, rule := range rules {
+		owners := rule.Owners
+		if owners == nil {
+			owners = []string{}
+		}
+		encoded, err := json.Marshal(owners)
+		if err != nil {
+			return err
+		}
+		if _, err := c.ExecContext(ctx, q, teamName, i, rule.Pattern, string(encoded)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) GetTeamNotifications(ctx context.Context, teamName string) (domain.TeamNotifications, error) {
+	const q = `
+		SELECT COALESCE(n.webhook_url, ''), COALESCE(n.channel, ''), COALESCE(n.templates, '{}')
+		FROM teams t
+		LEFT JOIN team_notifications n ON n.team_name = t.team_name
+		WHERE t.team_name = ?
+	`
+
+	res := domain.TeamNotifications{TeamName: teamName}
+	var templates string
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, q, teamName).Scan(&res.WebhookURL, &res.Channel, &templates)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TeamNotifications{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")
+		}
+		return domain.TeamNotifications{}, err
+	}
+
+	if err := json.Unmarshal([]byte(templates), &res.Templates); err != nil {
+		return domain.TeamNotifications{}, err
+	}
+	if len(res.Templates) == 0 {
+		res.Templates = nil
+	}
+
+	return res, nil
+}
+
+func (r *TeamRepository) UpsertTeamNotifications(ctx context.Context, n domain.TeamNotifications) error {
+	const q = `
+		INSERT INTO team_notifications (team_name, webhook_url, channel, templates)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (team_name) DO UPDATE
+		SET webhook_url = excluded.webhook_url,
+		    channel = excluded.channel,
+		    templates = excluded.templates,
+		    updated_at = strftime('%Y-%m-%dT%H:%M:%fZ', 'now')
+	`
+
+	templates := n.Templates
+	if templates == nil {
+		templates = map[domain.NotificationEvent]string{}
+	}
+	encoded, err := json.Marshal(templates)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, q, n.TeamName, n.WebhookURL, n.Channel, string(encoded))
+	return err
+}
@@ -0,0 +1,484 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+type UserRepository struct {
+	db *sql.DB
+}
+
+func NewUserRepository(db *sql.DB) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+// UpsertUsers — создаёт пользователей или обновляет username / is_active.
+// Пустой Seniority не затирает уже сохранённый уровень.
+func (r *UserRepository) UpsertUsers(ctx context.Context, teamName string, members []domain.TeamMember) error {
+	const query = `
+		INSERT INTO users (id, team_name, username, is_active, seniority)
+		VALUES (?, NULLIF(?, ''), ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE
+		SET
+			team_name = excluded.team_name,
+			username = excluded.username,
+			is_active = excluded.is_active,
+			seniority = COALESCE(NULLIF(excluded.seniority, ''), users.seniority)
+	`
+
+	q := conn(ctx, r.db)
+	for _, m := range members {
+		if _, err := q.ExecContext(ctx, query, m.UserID, teamName, m.Username, m.IsActive, string(m.Seniority)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetUserByID возвращает пользователя по его id.
+func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (domain.User, error) {
+	const q = `
+		SELECT id, username, is_active, COALESCE(team_name, ''), seniority
+		FROM users
+		WHERE id = ?
+	`
+
+	var u domain.User
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, q, userID).Scan(
+		&u.UserID, &u.Username, &u.IsActive, &u.TeamName, &u.Seniority,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.User{}, domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		return domain.User{}, err
+	}
+
+	return u, nil
+}
+
+// SetUserIsActive переключает active-флаг и возвращает обновлённого пользователя.
+func (r *UserRepository) SetUserIsActive(ctx context.Context, userID string, active bool) (domain.User, error) {
+	const q = `
+		UPDATE users
+		SET is_active = ?
+		WHERE id = ?
+		RETURNING id, username, is_active, COALESCE(team_name, ''), seniority
+	`
+
+	var u domain.User
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, q, active, userID).Scan(
+		&u.UserID, &u.Username, &u.IsActive, &u.TeamName, &u.Seniority,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.User{}, domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		return domain.User{}, err
+	}
+
+	return u, nil
+}
+
+// SetUserSeniority меняет уровень пользователя и возвращает обновлённого пользователя.
+func (r *UserRepository) SetUserSeniority(ctx context.Context, userID string, seniority domain.Seniority) (domain.User, error) {
+	const q = `
+		UPDATE users
+		SET seniority = ?
+		WHERE id = ?
+		RETURNING id, username, is_active, COALESCE(team_name, ''), seniority
+	`
+
+	var u domain.User
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, q, string(seniority), userID).Scan(
+		&u.UserID, &u.Username, &u.IsActive, &u.TeamName, &u.Seniority,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.User{}, domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		return domain.User{}, err
+	}
+
+	return u, nil
+}
+
+// GetTeamMembers возвращает участников команды. Если onlyActive = true, то только активных.
+func (r *UserRepository) GetTeamMembers(ctx context.Context, teamName string, onlyActive bool) ([]domain.User, error) {
+	query := `
+		SELECT id, username, is_active, seniority
+		FROM users
+		WHERE team_name = ?
+	`
+	if onlyActive {
+		query += ` AND is_active = 1`
+	}
+	query += ` ORDER BY username`
+
+	return r.queryTeamUsers(ctx, teamName, query, teamName)
+}
+
+// DetachUsers выводит пользователей из команды: team_name обнуляется, пользователь деактивируется.
+// История PR (авторство и ревью) при этом сохраняется.
+func (r *UserRepository) DetachUsers(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	placeholders, args := inClause(userIDs)
+	q := `
+		UPDATE users
+		SET team_name = NULL,
+		    is_active = 0
+		WHERE id IN (` + placeholders + `)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, q, args...)
+	return err
+}
+
+// GetAvailableTeamMembers возвращает активных участников команды, у которых нет периода недоступности на момент at.
+func (r *UserRepository) GetAvailableTeamMembers(ctx context.Context, teamName string, at time.Time) ([]domain.User, error) {
+	const q = `
+		SELECT u.id, u.username, u.is_active, u.seniority
+		FROM users u
+		WHERE u.team_name = ?
+		  AND u.is_active = 1
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM user_availability a
+			WHERE a.user_id = u.id
+			  AND a.starts_at <= ?
+			  AND a.ends_at > ?
+		  )
+		ORDER BY u.username
+	`
+
+	ts := formatTime(at)
+	return r.queryTeamUsers(ctx, teamName, q, teamName, ts, ts)
+}
+
+// ListUsers возвращает до limit пользователей с id больше afterID по возрастанию id.
+func (r *UserRepository) ListUsers(ctx context.Context, afterID string, limit int) ([]domain.User, error) {
+	const q = `
+		SELECT id, username, is_active, COALESCE(team_name, ''), seniority
+		FROM users
+		WHERE id > ?
+		ORDER BY id
+		LIMIT ?
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(&u.UserID, &u.Username, &u.IsActive, &u.TeamName, &u.Seniority); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	return users, rows.Err()
+}
+
+func (r *UserRepository) queryTeamUsers(ctx context.Context, teamName, q string, args ...any) ([]domain.User, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+
+	for rows.Next() {
+		u := domain.User{TeamName: teamName}
+		if err := rows.Scan(&u.UserID, &u.Username, &u.IsActive, &u.Seniority); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	return users, rows.Err()
+}
+
+// GetUserTags возвращает теги пользователей по алфавиту; пользователи без тегов в результат не попадают.
+func (r *UserRepository) GetUserTags(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	res := make(map[string][]string)
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+
+	placeholders, args := inClause(userIDs)
+	q := `
+		SELECT user_id, tag
+		FROM user_tags
+		WHERE user_id IN (` + placeholders + `)
+		ORDER BY user_id, tag
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID, tag string
+		if err := rows.Scan(&userID, &tag); err != nil {
+			return nil, err
+		}
+		res[userID] = append(res[userID], tag)
+	}
+
+	return res, rows.Err()
+}
+
+// ReplaceUserTags целиком заменяет теги пользователя.
+func (r *UserRepository) ReplaceUserTags(ctx context.Context, userID string, tags []string) error {
+	c := conn(ctx, r.db)
+
+	if _, err := c.ExecContext(ctx, `DELETE FROM user_tags WHERE user_id = ?`, userID); err != nil {
+		return err
+	}
+
+	const q = `
+		INSERT INTO user_tags (user_id, tag)
+		VALUES (?, ?)
+		ON CONFLICT DO NOTHING
+	`
+
+	for _, tag := range tags {
+		if _, err := c.ExecContext(ctx, q, userID, tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListForgeAccounts возвращает сопоставления логинов хостинга forge по возрастанию логина.
+func (r *UserRepository) ListForgeAccounts(ctx context.Context, forge domain.Forge) ([]domain.ForgeAccount, error) {
+	const q = `
+		SELECT forge, login, user_id
+		FROM forge_accounts
+		WHERE forge = ?
+		ORDER BY login
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, string(forge))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.ForgeAccount, 0)
+	for rows.Next() {
+		var (
+			a     domain.ForgeAccount
+			forge string
+		)
+		if err := rows.Scan(&forge, &a.Login, &a.UserID); err != nil {
+			return nil, err
+		}
+		a.Forge = domain.Forge(forge)
+		res = append(res, a)
+	}
+
+	return res, rows.Err()
+}
+
+// UpsertForgeAccount создаёт сопоставление логина или перепривязывает его к другому пользователю.
+func (r *UserRepository) UpsertForgeAccount(ctx context.Context, account domain.ForgeAccount) error {
+	const q = `
+		INSERT INTO forge_accounts (forge, login, user_id)
+		VALUES (?, ?, ?)
+		ON CONFLICT (forge, login) DO UPDATE
+		SET user_id = excluded.user_id
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, q, string(account.Forge), account.Login, account.UserID)
+	return err
+}
+
+func (r *UserRepository) DeleteForgeAccount(ctx context.Context, forge domain.Forge, login string) error {
+	const q = `DELETE FROM forge_accounts WHERE forge = ? AND login = ?`
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, q, string(forge), login)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.NewDomainError(domain.ErrorCodeNotFound, "forge account not found")
+	}
+
+	return nil
+}
+
+// GetForgeAccountUserID возвращает id пользователя, сопоставленного логину.
+func (r *UserRepository) GetForgeAccountUserID(ctx context.Context, forge domain.Forge, login string) (string, error) {
+	const q = `SELECT user_id FROM forge_accounts WHERE forge = ? AND login = ?`
+
+	var userID string
+	if err := conn(ctx, r.db).QueryRowContext(ctx, q, string(forge), login).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.NewDomainError(domain.ErrorCodeNotFound, "forge account not found")
+		}
+		return "", err
+	}
+
+	return userID, nil
+}
+
+// GetMutedUsers возвращает тех из userIDs, кто отказался от уведомлений в чат, по возрастанию id.
+func (r *UserRepository) GetMutedUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	res := make([]string, 0)
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+
+	placeholders, args := inClause(userIDs)
+	q := `SELECT user_id FROM notification_opt_outs WHERE user_id IN (` + placeholders + `) ORDER BY user_id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		res = append(res, id)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *UserRepository) SetNotificationsMuted(ctx context.Context, userID string, muted bool) error {
+	q := `DELETE FROM notification_opt_outs WHERE user_id = ?`
+	if muted {
+		q = `INSERT INTO notification_opt_outs (user_id) VALUES (?) ON CONFLICT DO NOTHING`
+	}
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, q, userID)
+	return err
+}
+
+func (r *UserRepository) GetUserPreferences(ctx context.Context, userID string) (domain.UserPreferences, error) {
+	const q = `
+		SELECT COALESCE(p.email, ''), COALESCE(p.digest, 'off'), COALESCE(p.digest_hour, 9),
+		       COALESCE(p.digest_weekday, 1), COALESCE(p.timezone, 'UTC'), p.last_digest_at
+		FROM users u
+		LEFT JOIN user_preferences p ON p.user_id = u.id
+		WHERE u.id = ?
+	`
+
+	var (
+		p       = domain.UserPreferences{UserID: userID}
+		digest  string
+		weekday int
+		last    sql.NullString
+	)
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, q, userID).Scan(&p.Email, &digest, &p.DigestHour, &weekday, &p.Timezone, &last)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.UserPreferences{}, domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+		}
+		return domain.UserPreferences{}, err
+	}
+	p.Digest = domain.DigestSchedule(digest)
+	p.DigestWeekday = time.Weekday(weekday)
+	if p.LastDigestAt, err = parseNullTime(last); err != nil {
+		return domain.UserPreferences{}, err
+	}
+
+	return p, nil
+}
+
+func (r *UserRepository) UpsertUserPreferences(ctx context.Context, p domain.UserPreferences) error {
+	const q = `
+		INSERT INTO user_preferences (user_id, email, digest, digest_hour, digest_weekday, timezone)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE
+		SET email = excluded.email,
+		    digest = excluded.digest,
+		    digest_hour = excluded.digest_hour,
+		    digest_weekday = excluded.digest_weekday,
+		    timezone = excluded.timezone
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, q,
+		p.UserID, p.Email, string(p.Digest), p.DigestHour, int(p.DigestWeekday), p.Timezone,
+	)
+	return err
+}
+
+func (r *UserRepository) ListDigestSubscriptions(ctx context.Context) ([]domain.UserPreferences, error) {
+	const q = `
+		SELECT p.user_id, p.email, p.digest, p.digest_hour, p.digest_weekday, p.timezone, p.last_digest_at
+		FROM user_preferences p
+		JOIN users u ON u.id = p.user_id
+		WHERE u.is_active AND p.digest <> 'off' AND p.email <> ''
+		ORDER BY p.user_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]domain.UserPreferences, 0)
+	for rows.Next() {
+		var (
+			p       domain.UserPreferences
+			digest  string
+			weekday int
+			last    sql.NullString
+		)
+		if err := rows.Scan(&p.UserID, &p.Email, &digest, &p.DigestHour, &weekday, &p.Timezone, &last); err != nil {
+			return nil, err
+		}
+		p.Digest = domain.DigestSchedule(digest)
+		p.DigestWeekday = time.Weekday(weekday)
+		if p.LastDigestAt, err = parseNullTime(last); err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+
+	return res, rows.Err()
+}
+
+func (r *UserRepository) SetLastDigestAt(ctx context.Context, userID string, at time.Time) error {
+	const q = `
+		INSERT INTO user_preferences (user_id, last_digest_at) VALUES (?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET last_digest_at = excluded.last_digest_at
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, q, userID, formatTime(at))
+	return err
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+func (s *serviceImpl) CreateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.CreateAvailability",
+		trace.WithAttributes(
+			attribute.String("user.id", a.UserID),
+			attribute.String("availability.starts_at", a.StartsAt.String()),
+			attribute.String("availability.ends_at", a.EndsAt.String()),
+		),
+	)
+	defer span.End()
+
+	if _, err := s.userRepo.GetUserByID(ctx, a.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user for availability period",
+			zap.String("user_id", a.UserID),
+		)
+		return domain.Availability{}, err
+	}
+
+	created, err := s.availRepo.CreateAvailability(ctx, a)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to create availability period",
+			zap.String("user_id", a.UserID),
+		)
+		return domain.Availability{}, err
+	}
+
+	span.SetAttributes(attribute.Int64("availability.id", created.ID))
+
+	return created, nil
+}
+
+func (s *serviceImpl) UpdateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.UpdateAvailability",
+		trace.WithAttributes(
+			attribute.Int64("availability.id", a.ID),
+			attribute.String("availability.starts_at", a.StartsAt.String()),
+			attribute.String("availability.ends_at", a.EndsAt.String()),
+		),
+	)
+	defer span.End()
+
+	updated, err := s.availRepo.UpdateAvailability(ctx, a)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to update availability period",
+			zap.Int64("availability_id", a.ID),
+		)
+		return domain.Availability{}, err
+	}
+
+	return updated, nil
+}
+
+func (s *serviceImpl) DeleteAvailability(ctx context.Context, id int64) error {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.DeleteAvailability",
+		trace.WithAttributes(attribute.Int64("availability.id", id)),
+	)
+	defer span.End()
+
+	if err := s.availRepo.DeleteAvailability(ctx, id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to delete availability period",
+			zap.Int64("availability_id", id),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (s *serviceImpl) ListAvailability(ctx context.Context, userID string) ([]domain.Availability, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.ListAvailability",
+		trace.WithAttributes(attribute.String("user.id", userID)),
+	)
+	defer span.End()
+
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user for availability periods",
+			zap.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	periods, err := s.availRepo.ListAvailability(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to list availability periods",
+			zap.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("availability.count", len(periods)))
+
+	return periods, nil
+}
+
+func (s *serviceImpl) ApplyStartedAvailability(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := tracer.Start(ctx, "Service.ApplyStartedAvailability")
+	defer span.End()
+
+	periods, err := s.availRepo.GetStartedAvailability(ctx, now)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get started availability periods")
+		return 0, err
+	}
+
+	applied := 0
+	for _, p := range periods {
+		if err := s.applyAvailability(ctx, p, now); err != nil {
+			// период останется неприменённым и будет обработан на следующем запуске
+			span.RecordError(err)
+			logger.LogDomainAware(ctx, err, "failed to apply availability period",
+				zap.Int64("availability_id", p.ID),
+				zap.String("user_id", p.UserID),
+			)
+			continue
+		}
+		applied++
+	}
+
+	span.SetAttributes(
+		attribute.Int("availability.started_count", len(periods)),
+		attribute.Int("availability.applied_count", applied),
+	)
+
+	metrics.AvailabilityAppliedTotal.Add(float64(applied))
+
+	return applied, nil
+}
+
+// --------------------HELPERS----------------------
+
+func (s *serviceImpl) applyAvailability(ctx context.Context, p domain.Availability, now time.Time) error {
+	user, err := s.userRepo.GetUserByID(ctx, p.UserID)
+	if err != nil {
+		return err
+	}
+
+	var updates []prUpdate
+	if user.IsActive && user.TeamName != "" {
+		updates, err = s.prepareMemberReplacement(ctx, user.TeamName, []string{user.UserID})
+		if err != nil {
+			return err
+		}
+	}
+
+	var evs []domain.Event
+
+	err = s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		prEvs, err := s.savePRUpdates(txCtx, updates)
+		if err != nil {
+			return err
+		}
+		evs = prEvs
+
+		return s.availRepo.MarkAvailabilityApplied(txCtx, p.ID, now)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.eventsCommitted(evs...)
+	s.notifyPRUpdates(ctx, updates)
+	return nil
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/mocks"
+)
+
+type availabilityDeps struct {
+	userRepo   *mocks.MockUserRepository
+	prRepo     *mocks.MockPRRepository
+	availRepo  *mocks.MockAvailabilityRepository
+	transactor *mocks.MockTransactor
+}
+
+func newAvailabilityService(t *testing.T) (*serviceImpl, *availabilityDeps) {
+	ctrl := gomock.NewController(t)
+
+	deps := &availabilityDeps{
+		userRepo:   mocks.NewMockUserRepository(ctrl),
+		prRepo:     mocks.NewMockPRRepository(ctrl),
+		availRepo:  mocks.NewMockAvailabilityRepository(ctrl),
+		transactor: mocks.NewMockTransactor(ctrl),
+	}
+
+	s := &serviceImpl{
+		teamRepo:   teamRepoWithoutRules(ctrl),
+		userRepo:   deps.userRepo,
+		prRepo:     deps.prRepo,
+		availRepo:  deps.availRepo,
+		transactor: deps.transactor,
+	}
+
+	return s, deps
+}
+
+func TestCreateAvailability_UserNotFound(t *testing.T) {
+	s, deps := newAvailabilityService(t)
+	ctx := context.Background()
+
+	wantErr := domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u-missing").
+		Return(domain.User{}, wantErr)
+
+	res, err := s.CreateAvailability(ctx, domain.Availability{UserID: "u-missing"})
+	require.ErrorIs(t, err, wantErr)
+	require.Equal(t, domain.Availability{}, res)
+}
+
+func TestCreateAvailability_Success(t *testing.T) {
+	s, deps := newAvailabilityService(t)
+	ctx := context.Background()
+
+	start := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	input := domain.Availability{
+		UserID:   "u1",
+		StartsAt: start,
+		EndsAt:   start.Add(14 * 24 * time.Hour),
+		Reason:   "vacation",
+	}
+	created := input
+	created.ID = 7
+
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1"}, nil)
+
+	deps.availRepo.EXPECT().
+		CreateAvailability(gomock.Any(), input).
+		Return(created, nil)
+
+	res, err := s.CreateAvailability(ctx, input)
+	require.NoError(t, err)
+	require.Equal(t, created, res)
+}
+
+func TestApplyStartedAvailability_ReassignsAndMarksApplied(t *testing.T) {
+	s, deps := newAvailabilityService(t)
+	ctx := context.Background()
+	now := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	deps.availRepo.EXPECT().
+		GetStartedAvailability(gomock.Any(), now).
+		Return([]domain.Availability{{ID: 1, UserID: "u2"}}, nil)
+
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend", IsActive: true}, nil)
+
+	deps.prRepo.EXPECT().
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u2"}).
+		Return([]domain.PullRequest{
+			{PullRequestID: "pr-1", AuthorID: "u1", AssignedReviewers: []string{"u2"}},
+		}, nil)
+
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u3"}}, nil)
+
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, fn func(context.Context) error) error {
+			return fn(txCtx)
+		})
+
+	deps.prRepo.EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-1", []string{"u3"}).
+		Return(nil)
+
+	deps.availRepo.EXPECT().
+		MarkAvailabilityApplied(gomock.Any(), int64(1), now).
+		Return(nil)
+
+	applied, err := s.ApplyStartedAvailability(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, applied)
+}
+
+func TestApplyStartedAvailability_FailedPeriodIsSkipped(t *testing.T) {
+	s, deps := newAvailabilityService(t)
+	ctx := context.Background()
+	now := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	deps.availRepo.EXPECT().
+		GetStartedAvailability(gomock.Any(), now).
+		Return([]domain.Availability{{ID: 1, UserID: "u2"}, {ID: 2, UserID: "u4"}}, nil)
+
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{}, errors.New("db error"))
+
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u4").
+		Return(domain.User{UserID: "u4", TeamName: "backend", IsActive: false}, nil)
+
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, fn func(context.Context) error) error {
+			return fn(txCtx)
+		})
+
+	deps.availRepo.EXPECT().
+		MarkAvailabilityApplied(gomock.Any(), int64(2), now).
+		Return(nil)
+
+	applied, err := s.ApplyStartedAvailability(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, applied)
+}
+
+func TestApplyStartedAvailability_RepoError(t *testing.T) {
+	s, deps := newAvailabilityService(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	wantErr := errors.New("db error")
+
+	deps.availRepo.EXPECT().
+		GetStartedAvailability(gomock.Any(), now).
+		Return(nil, wantErr)
+
+	applied, err := s.ApplyStartedAvailability(ctx, now)
+	require.ErrorIs(t, err, wantErr)
+	require.Zero(t, applied)
+}
@@ -0,0 +1,349 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+func (s *serviceImpl) GetCodeOwners(ctx context.Context, teamName string) ([]domain.CodeOwnerRule, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.GetCodeOwners",
+		trace.WithAttributes(attribute.String("team.name", teamName)),
+	)
+	defer span.End()
+
+	if _, err := s.teamRepo.GetTeam(ctx, teamName); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team for code owners",
+			zap.String("team_name", teamName),
+		)
+		return nil, err
+	}
+
+	rules, err := s.teamRepo.GetCodeOwners(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get code owners",
+			zap.String("team_name", teamName),
+		)
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// UpdateCodeOwners целиком заменяет правила владения путями; владельцы должны состоять в команде.
+func (s *serviceImpl) UpdateCodeOwners(ctx context.Context, teamName string, rules []domain.CodeOwnerRule) ([]domain.CodeOwnerRule, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.UpdateCodeOwners",
+		trace.WithAttributes(
+			attribute.String("team.name", teamName),
+			attribute.Int("codeowners.rules_count", len(rules)),
+		),
+	)
+	defer span.End()
+
+	team, err := s.teamRepo.GetTeam(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team for code owners update",
+			zap.String("team_name", teamName),
+		)
+		return nil, err
+	}
+
+	if err := checkCodeOwners(team, rules); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "invalid code owners",
+			zap.String("team_name", teamName),
+		)
+		return nil, err
+	}
+
+	if err := s.replaceCodeOwners(ctx, teamName, rules); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to save code owners",
+			zap.String("team_name", teamName),
+		)
+		return nil, err
+	}
+
+	return normalizeCodeOwners(rules), nil
+}
+
+// ImportCodeOwners заменяет правила команды содержимым файла CODEOWNERS. Владелец из файла
+// (@login или login) сопоставляется с user_id или username участника команды; не найденные
+// владельцы пропускаются и перечисляются в результате.
+func (s *serviceImpl) ImportCodeOwners(ctx context.Context, teamName, content string) (domain.CodeOwnersImport, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.ImportCodeOwners",
+		trace.WithAttributes(attribute.String("team.name", teamName)),
+	)
+	defer span.End()
+
+	parsed, err := parseCodeOwners(content)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "invalid CODEOWNERS file",
+			zap.String("team_name", teamName),
+		)
+		return domain.CodeOwnersImport{}, err
+	}
+
+	team, err := s.teamRepo.GetTeam(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team for CODEOWNERS import",
+			zap.String("team_name", teamName),
+		)
+		return domain.CodeOwnersImport{}, err
+	}
+
+	res := domain.CodeOwnersImport{Rules: make([]domain.CodeOwnerRule, 0, len(parsed))}
+	ignored := make(map[string]struct{})
+
+	for _, rule := range parsed {
+		owners := make([]string, 0, len(rule.Owners))
+		for _, owner := range rule.Owners {
+			id, ok := findCodeOwner(team, owner)
+			if !ok {
+				if _, seen := ignored[owner]; !seen {
+					ignored[owner] = struct{}{}
+					res.IgnoredOwners = append(res.IgnoredOwners, owner)
+				}
+				continue
+			}
+			owners = append(owners, id)
+		}
+		res.Rules = append(res.Rules, domain.CodeOwnerRule{Pattern: rule.Pattern, Owners: owners})
+	}
+	res.Rules = normalizeCodeOwners(res.Rules)
+
+	if err := s.replaceCodeOwners(ctx, teamName, res.Rules); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to save imported code owners",
+			zap.String("team_name", teamName),
+		)
+		return domain.CodeOwnersImport{}, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("codeowners.rules_count", len(res.Rules)),
+		attribute.Int("codeowners.ignored_owners", len(res.IgnoredOwners)),
+	)
+
+	return res, nil
+}
+
+func (s *serviceImpl) replaceCodeOwners(ctx context.Context, teamName string, rules []domain.CodeOwnerRule) error {
+	return s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		return s.teamRepo.ReplaceCodeOwners(txCtx, teamName, normalizeCodeOwners(rules))
+	})
+}
+
+// pathOwners считает, сколько из changedPaths принадлежит каждому владельцу.
+// Для пути действует последнее подходящее правило, как в CODEOWNERS.
+func (s *serviceImpl) pathOwners(ctx context.Context, teamName string, changedPaths []string) (map[string]int, error) {
+	rules, err := s.teamRepo.GetCodeOwners(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	patterns := make([]*regexp.Regexp, len(rules))
+	for i, rule := range rules {
+		// шаблоны проверяются при сохранении, невалидный просто ни с чем не совпадает
+		patterns[i], _ = compileCodeOwnerPattern(rule.Pattern)
+	}
+
+	owned := make(map[string]int)
+	for _, path := range changedPaths {
+		path = strings.TrimPrefix(strings.TrimSpace(path), "/")
+		if path == "" {
+			continue
+		}
+		for i := len(rules) - 1; i >= 0; i-- {
+			if patterns[i] == nil || !patterns[i].MatchString(path) {
+				continue
+			}
+			for _, owner := range rules[i].Owners {
+				owned[owner]++
+			}
+			break
+		}
+	}
+
+	return owned, nil
+}
+
+// --------------------HELPERS----------------------
+
+func checkCodeOwners(team domain.Team, rules []domain.CodeOwnerRule) error {
+	members := make(map[string]struct{}, len(team.Members))
+	for _, m := range team.Members {
+		members[m.UserID] = struct{}{}
+	}
+
+	for _, rule := range rules {
+		if _, err := compileCodeOwnerPattern(rule.Pattern); err != nil {
+			return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("invalid pattern %q", rule.Pattern))
+		}
+		for _, owner := range rule.Owners {
+			if _, ok := members[owner]; !ok {
+				return domain.NewDomainError(domain.ErrorCodeBadRequest,
+					fmt.Sprintf("owner %q is not a member of team %q", owner, team.TeamName))
+			}
+		}
+	}
+
+	return nil
+}
+
+// normalizeCodeOwners убирает повторы владельцев внутри правила, порядок сохраняется.
+func normalizeCodeOwners(rules []domain.CodeOwnerRule) []domain.CodeOwnerRule {
+	res := make([]domain.CodeOwnerRule, 0, len(rules))
+	for _, rule := range rules {
+		owners := make([]string, 0, len(rule.Owners))
+		seen := make(map[string]struct{}, len(rule.Owners))
+		for _, owner := range rule.Owners {
+			if _, dup := seen[owner]; dup {
+				continue
+			}
+			seen[owner] = struct{}{}
+			owners = append(owners, owner)
+		}
+		res = append(res, domain.CodeOwnerRule{Pattern: rule.Pattern, Owners: owners})
+	}
+	return res
+}
+
+func findCodeOwner(team domain.Team, owner string) (string, bool) {
+	login := strings.TrimPrefix(owner, "@")
+	for _, m := range team.Members {
+		if m.UserID == login || strings.EqualFold(m.Username, login) {
+			return m.UserID, true
+		}
+	}
+	return "", false
+}
+
+// parseCodeOwners разбирает файл CODEOWNERS. Пустые строки, комментарии и заголовки секций
+// GitLab пропускаются; владельцы возвращаются как есть.
+func parseCodeOwners(content string) ([]domain.CodeOwnerRule, error) {
+	rules := make([]domain.CodeOwnerRule, 0)
+
+	for i, line := range strings.Split(content, "\n") {
+		if idx := strings.Index(line, "#"); idx >= 0 && (idx == 0 || line[idx-1] != '\\') {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "[") || strings.HasPrefix(line, "^[") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		pattern := strings.ReplaceAll(fields[0], `\#`, "#")
+		if _, err := compileCodeOwnerPattern(pattern); err != nil {
+			return nil, domain.NewDomainError(domain.ErrorCodeBadRequest,
+				fmt.Sprintf("line %d: invalid pattern %q", i+1, pattern))
+		}
+
+		rules = append(rules, domain.CodeOwnerRule{Pattern: pattern, Owners: fields[1:]})
+	}
+
+	return rules, nil
+}
+
+// compileCodeOwnerPattern переводит шаблон CODEOWNERS в регулярное выражение по правилам gitignore:
+// шаблон со слешем в начале или середине привязан к корню, иначе совпадает на любой глубине;
+// "*" и "?" не выходят за пределы сегмента, "**" — любое число сегментов; совпадение
+// с каталогом распространяется на всё его содержимое.
+func compileCodeOwnerPattern(pattern string) (*regexp.Regexp, error) {
+	if strings.TrimSpace(pattern) == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	p := strings.TrimPrefix(pattern, "/")
+	anchored := p != pattern
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+	if p == "" {
+		return regexp.Compile(`^.*$`)
+	}
+	if strings.Contains(p, "/") {
+		anchored = true
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(p); {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 3
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i += 2
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+			i++
+		case p[i] == '?':
+			b.WriteString("[^/]")
+			i++
+		default:
+			b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+			i++
+		}
+	}
+
+	last := p[strings.LastIndex(p, "/")+1:]
+	switch {
+	case dirOnly:
+		b.WriteString("/.*$")
+	case strings.ContainsAny(last, "*?"):
+		// "docs/*" — только файлы прямо в docs, как в GitHub
+		b.WriteString("$")
+	default:
+		b.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.Compile(b.String())
+}
+
+// preferOwners ставит вперёд владельцев большего числа изменённых путей, порядок среди равных сохраняется.
+func preferOwners(ordered []string, owned map[string]int) []string {
+	if len(owned) == 0 {
+		return ordered
+	}
+	res := append([]string(nil), ordered...)
+	sort.SliceStable(res, func(i, j int) bool {
+		return owned[res[i]] > owned[res[j]]
+	})
+	return res
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+func backendTeam(members ...domain.TeamMember) domain.Team {
+	return domain.Team{TeamName: "backend", Members: members}
+}
+
+func TestCompileCodeOwnerPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		match   []string
+		noMatch []string
+	}{
+		{"*", []string{"main.go", "internal/db/conn.go"}, nil},
+		{"*.go", []string{"main.go", "internal/db/conn.go"}, []string{"README.md", "go.mod"}},
+		{"/internal/db/", []string{"internal/db/conn.go", "internal/db/pg/tx.go"}, []string{"pkg/internal/db/conn.go", "internal/dbx/a.go"}},
+		{"docs/*", []string{"docs/index.md"}, []string{"docs/api/v1.md", "src/docs/index.md"}},
+		{"**/logs", []string{"logs/a.log", "build/logs/a.log"}, []string{"logsx/a.log"}},
+		{"docs", []string{"docs/index.md", "src/docs/api/v1.md"}, []string{"docsite/index.md"}},
+		{"cmd/?pi/main.go", []string{"cmd/api/main.go"}, []string{"cmd/a/pi/main.go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			re, err := compileCodeOwnerPattern(tt.pattern)
+			require.NoError(t, err)
+			for _, p := range tt.match {
+				require.True(t, re.MatchString(p), p)
+			}
+			for _, p := range tt.noMatch {
+				require.False(t, re.MatchString(p), p)
+			}
+		})
+	}
+
+	_, err := compileCodeOwnerPattern("  ")
+	require.Error(t, err)
+}
+
+func TestParseCodeOwners(t *testing.T) {
+	content := "# owners\n" +
+		"\n" +
+		"*       @alice\n" +
+		"[Backend]\n" +
+		"/internal/ @bob u3 # db team\n" +
+		"docs/\\#draft/ @carol\n"
+
+	rules, err := parseCodeOwners(content)
+	require.NoError(t, err)
+	require.Equal(t, []domain.CodeOwnerRule{
+		{Pattern: "*", Owners: []string{"@alice"}},
+		{Pattern: "/internal/", Owners: []string{"@bob", "u3"}},
+		{Pattern: "docs/#draft/", Owners: []string{"@carol"}},
+	}, rules)
+}
+
+func TestImportCodeOwners_IgnoresUnknownOwners(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "backend").
+		Return(backendTeam(
+			domain.TeamMember{UserID: "u1", Username: "Alice"},
+			domain.TeamMember{UserID: "u2", Username: "bob"},
+		), nil)
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, f func(context.Context) error) error {
+			return f(txCtx)
+		})
+
+	want := []domain.CodeOwnerRule{
+		{Pattern: "*", Owners: []string{"u1"}},
+		{Pattern: "/internal/", Owners: []string{"u2"}},
+	}
+	deps.teamRepo.EXPECT().ReplaceCodeOwners(gomock.Any(), "backend", want).Return(nil)
+
+	res, err := s.ImportCodeOwners(ctx, "backend", "* @alice @ghost\n/internal/ @bob u2 @ghost @org/team\n")
+	require.NoError(t, err)
+	require.Equal(t, want, res.Rules)
+	require.Equal(t, []string{"@ghost", "@org/team"}, res.IgnoredOwners)
+}
+
+func TestUpdateCodeOwners_OwnerNotInTeam(t *testing.T) {
+	s, deps := newTeamService(t)
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "backend").
+		Return(backendTeam(domain.TeamMember{UserID: "u1"}), nil)
+
+	_, err := s.UpdateCodeOwners(context.Background(), "backend", []domain.CodeOwnerRule{
+		{Pattern: "*.go", Owners: []string{"u1", "u9"}},
+	})
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+	require.Contains(t, derr.Message, `"u9"`)
+}
+
+func TestCreatePR_PrefersCodeOwners(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		s, deps := newTeamService(t)
+		s.seed = seed
+		deps.noReviewRules()
+		ctx := context.Background()
+
+		deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+		deps.userRepo.EXPECT().
+			GetUserByID(gomock.Any(), "u1").
+			Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+		deps.userRepo.EXPECT().
+			GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+			Return([]domain.User{
+				{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}, {UserID: "u4"}, {UserID: "u5"},
+			}, nil)
+		deps.teamRepo.EXPECT().
+			GetCodeOwners(gomock.Any(), "backend").
+			Return([]domain.CodeOwnerRule{
+				{Pattern: "*", Owners: []string{"u2"}},
+				{Pattern: "/internal/db/", Owners: []string{"u5"}},
+			}, nil)
+		deps.transactor.EXPECT().
+			WithTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(txCtx context.Context, f func(context.Context) error) error {
+				return f(txCtx)
+			})
+		deps.prRepo.EXPECT().CreatePR(gomock.Any(), gomock.Any()).Return(nil)
+
+		var assigned []string
+		deps.prRepo.EXPECT().
+			SetPRReviewers(gomock.Any(), "pr-1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, ids []string) error {
+				assigned = ids
+				return nil
+			})
+		deps.prRepo.EXPECT().
+			GetPR(gomock.Any(), "pr-1").
+			DoAndReturn(func(_ context.Context, _ string) (domain.PullRequest, error) {
+				return domain.PullRequest{PullRequestID: "pr-1", AssignedReviewers: assigned}, nil
+			})
+
+		res, _, err := s.CreatePR(ctx, "pr-1", "name", "u1", nil, []string{"internal/db/conn.go", "/internal/db/tx.go", "README.md"}, nil)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"u5", "u2"}, res.AssignedReviewers)
+	}
+}
+
+func TestPreferOwners(t *testing.T) {
+	ordered := []string{"u1", "u2", "u3", "u4"}
+
+	require.Equal(t, ordered, preferOwners(ordered, nil))
+	require.Equal(t, []string{"u3", "u4", "u1", "u2"}, preferOwners(ordered, map[string]int{"u3": 2, "u4": 1}))
+	require.Equal(t, []string{"u1", "u2", "u3", "u4"}, ordered)
+}
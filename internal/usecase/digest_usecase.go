@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+func (s *serviceImpl) GetUserPreferences(ctx context.Context, userID string) (domain.UserPreferences, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.GetUserPreferences",
+		trace.WithAttributes(attribute.String("user.id", userID)),
+	)
+	defer span.End()
+
+	p, err := s.userRepo.GetUserPreferences(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user preferences",
+			zap.String("user_id", userID),
+		)
+		return domain.UserPreferences{}, err
+	}
+
+	return p, nil
+}
+
+func (s *serviceImpl) UpdateUserPreferences(ctx context.Context, p domain.UserPreferences) (domain.UserPreferences, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.UpdateUserPreferences",
+		trace.WithAttributes(
+			attribute.String("user.id", p.UserID),
+			attribute.String("digest.schedule", string(p.Digest)),
+		),
+	)
+	defer span.End()
+
+	if _, err := s.userRepo.GetUserByID(ctx, p.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user for preferences update",
+			zap.String("user_id", p.UserID),
+		)
+		return domain.UserPreferences{}, err
+	}
+
+	p, err := normalizePreferences(p)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.UserPreferences{}, err
+	}
+
+	if err := s.userRepo.UpsertUserPreferences(ctx, p); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to update user preferences",
+			zap.String("user_id", p.UserID),
+		)
+		return domain.UserPreferences{}, err
+	}
+
+	// время последнего дайджеста хранится отдельно и не приходит в запросе
+	saved, err := s.userRepo.GetUserPreferences(ctx, p.UserID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user preferences",
+			zap.String("user_id", p.UserID),
+		)
+		return domain.UserPreferences{}, err
+	}
+
+	return saved, nil
+}
+
+// ProcessDigests рассылает дайджесты, время которых наступило. Письмо, которое не удалось
+// отправить, повторяется на следующем запуске; пользователю без открытых ревью письмо не отправляется.
+func (s *serviceImpl) ProcessDigests(ctx context.Context, now time.Time) (domain.DigestRunResult, error) {
+	ctx, span := tracer.Start(ctx, "Service.ProcessDigests")
+	defer span.End()
+
+	if s.mail == nil {
+		return domain.DigestRunResult{}, nil
+	}
+
+	subs, err := s.userRepo.ListDigestSubscriptions(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to list digest subscriptions")
+		return domain.DigestRunResult{}, err
+	}
+
+	var res domain.DigestRunResult
+	for _, p := range subs {
+		if !p.DigestDue(now) {
+			continue
+		}
+
+		sent, err := s.sendDigest(ctx, p)
+		if err != nil {
+			span.RecordError(err)
+			res.Failed++
+			metrics.DigestsFailedTotal.Inc()
+			logger.LogDomainAware(ctx, err, "failed to send digest",
+				zap.String("user_id", p.UserID),
+			)
+			continue
+		}
+
+		if err := s.userRepo.SetLastDigestAt(ctx, p.UserID, now); err != nil {
+			// письмо уже ушло, но на следующем запуске будет отправлено повторно
+			span.RecordError(err)
+			logger.LogDomainAware(ctx, err, "failed to mark digest sent",
+				zap.String("user_id", p.UserID),
+			)
+		}
+
+		if !sent {
+			res.Empty++
+			continue
+		}
+		res.Sent++
+		metrics.DigestsSentTotal.Inc()
+	}
+
+	span.SetAttributes(
+		attribute.Int("digest.subscriptions", len(subs)),
+		attribute.Int("digest.sent", res.Sent),
+		attribute.Int("digest.failed", res.Failed),
+	)
+
+	return res, nil
+}
+
+// sendDigest отправляет пользователю письмо со списком открытых PR, ждущих его ревью.
+// sent == false — таких PR нет и письмо не отправлялось.
+func (s *serviceImpl) sendDigest(ctx context.Context, p domain.UserPreferences) (sent bool, err error) {
+	user, err := s.userRepo.GetUserByID(ctx, p.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	prs, err := s.prRepo.GetPRsWhereReviewer(ctx, p.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	open := make([]domain.PullRequestShort, 0, len(prs))
+	for _, pr := range prs {
+		if pr.Status == domain.PRStatusOpen {
+			open = append(open, pr)
+		}
+	}
+	if len(open) == 0 {
+		return false, nil
+	}
+
+	if err := s.mail.Send(ctx, digestEmail(p.Email, user.Username, open)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// --------------------HELPERS----------------------
+
+func digestEmail(to, username string, prs []domain.PullRequestShort) domain.Email {
+	subject := fmt.Sprintf("%d pull requests are waiting for your review", len(prs))
+	if len(prs) == 1 {
+		subject = "1 pull request is waiting for your review"
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Hi %s,\n\n%s:\n\n", username, subject)
+	for _, pr := range prs {
+		fmt.Fprintf(&body, "- %s %q by %s\n", pr.PullRequestID, pr.PullRequestName, pr.AuthorID)
+	}
+
+	return domain.Email{To: to, Subject: subject, Body: body.String()}
+}
+
+// normalizePreferences проверяет настройки дайджеста; пустой часовой пояс заменяется на UTC.
+func normalizePreferences(p domain.UserPreferences) (domain.UserPreferences, error) {
+	p.Email = strings.TrimSpace(p.Email)
+	p.Timezone = strings.TrimSpace(p.Timezone)
+	if p.Timezone == "" {
+		p.Timezone = domain.DefaultDigestTimezone
+	}
+
+	switch p.Digest {
+	case domain.DigestOff, domain.DigestDaily, domain.DigestWeekly:
+	default:
+		return p, domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("unknown digest schedule %q", p.Digest))
+	}
+
+	if p.Email != "" {
+		addr, err := mail.ParseAddress(p.Email)
+		if err != nil || addr.Address != p.Email {
+			return p, domain.NewDomainError(domain.ErrorCodeBadRequest, "email must be a plain address like name@example.com")
+		}
+	}
+	if p.Digest != domain.DigestOff && p.Email == "" {
+		return p, domain.NewDomainError(domain.ErrorCodeBadRequest, "email is required to receive a digest")
+	}
+
+	if p.DigestHour < 0 || p.DigestHour > 23 {
+		return p, domain.NewDomainError(domain.ErrorCodeBadRequest, "digest_hour must be between 0 and 23")
+	}
+	if p.DigestWeekday < time.Sunday || p.DigestWeekday > time.Saturday {
+		return p, domain.NewDomainError(domain.ErrorCodeBadRequest, "unknown digest weekday")
+	}
+
+	// Local — часовой пояс сервера, а не пользователя
+	if _, err := time.LoadLocation(p.Timezone); err != nil || p.Timezone == "Local" {
+		return p, domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("unknown timezone %q", p.Timezone))
+	}
+
+	return p, nil
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	mock_usecase "github.com/alnoi/pr-reviewer-service/internal/mocks"
+)
+
+func TestUserPreferences_DigestDue(t *testing.T) {
+	msk, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	daily := domain.UserPreferences{Digest: domain.DigestDaily, DigestHour: 9, Timezone: "Europe/Moscow"}
+	// 2024-03-04 — понедельник; 9:00 в Москве — 6:00 UTC
+	slot := time.Date(2024, 3, 4, 9, 0, 0, 0, msk)
+
+	// только что включённый дайджест приходит за последний наступивший момент
+	require.True(t, daily.DigestDue(slot.Add(-time.Minute)))
+	require.True(t, daily.DigestDue(slot))
+	require.True(t, daily.DigestDue(slot.Add(3*time.Hour)))
+
+	sent := slot.Add(time.Minute)
+	daily.LastDigestAt = &sent
+	require.False(t, daily.DigestDue(slot.Add(3*time.Hour)))
+	require.True(t, daily.DigestDue(slot.AddDate(0, 0, 1)))
+
+	weekly := domain.UserPreferences{
+		Digest:        domain.DigestWeekly,
+		DigestHour:    9,
+		DigestWeekday: time.Wednesday,
+		Timezone:      "Europe/Moscow",
+	}
+	got, ok := weekly.DigestSlot(slot)
+	require.True(t, ok)
+	require.True(t, got.Equal(time.Date(2024, 2, 28, 9, 0, 0, 0, msk)))
+
+	// пропущенный недельный дайджест не досылается через несколько дней
+	require.False(t, weekly.DigestDue(slot))
+	require.True(t, weekly.DigestDue(slot.AddDate(0, 0, 2)))
+
+	off := domain.DefaultUserPreferences("u1")
+	require.False(t, off.DigestDue(slot))
+
+	unknown := daily
+	unknown.Timezone = "Mars/Olympus"
+	require.False(t, unknown.DigestDue(slot))
+}
+
+func TestNormalizePreferences(t *testing.T) {
+	p, err := normalizePreferences(domain.UserPreferences{
+		UserID:        "u2",
+		Email:         " bob@example.com ",
+		Digest:        domain.DigestWeekly,
+		DigestHour:    0,
+		DigestWeekday: time.Sunday,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "bob@example.com", p.Email)
+	require.Equal(t, "UTC", p.Timezone)
+
+	_, err = normalizePreferences(domain.UserPreferences{UserID: "u2", Digest: domain.DigestOff})
+	require.NoError(t, err)
+
+	base := domain.UserPreferences{UserID: "u2", Email: "bob@example.com", Digest: domain.DigestDaily, Timezone: "UTC"}
+	bad := []func(p *domain.UserPreferences){
+		func(p *domain.UserPreferences) { p.Digest = "hourly" },
+		func(p *domain.UserPreferences) { p.Digest = "" },
+		func(p *domain.UserPreferences) { p.Email = "" },
+		func(p *domain.UserPreferences) { p.Email = "Bob <bob@example.com>" },
+		func(p *domain.UserPreferences) { p.Email = "bob" },
+		func(p *domain.UserPreferences) { p.DigestHour = 24 },
+		func(p *domain.UserPreferences) { p.DigestHour = -1 },
+		func(p *domain.UserPreferences) { p.DigestWeekday = 7 },
+		func(p *domain.UserPreferences) { p.Timezone = "Mars/Olympus" },
+		func(p *domain.UserPreferences) { p.Timezone = "Local" },
+	}
+	for i, mutate := range bad {
+		p := base
+		mutate(&p)
+		_, err := normalizePreferences(p)
+
+		var derr *domain.DomainError
+		require.ErrorAs(t, err, &derr, i)
+		require.Equal(t, domain.ErrorCodeBadRequest, derr.Code, i)
+	}
+}
+
+func TestProcessDigests(t *testing.T) {
+	s, deps := newTeamService(t)
+	mail := mock_usecase.NewMockMailSender(gomock.NewController(t))
+	s.mail = mail
+	ctx := context.Background()
+	now := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	earlier := now.Add(-time.Hour)
+	yesterday := now.Add(-23 * time.Hour)
+	subs := []domain.UserPreferences{
+		{UserID: "u2", Email: "bob@example.com", Digest: domain.DigestDaily, DigestHour: 9, Timezone: "UTC"},
+		// уже получил дайджест сегодня
+		{UserID: "u3", Email: "carol@example.com", Digest: domain.DigestDaily, DigestHour: 9, Timezone: "UTC", LastDigestAt: &earlier},
+		// вчерашний дайджест отправлен, сегодняшний ещё не наступил
+		{UserID: "u4", Email: "dave@example.com", Digest: domain.DigestDaily, DigestHour: 11, Timezone: "UTC", LastDigestAt: &yesterday},
+		{UserID: "u5", Email: "eve@example.com", Digest: domain.DigestDaily, DigestHour: 9, Timezone: "UTC"},
+		{UserID: "u6", Email: "frank@example.com", Digest: domain.DigestDaily, DigestHour: 9, Timezone: "UTC"},
+	}
+	deps.userRepo.EXPECT().ListDigestSubscriptions(gomock.Any()).Return(subs, nil)
+
+	// u2: письмо с открытыми PR, смерженные не попадают
+	deps.userRepo.EXPECT().GetUserByID(gomock.Any(), "u2").Return(domain.User{UserID: "u2", Username: "Bob"}, nil)
+	deps.prRepo.EXPECT().GetPRsWhereReviewer(gomock.Any(), "u2").Return([]domain.PullRequestShort{
+		{PullRequestID: "pr-1", PullRequestName: "Add feature", AuthorID: "u1", Status: domain.PRStatusOpen},
+		{PullRequestID: "pr-2", PullRequestName: "Old", AuthorID: "u1", Status: domain.PRStatusMerged},
+		{PullRequestID: "pr-3", PullRequestName: "Fix bug", AuthorID: "u5", Status: domain.PRStatusOpen},
+	}, nil)
+	mail.EXPECT().Send(gomock.Any(), domain.Email{
+		To:      "bob@example.com",
+		Subject: "2 pull requests are waiting for your review",
+		Body: "Hi Bob,\n\n2 pull requests are waiting for your review:\n\n" +
+			"- pr-1 \"Add feature\" by u1\n" +
+			"- pr-3 \"Fix bug\" by u5\n",
+	}).Return(nil)
+	deps.userRepo.EXPECT().SetLastDigestAt(gomock.Any(), "u2", now).Return(nil)
+
+	// u5: ревью не ждёт ни один PR — письма нет, но отметка ставится
+	deps.userRepo.EXPECT().GetUserByID(gomock.Any(), "u5").Return(domain.User{UserID: "u5", Username: "Eve"}, nil)
+	deps.prRepo.EXPECT().GetPRsWhereReviewer(gomock.Any(), "u5").Return(nil, nil)
+	deps.userRepo.EXPECT().SetLastDigestAt(gomock.Any(), "u5", now).Return(nil)
+
+	// u6: ошибка SMTP — отметки нет, письмо повторится на следующем запуске
+	deps.userRepo.EXPECT().GetUserByID(gomock.Any(), "u6").Return(domain.User{UserID: "u6", Username: "Frank"}, nil)
+	deps.prRepo.EXPECT().GetPRsWhereReviewer(gomock.Any(), "u6").Return([]domain.PullRequestShort{
+		{PullRequestID: "pr-1", PullRequestName: "Add feature", AuthorID: "u1", Status: domain.PRStatusOpen},
+	}, nil)
+	mail.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("451 try again later"))
+
+	res, err := s.ProcessDigests(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, domain.DigestRunResult{Sent: 1, Empty: 1, Failed: 1}, res)
+}
+
+func TestProcessDigests_NoMailSender(t *testing.T) {
+	s, _ := newTeamService(t)
+
+	res, err := s.ProcessDigests(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.Zero(t, res)
+}
+
+func TestDigestEmail_Single(t *testing.T) {
+	email := digestEmail("bob@example.com", "Bob", []domain.PullRequestShort{
+		{PullRequestID: "pr-1", PullRequestName: "Add feature", AuthorID: "u1"},
+	})
+	require.Equal(t, "1 pull request is waiting for your review", email.Subject)
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	// eventPageSize — сколько событий читается из хранилища за раз.
+	eventPageSize = 100
+	// eventPollInterval — как часто лента проверяет хранилище без сигнала от eventHub,
+	// чтобы получать события, записанные другими экземплярами сервиса.
+	eventPollInterval = time.Second
+)
+
+// StreamEvents передаёт в fn события ленты. Без filter.AfterID отдаются только события, записанные после вызова.
+// Новые события лента перечитывает из хранилища, поэтому порядок и фильтр одинаковы для истории и для новых.
+func (s *serviceImpl) StreamEvents(ctx context.Context, filter domain.EventFilter, fn func(domain.Event) error) error {
+	attrs := []attribute.KeyValue{
+		attribute.String("events.team_name", filter.TeamName),
+		attribute.String("events.user_id", filter.UserID),
+	}
+	if filter.AfterID != nil {
+		attrs = append(attrs, attribute.Int64("events.after_id", *filter.AfterID))
+	}
+	ctx, span := tracer.Start(ctx, "Service.StreamEvents", trace.WithAttributes(attrs...))
+	defer span.End()
+
+	if s.eventRepo == nil {
+		err := errors.New("event stream is not configured")
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	if filter.AfterID != nil && *filter.AfterID < 0 {
+		derr := domain.NewDomainError(domain.ErrorCodeBadRequest, "last event id must be non-negative")
+		span.RecordError(derr)
+		span.SetStatus(codes.Error, derr.Error())
+		return derr
+	}
+
+	// подписка до чтения последнего id, чтобы не проспать событие между ними
+	wake, unsubscribe := s.events.subscribe()
+	defer unsubscribe()
+
+	var after int64
+	if filter.AfterID != nil {
+		after = *filter.AfterID
+	} else {
+		last, err := s.eventRepo.LastEventID(ctx)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to get last event id")
+			return err
+		}
+		after = last
+	}
+
+	metrics.EventStreamClients.Inc()
+	defer metrics.EventStreamClients.Dec()
+
+	ticker := time.NewTicker(eventPollInterval)
+	defer ticker.Stop()
+
+	sent := 0
+	defer func() { span.SetAttributes(attribute.Int("events.sent", sent)) }()
+
+	for {
+		page, err := s.eventRepo.ListEvents(ctx, domain.EventFilter{
+			TeamName: filter.TeamName,
+			UserID:   filter.UserID,
+			AfterID:  &after,
+		}, eventPageSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to list events",
+				zap.Int64("after_id", after),
+			)
+			return err
+		}
+
+		for _, e := range page {
+			if err := fn(e); err != nil {
+				return err
+			}
+			after = e.ID
+			sent++
+		}
+
+		if len(page) == eventPageSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// appendEvent записывает событие в ленту. Вызывается в транзакции изменения, которое событие описывает,
+// чтобы событие не терялось при сбое после коммита: ошибка записи откатывает и само изменение.
+// Пустой ev.TeamName — команда определяется по автору. Подписчиков будит eventsCommitted после коммита.
+func (s *serviceImpl) appendEvent(ctx context.Context, ev domain.Event) error {
+	if s.eventRepo == nil {
+		return nil
+	}
+
+	if ev.TeamName == "" && ev.AuthorID != "" {
+		author, err := s.userRepo.GetUserByID(ctx, ev.AuthorID)
+		if err != nil {
+			logger.LogDomainAware(ctx, err, "failed to get PR author for event",
+				zap.String("event", string(ev.Type)),
+				zap.String("pr_id", ev.PullRequestID),
+			)
+			return err
+		}
+		ev.TeamName = author.TeamName
+	}
+
+	if _, err := s.eventRepo.AppendEvent(ctx, ev); err != nil {
+		logger.LogDomainAware(ctx, err, "failed to append event",
+			zap.String("event", string(ev.Type)),
+			zap.String("pr_id", ev.PullRequestID),
+		)
+		return err
+	}
+	return nil
+}
+
+// eventsCommitted учитывает записанные в транзакции события и будит подписчиков ленты.
+func (s *serviceImpl) eventsCommitted(evs ...domain.Event) {
+	if s.eventRepo == nil || len(evs) == 0 {
+		return
+	}
+
+	for _, ev := range evs {
+		metrics.EventsPublishedTotal.WithLabelValues(string(ev.Type)).Inc()
+	}
+	s.events.broadcast()
+}
+
+// appendPRUpdateEvents записывает события об изменении ревьюверов PR из u: pr.reassigned на каждую
+// замену или pr.reviewers_set, если ревьюверов только добавили.
+func (s *serviceImpl) appendPRUpdateEvents(ctx context.Context, u prUpdate) ([]domain.Event, error) {
+	pr := domain.PullRequest{
+		PullRequestID:     u.id,
+		PullRequestName:   u.name,
+		AuthorID:          u.author,
+		AssignedReviewers: u.reviewers,
+	}
+
+	var evs []domain.Event
+	if len(u.removed) == 0 {
+		evs = append(evs, prEvent(domain.EventPRReviewersSet, pr))
+	}
+	for i, old := range u.removed {
+		ev := prEvent(domain.EventPRReassigned, pr)
+		ev.OldReviewerID = old
+		ev.NewReviewerID = u.added[i]
+		evs = append(evs, ev)
+	}
+
+	for _, ev := range evs {
+		if err := s.appendEvent(ctx, ev); err != nil {
+			return nil, err
+		}
+	}
+	return evs, nil
+}
+
+// eventHub сообщает подписчикам ленты этого экземпляра, что в хранилище появились события.
+// Сами события подписчики читают из хранилища. Методы nil-хаба ничего не делают.
+type eventHub struct {
+	mu   sync.Mutex
+	subs map[chan struct{}]struct{}
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{subs: make(map[chan struct{}]struct{})}
+}
+
+// subscribe возвращает канал сигналов и функцию отписки. Сигналы не копятся: пока подписчик
+// не прочитал предыдущий, новые отбрасываются.
+func (h *eventHub) subscribe() (<-chan struct{}, func()) {
+	if h == nil {
+		return nil, func() {}
+	}
+
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subs, ch)
+		h.mu.Unlock()
+	}
+}
+
+func (h *eventHub) broadcast() {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// --------------------HELPERS----------------------
+
+func prEvent(t domain.EventType, pr domain.PullRequest) domain.Event {
+	return domain.Event{
+		Type:            t,
+		PullRequestID:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorID:        pr.AuthorID,
+		Reviewers:       pr.AssignedReviewers,
+	}
+}
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/mocks"
+)
+
+type eventDeps struct {
+	userRepo  *mocks.MockUserRepository
+	eventRepo *mocks.MockEventRepository
+}
+
+func newEventService(t *testing.T) (*serviceImpl, *eventDeps) {
+	ctrl := gomock.NewController(t)
+
+	deps := &eventDeps{
+		userRepo:  mocks.NewMockUserRepository(ctrl),
+		eventRepo: mocks.NewMockEventRepository(ctrl),
+	}
+
+	s := &serviceImpl{
+		userRepo:  deps.userRepo,
+		eventRepo: deps.eventRepo,
+		events:    newEventHub(),
+	}
+
+	return s, deps
+}
+
+func afterID(id int64) *int64 {
+	return &id
+}
+
+func TestStreamEvents_ResumesAfterLastEventID(t *testing.T) {
+	s, deps := newEventService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deps.eventRepo.EXPECT().
+		ListEvents(gomock.Any(), domain.EventFilter{TeamName: "backend", AfterID: afterID(5)}, eventPageSize).
+		Return([]domain.Event{
+			{ID: 6, Type: domain.EventPRCreated, TeamName: "backend"},
+			{ID: 8, Type: domain.EventPRMerged, TeamName: "backend"},
+		}, nil)
+
+	var got []int64
+	err := s.StreamEvents(ctx, domain.EventFilter{TeamName: "backend", AfterID: afterID(5)}, func(e domain.Event) error {
+		got = append(got, e.ID)
+		if e.ID == 8 {
+			cancel()
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []int64{6, 8}, got)
+}
+
+func TestStreamEvents_WithoutAfterIDStreamsOnlyNewEvents(t *testing.T) {
+	s, deps := newEventService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deps.eventRepo.EXPECT().LastEventID(gomock.Any()).Return(int64(10), nil)
+	gomock.InOrder(
+		deps.eventRepo.EXPECT().
+			ListEvents(gomock.Any(), domain.EventFilter{UserID: "u2", AfterID: afterID(10)}, eventPageSize).
+			DoAndReturn(func(context.Context, domain.EventFilter, int) ([]domain.Event, error) {
+				// событие записано в этом экземпляре, пока лента ждёт
+				s.events.broadcast()
+				return nil, nil
+			}),
+		deps.eventRepo.EXPECT().
+			ListEvents(gomock.Any(), domain.EventFilter{UserID: "u2", AfterID: afterID(10)}, eventPageSize).
+			Return([]domain.Event{{ID: 11, Type: domain.EventPRReassigned, NewReviewerID: "u2"}}, nil),
+	)
+
+	var got []domain.Event
+	err := s.StreamEvents(ctx, domain.EventFilter{UserID: "u2"}, func(e domain.Event) error {
+		got = append(got, e)
+		cancel()
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, int64(11), got[0].ID)
+}
+
+func TestStreamEvents_StopsOnCallbackError(t *testing.T) {
+	s, deps := newEventService(t)
+	errGone := errors.New("client gone")
+
+	deps.eventRepo.EXPECT().
+		ListEvents(gomock.Any(), gomock.Any(), eventPageSize).
+		Return([]domain.Event{{ID: 1}, {ID: 2}}, nil)
+
+	calls := 0
+	err := s.StreamEvents(context.Background(), domain.EventFilter{AfterID: afterID(0)}, func(domain.Event) error {
+		calls++
+		return errGone
+	})
+
+	require.ErrorIs(t, err, errGone)
+	require.Equal(t, 1, calls)
+}
+
+func TestStreamEvents_NegativeAfterID(t *testing.T) {
+	s, _ := newEventService(t)
+
+	err := s.StreamEvents(context.Background(), domain.EventFilter{AfterID: afterID(-1)}, func(domain.Event) error {
+		return nil
+	})
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+}
+
+func TestAppendEvent_ResolvesAuthorTeamAndWakesSubscribersAfterCommit(t *testing.T) {
+	s, deps := newEventService(t)
+	ctx := context.Background()
+
+	wake, unsubscribe := s.events.subscribe()
+	defer unsubscribe()
+
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend"}, nil)
+	deps.eventRepo.EXPECT().
+		AppendEvent(gomock.Any(), domain.Event{
+			Type:          domain.EventPRMerged,
+			TeamName:      "backend",
+			PullRequestID: "pr-1",
+			AuthorID:      "u1",
+			Reviewers:     []string{"u2"},
+		}).
+		DoAndReturn(func(_ context.Context, ev domain.Event) (domain.Event, error) {
+			ev.ID = 1
+			return ev, nil
+		})
+
+	ev := prEvent(domain.EventPRMerged, domain.PullRequest{
+		PullRequestID:     "pr-1",
+		AuthorID:          "u1",
+		AssignedReviewers: []string{"u2"},
+	})
+	require.NoError(t, s.appendEvent(ctx, ev))
+
+	// до коммита подписчики не знают о событии
+	select {
+	case <-wake:
+		t.Fatal("subscriber was woken up before commit")
+	default:
+	}
+
+	s.eventsCommitted(ev)
+
+	select {
+	case <-wake:
+	default:
+		t.Fatal("subscriber was not woken up")
+	}
+}
+
+func TestAppendEvent_FailureIsReturned(t *testing.T) {
+	s, deps := newEventService(t)
+
+	wantErr := errors.New("db down")
+	deps.eventRepo.EXPECT().
+		AppendEvent(gomock.Any(), gomock.Any()).
+		Return(domain.Event{}, wantErr)
+
+	err := s.appendEvent(context.Background(), domain.Event{
+		Type:               domain.EventTeamDeactivated,
+		TeamName:           "backend",
+		DeactivatedUserIDs: []string{"u2"},
+	})
+	require.ErrorIs(t, err, wantErr)
+}
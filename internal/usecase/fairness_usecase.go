@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// GetFairness считает по каждой команде распределение открытых ревью между активными участниками
+// и находит тех, кому ничего не назначалось последние idleDays дней.
+// Без фильтра по команде gauges пересобираются целиком, чтобы не оставлять метрики удалённых команд.
+func (s *serviceImpl) GetFairness(ctx context.Context, teamName string, idleDays int, now time.Time) (domain.FairnessReport, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.GetFairness",
+		trace.WithAttributes(
+			attribute.String("stats.team", teamName),
+			attribute.Int("fairness.idle_days", idleDays),
+		),
+	)
+	defer span.End()
+
+	if teamName != "" {
+		if _, err := s.teamRepo.GetTeam(ctx, teamName); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to get team for fairness report",
+				zap.String("team", teamName),
+			)
+			return domain.FairnessReport{}, err
+		}
+	}
+
+	loads, err := s.prRepo.GetReviewerLoad(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get reviewer load",
+			zap.String("team", teamName),
+		)
+		return domain.FairnessReport{}, err
+	}
+
+	report := domain.FairnessReport{
+		IdleSince: now.AddDate(0, 0, -idleDays),
+		Teams:     make([]domain.TeamFairness, 0),
+	}
+
+	// loads упорядочены по команде
+	for start := 0; start < len(loads); {
+		end := start
+		for end < len(loads) && loads[end].TeamName == loads[start].TeamName {
+			end++
+		}
+		report.Teams = append(report.Teams, teamFairness(loads[start].TeamName, loads[start:end], report.IdleSince))
+		start = end
+	}
+
+	if teamName == "" {
+		metrics.ReviewLoadGini.Reset()
+		metrics.ReviewLoadSpread.Reset()
+		metrics.ReviewLoadMean.Reset()
+		metrics.ReviewIdleMembers.Reset()
+	}
+	for _, t := range report.Teams {
+		metrics.ReviewLoadGini.WithLabelValues(t.TeamName).Set(t.Gini)
+		metrics.ReviewLoadSpread.WithLabelValues(t.TeamName).Set(float64(t.Spread()))
+		metrics.ReviewLoadMean.WithLabelValues(t.TeamName).Set(t.MeanLoad)
+		metrics.ReviewIdleMembers.WithLabelValues(t.TeamName).Set(float64(len(t.IdleMembers)))
+	}
+
+	span.SetAttributes(attribute.Int("fairness.teams", len(report.Teams)))
+
+	return report, nil
+}
+
+// --------------------HELPERS----------------------
+
+func teamFairness(teamName string, loads []domain.ReviewerLoad, idleSince time.Time) domain.TeamFairness {
+	res := domain.TeamFairness{
+		TeamName:    teamName,
+		Members:     make([]domain.ReviewerLoad, 0, len(loads)),
+		IdleMembers: make([]string, 0),
+	}
+
+	values := make([]int, 0, len(loads))
+	for _, l := range loads {
+		if !l.IsActive {
+			continue
+		}
+
+		res.Members = append(res.Members, l)
+		values = append(values, l.OpenAssignments)
+
+		if l.LastAssignedAt == nil || l.LastAssignedAt.Before(idleSince) {
+			res.IdleMembers = append(res.IdleMembers, l.UserID)
+		}
+	}
+
+	res.ActiveMembers = len(values)
+	if len(values) == 0 {
+		return res
+	}
+
+	res.MinLoad, res.MaxLoad = values[0], values[0]
+	for _, v := range values {
+		res.OpenAssignments += v
+		res.MinLoad = min(res.MinLoad, v)
+		res.MaxLoad = max(res.MaxLoad, v)
+	}
+	res.MeanLoad = float64(res.OpenAssignments) / float64(len(values))
+	res.Gini = gini(values)
+
+	return res
+}
+
+// gini — средняя абсолютная разность по всем парам, делённая на удвоенное среднее.
+func gini(values []int) float64 {
+	var sum, diff float64
+	for _, a := range values {
+		sum += float64(a)
+		for _, b := range values {
+			diff += math.Abs(float64(a - b))
+		}
+	}
+	if sum == 0 {
+		return 0
+	}
+
+	n := float64(len(values))
+	return diff / (2 * n * sum)
+}
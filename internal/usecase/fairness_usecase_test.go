@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+)
+
+func TestGetFairness_Success(t *testing.T) {
+	s, deps := newStatsService(t)
+	ctx := context.Background()
+
+	now := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC)
+	recent := now.Add(-24 * time.Hour)
+	old := now.AddDate(0, 0, -30)
+
+	deps.prRepo.EXPECT().
+		GetReviewerLoad(gomock.Any(), "").
+		Return([]domain.ReviewerLoad{
+			{UserID: "u1", TeamName: "backend", IsActive: true, OpenAssignments: 3, LastAssignedAt: &recent},
+			{UserID: "u2", TeamName: "backend", IsActive: true, OpenAssignments: 1, LastAssignedAt: &old},
+			{UserID: "u3", TeamName: "backend", IsActive: true},
+			{UserID: "u4", TeamName: "backend", IsActive: false, OpenAssignments: 5, LastAssignedAt: &recent},
+			{UserID: "f1", TeamName: "frontend", IsActive: true, OpenAssignments: 2, LastAssignedAt: &recent},
+			{UserID: "f2", TeamName: "frontend", IsActive: true, OpenAssignments: 2, LastAssignedAt: &recent},
+		}, nil)
+
+	res, err := s.GetFairness(ctx, "", 14, now)
+	require.NoError(t, err)
+
+	require.Equal(t, now.AddDate(0, 0, -14), res.IdleSince)
+	require.Len(t, res.Teams, 2)
+
+	backend := res.Teams[0]
+	require.Equal(t, "backend", backend.TeamName)
+	require.Equal(t, 3, backend.ActiveMembers)
+	require.Equal(t, 4, backend.OpenAssignments)
+	require.InDelta(t, 4.0/3.0, backend.MeanLoad, 1e-9)
+	require.Equal(t, 0, backend.MinLoad)
+	require.Equal(t, 3, backend.MaxLoad)
+	require.Equal(t, 3, backend.Spread())
+	// пары: |3-1|, |3-0|, |1-0| → (2+3+1)*2 / (2*3*4)
+	require.InDelta(t, 0.5, backend.Gini, 1e-9)
+	require.Equal(t, []string{"u2", "u3"}, backend.IdleMembers)
+	require.Len(t, backend.Members, 3)
+
+	frontend := res.Teams[1]
+	require.Equal(t, 0.0, frontend.Gini)
+	require.Equal(t, 0, frontend.Spread())
+	require.Empty(t, frontend.IdleMembers)
+
+	require.Equal(t, 0.5, testutil.ToFloat64(metrics.ReviewLoadGini.WithLabelValues("backend")))
+	require.Equal(t, 3.0, testutil.ToFloat64(metrics.ReviewLoadSpread.WithLabelValues("backend")))
+	require.Equal(t, 2.0, testutil.ToFloat64(metrics.ReviewIdleMembers.WithLabelValues("backend")))
+}
+
+func TestGetFairness_NoActiveMembers(t *testing.T) {
+	s, deps := newStatsService(t)
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "backend").
+		Return(domain.Team{TeamName: "backend"}, nil)
+	deps.prRepo.EXPECT().
+		GetReviewerLoad(gomock.Any(), "backend").
+		Return([]domain.ReviewerLoad{
+			{UserID: "u1", TeamName: "backend", IsActive: false, OpenAssignments: 2},
+		}, nil)
+
+	res, err := s.GetFairness(context.Background(), "backend", 14, time.Now())
+	require.NoError(t, err)
+	require.Len(t, res.Teams, 1)
+	require.Equal(t, domain.TeamFairness{
+		TeamName:    "backend",
+		Members:     []domain.ReviewerLoad{},
+		IdleMembers: []string{},
+	}, res.Teams[0])
+}
+
+func TestGetFairness_RepoError(t *testing.T) {
+	s, deps := newStatsService(t)
+
+	wantErr := errors.New("db error")
+	deps.prRepo.EXPECT().
+		GetReviewerLoad(gomock.Any(), "").
+		Return(nil, wantErr)
+
+	_, err := s.GetFairness(context.Background(), "", 14, time.Now())
+	require.ErrorIs(t, err, wantErr)
+}
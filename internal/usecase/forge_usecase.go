@@ -0,0 +1,424 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+func (s *serviceImpl) ListForgeAccounts(ctx context.Context, forge domain.Forge) ([]domain.ForgeAccount, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.ListForgeAccounts",
+		trace.WithAttributes(attribute.String("forge", string(forge))),
+	)
+	defer span.End()
+
+	if err := checkForge(forge); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	accounts, err := s.userRepo.ListForgeAccounts(ctx, forge)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to list forge accounts",
+			zap.String("forge", string(forge)),
+		)
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// SetForgeAccount привязывает логин на хостинге к пользователю. Логины хостингов
+// нечувствительны к регистру, поэтому хранятся в нижнем.
+func (s *serviceImpl) SetForgeAccount(ctx context.Context, account domain.ForgeAccount) (domain.ForgeAccount, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.SetForgeAccount",
+		trace.WithAttributes(
+			attribute.String("forge", string(account.Forge)),
+			attribute.String("forge.login", account.Login),
+			attribute.String("user.id", account.UserID),
+		),
+	)
+	defer span.End()
+
+	account.Login = strings.ToLower(strings.TrimSpace(account.Login))
+
+	err := checkForge(account.Forge)
+	if err == nil && account.Login == "" {
+		err = domain.NewDomainError(domain.ErrorCodeBadRequest, "login is required")
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.ForgeAccount{}, err
+	}
+
+	if _, err := s.userRepo.GetUserByID(ctx, account.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user for forge account",
+			zap.String("user_id", account.UserID),
+		)
+		return domain.ForgeAccount{}, err
+	}
+
+	if err := s.userRepo.UpsertForgeAccount(ctx, account); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to save forge account",
+			zap.String("forge", string(account.Forge)),
+			zap.String("login", account.Login),
+		)
+		return domain.ForgeAccount{}, err
+	}
+
+	return account, nil
+}
+
+func (s *serviceImpl) DeleteForgeAccount(ctx context.Context, forge domain.Forge, login string) error {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.DeleteForgeAccount",
+		trace.WithAttributes(
+			attribute.String("forge", string(forge)),
+			attribute.String("forge.login", login),
+		),
+	)
+	defer span.End()
+
+	if err := checkForge(forge); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	if err := s.userRepo.DeleteForgeAccount(ctx, forge, strings.ToLower(strings.TrimSpace(login))); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to delete forge account",
+			zap.String("forge", string(forge)),
+			zap.String("login", login),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// HandleForgeEvent создаёт PR при его открытии на хостинге и мержит при мерже.
+// События, которые сервису нечем отразить (закрытие без мержа, автор без привязанного
+// логина, повторная доставка), не считаются ошибкой и возвращаются как пропущенные.
+func (s *serviceImpl) HandleForgeEvent(ctx context.Context, ev domain.ForgePREvent) (domain.ForgeEventResult, error) {
+	prID := ev.PullRequestID()
+
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.HandleForgeEvent",
+		trace.WithAttributes(
+			attribute.String("forge", string(ev.Forge)),
+			attribute.String("forge.action", string(ev.Action)),
+			attribute.String("pr.id", prID),
+		),
+	)
+	defer span.End()
+
+	ignored := func(reason string) (domain.ForgeEventResult, error) {
+		span.SetAttributes(attribute.String("forge.ignored", reason))
+		logger.FromContext(ctx).Info("forge event ignored",
+			zap.String("pr_id", prID),
+			zap.String("action", string(ev.Action)),
+			zap.String("reason", reason),
+		)
+		return domain.ForgeEventResult{Outcome: domain.ForgeEventIgnored, Reason: reason}, nil
+	}
+
+	switch ev.Action {
+	case domain.ForgePROpened:
+		login := strings.ToLower(ev.AuthorLogin)
+		authorID, err := s.userRepo.GetForgeAccountUserID(ctx, ev.Forge, login)
+		if err != nil {
+			var derr *domain.DomainError
+			if errors.As(err, &derr) && derr.Code == domain.ErrorCodeNotFound {
+				return ignored(fmt.Sprintf("no user mapped to %s login %q", ev.Forge, login))
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to map forge login",
+				zap.String("forge", string(ev.Forge)),
+				zap.String("login", login),
+			)
+			return domain.ForgeEventResult{}, err
+		}
+
+		pr, _, err := s.CreatePR(ctx, prID, ev.Title, authorID, nil, nil, nil)
+		if err != nil {
+			var derr *domain.DomainError
+			if errors.As(err, &derr) && derr.Code == domain.ErrorCodePRExists {
+				return ignored("pull request already exists")
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return domain.ForgeEventResult{}, err
+		}
+		return domain.ForgeEventResult{Outcome: domain.ForgeEventCreated, PR: &pr}, nil
+
+	case domain.ForgePRMerged:
+		pr, err := s.MergePR(ctx, prID)
+		if err != nil {
+			var derr *domain.DomainError
+			if errors.As(err, &derr) && derr.Code == domain.ErrorCodeNotFound {
+				return ignored("pull request is unknown")
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return domain.ForgeEventResult{}, err
+		}
+		return domain.ForgeEventResult{Outcome: domain.ForgeEventMerged, PR: &pr}, nil
+
+	default:
+		return ignored("pull request closed without merge")
+	}
+}
+
+const (
+	// forgeSyncBatch — сколько заданий отправки обрабатывается за один проход
+	forgeSyncBatch = 100
+	// maxForgeSyncAttempts — после стольких неудачных попыток задание отбрасывается
+	maxForgeSyncAttempts = 10
+
+	forgeSyncBaseDelay = 30 * time.Second
+	forgeSyncMaxDelay  = time.Hour
+)
+
+// ProcessForgeSyncJobs отправляет на хостинги задания из очереди, время попытки которых наступило.
+// Ревьюверы без привязанного логина пропускаются. Сетевые ошибки и ответы 5xx повторяются
+// с экспоненциальной задержкой, отказ хостинга (domain.ErrForgeRejected) отбрасывает задание сразу.
+func (s *serviceImpl) ProcessForgeSyncJobs(ctx context.Context, now time.Time) (domain.ForgeSyncRunResult, error) {
+	ctx, span := tracer.Start(ctx, "Service.ProcessForgeSyncJobs")
+	defer span.End()
+
+	jobs, err := s.prRepo.ListDueForgeSyncJobs(ctx, now, forgeSyncBatch)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to list forge sync jobs")
+		return domain.ForgeSyncRunResult{}, err
+	}
+
+	var res domain.ForgeSyncRunResult
+	logins := make(map[domain.Forge]map[string]string)
+
+	for _, job := range jobs {
+		err := s.sendForgeSyncJob(ctx, job, logins)
+
+		switch {
+		case err == nil:
+			res.Sent++
+			metrics.ForgeSyncSentTotal.Inc()
+			if err := s.prRepo.DeleteForgeSyncJob(ctx, job.ID); err != nil {
+				// задание отправится повторно, для хостинга это безвредно
+				logger.LogDomainAware(ctx, err, "failed to delete sent forge sync job",
+					zap.Int64("job_id", job.ID),
+				)
+			}
+			continue
+
+		case errors.Is(err, domain.ErrForgeRejected) || job.Attempts+1 >= maxForgeSyncAttempts:
+			res.Dropped++
+			metrics.ForgeSyncDroppedTotal.Inc()
+			logger.FromContext(ctx).Warn("forge sync job dropped",
+				zap.Int64("job_id", job.ID),
+				zap.String("pr_id", job.PullRequestID),
+				zap.Int("attempts", job.Attempts+1),
+				zap.Error(err),
+			)
+			if err := s.prRepo.DeleteForgeSyncJob(ctx, job.ID); err != nil {
+				logger.LogDomainAware(ctx, err, "failed to delete dropped forge sync job",
+					zap.Int64("job_id", job.ID),
+				)
+			}
+			continue
+		}
+
+		job.Attempts++
+		job.NextAttemptAt = now.Add(forgeSyncDelay(job.Attempts))
+		job.LastError = err.Error()
+
+		res.Retried++
+		logger.FromContext(ctx).Info("forge sync job failed, will retry",
+			zap.Int64("job_id", job.ID),
+			zap.String("pr_id", job.PullRequestID),
+			zap.Int("attempts", job.Attempts),
+			zap.Time("next_attempt_at", job.NextAttemptAt),
+			zap.Error(err),
+		)
+		if err := s.prRepo.UpdateForgeSyncJob(ctx, job); err != nil {
+			logger.LogDomainAware(ctx, err, "failed to reschedule forge sync job",
+				zap.Int64("job_id", job.ID),
+			)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("forge_sync.jobs", len(jobs)),
+		attribute.Int("forge_sync.sent", res.Sent),
+		attribute.Int("forge_sync.retried", res.Retried),
+		attribute.Int("forge_sync.dropped", res.Dropped),
+	)
+
+	return res, nil
+}
+
+// sendForgeSyncJob сначала снимает с PR ревьюверов из job.Remove, потом запрашивает всех текущих.
+// Состав берётся из хранилища в момент отправки, а не из задания: задания одного PR повторяются
+// независимо и могут отправиться не по порядку, но каждое приводит хостинг к актуальному составу.
+// logins — кэш сопоставления пользователей с логинами на время одного прохода.
+func (s *serviceImpl) sendForgeSyncJob(ctx context.Context, job domain.ForgeSyncJob, logins map[domain.Forge]map[string]string) error {
+	forge, repo, number, ok := domain.ParseForgePRID(job.PullRequestID)
+	if !ok {
+		return fmt.Errorf("%w: pull request %q does not come from a forge", domain.ErrForgeRejected, job.PullRequestID)
+	}
+	client, ok := s.forgeClients[forge]
+	if !ok {
+		return fmt.Errorf("%w: no client configured for %s", domain.ErrForgeRejected, forge)
+	}
+
+	pr, err := s.prRepo.GetPR(ctx, job.PullRequestID)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) && derr.Code == domain.ErrorCodeNotFound {
+			return fmt.Errorf("%w: pull request %q is unknown", domain.ErrForgeRejected, job.PullRequestID)
+		}
+		return err
+	}
+
+	remove := subtractIDs(job.Remove, pr.AssignedReviewers)
+	// у смерженного PR запрашивать ревью уже незачем
+	var add []string
+	if pr.Status == domain.PRStatusOpen {
+		add = pr.AssignedReviewers
+	}
+
+	byUser, ok := logins[forge]
+	if !ok {
+		accounts, err := s.userRepo.ListForgeAccounts(ctx, forge)
+		if err != nil {
+			return err
+		}
+		byUser = make(map[string]string, len(accounts))
+		for _, a := range accounts {
+			if _, dup := byUser[a.UserID]; !dup {
+				byUser[a.UserID] = a.Login
+			}
+		}
+		logins[forge] = byUser
+	}
+
+	if remove := mapLogins(remove, byUser); len(remove) > 0 {
+		if err := client.RemoveReviewers(ctx, repo, number, remove); err != nil {
+			return err
+		}
+	}
+	if add := mapLogins(add, byUser); len(add) > 0 {
+		if err := client.RequestReviewers(ctx, repo, number, add); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// forgeSyncEnabled сообщает, нужно ли отправлять ревьюверов PR на хостинг: PR пришёл из вебхука,
+// клиент этого хостинга настроен и команда автора включила ForgeSync.
+func (s *serviceImpl) forgeSyncEnabled(ctx context.Context, prID, teamName string) (bool, error) {
+	forge, _, _, ok := domain.ParseForgePRID(prID)
+	if !ok || teamName == "" {
+		return false, nil
+	}
+	if _, ok := s.forgeClients[forge]; !ok {
+		return false, nil
+	}
+
+	settings, err := s.teamRepo.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return false, err
+	}
+	return settings.ForgeSync, nil
+}
+
+// prForgeSyncEnabled — forgeSyncEnabled для существующего PR; команду автора узнаём, только если PR из вебхука.
+func (s *serviceImpl) prForgeSyncEnabled(ctx context.Context, pr domain.PullRequest) (bool, error) {
+	forge, _, _, ok := domain.ParseForgePRID(pr.PullRequestID)
+	if !ok {
+		return false, nil
+	}
+	if _, ok := s.forgeClients[forge]; !ok {
+		return false, nil
+	}
+
+	author, err := s.userRepo.GetUserByID(ctx, pr.AuthorID)
+	if err != nil {
+		return false, err
+	}
+	return s.forgeSyncEnabled(ctx, pr.PullRequestID, author.TeamName)
+}
+
+func (s *serviceImpl) enqueueForgeSync(ctx context.Context, prID string, add, remove []string) error {
+	err := s.prRepo.EnqueueForgeSync(ctx, domain.ForgeSyncJob{
+		PullRequestID: prID,
+		Add:           add,
+		Remove:        remove,
+		NextAttemptAt: time.Now(),
+	})
+	if err != nil {
+		logger.LogDomainAware(ctx, err, "failed to enqueue forge sync",
+			zap.String("pr_id", prID),
+		)
+	}
+	return err
+}
+
+// --------------------HELPERS----------------------
+
+// forgeSyncDelay — задержка перед попыткой attempts+1: 30s, 1m, 2m, … но не больше часа.
+func forgeSyncDelay(attempts int) time.Duration {
+	d := forgeSyncBaseDelay
+	for i := 1; i < attempts && d < forgeSyncMaxDelay; i++ {
+		d *= 2
+	}
+	return min(d, forgeSyncMaxDelay)
+}
+
+func mapLogins(userIDs []string, byUser map[string]string) []string {
+	res := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if login, ok := byUser[id]; ok {
+			res = append(res, login)
+		}
+	}
+	return res
+}
+
+func checkForge(forge domain.Forge) error {
+	switch forge {
+	case domain.ForgeGitHub, domain.ForgeGitLab:
+		return nil
+	}
+	return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("unknown forge %q", forge))
+}
@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	mock_usecase "github.com/alnoi/pr-reviewer-service/internal/mocks"
+)
+
+func githubOpened() domain.ForgePREvent {
+	return domain.ForgePREvent{
+		Forge:       domain.ForgeGitHub,
+		Action:      domain.ForgePROpened,
+		Repo:        "acme/billing",
+		Number:      42,
+		Title:       "Add invoice export",
+		AuthorLogin: "Alice-Dev",
+	}
+}
+
+func TestForgePREvent_PullRequestID(t *testing.T) {
+	ev := githubOpened()
+	require.Equal(t, "github:acme/billing#42", ev.PullRequestID())
+
+	ev.Forge = domain.ForgeGitLab
+	require.Equal(t, "gitlab:acme/billing!42", ev.PullRequestID())
+}
+
+func TestSetForgeAccount(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1"}, nil)
+	deps.userRepo.EXPECT().
+		UpsertForgeAccount(gomock.Any(), domain.ForgeAccount{Forge: domain.ForgeGitHub, Login: "alice-dev", UserID: "u1"}).
+		Return(nil)
+
+	res, err := s.SetForgeAccount(ctx, domain.ForgeAccount{Forge: domain.ForgeGitHub, Login: " Alice-Dev ", UserID: "u1"})
+	require.NoError(t, err)
+	require.Equal(t, "alice-dev", res.Login)
+
+	for _, bad := range []domain.ForgeAccount{
+		{Forge: "bitbucket", Login: "alice", UserID: "u1"},
+		{Forge: domain.ForgeGitLab, Login: " ", UserID: "u1"},
+	} {
+		_, err := s.SetForgeAccount(ctx, bad)
+
+		var derr *domain.DomainError
+		require.ErrorAs(t, err, &derr)
+		require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+	}
+}
+
+func TestHandleForgeEvent_UnmappedAuthorIgnored(t *testing.T) {
+	s, deps := newTeamService(t)
+
+	deps.userRepo.EXPECT().
+		GetForgeAccountUserID(gomock.Any(), domain.ForgeGitHub, "alice-dev").
+		Return("", domain.NewDomainError(domain.ErrorCodeNotFound, "forge account not found"))
+
+	res, err := s.HandleForgeEvent(context.Background(), githubOpened())
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeEventIgnored, res.Outcome)
+	require.Contains(t, res.Reason, `"alice-dev"`)
+	require.Nil(t, res.PR)
+}
+
+func TestHandleForgeEvent_RedeliveryIgnored(t *testing.T) {
+	s, deps := newTeamService(t)
+
+	deps.userRepo.EXPECT().
+		GetForgeAccountUserID(gomock.Any(), domain.ForgeGitHub, "alice-dev").
+		Return("u1", nil)
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "github:acme/billing#42").Return(true, nil)
+
+	res, err := s.HandleForgeEvent(context.Background(), githubOpened())
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeEventIgnored, res.Outcome)
+}
+
+func TestHandleForgeEvent_Merged(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	ev := githubOpened()
+	ev.Action = domain.ForgePRMerged
+
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "github:acme/billing#42").
+		Return(domain.PullRequest{PullRequestID: "github:acme/billing#42", Status: domain.PRStatusMerged}, nil)
+
+	res, err := s.HandleForgeEvent(ctx, ev)
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeEventMerged, res.Outcome)
+	require.Equal(t, domain.PRStatusMerged, res.PR.Status)
+
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "github:acme/billing#42").
+		Return(domain.PullRequest{}, domain.NewDomainError(domain.ErrorCodeNotFound, "PR not found"))
+
+	res, err = s.HandleForgeEvent(ctx, ev)
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeEventIgnored, res.Outcome)
+
+	ev.Action = domain.ForgePRClosed
+	res, err = s.HandleForgeEvent(ctx, ev)
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeEventIgnored, res.Outcome)
+}
+
+func TestForgeSyncDelay(t *testing.T) {
+	require.Equal(t, 30*time.Second, forgeSyncDelay(1))
+	require.Equal(t, time.Minute, forgeSyncDelay(2))
+	require.Equal(t, 4*time.Minute, forgeSyncDelay(4))
+	require.Equal(t, time.Hour, forgeSyncDelay(8))
+	require.Equal(t, time.Hour, forgeSyncDelay(maxForgeSyncAttempts))
+}
+
+func TestProcessForgeSyncJobs(t *testing.T) {
+	s, deps := newTeamService(t)
+	client := mock_usecase.NewMockForgeClient(gomock.NewController(t))
+	s.forgeClients = map[domain.Forge]ForgeClient{domain.ForgeGitHub: client}
+	ctx := context.Background()
+	now := time.Now()
+
+	jobs := []domain.ForgeSyncJob{
+		{ID: 1, PullRequestID: "github:acme/billing#42", Add: []string{"u2", "u9"}, Remove: []string{"u3"}},
+		{ID: 2, PullRequestID: "github:acme/billing#43", Add: []string{"u2"}, Attempts: 2},
+		{ID: 3, PullRequestID: "github:acme/billing#44", Add: []string{"u2"}},
+		{ID: 4, PullRequestID: "github:acme/billing#45", Add: []string{"u2"}, Attempts: maxForgeSyncAttempts - 1},
+		{ID: 5, PullRequestID: "gitlab:acme/billing!7", Add: []string{"u2"}},
+		// устаревшее задание: u3 уже заменили обратно на u2
+		{ID: 6, PullRequestID: "github:acme/billing#46", Add: []string{"u3"}, Remove: []string{"u2"}},
+		{ID: 7, PullRequestID: "github:acme/billing#47", Add: []string{"u2"}, Remove: []string{"u3"}},
+		{ID: 8, PullRequestID: "github:acme/billing#48", Add: []string{"u2"}},
+	}
+	deps.prRepo.EXPECT().ListDueForgeSyncJobs(gomock.Any(), now, forgeSyncBatch).Return(jobs, nil)
+	for _, pr := range []domain.PullRequest{
+		{PullRequestID: "github:acme/billing#42", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u2", "u9"}},
+		{PullRequestID: "github:acme/billing#43", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u2"}},
+		{PullRequestID: "github:acme/billing#44", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u2"}},
+		{PullRequestID: "github:acme/billing#45", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u2"}},
+		{PullRequestID: "github:acme/billing#46", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u2"}},
+		{PullRequestID: "github:acme/billing#47", Status: domain.PRStatusMerged, AssignedReviewers: []string{"u2"}},
+	} {
+		deps.prRepo.EXPECT().GetPR(gomock.Any(), pr.PullRequestID).Return(pr, nil)
+	}
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "github:acme/billing#48").
+		Return(domain.PullRequest{}, domain.NewDomainError(domain.ErrorCodeNotFound, "PR not found"))
+	deps.userRepo.EXPECT().
+		ListForgeAccounts(gomock.Any(), domain.ForgeGitHub).
+		Return([]domain.ForgeAccount{
+			{Forge: domain.ForgeGitHub, Login: "bob", UserID: "u2"},
+			{Forge: domain.ForgeGitHub, Login: "carol", UserID: "u3"},
+		}, nil)
+
+	// 1: отправлено, u9 без логина пропущен
+	gomock.InOrder(
+		client.EXPECT().RemoveReviewers(gomock.Any(), "acme/billing", int64(42), []string{"carol"}).Return(nil),
+		client.EXPECT().RequestReviewers(gomock.Any(), "acme/billing", int64(42), []string{"bob"}).Return(nil),
+	)
+	deps.prRepo.EXPECT().DeleteForgeSyncJob(gomock.Any(), int64(1)).Return(nil)
+
+	// 2: временная ошибка — повтор позже
+	client.EXPECT().RequestReviewers(gomock.Any(), "acme/billing", int64(43), []string{"bob"}).Return(errors.New("502 Bad Gateway"))
+	deps.prRepo.EXPECT().
+		UpdateForgeSyncJob(gomock.Any(), domain.ForgeSyncJob{
+			ID:            2,
+			PullRequestID: "github:acme/billing#43",
+			Add:           []string{"u2"},
+			Attempts:      3,
+			NextAttemptAt: now.Add(2 * time.Minute),
+			LastError:     "502 Bad Gateway",
+		}).
+		Return(nil)
+
+	// 3: хостинг отказал — задание отбрасывается
+	client.EXPECT().
+		RequestReviewers(gomock.Any(), "acme/billing", int64(44), []string{"bob"}).
+		Return(fmt.Errorf("%w: 422", domain.ErrForgeRejected))
+	deps.prRepo.EXPECT().DeleteForgeSyncJob(gomock.Any(), int64(3)).Return(nil)
+
+	// 4: последняя попытка
+	client.EXPECT().RequestReviewers(gomock.Any(), "acme/billing", int64(45), []string{"bob"}).Return(errors.New("timeout"))
+	deps.prRepo.EXPECT().DeleteForgeSyncJob(gomock.Any(), int64(4)).Return(nil)
+
+	// 5: клиента GitLab нет
+	deps.prRepo.EXPECT().DeleteForgeSyncJob(gomock.Any(), int64(5)).Return(nil)
+
+	// 6: запрашивается текущий состав, u2 не снимается
+	client.EXPECT().RequestReviewers(gomock.Any(), "acme/billing", int64(46), []string{"bob"}).Return(nil)
+	deps.prRepo.EXPECT().DeleteForgeSyncJob(gomock.Any(), int64(6)).Return(nil)
+
+	// 7: у смерженного PR только снимаются ушедшие
+	client.EXPECT().RemoveReviewers(gomock.Any(), "acme/billing", int64(47), []string{"carol"}).Return(nil)
+	deps.prRepo.EXPECT().DeleteForgeSyncJob(gomock.Any(), int64(7)).Return(nil)
+
+	// 8: PR нет в хранилище
+	deps.prRepo.EXPECT().DeleteForgeSyncJob(gomock.Any(), int64(8)).Return(nil)
+
+	res, err := s.ProcessForgeSyncJobs(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Sent: 3, Retried: 1, Dropped: 4}, res)
+}
+
+func TestReassignReviewer_EnqueuesForgeSync(t *testing.T) {
+	s, deps := newTeamService(t)
+	client := mock_usecase.NewMockForgeClient(gomock.NewController(t))
+	s.forgeClients = map[domain.Forge]ForgeClient{domain.ForgeGitHub: client}
+	ctx := context.Background()
+
+	const prID = "github:acme/billing#42"
+	pr := domain.PullRequest{
+		PullRequestID:     prID,
+		AuthorID:          "u1",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"u2", "u3"},
+	}
+
+	deps.prRepo.EXPECT().GetPR(gomock.Any(), prID).Return(pr, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u3").
+		Return(domain.User{UserID: "u3", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}, {UserID: "u4"}}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	deps.teamRepo.EXPECT().ListReviewRules(gomock.Any(), "backend").Return(nil, nil)
+	deps.teamRepo.EXPECT().
+		GetTeamSettings(gomock.Any(), "backend").
+		Return(domain.TeamSettings{TeamName: "backend", ForgeSync: true}, nil).
+		Times(2)
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	deps.prRepo.EXPECT().SetPRReviewers(gomock.Any(), prID, []string{"u2", "u4"}).Return(nil)
+	deps.prRepo.EXPECT().
+		EnqueueForgeSync(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, job domain.ForgeSyncJob) error {
+			require.Equal(t, prID, job.PullRequestID)
+			require.Equal(t, []string{"u4"}, job.Add)
+			require.Equal(t, []string{"u3"}, job.Remove)
+			require.False(t, job.NextAttemptAt.IsZero())
+			return nil
+		})
+
+	_, newID, err := s.ReassignReviewer(ctx, prID, "u3")
+	require.NoError(t, err)
+	require.Equal(t, "u4", newID)
+}
@@ -0,0 +1,310 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// importBatchSize — сколько записей импорта применяется в одной транзакции.
+const importBatchSize = 500
+
+// exportPageSize — сколько пользователей или PR экспорт читает из хранилища за один запрос.
+const exportPageSize = 500
+
+func (s *serviceImpl) ImportData(ctx context.Context, records []domain.ImportRecord) (domain.ImportResult, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.ImportData",
+		trace.WithAttributes(attribute.Int("import.records", len(records))),
+	)
+	defer span.End()
+
+	var res domain.ImportResult
+
+	for start := 0; start < len(records); start += importBatchSize {
+		if err := ctx.Err(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return res, err
+		}
+
+		batch := records[start:min(start+importBatchSize, len(records))]
+
+		batchRes, err := s.importBatch(ctx, batch)
+		if err == nil {
+			mergeImportResult(&res, batchRes)
+			continue
+		}
+
+		// сбой хранилища откатывает всю пачку: применяем её построчно, чтобы отделить проблемные строки
+		logger.LogDomainAware(ctx, err, "import batch failed, retrying line by line",
+			zap.Int("first_line", batch[0].Line),
+			zap.Int("batch_size", len(batch)),
+		)
+
+		for i := range batch {
+			lineRes, err := s.importBatch(ctx, batch[i:i+1])
+			if err != nil {
+				lineRes = domain.ImportResult{Errors: []domain.ImportLineError{{
+					Line:    batch[i].Line,
+					Code:    domain.ErrorCodeInternal,
+					Message: err.Error(),
+				}}}
+			}
+			mergeImportResult(&res, lineRes)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("import.teams", res.Teams),
+		attribute.Int("import.users", res.Users),
+		attribute.Int("import.pull_requests", res.PullRequests),
+		attribute.Int("import.errors", len(res.Errors)),
+	)
+
+	logger.FromContext(ctx).Info("import finished",
+		zap.Int("teams", res.Teams),
+		zap.Int("users", res.Users),
+		zap.Int("pull_requests", res.PullRequests),
+		zap.Int("errors", len(res.Errors)),
+	)
+
+	return res, nil
+}
+
+// importBatch применяет записи в одной транзакции. Некорректные записи пропускаются,
+// ошибка возвращается только при сбое хранилища — тогда пачка откатывается целиком.
+func (s *serviceImpl) importBatch(ctx context.Context, batch []domain.ImportRecord) (domain.ImportResult, error) {
+	var res domain.ImportResult
+
+	err := s.transactor.WithTx(ctx, func(ctx context.Context) error {
+		res = domain.ImportResult{}
+
+		for _, rec := range batch {
+			err := s.importRecord(ctx, rec)
+
+			var derr *domain.DomainError
+			switch {
+			case errors.As(err, &derr):
+				res.Errors = append(res.Errors, domain.ImportLineError{
+					Line:    rec.Line,
+					Code:    derr.Code,
+					Message: derr.Message,
+				})
+				continue
+			case err != nil:
+				return err
+			}
+
+			switch rec.Type {
+			case domain.ImportRecordTeam:
+				res.Teams++
+			case domain.ImportRecordUser:
+				res.Users++
+			case domain.ImportRecordPullRequest:
+				res.PullRequests++
+			}
+		}
+
+		return nil
+	})
+
+	return res, err
+}
+
+func (s *serviceImpl) importRecord(ctx context.Context, rec domain.ImportRecord) error {
+	switch rec.Type {
+	case domain.ImportRecordTeam:
+		return s.importTeam(ctx, rec.TeamName)
+	case domain.ImportRecordUser:
+		return s.importUser(ctx, rec.User)
+	case domain.ImportRecordPullRequest:
+		return s.importPR(ctx, rec.PR)
+	default:
+		return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("unknown record type %q", rec.Type))
+	}
+}
+
+// importTeam создаёт команду, если её ещё нет.
+func (s *serviceImpl) importTeam(ctx context.Context, teamName string) error {
+	if teamName == "" {
+		return domain.NewDomainError(domain.ErrorCodeBadRequest, "team_name is required")
+	}
+
+	_, err := s.teamRepo.GetTeam(ctx, teamName)
+	if err == nil || !isNotFound(err) {
+		return err
+	}
+
+	return s.teamRepo.CreateTeam(ctx, teamName)
+}
+
+// importUser создаёт или обновляет пользователя. Пустая команда — пользователь вне команд.
+func (s *serviceImpl) importUser(ctx context.Context, u domain.User) error {
+	if u.UserID == "" || u.Username == "" {
+		return domain.NewDomainError(domain.ErrorCodeBadRequest, "user_id and username are required")
+	}
+
+	if u.TeamName != "" {
+		if _, err := s.teamRepo.GetTeam(ctx, u.TeamName); err != nil {
+			return notFoundAs(err, fmt.Sprintf("team %q not found", u.TeamName))
+		}
+	}
+
+	return s.userRepo.UpsertUsers(ctx, u.TeamName, []domain.TeamMember{{
+		UserID:   u.UserID,
+		Username: u.Username,
+		IsActive: u.IsActive,
+	}})
+}
+
+func (s *serviceImpl) importPR(ctx context.Context, pr domain.PullRequest) error {
+	if err := validateImportPR(pr); err != nil {
+		return err
+	}
+
+	if _, err := s.userRepo.GetUserByID(ctx, pr.AuthorID); err != nil {
+		return notFoundAs(err, fmt.Sprintf("author %q not found", pr.AuthorID))
+	}
+	for _, id := range pr.AssignedReviewers {
+		if _, err := s.userRepo.GetUserByID(ctx, id); err != nil {
+			return notFoundAs(err, fmt.Sprintf("reviewer %q not found", id))
+		}
+	}
+
+	return s.prRepo.ImportPR(ctx, pr)
+}
+
+// validateImportPR проверяет PR без обращения к хранилищу. Ревьюверы могут быть из любой команды:
+// в исторических данных они назначались не по правилам сервиса.
+func validateImportPR(pr domain.PullRequest) error {
+	var msg string
+
+	switch {
+	case pr.PullRequestID == "" || pr.PullRequestName == "" || pr.AuthorID == "":
+		msg = "pull_request_id, pull_request_name and author_id are required"
+	case pr.Status != domain.PRStatusOpen && pr.Status != domain.PRStatusMerged:
+		msg = "status must be OPEN or MERGED"
+	case pr.CreatedAt.IsZero():
+		msg = "created_at is required"
+	case pr.Status == domain.PRStatusMerged && pr.MergedAt == nil:
+		msg = "merged_at is required for MERGED pull request"
+	case pr.Status == domain.PRStatusOpen && pr.MergedAt != nil:
+		msg = "merged_at must be empty for OPEN pull request"
+	case pr.MergedAt != nil && pr.MergedAt.Before(pr.CreatedAt):
+		msg = "merged_at must not be before created_at"
+	case len(pr.AssignedReviewers) > maxReviewersPerPR:
+		msg = fmt.Sprintf("at most %d reviewers are allowed", maxReviewersPerPR)
+	}
+
+	if msg == "" {
+		seen := make(map[string]struct{}, len(pr.AssignedReviewers))
+		for _, id := range pr.AssignedReviewers {
+			if id == pr.AuthorID {
+				msg = "author cannot be a reviewer"
+				break
+			}
+			if _, dup := seen[id]; dup {
+				msg = fmt.Sprintf("reviewer %q is duplicated", id)
+				break
+			}
+			seen[id] = struct{}{}
+		}
+	}
+
+	if msg != "" {
+		return domain.NewDomainError(domain.ErrorCodeBadRequest, msg)
+	}
+	return nil
+}
+
+// ExportData передаёт в fn все команды, затем всех пользователей и PR — в порядке, в котором
+// их можно загрузить обратно через ImportData. Экспорт не снимок: записи, изменённые во время
+// выгрузки, могут попасть в неё в любом из состояний.
+func (s *serviceImpl) ExportData(ctx context.Context, fn func(domain.ImportRecord) error) error {
+	ctx, span := tracer.Start(ctx, "Service.ExportData")
+	defer span.End()
+
+	if err := s.exportData(ctx, fn); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to export data")
+		return err
+	}
+
+	return nil
+}
+
+func (s *serviceImpl) exportData(ctx context.Context, fn func(domain.ImportRecord) error) error {
+	teams, err := s.teamRepo.ListTeamNames(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range teams {
+		if err := fn(domain.ImportRecord{Type: domain.ImportRecordTeam, TeamName: name}); err != nil {
+			return err
+		}
+	}
+
+	for after := ""; ; {
+		users, err := s.userRepo.ListUsers(ctx, after, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			if err := fn(domain.ImportRecord{Type: domain.ImportRecordUser, User: u}); err != nil {
+				return err
+			}
+		}
+		if len(users) < exportPageSize {
+			break
+		}
+		after = users[len(users)-1].UserID
+	}
+
+	for after := ""; ; {
+		prs, err := s.prRepo.ListPRs(ctx, after, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, pr := range prs {
+			if err := fn(domain.ImportRecord{Type: domain.ImportRecordPullRequest, PR: pr}); err != nil {
+				return err
+			}
+		}
+		if len(prs) < exportPageSize {
+			break
+		}
+		after = prs[len(prs)-1].PullRequestID
+	}
+
+	return nil
+}
+
+func mergeImportResult(dst *domain.ImportResult, src domain.ImportResult) {
+	dst.Teams += src.Teams
+	dst.Users += src.Users
+	dst.PullRequests += src.PullRequests
+	dst.Errors = append(dst.Errors, src.Errors...)
+}
+
+func isNotFound(err error) bool {
+	var derr *domain.DomainError
+	return errors.As(err, &derr) && derr.Code == domain.ErrorCodeNotFound
+}
+
+// notFoundAs заменяет сообщение NOT_FOUND на более точное, остальные ошибки возвращает как есть.
+func notFoundAs(err error, msg string) error {
+	if isNotFound(err) {
+		return domain.NewDomainError(domain.ErrorCodeNotFound, msg)
+	}
+	return err
+}
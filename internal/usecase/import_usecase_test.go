@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+func newImportService(t *testing.T) (*serviceImpl, *teamDeps) {
+	s, deps := newTeamService(t)
+
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
+	return s, deps
+}
+
+func TestValidateImportPR(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mergedAt := createdAt.Add(time.Hour)
+	early := createdAt.Add(-time.Hour)
+
+	valid := domain.PullRequest{
+		PullRequestID:     "pr-1",
+		PullRequestName:   "name",
+		AuthorID:          "u1",
+		Status:            domain.PRStatusMerged,
+		AssignedReviewers: []string{"u2", "u3"},
+		CreatedAt:         createdAt,
+		MergedAt:          &mergedAt,
+	}
+	require.NoError(t, validateImportPR(valid))
+
+	tests := []struct {
+		name   string
+		modify func(pr *domain.PullRequest)
+	}{
+		{"missing id", func(pr *domain.PullRequest) { pr.PullRequestID = "" }},
+		{"unknown status", func(pr *domain.PullRequest) { pr.Status = "CLOSED" }},
+		{"missing created_at", func(pr *domain.PullRequest) { pr.CreatedAt = time.Time{} }},
+		{"merged without merged_at", func(pr *domain.PullRequest) { pr.MergedAt = nil }},
+		{"open with merged_at", func(pr *domain.PullRequest) { pr.Status = domain.PRStatusOpen }},
+		{"merged before created", func(pr *domain.PullRequest) { pr.MergedAt = &early }},
+		{"too many reviewers", func(pr *domain.PullRequest) { pr.AssignedReviewers = []string{"u2", "u3", "u4"} }},
+		{"author reviews", func(pr *domain.PullRequest) { pr.AssignedReviewers = []string{"u1"} }},
+		{"duplicate reviewer", func(pr *domain.PullRequest) { pr.AssignedReviewers = []string{"u2", "u2"} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := valid
+			tt.modify(&pr)
+
+			var derr *domain.DomainError
+			require.ErrorAs(t, validateImportPR(pr), &derr)
+			require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+		})
+	}
+}
+
+func TestImportData_SkipsInvalidRecords(t *testing.T) {
+	s, deps := newImportService(t)
+	ctx := context.Background()
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "backend").
+		Return(domain.Team{TeamName: "backend"}, nil).
+		Times(2)
+	deps.userRepo.EXPECT().
+		UpsertUsers(gomock.Any(), "backend", []domain.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}).
+		Return(nil)
+
+	res, err := s.ImportData(ctx, []domain.ImportRecord{
+		{Line: 1, Type: domain.ImportRecordTeam, TeamName: "backend"},
+		{Line: 2, Type: domain.ImportRecordUser, User: domain.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}},
+		{Line: 3, Type: domain.ImportRecordUser, User: domain.User{UserID: "u2"}},
+		{Line: 4, Type: "label"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Teams)
+	require.Equal(t, 1, res.Users)
+	require.Len(t, res.Errors, 2)
+	require.Equal(t, 3, res.Errors[0].Line)
+	require.Equal(t, domain.ErrorCodeBadRequest, res.Errors[0].Code)
+	require.Equal(t, 4, res.Errors[1].Line)
+}
+
+func TestImportData_RetriesFailedBatchLineByLine(t *testing.T) {
+	s, deps := newImportService(t)
+	ctx := context.Background()
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	pr := domain.PullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "name",
+		AuthorID:        "u1",
+		Status:          domain.PRStatusOpen,
+		CreatedAt:       createdAt,
+	}
+	wantErr := errors.New("disk full")
+
+	// пачка целиком, затем каждая строка отдельно
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "backend").
+		Return(domain.Team{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")).
+		Times(2)
+	deps.teamRepo.EXPECT().
+		CreateTeam(gomock.Any(), "backend").
+		Return(nil).
+		Times(2)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1"}, nil).
+		Times(2)
+	deps.prRepo.EXPECT().
+		ImportPR(gomock.Any(), pr).
+		Return(wantErr).
+		Times(2)
+
+	res, err := s.ImportData(ctx, []domain.ImportRecord{
+		{Line: 1, Type: domain.ImportRecordTeam, TeamName: "backend"},
+		{Line: 2, Type: domain.ImportRecordPullRequest, PR: pr},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Teams)
+	require.Zero(t, res.PullRequests)
+	require.Len(t, res.Errors, 1)
+	require.Equal(t, 2, res.Errors[0].Line)
+	require.Equal(t, domain.ErrorCodeInternal, res.Errors[0].Code)
+}
+
+func TestExportData_Pages(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	users := make([]domain.User, exportPageSize)
+	for i := range users {
+		users[i] = domain.User{UserID: fmt.Sprintf("u%04d", i)}
+	}
+	last := users[len(users)-1].UserID
+
+	gomock.InOrder(
+		deps.teamRepo.EXPECT().ListTeamNames(gomock.Any()).Return([]string{"backend"}, nil),
+		deps.userRepo.EXPECT().ListUsers(gomock.Any(), "", exportPageSize).Return(users, nil),
+		deps.userRepo.EXPECT().ListUsers(gomock.Any(), last, exportPageSize).Return([]domain.User{{UserID: "zz"}}, nil),
+		deps.prRepo.EXPECT().ListPRs(gomock.Any(), "", exportPageSize).Return([]domain.PullRequest{{PullRequestID: "pr-1"}}, nil),
+	)
+
+	var types []domain.ImportRecordType
+	err := s.ExportData(ctx, func(rec domain.ImportRecord) error {
+		types = append(types, rec.Type)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, types, 1+exportPageSize+1+1)
+	require.Equal(t, domain.ImportRecordTeam, types[0])
+	require.Equal(t, domain.ImportRecordUser, types[1])
+	require.Equal(t, domain.ImportRecordPullRequest, types[len(types)-1])
+}
+
+func TestExportData_StopsOnWriteError(t *testing.T) {
+	s, deps := newTeamService(t)
+	wantErr := errors.New("client gone")
+
+	deps.teamRepo.EXPECT().ListTeamNames(gomock.Any()).Return([]string{"backend", "frontend"}, nil)
+
+	calls := 0
+	err := s.ExportData(context.Background(), func(domain.ImportRecord) error {
+		calls++
+		return wantErr
+	})
+	require.ErrorIs(t, err, wantErr)
+	require.Equal(t, 1, calls)
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
 	"github.com/alnoi/pr-reviewer-service/internal/repository"
@@ -11,13 +12,27 @@ import (
 
 type (
 	PRUseCase interface {
-		CreatePR(ctx context.Context, prID, prName, authorID string) (domain.PullRequest, error)
+		// CreatePR создаёт PR; requestedReviewers назначаются первыми, остальные места заполняет подбор,
+		// который старается покрыть requiredTags и предпочитает владельцев changedPaths по CODEOWNERS команды.
+		// Вторым значением возвращаются требуемые теги, которых нет ни у одного назначенного ревьювера.
+		CreatePR(ctx context.Context, prID, prName, authorID string, requestedReviewers, changedPaths, requiredTags []string) (pr domain.PullRequest, unmetTags []string, err error)
+		// CreatePRBatch создаёт PR в одной транзакции и возвращает результат по каждому в порядке items.
+		CreatePRBatch(ctx context.Context, items []domain.NewPullRequest) ([]domain.PRBatchItemResult, error)
 		MergePR(ctx context.Context, prID string) (domain.PullRequest, error)
 		ReassignReviewer(ctx context.Context, prID, oldUserID string) (pr domain.PullRequest, replacedBy string, err error)
+		// SetReviewers вручную заменяет ревьюверов открытого PR.
+		SetReviewers(ctx context.Context, prID string, reviewers []string) (domain.PullRequest, error)
+
+		ListStalePRs(ctx context.Context, teamName string, now time.Time) ([]domain.StalePR, error)
+		// ProcessStalePRs отмечает новые зависшие PR и при включённой в команде ротации заменяет на них одного ревьювера.
+		ProcessStalePRs(ctx context.Context, now time.Time) (domain.StaleRunResult, error)
 	}
 
 	StatsUseCase interface {
-		GetStats(ctx context.Context) (domain.Stats, error)
+		GetStats(ctx context.Context, filter domain.StatsFilter, granularity domain.StatsGranularity) (domain.Stats, error)
+		GetCycleTime(ctx context.Context, filter domain.StatsFilter, now time.Time) (domain.CycleTimeStats, error)
+		// GetFairness строит отчёт о равномерности нагрузки и обновляет соответствующие gauges.
+		GetFairness(ctx context.Context, teamName string, idleDays int, now time.Time) (domain.FairnessReport, error)
 	}
 
 	TeamUseCase interface {
@@ -25,12 +40,99 @@ type (
 		GetTeam(ctx context.Context, teamName string) (domain.Team, error)
 
 		// extra
-		DeactivateTeamMembers(ctx context.Context, teamName string, userIDs []string) (domain.Team, error)
+		DeactivateTeamMembers(ctx context.Context, teamName string, userIDs []string, dryRun bool) (domain.DeactivationResult, error)
+		AddTeamMembers(ctx context.Context, teamName string, members []domain.TeamMember) (domain.Team, error)
+		RemoveTeamMembers(ctx context.Context, teamName string, userIDs []string) (domain.Team, error)
+		UpdateTeamMember(ctx context.Context, teamName string, member domain.TeamMember) (domain.Team, error)
+
+		GetTeamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error)
+		UpdateTeamSettings(ctx context.Context, settings domain.TeamSettings) (domain.TeamSettings, error)
+
+		ListReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error)
+		CreateReviewRule(ctx context.Context, rule domain.ReviewRule) (domain.ReviewRule, error)
+		DeleteReviewRule(ctx context.Context, id int64) error
+
+		GetCodeOwners(ctx context.Context, teamName string) ([]domain.CodeOwnerRule, error)
+		// UpdateCodeOwners целиком заменяет правила владения путями команды.
+		UpdateCodeOwners(ctx context.Context, teamName string, rules []domain.CodeOwnerRule) ([]domain.CodeOwnerRule, error)
+		// ImportCodeOwners заменяет правила владения путями содержимым файла CODEOWNERS.
+		ImportCodeOwners(ctx context.Context, teamName, content string) (domain.CodeOwnersImport, error)
+
+		GetTeamNotifications(ctx context.Context, teamName string) (domain.TeamNotifications, error)
+		// UpdateTeamNotifications задаёт вебхук чата и шаблоны сообщений команды.
+		UpdateTeamNotifications(ctx context.Context, n domain.TeamNotifications) (domain.TeamNotifications, error)
 	}
 
 	UserUseCase interface {
-		SetUserIsActive(ctx context.Context, userID string, isActive bool) (domain.User, error)
+		SetUserIsActive(ctx context.Context, userID string, isActive, rebalance bool) (domain.User, error)
+		SetUserSeniority(ctx context.Context, userID string, seniority domain.Seniority) (domain.User, error)
 		GetUserReviewPRs(ctx context.Context, userID string) ([]domain.PullRequestShort, error)
+
+		GetUserTags(ctx context.Context, userID string) ([]string, error)
+		// SetUserTags целиком заменяет теги пользователя и возвращает сохранённые.
+		SetUserTags(ctx context.Context, userID string, tags []string) ([]string, error)
+
+		// SetUserNotifications включает или отключает пользователю уведомления в чат.
+		SetUserNotifications(ctx context.Context, userID string, enabled bool) error
+
+		GetUserPreferences(ctx context.Context, userID string) (domain.UserPreferences, error)
+		// UpdateUserPreferences задаёт адрес, расписание и часовой пояс дайджеста ревью.
+		UpdateUserPreferences(ctx context.Context, p domain.UserPreferences) (domain.UserPreferences, error)
+		// ProcessDigests отправляет дайджест открытых ревью тем, кому он положен по расписанию на момент now.
+		ProcessDigests(ctx context.Context, now time.Time) (domain.DigestRunResult, error)
+	}
+
+	AvailabilityUseCase interface {
+		CreateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error)
+		UpdateAvailability(ctx context.Context, a domain.Availability) (domain.Availability, error)
+		DeleteAvailability(ctx context.Context, id int64) error
+		ListAvailability(ctx context.Context, userID string) ([]domain.Availability, error)
+
+		// ApplyStartedAvailability переназначает открытые PR пользователей, чей период недоступности начался.
+		ApplyStartedAvailability(ctx context.Context, now time.Time) (int, error)
+	}
+
+	ImportUseCase interface {
+		// ImportData применяет записи пачками, каждая пачка — в своей транзакции.
+		// Некорректные записи пропускаются и перечисляются в ошибках результата.
+		ImportData(ctx context.Context, records []domain.ImportRecord) (domain.ImportResult, error)
+		// ExportData передаёт в fn все команды, пользователей и PR в формате импорта.
+		ExportData(ctx context.Context, fn func(domain.ImportRecord) error) error
+	}
+
+	IntegrationUseCase interface {
+		ListForgeAccounts(ctx context.Context, forge domain.Forge) ([]domain.ForgeAccount, error)
+		// SetForgeAccount привязывает логин на хостинге к пользователю сервиса.
+		SetForgeAccount(ctx context.Context, account domain.ForgeAccount) (domain.ForgeAccount, error)
+		DeleteForgeAccount(ctx context.Context, forge domain.Forge, login string) error
+		// HandleForgeEvent отражает событие вебхука хостинга на PR сервиса.
+		HandleForgeEvent(ctx context.Context, ev domain.ForgePREvent) (domain.ForgeEventResult, error)
+		// ProcessForgeSyncJobs отправляет на хостинги изменения ревьюверов, время попытки которых наступило.
+		ProcessForgeSyncJobs(ctx context.Context, now time.Time) (domain.ForgeSyncRunResult, error)
+	}
+
+	EventUseCase interface {
+		// StreamEvents передаёт в fn события, прошедшие фильтр, по возрастанию id: сначала сохранённые после
+		// filter.AfterID, затем новые по мере появления. Возвращает nil, когда ctx отменён, или ошибку fn.
+		StreamEvents(ctx context.Context, filter domain.EventFilter, fn func(domain.Event) error) error
+	}
+
+	// ForgeClient меняет запрошенных ревьюверов PR на git-хостинге.
+	// Ошибки, обёрнутые в domain.ErrForgeRejected, не повторяются.
+	ForgeClient interface {
+		Forge() domain.Forge
+		RequestReviewers(ctx context.Context, repo string, number int64, logins []string) error
+		RemoveReviewers(ctx context.Context, repo string, number int64, logins []string) error
+	}
+
+	// ChatSender отправляет сообщение во входящий вебхук чата.
+	ChatSender interface {
+		Send(ctx context.Context, msg domain.ChatMessage) error
+	}
+
+	// MailSender отправляет письмо.
+	MailSender interface {
+		Send(ctx context.Context, email domain.Email) error
 	}
 
 	Transactor interface {
@@ -42,6 +144,10 @@ var _ TeamUseCase = (*serviceImpl)(nil)
 var _ UserUseCase = (*serviceImpl)(nil)
 var _ PRUseCase = (*serviceImpl)(nil)
 var _ StatsUseCase = (*serviceImpl)(nil)
+var _ AvailabilityUseCase = (*serviceImpl)(nil)
+var _ ImportUseCase = (*serviceImpl)(nil)
+var _ IntegrationUseCase = (*serviceImpl)(nil)
+var _ EventUseCase = (*serviceImpl)(nil)
 
 var tracer = otel.Tracer("pr-reviewer-service")
 
@@ -50,19 +156,59 @@ type serviceImpl struct {
 	teamRepo   repository.TeamRepository
 	userRepo   repository.UserRepository
 	prRepo     repository.PRRepository
+	availRepo  repository.AvailabilityRepository
+	eventRepo  repository.EventRepository
 	transactor Transactor
+
+	// базовый сид выбора ревьюверов, см. selectionRand
+	seed int64
+	// порог зависания PR для команд, где он не задан в настройках
+	staleAfter time.Duration
+	// клиенты хостингов, куда отправляются ревьюверы PR из вебхуков
+	forgeClients map[domain.Forge]ForgeClient
+	// chat — отправка уведомлений ревьюверам; nil отключает уведомления
+	chat ChatSender
+	// notifications доставляет уведомления в фоне, вне запроса
+	notifications *notifyQueue
+	// mail — отправка дайджестов ревью; nil отключает дайджесты
+	mail MailSender
+	// events будит подписчиков ленты этого экземпляра при записи события; запись событий
+	// отключена, если eventRepo nil
+	events *eventHub
 }
 
 func NewService(
 	teamRepo repository.TeamRepository,
 	userRepo repository.UserRepository,
 	prRepo repository.PRRepository,
+	availRepo repository.AvailabilityRepository,
+	eventRepo repository.EventRepository,
 	transactor Transactor,
+	selectionSeed int64,
+	staleAfter time.Duration,
+	chat ChatSender,
+	mail MailSender,
+	forgeClients ...ForgeClient,
 ) *serviceImpl {
+	clients := make(map[domain.Forge]ForgeClient, len(forgeClients))
+	for _, c := range forgeClients {
+		clients[c.Forge()] = c
+	}
+
 	return &serviceImpl{
 		teamRepo:   teamRepo,
 		userRepo:   userRepo,
 		prRepo:     prRepo,
+		availRepo:  availRepo,
+		eventRepo:  eventRepo,
 		transactor: transactor,
+		seed:       selectionSeed,
+		staleAfter: staleAfter,
+
+		forgeClients:  clients,
+		chat:          chat,
+		notifications: newNotifyQueue(),
+		mail:          mail,
+		events:        newEventHub(),
 	}
 }
@@ -0,0 +1,442 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/forge"
+	"github.com/alnoi/pr-reviewer-service/internal/forge/forgetest"
+	"github.com/alnoi/pr-reviewer-service/internal/notify"
+	"github.com/alnoi/pr-reviewer-service/internal/notify/notifytest"
+	"github.com/alnoi/pr-reviewer-service/internal/repository/memory"
+	"github.com/alnoi/pr-reviewer-service/internal/usecase"
+)
+
+type memoryService interface {
+	usecase.TeamUseCase
+	usecase.UserUseCase
+	usecase.PRUseCase
+	usecase.ImportUseCase
+	usecase.IntegrationUseCase
+	usecase.EventUseCase
+}
+
+// Сквозной сценарий поверх in-memory хранилища, без моков.
+func newMemoryService(forgeClients ...usecase.ForgeClient) memoryService {
+	store := memory.NewStore()
+	return usecase.NewService(
+		memory.NewTeamRepository(store),
+		memory.NewUserRepository(store),
+		memory.NewPRRepository(store),
+		memory.NewAvailabilityRepository(store),
+		memory.NewEventRepository(store),
+		memory.NewTransactor(store),
+		42,
+		72*time.Hour,
+		nil,
+		nil,
+		forgeClients...,
+	)
+}
+
+func TestService_MemoryStore_ReviewFlow(t *testing.T) {
+	ctx := context.Background()
+	svc := newMemoryService()
+
+	_, err := svc.CreateTeam(ctx, domain.Team{
+		TeamName: "backend",
+		Members: []domain.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: true},
+			{UserID: "u3", Username: "Charlie", IsActive: true},
+			{UserID: "u4", Username: "Dave", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	pr, _, err := svc.CreatePR(ctx, "pr-1", "feature", "u1", nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, pr.AssignedReviewers, 2)
+	require.NotContains(t, pr.AssignedReviewers, "u1")
+
+	old := pr.AssignedReviewers[0]
+	pr, replacedBy, err := svc.ReassignReviewer(ctx, "pr-1", old)
+	require.NoError(t, err)
+	require.NotEqual(t, old, replacedBy)
+	require.NotContains(t, pr.AssignedReviewers, old)
+	require.Contains(t, pr.AssignedReviewers, replacedBy)
+
+	res, err := svc.DeactivateTeamMembers(ctx, "backend", []string{replacedBy}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{replacedBy}, res.Deactivated)
+	require.Len(t, res.Updates, 1)
+
+	merged, err := svc.MergePR(ctx, "pr-1")
+	require.NoError(t, err)
+	require.Equal(t, domain.PRStatusMerged, merged.Status)
+	require.NotContains(t, merged.AssignedReviewers, replacedBy)
+}
+
+func TestService_MemoryStore_ImportExportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	src := newMemoryService()
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mergedAt := createdAt.Add(30 * time.Hour)
+
+	records := []domain.ImportRecord{
+		{Line: 1, Type: domain.ImportRecordTeam, TeamName: "backend"},
+		{Line: 2, Type: domain.ImportRecordUser, User: domain.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}},
+		{Line: 3, Type: domain.ImportRecordUser, User: domain.User{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}},
+		{Line: 4, Type: domain.ImportRecordUser, User: domain.User{UserID: "x1", Username: "Former"}},
+		{Line: 5, Type: domain.ImportRecordPullRequest, PR: domain.PullRequest{
+			PullRequestID:     "pr-1",
+			PullRequestName:   "Historic",
+			AuthorID:          "x1",
+			Status:            domain.PRStatusMerged,
+			AssignedReviewers: []string{"u1", "u2"},
+			CreatedAt:         createdAt,
+			MergedAt:          &mergedAt,
+		}},
+		{Line: 6, Type: domain.ImportRecordPullRequest, PR: domain.PullRequest{
+			PullRequestID:     "pr-2",
+			PullRequestName:   "Broken",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u9"},
+			CreatedAt:         createdAt,
+		}},
+		{Line: 7, Type: domain.ImportRecordUser, User: domain.User{UserID: "u3", Username: "Lost", TeamName: "frontend"}},
+	}
+
+	res, err := src.ImportData(ctx, records)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Teams)
+	require.Equal(t, 3, res.Users)
+	require.Equal(t, 1, res.PullRequests)
+	require.Equal(t, []domain.ImportLineError{
+		{Line: 6, Code: domain.ErrorCodeNotFound, Message: `reviewer "u9" not found`},
+		{Line: 7, Code: domain.ErrorCodeNotFound, Message: `team "frontend" not found`},
+	}, res.Errors)
+
+	var exported []domain.ImportRecord
+	require.NoError(t, src.ExportData(ctx, func(rec domain.ImportRecord) error {
+		exported = append(exported, rec)
+		return nil
+	}))
+	require.Len(t, exported, 5)
+
+	// выгрузка загружается в пустое хранилище без ошибок
+	dst := newMemoryService()
+	res, err = dst.ImportData(ctx, exported)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+	require.Equal(t, 1, res.PullRequests)
+
+	team, err := dst.GetTeam(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, team.Members, 2)
+
+	var again []domain.ImportRecord
+	require.NoError(t, dst.ExportData(ctx, func(rec domain.ImportRecord) error {
+		again = append(again, rec)
+		return nil
+	}))
+	require.Equal(t, exported, again)
+}
+
+func TestService_MemoryStore_ForgeSync(t *testing.T) {
+	ctx := context.Background()
+	gh := forgetest.NewGitHubServer()
+	t.Cleanup(gh.Close)
+	svc := newMemoryService(forge.NewGitHubClient(gh.URL, "tok"))
+
+	_, err := svc.CreateTeam(ctx, domain.Team{
+		TeamName: "backend",
+		Members: []domain.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: true},
+			{UserID: "u3", Username: "Charlie", IsActive: true},
+			{UserID: "u4", Username: "Dave", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	logins := map[string]string{"u1": "alice", "u2": "bob", "u3": "charlie", "u4": "dave"}
+	for id, login := range logins {
+		_, err := svc.SetForgeAccount(ctx, domain.ForgeAccount{Forge: domain.ForgeGitHub, Login: login, UserID: id})
+		require.NoError(t, err)
+	}
+
+	// без ForgeSync в настройках команды ничего не отправляется
+	_, _, err = svc.CreatePR(ctx, "github:acme/billing#1", "Quiet", "u1", nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = svc.UpdateTeamSettings(ctx, domain.TeamSettings{TeamName: "backend", ForgeSync: true})
+	require.NoError(t, err)
+
+	pr, _, err := svc.CreatePR(ctx, "github:acme/billing#42", "Add invoice export", "u1", nil, nil, nil)
+	require.NoError(t, err)
+
+	// обычные PR не уходят на хостинг и при включённой настройке
+	_, _, err = svc.CreatePR(ctx, "pr-1", "Local", "u1", nil, nil, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	gh.Fail(1, 502)
+
+	res, err := svc.ProcessForgeSyncJobs(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Retried: 1}, res)
+	require.Empty(t, gh.Reviewers("acme/billing", 42))
+
+	res, err = svc.ProcessForgeSyncJobs(ctx, now)
+	require.NoError(t, err)
+	require.Zero(t, res)
+
+	res, err = svc.ProcessForgeSyncJobs(ctx, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Sent: 1}, res)
+
+	want := make([]string, 0, len(pr.AssignedReviewers))
+	for _, id := range pr.AssignedReviewers {
+		want = append(want, logins[id])
+	}
+	require.ElementsMatch(t, want, gh.Reviewers("acme/billing", 42))
+
+	old := pr.AssignedReviewers[0]
+	pr, replacedBy, err := svc.ReassignReviewer(ctx, pr.PullRequestID, old)
+	require.NoError(t, err)
+
+	res, err = svc.ProcessForgeSyncJobs(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Sent: 1}, res)
+	require.NotContains(t, gh.Reviewers("acme/billing", 42), logins[old])
+	require.Contains(t, gh.Reviewers("acme/billing", 42), logins[replacedBy])
+	require.Len(t, gh.Reviewers("acme/billing", 42), len(pr.AssignedReviewers))
+
+	// задания одного PR могут уйти не по порядку, но хостинг всё равно приходит к текущему составу
+	_, err = svc.SetReviewers(ctx, pr.PullRequestID, []string{"u2"})
+	require.NoError(t, err)
+	_, err = svc.SetReviewers(ctx, pr.PullRequestID, []string{"u3", "u4"})
+	require.NoError(t, err)
+
+	now = time.Now()
+	gh.Fail(1, 502)
+
+	res, err = svc.ProcessForgeSyncJobs(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Sent: 1, Retried: 1}, res)
+	require.ElementsMatch(t, []string{"charlie", "dave"}, gh.Reviewers("acme/billing", 42))
+
+	res, err = svc.ProcessForgeSyncJobs(ctx, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Sent: 1}, res)
+	require.ElementsMatch(t, []string{"charlie", "dave"}, gh.Reviewers("acme/billing", 42))
+
+	// замена при деактивации тоже уходит на хостинг
+	_, err = svc.SetUserIsActive(ctx, "u3", false, false)
+	require.NoError(t, err)
+
+	res, err = svc.ProcessForgeSyncJobs(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Sent: 1}, res)
+	require.ElementsMatch(t, []string{"bob", "dave"}, gh.Reviewers("acme/billing", 42))
+
+	for _, req := range gh.Requests() {
+		require.Equal(t, int64(42), req.Number)
+		require.Equal(t, "tok", req.Token)
+	}
+
+	// PR из пачки отправляются так же, как созданные по одному
+	batch, err := svc.CreatePRBatch(ctx, []domain.NewPullRequest{
+		{PullRequestID: "github:acme/billing#43", PullRequestName: "Batch", AuthorID: "u1"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, batch[0].PR)
+
+	res, err = svc.ProcessForgeSyncJobs(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, domain.ForgeSyncRunResult{Sent: 1}, res)
+
+	want = want[:0]
+	for _, id := range batch[0].PR.AssignedReviewers {
+		want = append(want, logins[id])
+	}
+	require.ElementsMatch(t, want, gh.Reviewers("acme/billing", 43))
+}
+
+func TestService_MemoryStore_ReviewerChangeEvents(t *testing.T) {
+	ctx := context.Background()
+	svc := newMemoryService()
+
+	_, err := svc.CreateTeam(ctx, domain.Team{
+		TeamName: "backend",
+		Members: []domain.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: true},
+			{UserID: "u3", Username: "Charlie", IsActive: true},
+			{UserID: "u4", Username: "Dave", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	pr, _, err := svc.CreatePR(ctx, "pr-1", "feature", "u1", nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = svc.SetReviewers(ctx, pr.PullRequestID, []string{"u2"})
+	require.NoError(t, err)
+
+	_, err = svc.SetUserIsActive(ctx, "u2", false, false)
+	require.NoError(t, err)
+
+	// u2 заменил u3 или u4; удаляем из команды заменившего
+	replacement := "u4"
+	if prs, err := svc.GetUserReviewPRs(ctx, "u3"); err == nil && len(prs) > 0 {
+		replacement = "u3"
+	}
+	_, err = svc.RemoveTeamMembers(ctx, "backend", []string{replacement})
+	require.NoError(t, err)
+
+	var events []domain.Event
+	errDone := errors.New("done")
+	err = svc.StreamEvents(ctx, domain.EventFilter{AfterID: new(int64)}, func(ev domain.Event) error {
+		events = append(events, ev)
+		if ev.Type == domain.EventPRReassigned && ev.OldReviewerID == replacement {
+			return errDone
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errDone)
+
+	types := make([]domain.EventType, 0, len(events))
+	for _, ev := range events {
+		require.Equal(t, "backend", ev.TeamName)
+		types = append(types, ev.Type)
+	}
+	require.Equal(t, []domain.EventType{
+		domain.EventPRCreated,
+		domain.EventPRReviewersSet,
+		domain.EventPRReassigned,
+		domain.EventPRReassigned,
+	}, types)
+	require.Equal(t, []string{"u2"}, events[1].Reviewers)
+	require.Equal(t, "u2", events[2].OldReviewerID)
+}
+
+func TestService_MemoryStore_Notifications(t *testing.T) {
+	ctx := context.Background()
+	slack := notifytest.NewSlackServer()
+	t.Cleanup(slack.Close)
+
+	store := memory.NewStore()
+	svc := usecase.NewService(
+		memory.NewTeamRepository(store),
+		memory.NewUserRepository(store),
+		memory.NewPRRepository(store),
+		memory.NewAvailabilityRepository(store),
+		memory.NewEventRepository(store),
+		memory.NewTransactor(store),
+		42,
+		72*time.Hour,
+		notify.NewSlackSender(),
+		nil,
+	)
+
+	_, err := svc.CreateTeam(ctx, domain.Team{
+		TeamName: "backend",
+		Members: []domain.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: true},
+			{UserID: "u3", Username: "Charlie", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+
+	// уведомления доставляются в фоне по одному в порядке постановки, поэтому пришедшее
+	// сообщение значит, что все поставленные раньше уже обработаны
+	waitMessages := func(n int) []notifytest.Message {
+		t.Helper()
+		require.Eventually(t, func() bool { return len(slack.Messages()) >= n }, time.Second, 5*time.Millisecond)
+		return slack.Messages()
+	}
+
+	// без настроек команды сообщения не отправляются
+	_, _, err = svc.CreatePR(ctx, "pr-0", "Quiet", "u1", nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = svc.UpdateTeamNotifications(ctx, domain.TeamNotifications{
+		TeamName:   "backend",
+		WebhookURL: slack.URL + "/hooks/backend",
+		Channel:    "#reviews",
+		Templates: map[domain.NotificationEvent]string{
+			domain.NotificationAssigned: `{{.PullRequestID}} -> {{join .Reviewers " "}}`,
+			domain.NotificationReplaced: `{{.PullRequestID}}: {{.OldReviewerID}} -> {{join .Reviewers " "}}`,
+		},
+	})
+	require.NoError(t, err)
+
+	pr, _, err := svc.CreatePR(ctx, "pr-1", "Add feature", "u1", nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []notifytest.Message{{
+		Path:    "/hooks/backend",
+		Channel: "#reviews",
+		Text:    "pr-1 -> " + pr.AssignedReviewers[0] + " " + pr.AssignedReviewers[1],
+	}}, waitMessages(1))
+
+	// отказавшийся от уведомлений не упоминается; если адресатов нет, сообщения нет
+	slack.Reset()
+	require.NoError(t, svc.SetUserNotifications(ctx, "u2", false))
+	require.NoError(t, svc.SetUserNotifications(ctx, "u3", false))
+
+	_, _, err = svc.CreatePR(ctx, "pr-2", "Muted", "u1", nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, svc.SetUserNotifications(ctx, "u3", true))
+
+	_, _, err = svc.CreatePR(ctx, "pr-3", "Half muted", "u1", nil, nil, nil)
+	require.NoError(t, err)
+	msgs := waitMessages(1)
+	require.Len(t, msgs, 1)
+	require.Equal(t, "pr-3 -> u3", msgs[0].Text)
+
+	// ошибка чата не мешает операции
+	slack.Reset()
+	slack.Fail(1, 500)
+
+	_, _, err = svc.CreatePR(ctx, "pr-4", "Chat is down", "u1", nil, nil, nil)
+	require.NoError(t, err)
+
+	// замену при деактивации получает новый ревьювер
+	_, err = svc.AddTeamMembers(ctx, "backend", []domain.TeamMember{{UserID: "u4", Username: "Dave", IsActive: true}})
+	require.NoError(t, err)
+	_, err = svc.SetUserIsActive(ctx, "u3", false, false)
+	require.NoError(t, err)
+
+	// u3 ревьюит все пять PR
+	msgs = waitMessages(5)
+	texts := make([]string, 0, len(msgs))
+	for _, m := range msgs {
+		texts = append(texts, m.Text)
+	}
+	require.ElementsMatch(t, []string{
+		"pr-0: u3 -> u4", "pr-1: u3 -> u4", "pr-2: u3 -> u4", "pr-3: u3 -> u4", "pr-4: u3 -> u4",
+	}, texts)
+	// при ручной замене уведомляются только добавленные ревьюверы
+	slack.Reset()
+	require.NoError(t, svc.SetUserNotifications(ctx, "u2", true))
+
+	_, err = svc.SetReviewers(ctx, "pr-1", []string{"u4"})
+	require.NoError(t, err)
+	_, err = svc.SetReviewers(ctx, "pr-1", []string{"u2", "u4"})
+	require.NoError(t, err)
+
+	msgs = waitMessages(1)
+	require.Len(t, msgs, 1)
+	require.Equal(t, "pr-1 -> u2", msgs[0].Text)
+}
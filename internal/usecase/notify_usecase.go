@@ -0,0 +1,362 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// defaultTemplates — сообщения для событий, которым команда не задала свой шаблон.
+var defaultTemplates = map[domain.NotificationEvent]string{
+	domain.NotificationAssigned: `{{join .Reviewers ", "}}: please review {{.PullRequestID}} "{{.PullRequestName}}" by {{.AuthorID}}`,
+	domain.NotificationReplaced: `{{join .Reviewers ", "}}: you replace {{.OldReviewerID}} as reviewer of {{.PullRequestID}} "{{.PullRequestName}}"`,
+	domain.NotificationStale:    `{{.PullRequestID}} "{{.PullRequestName}}" has had no activity since {{.LastActivityAt.Format "2006-01-02 15:04 MST"}}: {{join .Reviewers ", "}}, please take a look`,
+}
+
+var templateFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
+func (s *serviceImpl) GetTeamNotifications(ctx context.Context, teamName string) (domain.TeamNotifications, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.GetTeamNotifications",
+		trace.WithAttributes(attribute.String("team.name", teamName)),
+	)
+	defer span.End()
+
+	n, err := s.teamRepo.GetTeamNotifications(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team notifications",
+			zap.String("team_name", teamName),
+		)
+		return domain.TeamNotifications{}, err
+	}
+
+	return n, nil
+}
+
+// UpdateTeamNotifications сохраняет адрес вебхука и шаблоны команды. Каждый шаблон проверяется
+// на примере события, чтобы ошибка в нём не всплыла только при отправке.
+func (s *serviceImpl) UpdateTeamNotifications(ctx context.Context, n domain.TeamNotifications) (domain.TeamNotifications, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.UpdateTeamNotifications",
+		trace.WithAttributes(
+			attribute.String("team.name", n.TeamName),
+			attribute.Bool("notifications.enabled", n.WebhookURL != ""),
+			attribute.Int("notifications.templates", len(n.Templates)),
+		),
+	)
+	defer span.End()
+
+	if _, err := s.teamRepo.GetTeam(ctx, n.TeamName); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team for notifications update",
+			zap.String("team_name", n.TeamName),
+		)
+		return domain.TeamNotifications{}, err
+	}
+
+	n, err := normalizeNotifications(n)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.TeamNotifications{}, err
+	}
+
+	if err := s.teamRepo.UpsertTeamNotifications(ctx, n); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to update team notifications",
+			zap.String("team_name", n.TeamName),
+		)
+		return domain.TeamNotifications{}, err
+	}
+
+	return n, nil
+}
+
+// SetUserNotifications включает или отключает пользователю уведомления в чат.
+func (s *serviceImpl) SetUserNotifications(ctx context.Context, userID string, enabled bool) error {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.SetUserNotifications",
+		trace.WithAttributes(
+			attribute.String("user.id", userID),
+			attribute.Bool("notifications.enabled", enabled),
+		),
+	)
+	defer span.End()
+
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user for notifications",
+			zap.String("user_id", userID),
+		)
+		return err
+	}
+
+	if err := s.userRepo.SetNotificationsMuted(ctx, userID, !enabled); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to set user notifications",
+			zap.String("user_id", userID),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// notify отправляет сообщение о событии в чат команды автора PR. Вызывается после коммита.
+// Адресаты и текст определяются сразу, а сама отправка ставится в очередь и идёт в фоне,
+// поэтому ни медленный чат, ни отключившийся клиент не влияют на операцию. Ошибки только
+// логируются. Пустой n.TeamName — команда определяется по автору.
+func (s *serviceImpl) notify(ctx context.Context, n domain.Notification) {
+	if s.chat == nil || len(n.Reviewers) == 0 {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	log := logger.FromContext(ctx).With(
+		zap.String("event", string(n.Event)),
+		zap.String("pr_id", n.PullRequestID),
+	)
+
+	if n.TeamName == "" {
+		author, err := s.userRepo.GetUserByID(ctx, n.AuthorID)
+		if err != nil {
+			log.Warn("failed to get PR author for notification", zap.Error(err))
+			return
+		}
+		if author.TeamName == "" {
+			return
+		}
+		n.TeamName = author.TeamName
+	}
+
+	cfg, err := s.teamRepo.GetTeamNotifications(ctx, n.TeamName)
+	if err != nil {
+		log.Warn("failed to get team notifications", zap.String("team", n.TeamName), zap.Error(err))
+		return
+	}
+	if cfg.WebhookURL == "" {
+		return
+	}
+
+	muted, err := s.userRepo.GetMutedUsers(ctx, n.Reviewers)
+	if err != nil {
+		log.Warn("failed to get muted users for notification", zap.Error(err))
+		return
+	}
+	n.Reviewers = slices.DeleteFunc(slices.Clone(n.Reviewers), func(id string) bool {
+		return slices.Contains(muted, id)
+	})
+	if len(n.Reviewers) == 0 {
+		return
+	}
+
+	text, err := renderNotification(cfg.Templates[n.Event], n)
+	if err != nil {
+		// шаблон проверяется при сохранении, сюда попадают только ошибки на реальных данных
+		metrics.NotificationsFailedTotal.WithLabelValues(string(n.Event)).Inc()
+		log.Warn("failed to render notification", zap.String("team", n.TeamName), zap.Error(err))
+		return
+	}
+
+	msg := domain.ChatMessage{WebhookURL: cfg.WebhookURL, Channel: cfg.Channel, Text: text}
+	queued := s.notifications.push(func() {
+		if err := s.chat.Send(ctx, msg); err != nil {
+			metrics.NotificationsFailedTotal.WithLabelValues(string(n.Event)).Inc()
+			log.Warn("failed to send notification", zap.String("team", n.TeamName), zap.Error(err))
+			return
+		}
+		metrics.NotificationsSentTotal.WithLabelValues(string(n.Event)).Inc()
+	})
+	if !queued {
+		metrics.NotificationsFailedTotal.WithLabelValues(string(n.Event)).Inc()
+		log.Warn("notification queue is full, notification dropped", zap.String("team", n.TeamName))
+	}
+}
+
+// notifyPRUpdates сообщает ревьюверам, назначенным заменой или rebalance, об их новых PR.
+func (s *serviceImpl) notifyPRUpdates(ctx context.Context, updates []prUpdate) {
+	for _, u := range updates {
+		n := domain.Notification{
+			PullRequestID:   u.id,
+			PullRequestName: u.name,
+			AuthorID:        u.author,
+		}
+
+		if len(u.removed) == 0 {
+			n.Event = domain.NotificationAssigned
+			n.Reviewers = u.added
+			s.notify(ctx, n)
+			continue
+		}
+
+		for i, old := range u.removed {
+			n.Event = domain.NotificationReplaced
+			n.Reviewers = []string{u.added[i]}
+			n.OldReviewerID = old
+			s.notify(ctx, n)
+		}
+	}
+}
+
+// maxPendingNotifications — сколько сообщений может ждать отправки; сверх этого новые отбрасываются.
+const maxPendingNotifications = 1000
+
+// notifyQueue отправляет сообщения по одному в порядке постановки. Воркер запускается
+// при первом сообщении и завершается, когда очередь пуста. Nil-очередь отправляет сразу.
+type notifyQueue struct {
+	mu      sync.Mutex
+	pending []func()
+	running bool
+}
+
+func newNotifyQueue() *notifyQueue {
+	return &notifyQueue{}
+}
+
+// push ставит отправку в очередь; false — очередь переполнена.
+func (q *notifyQueue) push(send func()) bool {
+	if q == nil {
+		send()
+		return true
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.pending) >= maxPendingNotifications {
+		return false
+	}
+	q.pending = append(q.pending, send)
+
+	if !q.running {
+		q.running = true
+		go q.run()
+	}
+	return true
+}
+
+func (q *notifyQueue) run() {
+	for {
+		q.mu.Lock()
+		if len(q.pending) == 0 {
+			q.running = false
+			q.pending = nil
+			q.mu.Unlock()
+			return
+		}
+		send := q.pending[0]
+		q.pending = q.pending[1:]
+		q.mu.Unlock()
+
+		send()
+	}
+}
+
+// --------------------HELPERS----------------------
+
+func assignedNotification(pr domain.PullRequest) domain.Notification {
+	return domain.Notification{
+		Event:           domain.NotificationAssigned,
+		PullRequestID:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorID:        pr.AuthorID,
+		Reviewers:       pr.AssignedReviewers,
+	}
+}
+
+// renderNotification подставляет n в шаблон; пустой шаблон заменяется встроенным для события.
+func renderNotification(text string, n domain.Notification) (string, error) {
+	if text == "" {
+		text = defaultTemplates[n.Event]
+	}
+
+	tmpl, err := template.New(string(n.Event)).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, n); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
+
+// normalizeNotifications проверяет адрес вебхука и шаблоны; пустые шаблоны отбрасываются.
+func normalizeNotifications(n domain.TeamNotifications) (domain.TeamNotifications, error) {
+	n.WebhookURL = strings.TrimSpace(n.WebhookURL)
+	n.Channel = strings.TrimSpace(n.Channel)
+
+	if n.WebhookURL != "" {
+		u, err := url.Parse(n.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return n, domain.NewDomainError(domain.ErrorCodeBadRequest, "webhook_url must be an http(s) URL")
+		}
+	}
+
+	templates := make(map[domain.NotificationEvent]string, len(n.Templates))
+	for event, text := range n.Templates {
+		if !slices.Contains(domain.NotificationEvents, event) {
+			return n, domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("unknown notification event %q", event))
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		sample := sampleNotification(event)
+		out, err := renderNotification(text, sample)
+		if err == nil && out == "" {
+			err = errors.New("template renders to an empty message")
+		}
+		if err != nil {
+			return n, domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("invalid %s template: %v", event, err))
+		}
+		templates[event] = text
+	}
+
+	n.Templates = templates
+	if len(templates) == 0 {
+		n.Templates = nil
+	}
+	return n, nil
+}
+
+// sampleNotification — событие, на котором проверяются шаблоны при сохранении.
+func sampleNotification(event domain.NotificationEvent) domain.Notification {
+	return domain.Notification{
+		Event:           event,
+		TeamName:        "backend",
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add feature",
+		AuthorID:        "u1",
+		Reviewers:       []string{"u2", "u3"},
+		OldReviewerID:   "u4",
+		LastActivityAt:  time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		StaleAfter:      72 * time.Hour,
+	}
+}
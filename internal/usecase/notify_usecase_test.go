@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+func TestRenderNotification_Defaults(t *testing.T) {
+	for _, event := range domain.NotificationEvents {
+		text, err := renderNotification("", sampleNotification(event))
+		require.NoError(t, err, event)
+		require.Contains(t, text, "pr-1", event)
+		require.Contains(t, text, "u2, u3", event)
+	}
+
+	text, err := renderNotification(`{{.PullRequestID}}: {{join .Reviewers " "}} (stale after {{.StaleAfter}})`, domain.Notification{
+		Event:         domain.NotificationStale,
+		PullRequestID: "pr-7",
+		Reviewers:     []string{"u2"},
+		StaleAfter:    time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pr-7: u2 (stale after 1h0m0s)", text)
+}
+
+func TestNormalizeNotifications(t *testing.T) {
+	n, err := normalizeNotifications(domain.TeamNotifications{
+		TeamName:   "backend",
+		WebhookURL: " https://hooks.slack.com/services/T/B/X ",
+		Channel:    " #reviews ",
+		Templates: map[domain.NotificationEvent]string{
+			domain.NotificationAssigned: "{{.PullRequestID}}",
+			domain.NotificationStale:    "  ",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://hooks.slack.com/services/T/B/X", n.WebhookURL)
+	require.Equal(t, "#reviews", n.Channel)
+	require.Equal(t, map[domain.NotificationEvent]string{domain.NotificationAssigned: "{{.PullRequestID}}"}, n.Templates)
+
+	n, err = normalizeNotifications(domain.TeamNotifications{TeamName: "backend"})
+	require.NoError(t, err)
+	require.Nil(t, n.Templates)
+
+	bad := []domain.TeamNotifications{
+		{WebhookURL: "hooks.slack.com/services/T/B/X"},
+		{WebhookURL: "ftp://hooks.example.com"},
+		{Templates: map[domain.NotificationEvent]string{"merged": "{{.PullRequestID}}"}},
+		{Templates: map[domain.NotificationEvent]string{domain.NotificationAssigned: "{{.PullRequestID"}},
+		{Templates: map[domain.NotificationEvent]string{domain.NotificationAssigned: "{{.Title}}"}},
+		{Templates: map[domain.NotificationEvent]string{domain.NotificationAssigned: "{{if false}}x{{end}}"}},
+	}
+	for _, n := range bad {
+		_, err := normalizeNotifications(n)
+
+		var derr *domain.DomainError
+		require.ErrorAs(t, err, &derr, n)
+		require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+	}
+}
+
+func TestUpdateTeamNotifications_UnknownTeam(t *testing.T) {
+	s, deps := newTeamService(t)
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "ghost").
+		Return(domain.Team{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found"))
+
+	_, err := s.UpdateTeamNotifications(context.Background(), domain.TeamNotifications{
+		TeamName:   "ghost",
+		WebhookURL: "https://hooks.example.com/x",
+	})
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNotFound, derr.Code)
+}
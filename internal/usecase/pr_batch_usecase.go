@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// maxPRBatchSize — сколько PR можно создать одним запросом.
+const maxPRBatchSize = 100
+
+// CreatePRBatch создаёт PR в одной транзакции. Каждый PR проверяется так же, как в CreatePR;
+// не прошедшие проверку пропускаются с ошибкой в результате. Ревьюверы подбираются с учётом
+// назначений на предыдущие PR пачки. Ошибка возвращается при некорректной пачке или сбое
+// хранилища — тогда не создаётся ни один PR.
+func (s *serviceImpl) CreatePRBatch(ctx context.Context, items []domain.NewPullRequest) ([]domain.PRBatchItemResult, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.CreatePRBatch",
+		trace.WithAttributes(attribute.Int("batch.size", len(items))),
+	)
+	defer span.End()
+
+	if len(items) == 0 || len(items) > maxPRBatchSize {
+		derr := domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("batch must contain from 1 to %d pull requests", maxPRBatchSize))
+		span.RecordError(derr)
+		span.SetStatus(codes.Error, derr.Error())
+		return nil, derr
+	}
+
+	var (
+		results []domain.PRBatchItemResult
+		evs     []domain.Event
+	)
+
+	err := s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		results, err = s.createPRBatch(txCtx, items, time.Now())
+		if err != nil {
+			return err
+		}
+
+		for _, r := range results {
+			if r.PR == nil {
+				continue
+			}
+			ev := prEvent(domain.EventPRCreated, *r.PR)
+			if err := s.appendEvent(txCtx, ev); err != nil {
+				return err
+			}
+			evs = append(evs, ev)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to create PR batch",
+			zap.Int("batch_size", len(items)),
+		)
+		return nil, err
+	}
+
+	created := 0
+	for _, r := range results {
+		if r.PR != nil {
+			created++
+		}
+	}
+
+	span.SetAttributes(attribute.Int("batch.created", created))
+	metrics.PRCreatedTotal.Add(float64(created))
+
+	for _, r := range results {
+		if r.PR != nil {
+			s.notify(ctx, assignedNotification(*r.PR))
+		}
+	}
+	s.eventsCommitted(evs...)
+
+	return results, nil
+}
+
+func (s *serviceImpl) createPRBatch(ctx context.Context, items []domain.NewPullRequest, now time.Time) ([]domain.PRBatchItemResult, error) {
+	results := make([]domain.PRBatchItemResult, len(items))
+	prs := make([]domain.PullRequest, 0, len(items))
+	created := make([]int, 0, len(items))
+	// команды авторов созданных PR, для проверки ForgeSync
+	teams := make([]string, 0, len(items))
+
+	seen := make(map[string]struct{}, len(items))
+	members := make(map[string][]domain.User)
+	rules := make(map[string]reviewRules)
+	// сколько PR пачки уже досталось каждому участнику
+	load := make(map[string]int)
+
+	for i, item := range items {
+		results[i].PullRequestID = item.PullRequestID
+
+		if _, dup := seen[item.PullRequestID]; dup && item.PullRequestID != "" {
+			results[i].Err = domain.NewDomainError(domain.ErrorCodePRExists, "PR id is duplicated in batch")
+			continue
+		}
+		seen[item.PullRequestID] = struct{}{}
+
+		author, err := s.checkNewPR(ctx, item.PullRequestID, item.PullRequestName, item.AuthorID)
+		var derr *domain.DomainError
+		switch {
+		case errors.As(err, &derr):
+			results[i].Err = derr
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		team, ok := members[author.TeamName]
+		if !ok {
+			team, err = s.userRepo.GetAvailableTeamMembers(ctx, author.TeamName, now)
+			if err != nil {
+				logger.LogDomainAware(ctx, err, "failed to get team members for PR creation",
+					zap.String("team", author.TeamName),
+				)
+				return nil, err
+			}
+			members[author.TeamName] = team
+
+			rules[author.TeamName], err = s.reviewRulesFor(ctx, author.TeamName)
+			if err != nil {
+				logger.LogDomainAware(ctx, err, "failed to get review rules for PR creation",
+					zap.String("team", author.TeamName),
+				)
+				return nil, err
+			}
+		}
+
+		candidateIDs := buildCandidateIDs(team, map[string]struct{}{item.AuthorID: {}})
+		rnd, seed := s.selectionRand(item.PullRequestID)
+		reviewers, reason := rules[author.TeamName].pick(item.AuthorID, nil, takeLeastLoaded(rnd, candidateIDs, load, len(candidateIDs)), maxReviewersPerPR)
+		if reason != "" {
+			results[i].Err = domain.NewDomainError(domain.ErrorCodeNoCandidate, reason)
+			continue
+		}
+		for _, id := range reviewers {
+			load[id]++
+		}
+
+		logger.FromContext(ctx).Debug("reviewers selected",
+			zap.String("pr_id", item.PullRequestID),
+			zap.Int64("selection_seed", seed),
+			zap.Strings("candidates", candidateIDs),
+			zap.Strings("reviewers", reviewers),
+		)
+
+		prs = append(prs, domain.PullRequest{
+			PullRequestID:     item.PullRequestID,
+			PullRequestName:   item.PullRequestName,
+			AuthorID:          item.AuthorID,
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: reviewers,
+			CreatedAt:         now,
+		})
+		created = append(created, i)
+		teams = append(teams, author.TeamName)
+	}
+
+	if len(prs) == 0 {
+		return results, nil
+	}
+
+	if err := s.prRepo.CreatePRs(ctx, prs); err != nil {
+		return nil, err
+	}
+
+	for j, i := range created {
+		pr := prs[j]
+
+		if len(pr.AssignedReviewers) > 0 {
+			forgeSync, err := s.forgeSyncEnabled(ctx, pr.PullRequestID, teams[j])
+			if err != nil {
+				logger.LogDomainAware(ctx, err, "failed to get team settings for forge sync",
+					zap.String("team", teams[j]),
+				)
+				return nil, err
+			}
+			if forgeSync {
+				if err := s.enqueueForgeSync(ctx, pr.PullRequestID, pr.AssignedReviewers, nil); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// в том же порядке, в каком ревьюверов возвращает GetPR
+		pr.AssignedReviewers = append([]string(nil), pr.AssignedReviewers...)
+		sort.Strings(pr.AssignedReviewers)
+		results[i].PR = &pr
+	}
+
+	return results, nil
+}
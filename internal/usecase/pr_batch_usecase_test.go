@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+func TestCreatePRBatch_SpreadsReviewersAcrossBatch(t *testing.T) {
+	s, deps := newImportService(t)
+	deps.teamRepo.EXPECT().ListReviewRules(gomock.Any(), "backend").Return(nil, nil)
+	deps.teamRepo.EXPECT().GetTeamSettings(gomock.Any(), "backend").Return(domain.TeamSettings{TeamName: "backend"}, nil)
+	ctx := context.Background()
+
+	items := make([]domain.NewPullRequest, 6)
+	for i := range items {
+		items[i] = domain.NewPullRequest{
+			PullRequestID:   fmt.Sprintf("pr-%d", i),
+			PullRequestName: "stacked",
+			AuthorID:        "u1",
+		}
+	}
+
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), gomock.Any()).Return(false, nil).Times(len(items))
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil).
+		Times(len(items))
+	// участники команды читаются один раз на пачку
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{
+			{UserID: "u1", IsActive: true},
+			{UserID: "u2", IsActive: true},
+			{UserID: "u3", IsActive: true},
+			{UserID: "u4", IsActive: true},
+		}, nil)
+
+	var created []domain.PullRequest
+	deps.prRepo.EXPECT().
+		CreatePRs(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, prs []domain.PullRequest) error {
+			created = prs
+			return nil
+		})
+
+	res, err := s.CreatePRBatch(ctx, items)
+	require.NoError(t, err)
+	require.Len(t, res, len(items))
+	require.Len(t, created, len(items))
+
+	load := map[string]int{}
+	for i, r := range res {
+		require.Nil(t, r.Err)
+		require.NotNil(t, r.PR)
+		require.Equal(t, items[i].PullRequestID, r.PR.PullRequestID)
+		require.Len(t, r.PR.AssignedReviewers, maxReviewersPerPR)
+		require.NotContains(t, r.PR.AssignedReviewers, "u1")
+		for _, id := range r.PR.AssignedReviewers {
+			load[id]++
+		}
+	}
+	// 12 назначений на трёх кандидатов
+	require.Equal(t, map[string]int{"u2": 4, "u3": 4, "u4": 4}, load)
+}
+
+func TestCreatePRBatch_SkipsInvalidItems(t *testing.T) {
+	s, deps := newImportService(t)
+	deps.teamRepo.EXPECT().ListReviewRules(gomock.Any(), "backend").Return(nil, nil)
+	deps.teamRepo.EXPECT().GetTeamSettings(gomock.Any(), "backend").Return(domain.TeamSettings{TeamName: "backend"}, nil)
+	ctx := context.Background()
+
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-2").Return(true, nil)
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-3").Return(false, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend"}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "ghost").
+		Return(domain.User{}, domain.NewDomainError(domain.ErrorCodeNotFound, "user not found"))
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}}, nil)
+
+	var created []domain.PullRequest
+	deps.prRepo.EXPECT().
+		CreatePRs(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, prs []domain.PullRequest) error {
+			created = prs
+			return nil
+		})
+
+	res, err := s.CreatePRBatch(ctx, []domain.NewPullRequest{
+		{PullRequestID: "pr-1", PullRequestName: "ok", AuthorID: "u1"},
+		{PullRequestID: "pr-2", PullRequestName: "exists", AuthorID: "u1"},
+		{PullRequestID: "pr-1", PullRequestName: "duplicate", AuthorID: "u1"},
+		{PullRequestID: "pr-3", PullRequestName: "no author", AuthorID: "ghost"},
+		{PullRequestID: "pr-4", AuthorID: "u1"},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 5)
+
+	require.Len(t, created, 1)
+	require.Equal(t, "ok", created[0].PullRequestName)
+	require.NotNil(t, res[0].PR)
+	require.Empty(t, res[0].PR.AssignedReviewers)
+
+	codes := make([]domain.ErrorCode, 0, 4)
+	for _, r := range res[1:] {
+		require.Nil(t, r.PR)
+		require.NotNil(t, r.Err)
+		codes = append(codes, r.Err.Code)
+	}
+	require.Equal(t, []domain.ErrorCode{
+		domain.ErrorCodePRExists,
+		domain.ErrorCodePRExists,
+		domain.ErrorCodeNotFound,
+		domain.ErrorCodeBadRequest,
+	}, codes)
+}
+
+func TestCreatePRBatch_NothingValid(t *testing.T) {
+	s, deps := newImportService(t)
+
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(true, nil)
+
+	res, err := s.CreatePRBatch(context.Background(), []domain.NewPullRequest{
+		{PullRequestID: "pr-1", PullRequestName: "exists", AuthorID: "u1"},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.NotNil(t, res[0].Err)
+}
+
+func TestCreatePRBatch_StorageErrorFailsBatch(t *testing.T) {
+	s, deps := newImportService(t)
+	deps.teamRepo.EXPECT().ListReviewRules(gomock.Any(), "backend").Return(nil, nil)
+	deps.teamRepo.EXPECT().GetTeamSettings(gomock.Any(), "backend").Return(domain.TeamSettings{TeamName: "backend"}, nil)
+	wantErr := errors.New("db down")
+
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-2").Return(false, wantErr)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend"}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return(nil, nil)
+
+	res, err := s.CreatePRBatch(context.Background(), []domain.NewPullRequest{
+		{PullRequestID: "pr-1", PullRequestName: "first", AuthorID: "u1"},
+		{PullRequestID: "pr-2", PullRequestName: "second", AuthorID: "u1"},
+	})
+	require.ErrorIs(t, err, wantErr)
+	require.Nil(t, res)
+}
+
+func TestCreatePRBatch_InvalidSize(t *testing.T) {
+	s, _ := newTeamService(t)
+
+	for _, n := range []int{0, maxPRBatchSize + 1} {
+		_, err := s.CreatePRBatch(context.Background(), make([]domain.NewPullRequest, n))
+
+		var derr *domain.DomainError
+		require.ErrorAs(t, err, &derr)
+		require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+	}
+}
+
+func TestTakeLeastLoaded(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	load := map[string]int{"u1": 2, "u2": 0, "u3": 1, "u4": 0}
+
+	res := takeLeastLoaded(rnd, []string{"u1", "u2", "u3", "u4"}, load, 3)
+	require.Len(t, res, 3)
+	require.ElementsMatch(t, []string{"u2", "u4"}, res[:2])
+	require.Equal(t, "u3", res[2])
+
+	require.Empty(t, takeLeastLoaded(rnd, nil, load, 2))
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"github.com/alnoi/pr-reviewer-service/internal/metrics"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
@@ -14,7 +16,14 @@ import (
 	"go.uber.org/zap"
 )
 
-func (s *serviceImpl) CreatePR(ctx context.Context, prID, prName, authorID string) (domain.PullRequest, error) {
+// maxReviewersPerPR — сколько ревьюверов назначается на PR при создании.
+const maxReviewersPerPR = 2
+
+// CreatePR создаёт PR. Запрошенные автором ревьюверы назначаются первыми, оставшиеся места
+// заполняются случайными участниками команды автора; первыми идут кандидаты с недостающими
+// требуемыми тегами, затем владельцы изменённых путей. Возвращает также требуемые теги,
+// которых нет ни у одного назначенного ревьювера.
+func (s *serviceImpl) CreatePR(ctx context.Context, prID, prName, authorID string, requestedReviewers, changedPaths, requiredTags []string) (domain.PullRequest, []string, error) {
 	ctx, span := tracer.Start(
 		ctx,
 		"Service.CreatePR",
@@ -22,55 +31,145 @@ func (s *serviceImpl) CreatePR(ctx context.Context, prID, prName, authorID strin
 			attribute.String("pr.id", prID),
 			attribute.String("pr.name", prName),
 			attribute.String("pr.author_id", authorID),
+			attribute.StringSlice("pr.requested_reviewers", requestedReviewers),
+			attribute.Int("pr.changed_paths", len(changedPaths)),
+			attribute.StringSlice("pr.required_tags", requiredTags),
 		),
 	)
 	defer span.End()
 
 	var res domain.PullRequest
 
-	exists, err := s.prRepo.PRExists(ctx, prID)
+	author, err := s.checkNewPR(ctx, prID, prName, authorID)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		logger.LogDomainAware(ctx, err, "failed to check PR existence",
+		return res, nil, err
+	}
+
+	if err := s.checkReviewers(ctx, author, requestedReviewers); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "invalid requested reviewers",
 			zap.String("pr_id", prID),
+			zap.Strings("requested_reviewers", requestedReviewers),
 		)
-		return res, err
-	}
-	if exists {
-		logger.FromContext(ctx).Warn("PR already exists", zap.String("pr_id", prID))
-		derr := domain.NewDomainError(domain.ErrorCodePRExists, "PR id already exists")
-		span.RecordError(derr)
-		span.SetStatus(codes.Error, derr.Error())
-		return res, derr
+		return res, nil, err
 	}
 
-	author, err := s.userRepo.GetUserByID(ctx, authorID)
+	requiredTags, err = normalizeTags(requiredTags)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		logger.LogDomainAware(ctx, err, "failed to get PR author",
-			zap.String("author_id", authorID),
-		)
-		return res, err
+		return res, nil, err
 	}
 
-	members, err := s.userRepo.GetTeamMembers(ctx, author.TeamName, true)
+	rules, err := s.reviewRulesFor(ctx, author.TeamName)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		logger.LogDomainAware(ctx, err, "failed to get team members for PR creation",
+		logger.LogDomainAware(ctx, err, "failed to get review rules for PR creation",
 			zap.String("team", author.TeamName),
 		)
-		return res, err
+		return res, nil, err
+	}
+	for _, id := range requestedReviewers {
+		if reason := rules.conflict(authorID, id); reason != "" {
+			derr := domain.NewDomainError(domain.ErrorCodeBadRequest, reason)
+			span.RecordError(derr)
+			span.SetStatus(codes.Error, derr.Error())
+			return res, nil, derr
+		}
 	}
 
-	exclude := map[string]struct{}{
-		authorID: {},
+	var candidateIDs []string
+	if len(requestedReviewers) < maxReviewersPerPR {
+		members, err := s.userRepo.GetAvailableTeamMembers(ctx, author.TeamName, time.Now())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to get team members for PR creation",
+				zap.String("team", author.TeamName),
+			)
+			return res, nil, err
+		}
+
+		exclude := map[string]struct{}{
+			authorID: {},
+		}
+		for _, id := range requestedReviewers {
+			exclude[id] = struct{}{}
+		}
+		candidateIDs = buildCandidateIDs(members, exclude)
 	}
-	candidateIDs := buildCandidateIDs(members, exclude)
 
-	reviewers := shuffleAndTake(candidateIDs, 2)
+	rnd, seed := s.selectionRand(prID)
+	ordered := shuffleAndTake(rnd, candidateIDs, len(candidateIDs))
+
+	if len(changedPaths) > 0 && len(ordered) > 0 {
+		owned, err := s.pathOwners(ctx, author.TeamName, changedPaths)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to get code owners for PR creation",
+				zap.String("team", author.TeamName),
+			)
+			return res, nil, err
+		}
+		ordered = preferOwners(ordered, owned)
+	}
+
+	var tags map[string][]string
+	if len(requiredTags) > 0 {
+		allowed, _ := filterCandidates(ordered, func(id string) string {
+			return rules.conflict(authorID, id)
+		})
+		tags, err = s.userRepo.GetUserTags(ctx, append(append([]string(nil), requestedReviewers...), allowed...))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to get user tags for PR creation",
+				zap.String("team", author.TeamName),
+			)
+			return res, nil, err
+		}
+		ordered = preferTagged(ordered, tags, requiredTags, requestedReviewers)
+	}
+
+	picked, reason := rules.pick(authorID, requestedReviewers, ordered, maxReviewersPerPR-len(requestedReviewers))
+	if reason != "" {
+		derr := domain.NewDomainError(domain.ErrorCodeNoCandidate, reason)
+		span.RecordError(derr)
+		span.SetStatus(codes.Error, derr.Error())
+		logger.LogDomainAware(ctx, derr, "review rules leave no reviewers for PR",
+			zap.String("pr_id", prID),
+			zap.Strings("candidates", candidateIDs),
+		)
+		return res, nil, derr
+	}
+	reviewers := append(append([]string(nil), requestedReviewers...), picked...)
+	unmet := unmetTags(requiredTags, reviewers, tags)
+
+	span.SetAttributes(attribute.Int64("selection.seed", seed))
+	logger.FromContext(ctx).Debug("reviewers selected",
+		zap.String("pr_id", prID),
+		zap.Int64("selection_seed", seed),
+		zap.Strings("candidates", candidateIDs),
+		zap.Strings("reviewers", reviewers),
+		zap.Strings("unmet_tags", unmet),
+	)
+
+	forgeSync, err := s.forgeSyncEnabled(ctx, prID, author.TeamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team settings for forge sync",
+			zap.String("team", author.TeamName),
+		)
+		return res, nil, err
+	}
+
+	var ev domain.Event
 
 	err = s.transactor.WithTx(ctx, func(txCtx context.Context) error {
 		pr := domain.PullRequest{
@@ -95,6 +194,12 @@ func (s *serviceImpl) CreatePR(ctx context.Context, prID, prName, authorID strin
 				)
 				return err
 			}
+
+			if forgeSync {
+				if err := s.enqueueForgeSync(txCtx, prID, reviewers, nil); err != nil {
+					return err
+				}
+			}
 		}
 
 		created, err := s.prRepo.GetPR(txCtx, prID)
@@ -105,20 +210,64 @@ func (s *serviceImpl) CreatePR(ctx context.Context, prID, prName, authorID strin
 			return err
 		}
 
+		ev = prEvent(domain.EventPRCreated, created)
+		ev.TeamName = author.TeamName
+		if err := s.appendEvent(txCtx, ev); err != nil {
+			return err
+		}
+
 		res = created
 		return nil
 	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return res, err
+		return res, nil, err
 	}
 
-	span.SetAttributes(attribute.Int("pr.reviewers_count", len(res.AssignedReviewers)))
+	span.SetAttributes(
+		attribute.Int("pr.reviewers_count", len(res.AssignedReviewers)),
+		attribute.StringSlice("pr.unmet_tags", unmet),
+	)
 
 	metrics.PRCreatedTotal.Inc()
 
-	return res, nil
+	n := assignedNotification(res)
+	n.TeamName = author.TeamName
+	s.notify(ctx, n)
+
+	s.eventsCommitted(ev)
+
+	return res, unmet, nil
+}
+
+// checkNewPR проверяет, что PR можно создать, и возвращает его автора.
+func (s *serviceImpl) checkNewPR(ctx context.Context, prID, prName, authorID string) (domain.User, error) {
+	if prID == "" || prName == "" || authorID == "" {
+		return domain.User{}, domain.NewDomainError(domain.ErrorCodeBadRequest, "pull_request_id, pull_request_name and author_id are required")
+	}
+
+	exists, err := s.prRepo.PRExists(ctx, prID)
+	if err != nil {
+		logger.LogDomainAware(ctx, err, "failed to check PR existence",
+			zap.String("pr_id", prID),
+		)
+		return domain.User{}, err
+	}
+	if exists {
+		logger.FromContext(ctx).Warn("PR already exists", zap.String("pr_id", prID))
+		return domain.User{}, domain.NewDomainError(domain.ErrorCodePRExists, "PR id already exists")
+	}
+
+	author, err := s.userRepo.GetUserByID(ctx, authorID)
+	if err != nil {
+		logger.LogDomainAware(ctx, err, "failed to get PR author",
+			zap.String("author_id", authorID),
+		)
+		return domain.User{}, err
+	}
+
+	return author, nil
 }
 
 func (s *serviceImpl) MergePR(ctx context.Context, prID string) (domain.PullRequest, error) {
@@ -150,20 +299,64 @@ func (s *serviceImpl) MergePR(ctx context.Context, prID string) (domain.PullRequ
 	now := time.Now()
 	pr.MergedAt = &now
 
-	if err := s.prRepo.UpdatePR(ctx, pr); err != nil {
+	ev := prEvent(domain.EventPRMerged, pr)
+
+	err = s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		if err := s.prRepo.UpdatePR(txCtx, pr); err != nil {
+			logger.LogDomainAware(txCtx, err, "failed to update PR status to merged",
+				zap.String("pr_id", prID),
+			)
+			return err
+		}
+		return s.appendEvent(txCtx, ev)
+	})
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		logger.LogDomainAware(ctx, err, "failed to update PR status to merged",
-			zap.String("pr_id", prID),
-		)
 		return domain.PullRequest{}, err
 	}
 
 	span.SetAttributes(attribute.String("pr.status", string(pr.Status)))
+	s.observeCycleTime(ctx, pr)
+	s.eventsCommitted(ev)
 
 	return pr, nil
 }
 
+// observeCycleTime пишет время от создания до мержа в гистограммы по команде автора и по командам ревьюверов.
+// Ошибка получения пользователей не должна ломать мерж, поэтому она только логируется.
+func (s *serviceImpl) observeCycleTime(ctx context.Context, pr domain.PullRequest) {
+	if pr.MergedAt == nil || pr.CreatedAt.IsZero() {
+		return
+	}
+	cycle := pr.MergedAt.Sub(pr.CreatedAt).Seconds()
+
+	author, err := s.userRepo.GetUserByID(ctx, pr.AuthorID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to get PR author for cycle time metrics",
+			zap.String("pr_id", pr.PullRequestID),
+			zap.String("author_id", pr.AuthorID),
+			zap.Error(err),
+		)
+	} else {
+		metrics.PRCycleTimeSeconds.WithLabelValues(author.TeamName).Observe(cycle)
+	}
+
+	// ревьюверов может быть сколько угодно, поэтому метка — их команда, а не ID
+	for _, reviewerID := range pr.AssignedReviewers {
+		reviewer, err := s.userRepo.GetUserByID(ctx, reviewerID)
+		if err != nil {
+			logger.FromContext(ctx).Warn("failed to get PR reviewer for cycle time metrics",
+				zap.String("pr_id", pr.PullRequestID),
+				zap.String("reviewer_id", reviewerID),
+				zap.Error(err),
+			)
+			continue
+		}
+		metrics.PRReviewerCycleTimeSeconds.WithLabelValues(reviewer.TeamName).Observe(cycle)
+	}
+}
+
 func (s *serviceImpl) ReassignReviewer(ctx context.Context, prID, oldUserID string) (domain.PullRequest, string, error) {
 	ctx, span := tracer.Start(
 		ctx,
@@ -216,7 +409,7 @@ func (s *serviceImpl) ReassignReviewer(ctx context.Context, prID, oldUserID stri
 		return domain.PullRequest{}, "", derr
 	}
 
-	members, err := s.userRepo.GetTeamMembers(ctx, oldUser.TeamName, true)
+	members, err := s.userRepo.GetAvailableTeamMembers(ctx, oldUser.TeamName, time.Now())
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -243,21 +436,80 @@ func (s *serviceImpl) ReassignReviewer(ctx context.Context, prID, oldUserID stri
 		return domain.PullRequest{}, "", derr
 	}
 
-	newReviewerID := chooseOneRandom(candidateIDs)
+	rules, err := s.reviewRulesFor(ctx, oldUser.TeamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get review rules for reassignment",
+			zap.String("team", oldUser.TeamName),
+		)
+		return domain.PullRequest{}, "", err
+	}
+
+	candidateIDs, reason := filterCandidates(candidateIDs, func(id string) string {
+		return rules.check(pr.AuthorID, replaceReviewer(pr.AssignedReviewers, oldUserID, id))
+	})
+	if len(candidateIDs) == 0 {
+		derr := domain.NewDomainError(domain.ErrorCodeNoCandidate, "no replacement candidate satisfies team rules: "+reason)
+		span.RecordError(derr)
+		span.SetStatus(codes.Error, derr.Error())
+		logger.LogDomainAware(ctx, derr, "review rules leave no replacement candidate",
+			zap.String("pr_id", prID),
+		)
+		return domain.PullRequest{}, "", derr
+	}
+
+	rnd, seed := s.selectionRand(prID)
+	newReviewerID := chooseOneRandom(rnd, candidateIDs)
+
+	span.SetAttributes(attribute.Int64("selection.seed", seed))
+	logger.FromContext(ctx).Debug("replacement selected",
+		zap.String("pr_id", prID),
+		zap.Int64("selection_seed", seed),
+		zap.Strings("candidates", candidateIDs),
+		zap.String("new_reviewer_id", newReviewerID),
+	)
 
 	newReviewers := replaceReviewer(pr.AssignedReviewers, oldUserID, newReviewerID)
 
 	logger.FromContext(ctx).Debug("new reviewers", zap.Any("new_reviewers", newReviewers))
 
-	if err := s.prRepo.SetPRReviewers(ctx, prID, newReviewers); err != nil {
+	forgeSync, err := s.prForgeSyncEnabled(ctx, pr)
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		logger.LogDomainAware(ctx, err, "failed to update reviewers during reassignment",
+		logger.LogDomainAware(ctx, err, "failed to check forge sync for reassignment",
 			zap.String("pr_id", prID),
 		)
 		return domain.PullRequest{}, "", err
 	}
 
+	ev := prEvent(domain.EventPRReassigned, pr)
+	ev.Reviewers = newReviewers
+	ev.OldReviewerID = oldUserID
+	ev.NewReviewerID = newReviewerID
+
+	err = s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		if err := s.prRepo.SetPRReviewers(txCtx, prID, newReviewers); err != nil {
+			logger.LogDomainAware(txCtx, err, "failed to update reviewers during reassignment",
+				zap.String("pr_id", prID),
+			)
+			return err
+		}
+		if err := s.appendEvent(txCtx, ev); err != nil {
+			return err
+		}
+		if !forgeSync {
+			return nil
+		}
+		return s.enqueueForgeSync(txCtx, prID, []string{newReviewerID}, []string{oldUserID})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.PullRequest{}, "", err
+	}
+
 	pr.AssignedReviewers = newReviewers
 
 	span.SetAttributes(
@@ -267,9 +519,233 @@ func (s *serviceImpl) ReassignReviewer(ctx context.Context, prID, oldUserID stri
 
 	metrics.PRReassignedTotal.Inc()
 
+	s.notify(ctx, domain.Notification{
+		Event:           domain.NotificationReplaced,
+		PullRequestID:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorID:        pr.AuthorID,
+		Reviewers:       []string{newReviewerID},
+		OldReviewerID:   oldUserID,
+	})
+
+	s.eventsCommitted(ev)
+
 	return pr, newReviewerID, nil
 }
 
+// SetReviewers заменяет ревьюверов открытого PR указанными, без автоматического подбора.
+// Пустой список отклоняется: PR без ревьюверов никто не посмотрит, а снять одного ревьювера
+// можно только заменой через ReassignReviewer.
+func (s *serviceImpl) SetReviewers(ctx context.Context, prID string, reviewers []string) (domain.PullRequest, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.SetReviewers",
+		trace.WithAttributes(
+			attribute.String("pr.id", prID),
+			attribute.StringSlice("pr.reviewers", reviewers),
+		),
+	)
+	defer span.End()
+
+	if len(reviewers) == 0 {
+		derr := domain.NewDomainError(domain.ErrorCodeBadRequest, "at least one reviewer is required")
+		span.RecordError(derr)
+		span.SetStatus(codes.Error, derr.Error())
+		return domain.PullRequest{}, derr
+	}
+
+	pr, err := s.prRepo.GetPR(ctx, prID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to fetch PR for setting reviewers",
+			zap.String("pr_id", prID),
+		)
+		return domain.PullRequest{}, err
+	}
+
+	if pr.Status == domain.PRStatusMerged {
+		derr := domain.NewDomainError(domain.ErrorCodePRMerged, "cannot set reviewers on merged PR")
+		span.RecordError(derr)
+		span.SetStatus(codes.Error, derr.Error())
+		logger.LogDomainAware(ctx, derr, "cannot set reviewers on merged PR",
+			zap.String("pr_id", prID),
+		)
+		return domain.PullRequest{}, derr
+	}
+
+	author, err := s.userRepo.GetUserByID(ctx, pr.AuthorID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get PR author",
+			zap.String("author_id", pr.AuthorID),
+		)
+		return domain.PullRequest{}, err
+	}
+
+	if err := s.checkReviewers(ctx, author, reviewers); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "invalid reviewers",
+			zap.String("pr_id", prID),
+			zap.Strings("reviewers", reviewers),
+		)
+		return domain.PullRequest{}, err
+	}
+
+	rules, err := s.reviewRulesFor(ctx, author.TeamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get review rules",
+			zap.String("team", author.TeamName),
+		)
+		return domain.PullRequest{}, err
+	}
+	if reason := rules.checkSlots(pr.AuthorID, reviewers, maxReviewersPerPR); reason != "" {
+		derr := domain.NewDomainError(domain.ErrorCodeBadRequest, reason)
+		span.RecordError(derr)
+		span.SetStatus(codes.Error, derr.Error())
+		return domain.PullRequest{}, derr
+	}
+
+	forgeSync, err := s.forgeSyncEnabled(ctx, prID, author.TeamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team settings for forge sync",
+			zap.String("team", author.TeamName),
+		)
+		return domain.PullRequest{}, err
+	}
+
+	removed := subtractIDs(pr.AssignedReviewers, reviewers)
+	added := subtractIDs(reviewers, pr.AssignedReviewers)
+	pr.AssignedReviewers = append([]string(nil), reviewers...)
+	sort.Strings(pr.AssignedReviewers)
+
+	ev := prEvent(domain.EventPRReviewersSet, pr)
+	ev.TeamName = author.TeamName
+
+	err = s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		if err := s.prRepo.SetPRReviewers(txCtx, prID, reviewers); err != nil {
+			logger.LogDomainAware(txCtx, err, "failed to set reviewers",
+				zap.String("pr_id", prID),
+			)
+			return err
+		}
+		if err := s.appendEvent(txCtx, ev); err != nil {
+			return err
+		}
+		if !forgeSync {
+			return nil
+		}
+		return s.enqueueForgeSync(txCtx, prID, added, removed)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.PullRequest{}, err
+	}
+
+	s.eventsCommitted(ev)
+
+	s.notify(ctx, domain.Notification{
+		Event:           domain.NotificationAssigned,
+		TeamName:        author.TeamName,
+		PullRequestID:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorID:        pr.AuthorID,
+		Reviewers:       added,
+	})
+
+	return pr, nil
+}
+
+// checkReviewers проверяет явно указанных ревьюверов: не больше maxReviewersPerPR, без повторов и автора,
+// все активны, не в отпуске и состоят в команде автора или в одной из её ReviewerTeams.
+// Недоступного ревьювера автоматический подбор тоже не назначил бы, поэтому ошибка та же — NO_CANDIDATE.
+func (s *serviceImpl) checkReviewers(ctx context.Context, author domain.User, ids []string) error {
+	if len(ids) > maxReviewersPerPR {
+		return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("at most %d reviewers are allowed", maxReviewersPerPR))
+	}
+
+	var allowed map[string]struct{}
+	seen := make(map[string]struct{}, len(ids))
+	now := time.Now()
+
+	for _, id := range ids {
+		if id == "" || id == author.UserID {
+			return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("invalid reviewer %q", id))
+		}
+		if _, dup := seen[id]; dup {
+			return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("reviewer %q is duplicated", id))
+		}
+		seen[id] = struct{}{}
+
+		u, err := s.userRepo.GetUserByID(ctx, id)
+		if err != nil {
+			return notFoundAs(err, fmt.Sprintf("reviewer %q not found", id))
+		}
+		if !u.IsActive {
+			return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("reviewer %q is inactive", id))
+		}
+		if err := s.checkAvailable(ctx, id, now); err != nil {
+			return err
+		}
+		if u.TeamName != "" && u.TeamName == author.TeamName {
+			continue
+		}
+
+		if allowed == nil {
+			allowed = make(map[string]struct{})
+			if author.TeamName != "" {
+				settings, err := s.teamRepo.GetTeamSettings(ctx, author.TeamName)
+				if err != nil {
+					return err
+				}
+				for _, name := range settings.ReviewerTeams {
+					allowed[name] = struct{}{}
+				}
+			}
+		}
+		if _, ok := allowed[u.TeamName]; !ok {
+			return domain.NewDomainError(domain.ErrorCodeBadRequest,
+				fmt.Sprintf("reviewer %q is not in the author's team or its reviewer teams", id))
+		}
+	}
+
+	return nil
+}
+
+// checkAvailable возвращает NO_CANDIDATE, если у пользователя идёт период недоступности.
+func (s *serviceImpl) checkAvailable(ctx context.Context, userID string, now time.Time) error {
+	p, ok, err := s.activeAvailability(ctx, userID, now)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return domain.NewDomainError(domain.ErrorCodeNoCandidate,
+			fmt.Sprintf("reviewer %q is unavailable until %s", userID, p.EndsAt.Format(time.RFC3339)))
+	}
+	return nil
+}
+
+// activeAvailability возвращает период недоступности пользователя, идущий в момент now.
+func (s *serviceImpl) activeAvailability(ctx context.Context, userID string, now time.Time) (domain.Availability, bool, error) {
+	periods, err := s.availRepo.ListAvailability(ctx, userID)
+	if err != nil {
+		return domain.Availability{}, false, err
+	}
+	for _, p := range periods {
+		if p.Covers(now) {
+			return p, true, nil
+		}
+	}
+	return domain.Availability{}, false, nil
+}
+
 // --------------------HELPERS----------------------
 
 func buildCandidateIDs(members []domain.User, exclude map[string]struct{}) []string {
@@ -283,26 +759,29 @@ func buildCandidateIDs(members []domain.User, exclude map[string]struct{}) []str
 	return res
 }
 
-func shuffleAndTake(ids []string, max int) []string {
+// shuffleAndTake перемешивает копию ids и возвращает не более max элементов.
+func shuffleAndTake(rnd *rand.Rand, ids []string, max int) []string {
 	if len(ids) == 0 {
 		return nil
 	}
-	if len(ids) > 1 {
-		rand.Shuffle(len(ids), func(i, j int) {
-			ids[i], ids[j] = ids[j], ids[i]
+	res := make([]string, len(ids))
+	copy(res, ids)
+	if len(res) > 1 {
+		rnd.Shuffle(len(res), func(i, j int) {
+			res[i], res[j] = res[j], res[i]
 		})
 	}
-	if len(ids) <= max {
-		return ids
+	if len(res) <= max {
+		return res
 	}
-	return ids[:max]
+	return res[:max]
 }
 
-func chooseOneRandom(ids []string) string {
+func chooseOneRandom(rnd *rand.Rand, ids []string) string {
 	if len(ids) == 1 {
 		return ids[0]
 	}
-	idx := rand.Intn(len(ids))
+	idx := rnd.Intn(len(ids))
 	return ids[idx]
 }
 
@@ -326,3 +805,15 @@ func replaceReviewer(reviewers []string, oldID, newID string) []string {
 	}
 	return res
 }
+
+// subtractIDs возвращает id из ids, которых нет в exclude.
+func subtractIDs(ids, exclude []string) []string {
+	skip := buildUniqueIDSet(exclude)
+	var res []string
+	for _, id := range ids {
+		if _, ok := skip[id]; !ok {
+			res = append(res, id)
+		}
+	}
+	return res
+}
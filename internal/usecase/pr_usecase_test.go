@@ -3,14 +3,18 @@ package usecase
 import (
 	"context"
 	"errors"
+	"math/rand"
 	"slices"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
 	"github.com/alnoi/pr-reviewer-service/internal/mocks"
 )
 
@@ -22,14 +26,40 @@ func newPRServiceWithRepos(ctrl *gomock.Controller) (*serviceImpl, *mocks.MockPR
 	tx := mocks.NewMockTransactor(ctrl)
 
 	svc := &serviceImpl{
+		teamRepo:   teamRepoWithoutRules(ctrl),
 		prRepo:     prRepo,
 		userRepo:   userRepo,
+		availRepo:  availRepoWithoutPeriods(ctrl),
 		transactor: tx,
 	}
 
 	return svc, prRepo, userRepo, tx
 }
 
+// expectTx ожидает одну транзакцию, которая просто выполняет fn.
+func expectTx(tx *mocks.MockTransactor) {
+	tx.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+// teamRepoWithoutRules — репозиторий команд, в которых не заданы правила подбора ревьюверов.
+func teamRepoWithoutRules(ctrl *gomock.Controller) *mocks.MockTeamRepository {
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	teamRepo.EXPECT().ListReviewRules(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	teamRepo.EXPECT().GetTeamSettings(gomock.Any(), gomock.Any()).Return(domain.TeamSettings{}, nil).AnyTimes()
+	return teamRepo
+}
+
+// availRepoWithoutPeriods — репозиторий, в котором ни у кого нет периодов недоступности.
+func availRepoWithoutPeriods(ctrl *gomock.Controller) *mocks.MockAvailabilityRepository {
+	availRepo := mocks.NewMockAvailabilityRepository(ctrl)
+	availRepo.EXPECT().ListAvailability(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return availRepo
+}
+
 func isEqualPR(this, other domain.PullRequest) bool {
 	return this.PullRequestID == other.PullRequestID &&
 		this.PullRequestName == other.PullRequestName &&
@@ -55,10 +85,10 @@ func TestCreatePR_PrExistsError(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		PRExists(ctx, prID).
+		PRExists(gomock.Any(), prID).
 		Return(false, wantErr)
 
-	res, err := svc.CreatePR(ctx, prID, "name", "u1")
+	res, _, err := svc.CreatePR(ctx, prID, "name", "u1", nil, nil, nil)
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, wantErr)
@@ -76,10 +106,10 @@ func TestCreatePR_PrAlreadyExists(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		PRExists(ctx, prID).
+		PRExists(gomock.Any(), prID).
 		Return(true, nil)
 
-	res, err := svc.CreatePR(ctx, prID, "name", "u1")
+	res, _, err := svc.CreatePR(ctx, prID, "name", "u1", nil, nil, nil)
 
 	require.Error(t, err)
 
@@ -101,17 +131,17 @@ func TestCreatePR_GetAuthorError(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		PRExists(ctx, prID).
+		PRExists(gomock.Any(), prID).
 		Return(false, nil)
 
 	wantErr := errors.New("author not found")
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, authorID).
+		GetUserByID(gomock.Any(), authorID).
 		Return(domain.User{}, wantErr)
 
-	res, err := svc.CreatePR(ctx, prID, "name", authorID)
+	res, _, err := svc.CreatePR(ctx, prID, "name", authorID, nil, nil, nil)
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, wantErr)
@@ -130,12 +160,12 @@ func TestCreatePR_GetTeamMembersError(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		PRExists(ctx, prID).
+		PRExists(gomock.Any(), prID).
 		Return(false, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, authorID).
+		GetUserByID(gomock.Any(), authorID).
 		Return(domain.User{
 			UserID:   authorID,
 			Username: "Alice",
@@ -147,10 +177,10 @@ func TestCreatePR_GetTeamMembersError(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetTeamMembers(ctx, "backend", true).
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
 		Return(nil, wantErr)
 
-	res, err := svc.CreatePR(ctx, prID, "name", authorID)
+	res, _, err := svc.CreatePR(ctx, prID, "name", authorID, nil, nil, nil)
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, wantErr)
@@ -169,12 +199,12 @@ func TestCreatePR_Tx_CreateError(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		PRExists(ctx, prID).
+		PRExists(gomock.Any(), prID).
 		Return(false, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, authorID).
+		GetUserByID(gomock.Any(), authorID).
 		Return(domain.User{
 			UserID:   authorID,
 			Username: "Alice",
@@ -184,7 +214,7 @@ func TestCreatePR_Tx_CreateError(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetTeamMembers(ctx, "backend", true).
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
 		Return([]domain.User{}, nil)
 
 	wantErr := errors.New("create failed")
@@ -196,12 +226,12 @@ func TestCreatePR_Tx_CreateError(t *testing.T) {
 
 	tx.
 		EXPECT().
-		WithTx(ctx, gomock.Any()).
+		WithTx(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		})
 
-	res, err := svc.CreatePR(ctx, prID, "name", authorID)
+	res, _, err := svc.CreatePR(ctx, prID, "name", authorID, nil, nil, nil)
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, wantErr)
@@ -220,12 +250,12 @@ func TestCreatePR_Tx_SetReviewersError(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		PRExists(ctx, prID).
+		PRExists(gomock.Any(), prID).
 		Return(false, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, authorID).
+		GetUserByID(gomock.Any(), authorID).
 		Return(domain.User{
 			UserID:   authorID,
 			Username: "Alice",
@@ -235,7 +265,7 @@ func TestCreatePR_Tx_SetReviewersError(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetTeamMembers(ctx, "backend", true).
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
 		Return([]domain.User{
 			{
 				UserID:   "u2",
@@ -259,12 +289,12 @@ func TestCreatePR_Tx_SetReviewersError(t *testing.T) {
 
 	tx.
 		EXPECT().
-		WithTx(ctx, gomock.Any()).
+		WithTx(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		})
 
-	res, err := svc.CreatePR(ctx, prID, "name", authorID)
+	res, _, err := svc.CreatePR(ctx, prID, "name", authorID, nil, nil, nil)
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, wantErr)
@@ -283,12 +313,12 @@ func TestCreatePR_Tx_GetPR_Error(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		PRExists(ctx, prID).
+		PRExists(gomock.Any(), prID).
 		Return(false, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, authorID).
+		GetUserByID(gomock.Any(), authorID).
 		Return(domain.User{
 			UserID:   authorID,
 			Username: "Alice",
@@ -298,7 +328,7 @@ func TestCreatePR_Tx_GetPR_Error(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetTeamMembers(ctx, "backend", true).
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
 		Return([]domain.User{}, nil)
 
 	prRepo.
@@ -315,12 +345,12 @@ func TestCreatePR_Tx_GetPR_Error(t *testing.T) {
 
 	tx.
 		EXPECT().
-		WithTx(ctx, gomock.Any()).
+		WithTx(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		})
 
-	res, err := svc.CreatePR(ctx, prID, "name", authorID)
+	res, _, err := svc.CreatePR(ctx, prID, "name", authorID, nil, nil, nil)
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, wantErr)
@@ -339,12 +369,12 @@ func TestCreatePR_Success_NoReviewers(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		PRExists(ctx, prID).
+		PRExists(gomock.Any(), prID).
 		Return(false, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, authorID).
+		GetUserByID(gomock.Any(), authorID).
 		Return(domain.User{
 			UserID:   authorID,
 			Username: "Alice",
@@ -354,7 +384,7 @@ func TestCreatePR_Success_NoReviewers(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetTeamMembers(ctx, "backend", true).
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
 		Return([]domain.User{}, nil)
 
 	prRepo.
@@ -377,12 +407,12 @@ func TestCreatePR_Success_NoReviewers(t *testing.T) {
 
 	tx.
 		EXPECT().
-		WithTx(ctx, gomock.Any()).
+		WithTx(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		})
 
-	res, err := svc.CreatePR(ctx, prID, "name", authorID)
+	res, _, err := svc.CreatePR(ctx, prID, "name", authorID, nil, nil, nil)
 
 	require.NoError(t, err)
 	require.True(t, isEqualPR(res, expected))
@@ -400,12 +430,12 @@ func TestCreatePR_Success_WithReviewers(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		PRExists(ctx, prID).
+		PRExists(gomock.Any(), prID).
 		Return(false, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, authorID).
+		GetUserByID(gomock.Any(), authorID).
 		Return(domain.User{
 			UserID:   authorID,
 			Username: "Alice",
@@ -415,7 +445,7 @@ func TestCreatePR_Success_WithReviewers(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetTeamMembers(ctx, "backend", true).
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
 		Return([]domain.User{
 			{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
 		}, nil)
@@ -445,17 +475,143 @@ func TestCreatePR_Success_WithReviewers(t *testing.T) {
 
 	tx.
 		EXPECT().
-		WithTx(ctx, gomock.Any()).
+		WithTx(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		})
 
-	res, err := svc.CreatePR(ctx, prID, "name", authorID)
+	res, _, err := svc.CreatePR(ctx, prID, "name", authorID, nil, nil, nil)
 
 	require.NoError(t, err)
 	require.True(t, isEqualPR(res, expected))
 }
 
+func TestCreatePR_RequestedReviewersTakePrecedence(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "p1").
+		Return(domain.User{UserID: "p1", TeamName: "platform", IsActive: true}, nil)
+	deps.teamRepo.EXPECT().
+		GetTeamSettings(gomock.Any(), "backend").
+		Return(domain.TeamSettings{TeamName: "backend", ReviewerTeams: []string{"platform"}}, nil).
+		Times(2)
+	deps.noReviewRules()
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+		}, nil)
+
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	deps.prRepo.EXPECT().CreatePR(gomock.Any(), gomock.Any()).Return(nil)
+	deps.prRepo.EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-1", []string{"p1", "u2"}).
+		Return(nil)
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "pr-1").
+		Return(domain.PullRequest{PullRequestID: "pr-1", AssignedReviewers: []string{"p1", "u2"}}, nil)
+
+	res, _, err := s.CreatePR(ctx, "pr-1", "name", "u1", []string{"p1"}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"p1", "u2"}, res.AssignedReviewers)
+}
+
+func TestCreatePR_RequestedReviewersFillAllSlots(t *testing.T) {
+	s, deps := newTeamService(t)
+	deps.noReviewRules()
+	ctx := context.Background()
+
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	for _, id := range []string{"u3", "u2"} {
+		deps.userRepo.EXPECT().
+			GetUserByID(gomock.Any(), id).
+			Return(domain.User{UserID: id, TeamName: "backend", IsActive: true}, nil)
+	}
+
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	deps.prRepo.EXPECT().CreatePR(gomock.Any(), gomock.Any()).Return(nil)
+	deps.prRepo.EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-1", []string{"u3", "u2"}).
+		Return(nil)
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "pr-1").
+		Return(domain.PullRequest{PullRequestID: "pr-1", AssignedReviewers: []string{"u2", "u3"}}, nil)
+
+	_, _, err := s.CreatePR(ctx, "pr-1", "name", "u1", []string{"u3", "u2"}, nil, nil)
+	require.NoError(t, err)
+}
+
+func TestCreatePR_InvalidRequestedReviewers(t *testing.T) {
+	author := domain.User{UserID: "u1", TeamName: "backend", IsActive: true}
+	users := map[string]domain.User{
+		"u2": {UserID: "u2", TeamName: "backend", IsActive: true},
+		"u3": {UserID: "u3", TeamName: "backend", IsActive: false},
+		"f1": {UserID: "f1", TeamName: "frontend", IsActive: true},
+		"x1": {UserID: "x1", IsActive: true},
+	}
+
+	tests := []struct {
+		name      string
+		requested []string
+		wantCode  domain.ErrorCode
+	}{
+		{"too many", []string{"u2", "f1", "x1"}, domain.ErrorCodeBadRequest},
+		{"author", []string{"u1"}, domain.ErrorCodeBadRequest},
+		{"duplicate", []string{"u2", "u2"}, domain.ErrorCodeBadRequest},
+		{"inactive", []string{"u3"}, domain.ErrorCodeBadRequest},
+		{"team not allowed", []string{"f1"}, domain.ErrorCodeBadRequest},
+		{"without team", []string{"x1"}, domain.ErrorCodeBadRequest},
+		{"unknown", []string{"ghost"}, domain.ErrorCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, deps := newTeamService(t)
+
+			deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+			deps.userRepo.EXPECT().GetUserByID(gomock.Any(), "u1").Return(author, nil)
+			deps.userRepo.EXPECT().
+				GetUserByID(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, id string) (domain.User, error) {
+					u, ok := users[id]
+					if !ok {
+						return domain.User{}, domain.NewDomainError(domain.ErrorCodeNotFound, "user not found")
+					}
+					return u, nil
+				}).
+				AnyTimes()
+			deps.teamRepo.EXPECT().
+				GetTeamSettings(gomock.Any(), "backend").
+				Return(domain.TeamSettings{TeamName: "backend", ReviewerTeams: []string{"platform"}}, nil).
+				AnyTimes()
+
+			_, _, err := s.CreatePR(context.Background(), "pr-1", "name", "u1", tt.requested, nil, nil)
+
+			var derr *domain.DomainError
+			require.ErrorAs(t, err, &derr)
+			require.Equal(t, tt.wantCode, derr.Code)
+		})
+	}
+}
+
 // ----------MERGE PR TESTS----------
 
 func TestMergePR_GetPRError(t *testing.T) {
@@ -471,7 +627,7 @@ func TestMergePR_GetPRError(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(domain.PullRequest{}, wantErr)
 
 	_, err := svc.MergePR(ctx, prID)
@@ -501,7 +657,7 @@ func TestMergePR_AlreadyMerged_Idempotent(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(existing, nil)
 
 	prRepo.
@@ -522,28 +678,41 @@ func TestMergePR_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	svc, prRepo, _, _ := newPRServiceWithRepos(ctrl)
+	svc, prRepo, userRepo, tx := newPRServiceWithRepos(ctrl)
+	expectTx(tx)
 
 	ctx := context.Background()
 	prID := "pr-1"
 
 	existing := domain.PullRequest{
-		PullRequestID:   prID,
-		PullRequestName: "name",
-		AuthorID:        "u1",
-		Status:          domain.PRStatusOpen,
+		PullRequestID:     prID,
+		PullRequestName:   "name",
+		AuthorID:          "u1",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"u2"},
+		CreatedAt:         time.Now().Add(-time.Hour),
 	}
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(existing, nil)
 
 	prRepo.
 		EXPECT().
-		UpdatePR(ctx, gomock.Any()).
+		UpdatePR(gomock.Any(), gomock.Any()).
 		Return(nil)
 
+	userRepo.
+		EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend"}, nil)
+
+	userRepo.
+		EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend"}, nil)
+
 	res, err := svc.MergePR(ctx, prID)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -559,6 +728,53 @@ func TestMergePR_Success(t *testing.T) {
 	}
 }
 
+func TestMergePR_ObservesCycleTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, prRepo, userRepo, tx := newPRServiceWithRepos(ctrl)
+	expectTx(tx)
+
+	ctx := context.Background()
+	prID := "pr-cycle"
+
+	existing := domain.PullRequest{
+		PullRequestID:     prID,
+		PullRequestName:   "name",
+		AuthorID:          "u1",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"cycle-r1"},
+		CreatedAt:         time.Now().Add(-2 * time.Hour),
+	}
+
+	prRepo.EXPECT().GetPR(gomock.Any(), prID).Return(existing, nil)
+	prRepo.EXPECT().UpdatePR(gomock.Any(), gomock.Any()).Return(nil)
+	userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{}, errors.New("db error"))
+	userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "cycle-r1").
+		Return(domain.User{UserID: "cycle-r1", TeamName: "cycle-team"}, nil)
+
+	reviewerHist := metrics.PRReviewerCycleTimeSeconds.WithLabelValues("cycle-team").(prometheus.Histogram)
+	before := histogramCount(t, reviewerHist)
+
+	// ошибка получения автора не ломает мерж
+	res, err := svc.MergePR(ctx, prID)
+	require.NoError(t, err)
+	require.Equal(t, domain.PRStatusMerged, res.Status)
+
+	require.Equal(t, before+1, histogramCount(t, reviewerHist))
+}
+
+func histogramCount(t *testing.T, h prometheus.Histogram) uint64 {
+	t.Helper()
+
+	var m dto.Metric
+	require.NoError(t, h.Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
 // ----------REASSIGN REVIEWER TESTS----------
 
 func TestReassignReviewer_GetPRError(t *testing.T) {
@@ -574,7 +790,7 @@ func TestReassignReviewer_GetPRError(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(domain.PullRequest{}, wantErr)
 
 	_, _, err := svc.ReassignReviewer(ctx, prID, "u2")
@@ -605,7 +821,7 @@ func TestReassignReviewer_PRMerged(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(pr, nil)
 
 	_, _, err := svc.ReassignReviewer(ctx, prID, "u2")
@@ -638,14 +854,14 @@ func TestReassignReviewer_GetOldUserError(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(pr, nil)
 
 	wantErr := errors.New("user not found")
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, oldID).
+		GetUserByID(gomock.Any(), oldID).
 		Return(domain.User{}, wantErr)
 
 	_, _, err := svc.ReassignReviewer(ctx, prID, oldID)
@@ -677,12 +893,12 @@ func TestReassignReviewer_NotAssigned(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(pr, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, oldID).
+		GetUserByID(gomock.Any(), oldID).
 		Return(domain.User{
 			UserID:   oldID,
 			Username: "Ghost",
@@ -720,12 +936,12 @@ func TestReassignReviewer_GetTeamMembersError(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(pr, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, oldID).
+		GetUserByID(gomock.Any(), oldID).
 		Return(domain.User{
 			UserID:   oldID,
 			Username: "Bob",
@@ -737,7 +953,7 @@ func TestReassignReviewer_GetTeamMembersError(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetTeamMembers(ctx, "backend", true).
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
 		Return(nil, wantErr)
 
 	_, _, err := svc.ReassignReviewer(ctx, prID, oldID)
@@ -769,12 +985,12 @@ func TestReassignReviewer_NoCandidates(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(pr, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, oldID).
+		GetUserByID(gomock.Any(), oldID).
 		Return(domain.User{
 			UserID:   oldID,
 			Username: "Bob",
@@ -784,7 +1000,7 @@ func TestReassignReviewer_NoCandidates(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetTeamMembers(ctx, "backend", true).
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
 		Return([]domain.User{
 			{UserID: "u1", Username: "Author", TeamName: "backend", IsActive: true},
 			{UserID: oldID, Username: "Bob", TeamName: "backend", IsActive: true},
@@ -804,7 +1020,8 @@ func TestReassignReviewer_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	svc, prRepo, userRepo, _ := newPRServiceWithRepos(ctrl)
+	svc, prRepo, userRepo, tx := newPRServiceWithRepos(ctrl)
+	expectTx(tx)
 
 	ctx := context.Background()
 	prID := "pr-1"
@@ -820,12 +1037,12 @@ func TestReassignReviewer_Success(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPR(ctx, prID).
+		GetPR(gomock.Any(), prID).
 		Return(pr, nil)
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, oldID).
+		GetUserByID(gomock.Any(), oldID).
 		Return(domain.User{
 			UserID:   oldID,
 			Username: "Bob",
@@ -835,7 +1052,7 @@ func TestReassignReviewer_Success(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetTeamMembers(ctx, "backend", true).
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
 		Return([]domain.User{
 			{UserID: "u1", Username: "Author", TeamName: "backend", IsActive: true},
 			{UserID: oldID, Username: "Bob", TeamName: "backend", IsActive: true},
@@ -845,7 +1062,7 @@ func TestReassignReviewer_Success(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		SetPRReviewers(ctx, prID, []string{"u4", "u3"}).
+		SetPRReviewers(gomock.Any(), prID, []string{"u4", "u3"}).
 		Return(nil)
 
 	res, replacedBy, err := svc.ReassignReviewer(ctx, prID, oldID)
@@ -863,6 +1080,119 @@ func TestReassignReviewer_Success(t *testing.T) {
 	}
 }
 
+// ----------SET REVIEWERS TESTS----------
+
+func TestSetReviewers_Success(t *testing.T) {
+	s, deps := newTeamService(t)
+	expectTx(deps.transactor)
+	deps.noReviewRules()
+	ctx := context.Background()
+
+	pr := domain.PullRequest{
+		PullRequestID:     "pr-1",
+		AuthorID:          "u1",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"u2", "u3"},
+	}
+
+	deps.prRepo.EXPECT().GetPR(gomock.Any(), "pr-1").Return(pr, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u5").
+		Return(domain.User{UserID: "u5", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u3").
+		Return(domain.User{UserID: "u3", TeamName: "backend", IsActive: true}, nil)
+	deps.prRepo.EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-1", []string{"u5", "u3"}).
+		Return(nil)
+
+	res, err := s.SetReviewers(ctx, "pr-1", []string{"u5", "u3"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"u3", "u5"}, res.AssignedReviewers)
+}
+
+func TestSetReviewers_EmptyListRejected(t *testing.T) {
+	s, _ := newTeamService(t)
+
+	for _, reviewers := range [][]string{nil, {}} {
+		_, err := s.SetReviewers(context.Background(), "pr-1", reviewers)
+
+		var derr *domain.DomainError
+		require.ErrorAs(t, err, &derr)
+		require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+	}
+}
+
+func TestSetReviewers_PRMerged(t *testing.T) {
+	s, deps := newTeamService(t)
+
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "pr-1").
+		Return(domain.PullRequest{PullRequestID: "pr-1", Status: domain.PRStatusMerged}, nil)
+
+	_, err := s.SetReviewers(context.Background(), "pr-1", []string{"u2"})
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodePRMerged, derr.Code)
+}
+
+func TestSetReviewers_InvalidReviewer(t *testing.T) {
+	s, deps := newTeamService(t)
+
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "pr-1").
+		Return(domain.PullRequest{PullRequestID: "pr-1", AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "f1").
+		Return(domain.User{UserID: "f1", TeamName: "frontend", IsActive: true}, nil)
+	deps.teamRepo.EXPECT().
+		GetTeamSettings(gomock.Any(), "backend").
+		Return(domain.TeamSettings{TeamName: "backend"}, nil)
+
+	_, err := s.SetReviewers(context.Background(), "pr-1", []string{"f1"})
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+}
+
+func TestSetReviewers_UnavailableReviewer(t *testing.T) {
+	s, deps := newTeamService(t)
+	now := time.Now()
+
+	availRepo := mocks.NewMockAvailabilityRepository(gomock.NewController(t))
+	s.availRepo = availRepo
+
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "pr-1").
+		Return(domain.PullRequest{PullRequestID: "pr-1", AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend", IsActive: true}, nil)
+	availRepo.EXPECT().
+		ListAvailability(gomock.Any(), "u2").
+		Return([]domain.Availability{
+			{ID: 1, UserID: "u2", StartsAt: now.Add(-48 * time.Hour), EndsAt: now.Add(-24 * time.Hour)},
+			{ID: 2, UserID: "u2", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(24 * time.Hour)},
+		}, nil)
+
+	_, err := s.SetReviewers(context.Background(), "pr-1", []string{"u2"})
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNoCandidate, derr.Code)
+}
+
 // ----------HELPER FUNCTION TESTS----------
 
 func TestBuildCandidateIDs(t *testing.T) {
@@ -884,7 +1214,7 @@ func TestBuildCandidateIDs(t *testing.T) {
 }
 
 func TestShuffleAndTake_Empty(t *testing.T) {
-	res := shuffleAndTake(nil, 2)
+	res := shuffleAndTake(rand.New(rand.NewSource(1)), nil, 2)
 	if res != nil {
 		t.Fatalf("expected nil, got %+v", res)
 	}
@@ -892,7 +1222,7 @@ func TestShuffleAndTake_Empty(t *testing.T) {
 
 func TestShuffleAndTake_LessOrEqualMax(t *testing.T) {
 	ids := []string{"u1"}
-	res := shuffleAndTake(ids, 2)
+	res := shuffleAndTake(rand.New(rand.NewSource(1)), ids, 2)
 	if len(res) != 1 || res[0] != "u1" {
 		t.Fatalf("expected [u1], got %+v", res)
 	}
@@ -900,12 +1230,37 @@ func TestShuffleAndTake_LessOrEqualMax(t *testing.T) {
 
 func TestShuffleAndTake_MoreThanMax(t *testing.T) {
 	ids := []string{"u1", "u2", "u3"}
-	res := shuffleAndTake(ids, 2)
+	res := shuffleAndTake(rand.New(rand.NewSource(1)), ids, 2)
 	if len(res) != 2 {
 		t.Fatalf("expected 2 ids, got %d", len(res))
 	}
 }
 
+func TestShuffleAndTake_DoesNotMutateInput(t *testing.T) {
+	ids := []string{"u1", "u2", "u3", "u4", "u5"}
+	_ = shuffleAndTake(rand.New(rand.NewSource(42)), ids, 2)
+	require.Equal(t, []string{"u1", "u2", "u3", "u4", "u5"}, ids)
+}
+
+func TestSelectionRand_SameSeedAndPRAreReproducible(t *testing.T) {
+	ids := []string{"u1", "u2", "u3", "u4", "u5"}
+
+	s1 := &serviceImpl{seed: 42}
+	s2 := &serviceImpl{seed: 42}
+
+	rnd1, seed1 := s1.selectionRand("pr-1")
+	rnd2, seed2 := s2.selectionRand("pr-1")
+
+	require.Equal(t, seed1, seed2)
+	require.Equal(t, shuffleAndTake(rnd1, ids, 2), shuffleAndTake(rnd2, ids, 2))
+
+	_, otherPR := s1.selectionRand("pr-2")
+	require.NotEqual(t, seed1, otherPR)
+
+	_, otherBase := (&serviceImpl{seed: 43}).selectionRand("pr-1")
+	require.NotEqual(t, seed1, otherBase)
+}
+
 func TestIsReviewerAssigned(t *testing.T) {
 	pr := domain.PullRequest{
 		AssignedReviewers: []string{"u1", "u2"},
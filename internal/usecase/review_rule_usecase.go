@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+func (s *serviceImpl) ListReviewRules(ctx context.Context, teamName string) ([]domain.ReviewRule, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.ListReviewRules",
+		trace.WithAttributes(attribute.String("team.name", teamName)),
+	)
+	defer span.End()
+
+	if _, err := s.teamRepo.GetTeam(ctx, teamName); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team for review rules",
+			zap.String("team_name", teamName),
+		)
+		return nil, err
+	}
+
+	rules, err := s.teamRepo.ListReviewRules(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to list review rules",
+			zap.String("team_name", teamName),
+		)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("team.rules_count", len(rules)))
+
+	return rules, nil
+}
+
+// CreateReviewRule добавляет правило; оба пользователя должны состоять в команде правила.
+func (s *serviceImpl) CreateReviewRule(ctx context.Context, rule domain.ReviewRule) (domain.ReviewRule, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.CreateReviewRule",
+		trace.WithAttributes(
+			attribute.String("team.name", rule.TeamName),
+			attribute.String("rule.type", string(rule.Type)),
+			attribute.String("rule.user_id", rule.UserID),
+			attribute.String("rule.other_user_id", rule.OtherUserID),
+		),
+	)
+	defer span.End()
+
+	if err := s.checkReviewRule(ctx, rule); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "invalid review rule",
+			zap.String("team_name", rule.TeamName),
+		)
+		return domain.ReviewRule{}, err
+	}
+
+	created, err := s.teamRepo.CreateReviewRule(ctx, rule)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to create review rule",
+			zap.String("team_name", rule.TeamName),
+		)
+		return domain.ReviewRule{}, err
+	}
+
+	span.SetAttributes(attribute.Int64("rule.id", created.ID))
+
+	return created, nil
+}
+
+func (s *serviceImpl) DeleteReviewRule(ctx context.Context, id int64) error {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.DeleteReviewRule",
+		trace.WithAttributes(attribute.Int64("rule.id", id)),
+	)
+	defer span.End()
+
+	if err := s.teamRepo.DeleteReviewRule(ctx, id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to delete review rule",
+			zap.Int64("rule_id", id),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (s *serviceImpl) checkReviewRule(ctx context.Context, rule domain.ReviewRule) error {
+	if rule.Type != domain.ReviewRuleConflict && rule.Type != domain.ReviewRulePair {
+		return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("unknown rule type %q", rule.Type))
+	}
+	if rule.UserID == "" || rule.OtherUserID == "" || rule.UserID == rule.OtherUserID {
+		return domain.NewDomainError(domain.ErrorCodeBadRequest, "user_id and other_user_id must be different users")
+	}
+
+	team, err := s.teamRepo.GetTeam(ctx, rule.TeamName)
+	if err != nil {
+		return err
+	}
+
+	members := make(map[string]struct{}, len(team.Members))
+	for _, m := range team.Members {
+		members[m.UserID] = struct{}{}
+	}
+	for _, id := range []string{rule.UserID, rule.OtherUserID} {
+		if _, ok := members[id]; !ok {
+			return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("user %q is not a member of team %q", id, rule.TeamName))
+		}
+	}
+
+	existing, err := s.teamRepo.ListReviewRules(ctx, rule.TeamName)
+	if err != nil {
+		return err
+	}
+	for _, r := range existing {
+		if r.Type == rule.Type && r.UserID == rule.UserID && r.OtherUserID == rule.OtherUserID {
+			return domain.NewDomainError(domain.ErrorCodeBadRequest, "rule already exists")
+		}
+	}
+
+	return nil
+}
+
+// reviewRulesFor загружает правила команды, из которой подбираются ревьюверы, вместе с её
+// требованием к числу сеньоров. У пользователей без команды правил нет.
+func (s *serviceImpl) reviewRulesFor(ctx context.Context, teamName string) (reviewRules, error) {
+	if teamName == "" {
+		return reviewRules{}, nil
+	}
+
+	rules, err := s.teamRepo.ListReviewRules(ctx, teamName)
+	if err != nil {
+		return reviewRules{}, err
+	}
+	res := newReviewRules(rules)
+
+	settings, err := s.teamRepo.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return reviewRules{}, err
+	}
+	if settings.MinSeniorReviewers == 0 {
+		return res, nil
+	}
+
+	// ревьюверы могут быть и из reviewer_teams, поэтому сеньоров ищем и там
+	res.minSeniors = settings.MinSeniorReviewers
+	res.seniors = make(map[string]struct{})
+	for _, name := range append([]string{teamName}, settings.ReviewerTeams...) {
+		members, err := s.userRepo.GetTeamMembers(ctx, name, false)
+		if err != nil {
+			return reviewRules{}, err
+		}
+		for _, m := range members {
+			if m.Seniority == domain.SenioritySenior {
+				res.seniors[m.UserID] = struct{}{}
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,297 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+func backendRules(rules ...domain.ReviewRule) []domain.ReviewRule {
+	for i := range rules {
+		rules[i].ID = int64(i + 1)
+		rules[i].TeamName = "backend"
+	}
+	return rules
+}
+
+func TestReviewRules_Check(t *testing.T) {
+	rules := newReviewRules(backendRules(
+		domain.ReviewRule{Type: domain.ReviewRuleConflict, UserID: "u2", OtherUserID: "u1"},
+		domain.ReviewRule{Type: domain.ReviewRulePair, UserID: "j1", OtherUserID: "s1"},
+		domain.ReviewRule{Type: domain.ReviewRulePair, UserID: "j1", OtherUserID: "s2"},
+	))
+
+	require.Empty(t, rules.check("u1", []string{"u3", "u4"}))
+	require.Empty(t, rules.check("u3", []string{"u2"}))
+	require.Contains(t, rules.check("u1", []string{"u3", "u2"}), `"u2"`)
+
+	require.Empty(t, rules.check("u1", []string{"j1", "s2"}))
+	require.Contains(t, rules.check("u1", []string{"j1", "u3"}), "s1, s2")
+	require.NotEmpty(t, rules.check("u1", []string{"j1"}))
+
+	require.Empty(t, reviewRules{}.check("u1", []string{"u1"}))
+}
+
+func TestReviewRules_Pick(t *testing.T) {
+	rules := newReviewRules(backendRules(
+		domain.ReviewRule{Type: domain.ReviewRuleConflict, UserID: "u2", OtherUserID: "u1"},
+		domain.ReviewRule{Type: domain.ReviewRulePair, UserID: "j1", OtherUserID: "s1"},
+	))
+
+	tests := []struct {
+		name       string
+		fixed      []string
+		ordered    []string
+		n          int
+		want       []string
+		wantReason bool
+	}{
+		{"no candidates", nil, nil, 2, nil, false},
+		{"first in order", nil, []string{"u3", "u4", "u5"}, 2, []string{"u3", "u4"}, false},
+		{"conflict skipped", nil, []string{"u2", "u3", "u4"}, 2, []string{"u3", "u4"}, false},
+		{"junior with partner", nil, []string{"j1", "u3", "s1"}, 2, []string{"j1", "s1"}, false},
+		{"junior without partner", nil, []string{"j1", "u3"}, 2, []string{"u3"}, false},
+		{"fill next to fixed junior", []string{"j1"}, []string{"u3", "s1"}, 1, []string{"s1"}, false},
+		{"only conflicting candidate", nil, []string{"u2"}, 2, nil, true},
+		{"only lonely junior", nil, []string{"j1"}, 2, nil, true},
+		{"fixed junior without partner", []string{"j1"}, []string{"u3"}, 1, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := rules.pick("u1", tt.fixed, tt.ordered, tt.n)
+			if tt.wantReason {
+				require.NotEmpty(t, reason)
+				return
+			}
+			require.Empty(t, reason)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	got, reason := reviewRules{}.pick("u1", nil, []string{"u3", "u4", "u5"}, 2)
+	require.Empty(t, reason)
+	require.Equal(t, []string{"u3", "u4"}, got)
+}
+
+func TestCreatePR_ReviewRulesLeaveNoCandidate(t *testing.T) {
+	s, deps := newTeamService(t)
+	deps.defaultTeamSettings()
+	ctx := context.Background()
+
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	deps.teamRepo.EXPECT().
+		ListReviewRules(gomock.Any(), "backend").
+		Return(backendRules(
+			domain.ReviewRule{Type: domain.ReviewRuleConflict, UserID: "u2", OtherUserID: "u1"},
+		), nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+		}, nil)
+
+	_, _, err := s.CreatePR(ctx, "pr-1", "name", "u1", nil, nil, nil)
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNoCandidate, derr.Code)
+	require.Contains(t, derr.Message, `"u2"`)
+}
+
+func TestCreatePR_RequestedReviewerConflict(t *testing.T) {
+	s, deps := newTeamService(t)
+	deps.defaultTeamSettings()
+	ctx := context.Background()
+
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend", IsActive: true}, nil)
+	deps.teamRepo.EXPECT().
+		ListReviewRules(gomock.Any(), "backend").
+		Return(backendRules(
+			domain.ReviewRule{Type: domain.ReviewRuleConflict, UserID: "u2", OtherUserID: "u1"},
+		), nil)
+
+	_, _, err := s.CreatePR(ctx, "pr-1", "name", "u1", []string{"u2"}, nil, nil)
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+}
+
+func TestReassignReviewer_KeepsPairedReviewer(t *testing.T) {
+	s, deps := newTeamService(t)
+	expectTx(deps.transactor)
+	deps.defaultTeamSettings()
+	ctx := context.Background()
+
+	pr := domain.PullRequest{
+		PullRequestID:     "pr-1",
+		AuthorID:          "u1",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"j1", "s1"},
+	}
+
+	deps.prRepo.EXPECT().GetPR(gomock.Any(), "pr-1").Return(pr, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "s1").
+		Return(domain.User{UserID: "s1", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{
+			{UserID: "u1"}, {UserID: "j1"}, {UserID: "s1"}, {UserID: "u3"}, {UserID: "s2"},
+		}, nil)
+	deps.teamRepo.EXPECT().
+		ListReviewRules(gomock.Any(), "backend").
+		Return(backendRules(
+			domain.ReviewRule{Type: domain.ReviewRulePair, UserID: "j1", OtherUserID: "s1"},
+			domain.ReviewRule{Type: domain.ReviewRulePair, UserID: "j1", OtherUserID: "s2"},
+		), nil)
+	deps.prRepo.EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-1", []string{"j1", "s2"}).
+		Return(nil)
+
+	res, newID, err := s.ReassignReviewer(ctx, "pr-1", "s1")
+	require.NoError(t, err)
+	require.Equal(t, "s2", newID)
+	require.Equal(t, []string{"j1", "s2"}, res.AssignedReviewers)
+}
+
+func TestReassignReviewer_ReviewRulesLeaveNoCandidate(t *testing.T) {
+	s, deps := newTeamService(t)
+	deps.defaultTeamSettings()
+	ctx := context.Background()
+
+	pr := domain.PullRequest{
+		PullRequestID:     "pr-1",
+		AuthorID:          "u1",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"j1", "s1"},
+	}
+
+	deps.prRepo.EXPECT().GetPR(gomock.Any(), "pr-1").Return(pr, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "s1").
+		Return(domain.User{UserID: "s1", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "j1"}, {UserID: "s1"}, {UserID: "u3"}}, nil)
+	deps.teamRepo.EXPECT().
+		ListReviewRules(gomock.Any(), "backend").
+		Return(backendRules(
+			domain.ReviewRule{Type: domain.ReviewRulePair, UserID: "j1", OtherUserID: "s1"},
+		), nil)
+
+	_, _, err := s.ReassignReviewer(ctx, "pr-1", "s1")
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNoCandidate, derr.Code)
+	require.Contains(t, derr.Message, `"j1"`)
+}
+
+func TestPreparePRUpdates_RespectsReviewRules(t *testing.T) {
+	s := &serviceImpl{seed: 1}
+	rules := newReviewRules(backendRules(
+		domain.ReviewRule{Type: domain.ReviewRuleConflict, UserID: "u3", OtherUserID: "u1"},
+		domain.ReviewRule{Type: domain.ReviewRulePair, UserID: "j1", OtherUserID: "s1"},
+	))
+
+	prs := []domain.PullRequest{
+		{PullRequestID: "pr-1", AuthorID: "u1", AssignedReviewers: []string{"u2", "u4"}},
+		{PullRequestID: "pr-2", AuthorID: "u5", AssignedReviewers: []string{"u2", "s1"}},
+	}
+
+	for i := 0; i < 20; i++ {
+		s.seed = int64(i)
+		updates, err := s.preparePRUpdates(context.Background(), prs, []string{"u3", "j1", "s1", "u6"}, []string{"u2"}, rules)
+		require.NoError(t, err)
+		require.Len(t, updates, 2)
+
+		require.NotContains(t, updates[0].reviewers, "u3")
+		require.NotContains(t, updates[0].reviewers, "j1")
+		require.Empty(t, rules.check("u5", updates[1].reviewers))
+	}
+
+	_, err := s.preparePRUpdates(context.Background(), prs[:1], []string{"u3", "j1"}, []string{"u2"}, rules)
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNoCandidate, derr.Code)
+}
+
+func TestCreateReviewRule_Success(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	rule := domain.ReviewRule{TeamName: "backend", Type: domain.ReviewRulePair, UserID: "u2", OtherUserID: "u1"}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "backend").
+		Return(domain.Team{TeamName: "backend", Members: []domain.TeamMember{{UserID: "u1"}, {UserID: "u2"}}}, nil)
+	deps.teamRepo.EXPECT().
+		ListReviewRules(gomock.Any(), "backend").
+		Return(backendRules(
+			domain.ReviewRule{Type: domain.ReviewRuleConflict, UserID: "u2", OtherUserID: "u1"},
+		), nil)
+
+	want := rule
+	want.ID = 2
+	deps.teamRepo.EXPECT().CreateReviewRule(gomock.Any(), rule).Return(want, nil)
+
+	res, err := s.CreateReviewRule(ctx, rule)
+	require.NoError(t, err)
+	require.Equal(t, want, res)
+}
+
+func TestCreateReviewRule_Invalid(t *testing.T) {
+	team := domain.Team{TeamName: "backend", Members: []domain.TeamMember{{UserID: "u1"}, {UserID: "u2"}}}
+	existing := backendRules(domain.ReviewRule{Type: domain.ReviewRuleConflict, UserID: "u2", OtherUserID: "u1"})
+
+	tests := []struct {
+		name     string
+		rule     domain.ReviewRule
+		wantCode domain.ErrorCode
+	}{
+		{"unknown type", domain.ReviewRule{Type: "mentor", UserID: "u1", OtherUserID: "u2"}, domain.ErrorCodeBadRequest},
+		{"same user", domain.ReviewRule{Type: domain.ReviewRulePair, UserID: "u1", OtherUserID: "u1"}, domain.ErrorCodeBadRequest},
+		{"missing team", domain.ReviewRule{TeamName: "missing", Type: domain.ReviewRulePair, UserID: "u1", OtherUserID: "u2"}, domain.ErrorCodeNotFound},
+		{"not a member", domain.ReviewRule{Type: domain.ReviewRulePair, UserID: "u1", OtherUserID: "f1"}, domain.ErrorCodeBadRequest},
+		{"duplicate", domain.ReviewRule{Type: domain.ReviewRuleConflict, UserID: "u2", OtherUserID: "u1"}, domain.ErrorCodeBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, deps := newTeamService(t)
+			if tt.rule.TeamName == "" {
+				tt.rule.TeamName = "backend"
+			}
+
+			deps.teamRepo.EXPECT().GetTeam(gomock.Any(), "backend").Return(team, nil).AnyTimes()
+			deps.teamRepo.EXPECT().
+				GetTeam(gomock.Any(), "missing").
+				Return(domain.Team{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")).
+				AnyTimes()
+			deps.teamRepo.EXPECT().ListReviewRules(gomock.Any(), "backend").Return(existing, nil).AnyTimes()
+
+			_, err := s.CreateReviewRule(context.Background(), tt.rule)
+
+			var derr *domain.DomainError
+			require.ErrorAs(t, err, &derr)
+			require.Equal(t, tt.wantCode, derr.Code)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+// selectionRand возвращает генератор для выбора ревьюверов по конкретному PR.
+// Сид выводится из базового сида сервиса и ID PR, поэтому при известном
+// базовом сиде назначение можно воспроизвести офлайн.
+func (s *serviceImpl) selectionRand(prID string) (*rand.Rand, int64) {
+	seed := deriveSeed(s.seed, prID)
+	return rand.New(rand.NewSource(seed)), seed
+}
+
+func deriveSeed(base int64, key string) int64 {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(base))
+
+	h := fnv.New64a()
+	_, _ = h.Write(buf[:])
+	_, _ = h.Write([]byte(key))
+
+	return int64(h.Sum64())
+}
+
+// takeLeastLoaded перемешивает копию ids и возвращает не более max наименее загруженных по load.
+// Среди равных по нагрузке порядок случайный.
+func takeLeastLoaded(rnd *rand.Rand, ids []string, load map[string]int, max int) []string {
+	res := shuffleAndTake(rnd, ids, len(ids))
+	sort.SliceStable(res, func(i, j int) bool {
+		return load[res[i]] < load[res[j]]
+	})
+	if len(res) <= max {
+		return res
+	}
+	return res[:max]
+}
+
+// reviewRules — правила подбора ревьюверов одной команды: domain.ReviewRule и требование
+// к числу сеньоров из настроек команды. Нулевое значение — отсутствие правил.
+type reviewRules struct {
+	// conflicts[reviewer] — авторы, чьи PR reviewer не ревьюит
+	conflicts map[string]map[string]struct{}
+	// partners[reviewer] — напарники, хотя бы один из которых должен ревьюить вместе с reviewer
+	partners map[string][]string
+
+	// minSeniors — сколько ревьюверов должны быть из seniors; если мест под ревьюверов меньше,
+	// сеньорами должны быть все места
+	minSeniors int
+	seniors    map[string]struct{}
+}
+
+func newReviewRules(rules []domain.ReviewRule) reviewRules {
+	var res reviewRules
+	for _, rule := range rules {
+		switch rule.Type {
+		case domain.ReviewRuleConflict:
+			if res.conflicts == nil {
+				res.conflicts = make(map[string]map[string]struct{})
+			}
+			if res.conflicts[rule.UserID] == nil {
+				res.conflicts[rule.UserID] = make(map[string]struct{})
+			}
+			res.conflicts[rule.UserID][rule.OtherUserID] = struct{}{}
+		case domain.ReviewRulePair:
+			if res.partners == nil {
+				res.partners = make(map[string][]string)
+			}
+			res.partners[rule.UserID] = append(res.partners[rule.UserID], rule.OtherUserID)
+		}
+	}
+	return res
+}
+
+// conflict возвращает причину, по которой reviewerID не может ревьюить PR автора authorID, или "".
+func (r reviewRules) conflict(authorID, reviewerID string) string {
+	if _, ok := r.conflicts[reviewerID][authorID]; ok {
+		return fmt.Sprintf("reviewer %q must not review pull requests of %q", reviewerID, authorID)
+	}
+	return ""
+}
+
+// check возвращает причину, по которой набор ревьюверов нарушает правила, или "".
+// Требование к сеньорам считается от размера самого набора.
+func (r reviewRules) check(authorID string, reviewers []string) string {
+	return r.checkSlots(authorID, reviewers, len(reviewers))
+}
+
+// checkSlots — check для набора, который должен занимать slots мест: требование к сеньорам
+// не уменьшается, если ревьюверов набралось меньше. Пустой набор (PR без ревьюверов) ему не подлежит.
+func (r reviewRules) checkSlots(authorID string, reviewers []string, slots int) string {
+	for _, id := range reviewers {
+		if reason := r.conflict(authorID, id); reason != "" {
+			return reason
+		}
+
+		partners := r.partners[id]
+		if len(partners) == 0 {
+			continue
+		}
+		paired := false
+		for _, p := range partners {
+			if containsID(reviewers, p) {
+				paired = true
+				break
+			}
+		}
+		if !paired {
+			return fmt.Sprintf("reviewer %q must review together with one of: %s", id, strings.Join(partners, ", "))
+		}
+	}
+
+	if need := min(r.minSeniors, slots); need > 0 && len(reviewers) > 0 {
+		seniors := 0
+		for _, id := range reviewers {
+			if _, ok := r.seniors[id]; ok {
+				seniors++
+			}
+		}
+		if seniors < need {
+			return fmt.Sprintf("at least %d senior reviewer(s) required, got %d", need, seniors)
+		}
+	}
+	return ""
+}
+
+// filterCandidates оставляет кандидатов, для которых fits не вернул причину отказа.
+// Вторым значением возвращается причина отказа последнего отброшенного кандидата.
+func filterCandidates(ids []string, fits func(id string) string) ([]string, string) {
+	res := make([]string, 0, len(ids))
+	var reason string
+	for _, id := range ids {
+		if r := fits(id); r != "" {
+			reason = r
+			continue
+		}
+		res = append(res, id)
+	}
+	return res, reason
+}
+
+// pick дополняет fixed не более чем n ревьюверами из ordered так, чтобы набор проходил правила.
+// Предпочитаются наборы побольше, среди равных — идущие раньше в ordered, поэтому без правил
+// это просто первые n из ordered. Требование к сеньорам считается от len(fixed)+n мест, даже
+// если набор приходится уменьшить. Если кандидаты есть, но правила не допускают ни одного
+// ревьювера (или делают невалидным fixed), возвращается причина.
+func (r reviewRules) pick(authorID string, fixed, ordered []string, n int) ([]string, string) {
+	slots := len(fixed) + n
+	n = min(n, len(ordered))
+	allowed, _ := filterCandidates(ordered, func(id string) string {
+		return r.conflict(authorID, id)
+	})
+
+	for k := min(n, len(allowed)); k >= 0; k-- {
+		if k == 0 && len(fixed) == 0 && n > 0 {
+			break
+		}
+		if res, ok := r.firstValid(authorID, fixed, allowed, k, slots); ok {
+			return res, ""
+		}
+	}
+
+	// объясняем, чем плох набор, который выбрался бы без правил
+	if reason := r.checkSlots(authorID, append(append([]string(nil), fixed...), ordered[:n]...), slots); reason != "" {
+		return nil, reason
+	}
+	return nil, "no reviewer combination satisfies team rules"
+}
+
+// firstValid перебирает сочетания из k кандидатов в порядке ordered и возвращает первое,
+// с которым fixed проходит правила для slots мест.
+func (r reviewRules) firstValid(authorID string, fixed, ordered []string, k, slots int) ([]string, bool) {
+	if k == 0 {
+		return nil, r.checkSlots(authorID, fixed, slots) == ""
+	}
+
+	set := make([]string, len(fixed), len(fixed)+k)
+	copy(set, fixed)
+
+	var walk func(from int) bool
+	walk = func(from int) bool {
+		if len(set) == len(fixed)+k {
+			return r.checkSlots(authorID, set, slots) == ""
+		}
+		for i := from; i <= len(ordered)-(len(fixed)+k-len(set)); i++ {
+			set = append(set, ordered[i])
+			if walk(i + 1) {
+				return true
+			}
+			set = set[:len(set)-1]
+		}
+		return false
+	}
+
+	if !walk(0) {
+		return nil, false
+	}
+	return set[len(fixed):], true
+}
+
+func containsID(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
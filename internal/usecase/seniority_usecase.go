@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// SetUserSeniority меняет уровень пользователя. Уже назначенные ревьюверы не пересматриваются:
+// требование команды к сеньорам применяется при следующем подборе.
+func (s *serviceImpl) SetUserSeniority(ctx context.Context, userID string, seniority domain.Seniority) (domain.User, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.SetUserSeniority",
+		trace.WithAttributes(
+			attribute.String("user.id", userID),
+			attribute.String("user.seniority", string(seniority)),
+		),
+	)
+	defer span.End()
+
+	if seniority == "" {
+		err := domain.NewDomainError(domain.ErrorCodeBadRequest, "seniority is required")
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.User{}, err
+	}
+	if err := checkSeniority(seniority); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.User{}, err
+	}
+
+	user, err := s.userRepo.SetUserSeniority(ctx, userID, seniority)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to set user seniority",
+			zap.String("user_id", userID),
+			zap.String("seniority", string(seniority)),
+		)
+		return domain.User{}, err
+	}
+
+	return user, nil
+}
+
+// --------------------HELPERS----------------------
+
+// checkSeniority допускает известные уровни и пустое значение («не указан»).
+func checkSeniority(seniority domain.Seniority) error {
+	switch seniority {
+	case "", domain.SeniorityJunior, domain.SeniorityMiddle, domain.SenioritySenior:
+		return nil
+	}
+	return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("unknown seniority %q", seniority))
+}
+
+func checkMembersSeniority(members []domain.TeamMember) error {
+	for _, m := range members {
+		if err := checkSeniority(m.Seniority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+func TestReviewRules_MinSeniors(t *testing.T) {
+	rules := reviewRules{
+		minSeniors: 1,
+		seniors:    map[string]struct{}{"s1": {}, "s2": {}},
+	}
+
+	require.Empty(t, rules.check("u1", nil))
+	require.Empty(t, rules.check("u1", []string{"u2", "s1"}))
+	require.NotEmpty(t, rules.check("u1", []string{"u2", "u3"}))
+
+	got, reason := rules.pick("u1", nil, []string{"u2", "u3", "s2"}, 2)
+	require.Empty(t, reason)
+	require.Equal(t, []string{"u2", "s2"}, got)
+
+	got, reason = rules.pick("u1", []string{"u2"}, []string{"u3", "s1"}, 1)
+	require.Empty(t, reason)
+	require.Equal(t, []string{"s1"}, got)
+
+	_, reason = rules.pick("u1", nil, []string{"u2", "u3"}, 2)
+	require.NotEmpty(t, reason)
+
+	// сеньоры не могут быть все, если ревьюверов меньше требуемого
+	rules.minSeniors = 2
+	got, reason = rules.pick("u1", nil, []string{"u2", "s1"}, 1)
+	require.Empty(t, reason)
+	require.Equal(t, []string{"s1"}, got)
+
+	// при двух местах одного сеньора мало, даже если взять его без напарника
+	_, reason = rules.pick("u1", nil, []string{"u2", "u3", "s1"}, 2)
+	require.NotEmpty(t, reason)
+	require.NotEmpty(t, rules.checkSlots("u1", []string{"s1"}, 2))
+	require.Empty(t, rules.checkSlots("u1", []string{"s1", "s2"}, 2))
+}
+
+func TestSetUserSeniority(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	deps.userRepo.EXPECT().
+		SetUserSeniority(gomock.Any(), "u1", domain.SenioritySenior).
+		Return(domain.User{UserID: "u1", Seniority: domain.SenioritySenior}, nil)
+
+	user, err := s.SetUserSeniority(ctx, "u1", domain.SenioritySenior)
+	require.NoError(t, err)
+	require.Equal(t, domain.SenioritySenior, user.Seniority)
+
+	for _, bad := range []domain.Seniority{"", "lead"} {
+		_, err := s.SetUserSeniority(ctx, "u1", bad)
+
+		var derr *domain.DomainError
+		require.ErrorAs(t, err, &derr, bad)
+		require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+	}
+}
+
+func TestUpdateTeamSettings_MinSeniorReviewersOutOfRange(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "backend").
+		Return(backendTeam(), nil).
+		Times(2)
+
+	for _, n := range []int{-1, maxReviewersPerPR + 1} {
+		_, err := s.UpdateTeamSettings(ctx, domain.TeamSettings{TeamName: "backend", MinSeniorReviewers: n})
+
+		var derr *domain.DomainError
+		require.ErrorAs(t, err, &derr)
+		require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+	}
+}
+
+func TestCreateTeam_UnknownSeniority(t *testing.T) {
+	s, _ := newTeamService(t)
+
+	_, err := s.CreateTeam(context.Background(), backendTeam(
+		domain.TeamMember{UserID: "u1", Seniority: "lead"},
+	))
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+}
+
+func TestReassignReviewer_KeepsSenior(t *testing.T) {
+	s, deps := newTeamService(t)
+	expectTx(deps.transactor)
+	ctx := context.Background()
+
+	pr := domain.PullRequest{
+		PullRequestID:     "pr-1",
+		AuthorID:          "u1",
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"u2", "s1"},
+	}
+
+	deps.prRepo.EXPECT().GetPR(gomock.Any(), "pr-1").Return(pr, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "s1").
+		Return(domain.User{UserID: "s1", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{
+			{UserID: "u1"}, {UserID: "u2"}, {UserID: "s1"}, {UserID: "u3"}, {UserID: "s2"},
+		}, nil)
+	deps.teamRepo.EXPECT().ListReviewRules(gomock.Any(), "backend").Return(nil, nil)
+	deps.teamRepo.EXPECT().
+		GetTeamSettings(gomock.Any(), "backend").
+		Return(domain.TeamSettings{TeamName: "backend", MinSeniorReviewers: 1}, nil)
+	deps.userRepo.EXPECT().
+		GetTeamMembers(gomock.Any(), "backend", false).
+		Return([]domain.User{
+			{UserID: "u1", Seniority: domain.SeniorityMiddle},
+			{UserID: "u2", Seniority: domain.SeniorityJunior},
+			{UserID: "s1", Seniority: domain.SenioritySenior},
+			{UserID: "u3"},
+			{UserID: "s2", Seniority: domain.SenioritySenior},
+		}, nil)
+	deps.prRepo.EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-1", []string{"u2", "s2"}).
+		Return(nil)
+
+	res, newID, err := s.ReassignReviewer(ctx, "pr-1", "s1")
+	require.NoError(t, err)
+	require.Equal(t, "s2", newID)
+	require.Equal(t, []string{"u2", "s2"}, res.AssignedReviewers)
+}
+
+func TestCreatePR_NotEnoughSeniors(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	deps.teamRepo.EXPECT().ListReviewRules(gomock.Any(), "backend").Return(nil, nil)
+	deps.teamRepo.EXPECT().
+		GetTeamSettings(gomock.Any(), "backend").
+		Return(domain.TeamSettings{TeamName: "backend", MinSeniorReviewers: 2}, nil)
+	deps.userRepo.EXPECT().
+		GetTeamMembers(gomock.Any(), "backend", false).
+		Return([]domain.User{
+			{UserID: "u1"},
+			{UserID: "u2", Seniority: domain.SeniorityJunior},
+			{UserID: "u3", Seniority: domain.SeniorityJunior},
+			{UserID: "s1", Seniority: domain.SenioritySenior},
+		}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}, {UserID: "s1"}}, nil)
+
+	// одного сеньора не хватает, а PR с ним одним требование не обходит
+	_, _, err := s.CreatePR(ctx, "pr-1", "Test", "u1", nil, nil, nil)
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNoCandidate, derr.Code)
+}
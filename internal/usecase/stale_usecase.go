@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+func (s *serviceImpl) ListStalePRs(ctx context.Context, teamName string, now time.Time) ([]domain.StalePR, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.ListStalePRs",
+		trace.WithAttributes(attribute.String("team.name", teamName)),
+	)
+	defer span.End()
+
+	if teamName != "" {
+		if _, err := s.teamRepo.GetTeam(ctx, teamName); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to get team for stale PRs",
+				zap.String("team", teamName),
+			)
+			return nil, err
+		}
+	}
+
+	prs, err := s.prRepo.GetStalePRs(ctx, teamName, s.staleAfter, now)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get stale PRs",
+			zap.String("team", teamName),
+		)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("stale.count", len(prs)))
+
+	return prs, nil
+}
+
+func (s *serviceImpl) ProcessStalePRs(ctx context.Context, now time.Time) (domain.StaleRunResult, error) {
+	ctx, span := tracer.Start(ctx, "Service.ProcessStalePRs")
+	defer span.End()
+
+	prs, err := s.prRepo.GetStalePRs(ctx, "", s.staleAfter, now)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get stale PRs")
+		return domain.StaleRunResult{}, err
+	}
+
+	metrics.PRStaleOpen.Reset()
+
+	var res domain.StaleRunResult
+	for _, p := range prs {
+		metrics.PRStaleOpen.WithLabelValues(p.TeamName).Inc()
+
+		// о PR сообщаем один раз, пока по нему снова не появится активность
+		if !p.IsNewlyStale() {
+			continue
+		}
+
+		if err := s.prRepo.MarkPRStale(ctx, p.PullRequest.PullRequestID, now); err != nil {
+			// PR будет обработан на следующем запуске
+			span.RecordError(err)
+			logger.LogDomainAware(ctx, err, "failed to mark PR stale",
+				zap.String("pr_id", p.PullRequest.PullRequestID),
+			)
+			continue
+		}
+
+		res.Detected++
+		metrics.PRStaleTotal.WithLabelValues(p.TeamName).Inc()
+		logger.FromContext(ctx).Info("pr.stale",
+			zap.String("pr_id", p.PullRequest.PullRequestID),
+			zap.String("team", p.TeamName),
+			zap.Strings("reviewers", p.PullRequest.AssignedReviewers),
+			zap.Time("last_activity_at", p.LastActivityAt),
+			zap.Duration("stale_after", p.StaleAfter),
+		)
+		s.notify(ctx, domain.Notification{
+			Event:           domain.NotificationStale,
+			TeamName:        p.TeamName,
+			PullRequestID:   p.PullRequest.PullRequestID,
+			PullRequestName: p.PullRequest.PullRequestName,
+			AuthorID:        p.PullRequest.AuthorID,
+			Reviewers:       p.PullRequest.AssignedReviewers,
+			LastActivityAt:  p.LastActivityAt,
+			StaleAfter:      p.StaleAfter,
+		})
+
+		if !p.AutoRotate || p.OldestReviewerID == "" {
+			continue
+		}
+
+		if s.rotateStaleReviewer(ctx, p) {
+			res.Rotated++
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("stale.count", len(prs)),
+		attribute.Int("stale.detected", res.Detected),
+		attribute.Int("stale.rotated", res.Rotated),
+	)
+
+	metrics.PRStaleRotatedTotal.Add(float64(res.Rotated))
+
+	return res, nil
+}
+
+// rotateStaleReviewer заменяет ревьювера, назначенного раньше остальных, по правилам ReassignReviewer.
+// Отсутствие кандидатов — штатная ситуация для маленьких команд, PR просто остаётся зависшим.
+func (s *serviceImpl) rotateStaleReviewer(ctx context.Context, p domain.StalePR) bool {
+	prID := p.PullRequest.PullRequestID
+
+	_, newReviewerID, err := s.ReassignReviewer(ctx, prID, p.OldestReviewerID)
+	if err != nil {
+		var derr *domain.DomainError
+		if errors.As(err, &derr) && derr.Code == domain.ErrorCodeNoCandidate {
+			logger.FromContext(ctx).Info("no candidate to rotate stale PR reviewer",
+				zap.String("pr_id", prID),
+				zap.String("reviewer_id", p.OldestReviewerID),
+			)
+			return false
+		}
+
+		logger.LogDomainAware(ctx, err, "failed to rotate stale PR reviewer",
+			zap.String("pr_id", prID),
+			zap.String("reviewer_id", p.OldestReviewerID),
+		)
+		return false
+	}
+
+	logger.FromContext(ctx).Info("stale PR reviewer rotated",
+		zap.String("pr_id", prID),
+		zap.String("old_reviewer_id", p.OldestReviewerID),
+		zap.String("new_reviewer_id", newReviewerID),
+	)
+	return true
+}
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/metrics"
+)
+
+func newStaleService(t *testing.T) (*serviceImpl, *teamDeps) {
+	s, deps := newTeamService(t)
+	s.staleAfter = 72 * time.Hour
+	return s, deps
+}
+
+func TestListStalePRs_UsesDefaultThreshold(t *testing.T) {
+	s, deps := newStaleService(t)
+	now := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	want := []domain.StalePR{{
+		PullRequest: domain.PullRequest{PullRequestID: "pr-1", Status: domain.PRStatusOpen},
+		TeamName:    "backend",
+		StaleAfter:  72 * time.Hour,
+	}}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "backend").
+		Return(domain.Team{TeamName: "backend"}, nil)
+	deps.prRepo.EXPECT().
+		GetStalePRs(gomock.Any(), "backend", 72*time.Hour, now).
+		Return(want, nil)
+
+	res, err := s.ListStalePRs(context.Background(), "backend", now)
+	require.NoError(t, err)
+	require.Equal(t, want, res)
+}
+
+func TestListStalePRs_TeamNotFound(t *testing.T) {
+	s, deps := newStaleService(t)
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "ghost").
+		Return(domain.Team{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found"))
+
+	_, err := s.ListStalePRs(context.Background(), "ghost", time.Now())
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNotFound, derr.Code)
+}
+
+func TestProcessStalePRs_MarksAndRotates(t *testing.T) {
+	s, deps := newStaleService(t)
+	expectTx(deps.transactor)
+	deps.noReviewRules()
+	ctx := context.Background()
+
+	now := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC)
+	activity := now.Add(-96 * time.Hour)
+	marked := now.Add(-time.Hour)
+
+	fresh := domain.StalePR{
+		PullRequest: domain.PullRequest{
+			PullRequestID:     "pr-1",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u2", "u3"},
+		},
+		TeamName:         "backend",
+		LastActivityAt:   activity,
+		StaleAfter:       72 * time.Hour,
+		AutoRotate:       true,
+		OldestReviewerID: "u2",
+	}
+	// уже отмечен после последней активности — повторно не сообщаем
+	reported := domain.StalePR{
+		PullRequest:      domain.PullRequest{PullRequestID: "pr-2", Status: domain.PRStatusOpen},
+		TeamName:         "backend",
+		LastActivityAt:   activity,
+		StaleAfter:       72 * time.Hour,
+		AutoRotate:       true,
+		MarkedAt:         &marked,
+		OldestReviewerID: "u4",
+	}
+
+	staleBefore := testutil.ToFloat64(metrics.PRStaleTotal.WithLabelValues("backend"))
+	rotatedBefore := testutil.ToFloat64(metrics.PRStaleRotatedTotal)
+
+	deps.prRepo.EXPECT().
+		GetStalePRs(gomock.Any(), "", 72*time.Hour, now).
+		Return([]domain.StalePR{fresh, reported}, nil)
+	deps.prRepo.EXPECT().
+		MarkPRStale(gomock.Any(), "pr-1", now).
+		Return(nil)
+
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "pr-1").
+		Return(fresh.PullRequest, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+			{UserID: "u3", TeamName: "backend", IsActive: true},
+			{UserID: "u5", TeamName: "backend", IsActive: true},
+		}, nil)
+	deps.prRepo.EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-1", []string{"u5", "u3"}).
+		Return(nil)
+
+	res, err := s.ProcessStalePRs(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, domain.StaleRunResult{Detected: 1, Rotated: 1}, res)
+
+	require.Equal(t, staleBefore+1, testutil.ToFloat64(metrics.PRStaleTotal.WithLabelValues("backend")))
+	require.Equal(t, rotatedBefore+1, testutil.ToFloat64(metrics.PRStaleRotatedTotal))
+	require.Equal(t, 2.0, testutil.ToFloat64(metrics.PRStaleOpen.WithLabelValues("backend")))
+}
+
+func TestProcessStalePRs_NoCandidate(t *testing.T) {
+	s, deps := newStaleService(t)
+	now := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	p := domain.StalePR{
+		PullRequest: domain.PullRequest{
+			PullRequestID:     "pr-1",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u2"},
+		},
+		TeamName:         "backend",
+		LastActivityAt:   now.Add(-96 * time.Hour),
+		StaleAfter:       72 * time.Hour,
+		AutoRotate:       true,
+		OldestReviewerID: "u2",
+	}
+
+	deps.prRepo.EXPECT().
+		GetStalePRs(gomock.Any(), "", 72*time.Hour, now).
+		Return([]domain.StalePR{p}, nil)
+	deps.prRepo.EXPECT().
+		MarkPRStale(gomock.Any(), "pr-1", now).
+		Return(nil)
+	deps.prRepo.EXPECT().
+		GetPR(gomock.Any(), "pr-1").
+		Return(p.PullRequest, nil)
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend", IsActive: true}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+		}, nil)
+
+	res, err := s.ProcessStalePRs(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, domain.StaleRunResult{Detected: 1}, res)
+}
+
+func TestProcessStalePRs_WithoutAutoRotate(t *testing.T) {
+	s, deps := newStaleService(t)
+	now := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	deps.prRepo.EXPECT().
+		GetStalePRs(gomock.Any(), "", 72*time.Hour, now).
+		Return([]domain.StalePR{{
+			PullRequest:      domain.PullRequest{PullRequestID: "pr-1", Status: domain.PRStatusOpen},
+			TeamName:         "frontend",
+			LastActivityAt:   now.Add(-96 * time.Hour),
+			StaleAfter:       72 * time.Hour,
+			OldestReviewerID: "u2",
+		}}, nil)
+	deps.prRepo.EXPECT().
+		MarkPRStale(gomock.Any(), "pr-1", now).
+		Return(nil)
+
+	res, err := s.ProcessStalePRs(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, domain.StaleRunResult{Detected: 1}, res)
+}
+
+func TestProcessStalePRs_MarkError(t *testing.T) {
+	s, deps := newStaleService(t)
+	now := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	deps.prRepo.EXPECT().
+		GetStalePRs(gomock.Any(), "", 72*time.Hour, now).
+		Return([]domain.StalePR{{
+			PullRequest:      domain.PullRequest{PullRequestID: "pr-1", Status: domain.PRStatusOpen},
+			TeamName:         "backend",
+			LastActivityAt:   now.Add(-96 * time.Hour),
+			AutoRotate:       true,
+			OldestReviewerID: "u2",
+		}}, nil)
+	deps.prRepo.EXPECT().
+		MarkPRStale(gomock.Any(), "pr-1", now).
+		Return(errors.New("db down"))
+
+	res, err := s.ProcessStalePRs(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, domain.StaleRunResult{}, res)
+}
+
+func TestProcessStalePRs_GetError(t *testing.T) {
+	s, deps := newStaleService(t)
+	wantErr := errors.New("db down")
+
+	deps.prRepo.EXPECT().
+		GetStalePRs(gomock.Any(), "", 72*time.Hour, gomock.Any()).
+		Return(nil, wantErr)
+
+	_, err := s.ProcessStalePRs(context.Background(), time.Now())
+	require.ErrorIs(t, err, wantErr)
+}
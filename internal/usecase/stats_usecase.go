@@ -2,21 +2,42 @@ package usecase
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
 	"github.com/alnoi/pr-reviewer-service/internal/logger"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
-func (s *serviceImpl) GetStats(ctx context.Context) (domain.Stats, error) {
+// GetStats считает статистику по PR, попавшим под фильтр.
+// Временной ряд строится, только если задана гранулярность.
+func (s *serviceImpl) GetStats(ctx context.Context, filter domain.StatsFilter, granularity domain.StatsGranularity) (domain.Stats, error) {
 	ctx, span := tracer.Start(
 		ctx,
 		"Service.GetStats",
+		trace.WithAttributes(
+			attribute.String("stats.team", filter.TeamName),
+			attribute.String("stats.granularity", string(granularity)),
+		),
 	)
 	defer span.End()
 
-	assignments, err := s.prRepo.GetAssignmentsCountByUser(ctx)
+	if filter.TeamName != "" {
+		if _, err := s.teamRepo.GetTeam(ctx, filter.TeamName); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to get team for statistics",
+				zap.String("team", filter.TeamName),
+			)
+			return domain.Stats{}, err
+		}
+	}
+
+	assignments, err := s.prRepo.GetAssignmentsCountByUser(ctx, filter)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -24,7 +45,7 @@ func (s *serviceImpl) GetStats(ctx context.Context) (domain.Stats, error) {
 		return domain.Stats{}, err
 	}
 
-	counts, err := s.prRepo.GetPRStatusCounts(ctx)
+	counts, err := s.prRepo.GetPRStatusCounts(ctx, filter)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -32,9 +53,33 @@ func (s *serviceImpl) GetStats(ctx context.Context) (domain.Stats, error) {
 		return domain.Stats{}, err
 	}
 
+	byTeam, err := s.prRepo.GetPRStatusCountsByTeam(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get per-team PR statistics")
+		return domain.Stats{}, err
+	}
+
+	series := make([]domain.StatsBucket, 0)
+	if granularity != "" {
+		buckets, err := s.prRepo.GetPRCountsByPeriod(ctx, filter, granularity)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to get PR time series",
+				zap.String("granularity", string(granularity)),
+			)
+			return domain.Stats{}, err
+		}
+		series = fillBucketGaps(buckets, granularity)
+	}
+
 	span.SetAttributes(
 		attribute.Int("stats.assignments_users", len(assignments)),
 		attribute.Int("stats.pr_total", counts.Total),
+		attribute.Int("stats.teams", len(byTeam)),
+		attribute.Int("stats.buckets", len(series)),
 	)
 
 	return domain.Stats{
@@ -44,5 +89,168 @@ func (s *serviceImpl) GetStats(ctx context.Context) (domain.Stats, error) {
 			Merged: counts.Merged,
 			Total:  counts.Total,
 		},
+		ByTeam:     byTeam,
+		TimeSeries: series,
 	}, nil
 }
+
+// GetCycleTime считает время от создания до мержа по PR под фильтром (в целом, по командам и ревьюверам)
+// и распределение возраста ещё открытых PR на момент now.
+func (s *serviceImpl) GetCycleTime(ctx context.Context, filter domain.StatsFilter, now time.Time) (domain.CycleTimeStats, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.GetCycleTime",
+		trace.WithAttributes(attribute.String("stats.team", filter.TeamName)),
+	)
+	defer span.End()
+
+	if filter.TeamName != "" {
+		if _, err := s.teamRepo.GetTeam(ctx, filter.TeamName); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to get team for cycle time",
+				zap.String("team", filter.TeamName),
+			)
+			return domain.CycleTimeStats{}, err
+		}
+	}
+
+	timings, err := s.prRepo.GetPRTimings(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get PR timings")
+		return domain.CycleTimeStats{}, err
+	}
+
+	var (
+		overall    []time.Duration
+		openAges   []time.Duration
+		byTeam     = make(map[string][]time.Duration)
+		byReviewer = make(map[string][]time.Duration)
+	)
+
+	for _, t := range timings {
+		if t.MergedAt == nil {
+			openAges = append(openAges, now.Sub(t.CreatedAt))
+			continue
+		}
+
+		cycle := t.MergedAt.Sub(t.CreatedAt)
+		overall = append(overall, cycle)
+		if t.TeamName != "" {
+			byTeam[t.TeamName] = append(byTeam[t.TeamName], cycle)
+		}
+		for _, reviewerID := range t.Reviewers {
+			byReviewer[reviewerID] = append(byReviewer[reviewerID], cycle)
+		}
+	}
+
+	res := domain.CycleTimeStats{
+		Overall:    summarizeDurations(overall),
+		ByTeam:     make([]domain.TeamCycleTime, 0, len(byTeam)),
+		ByReviewer: make([]domain.ReviewerCycleTime, 0, len(byReviewer)),
+		OpenAge: domain.OpenPRAge{
+			Age:     summarizeDurations(openAges),
+			Buckets: ageBuckets(openAges),
+		},
+	}
+
+	for _, teamName := range sortedKeys(byTeam) {
+		res.ByTeam = append(res.ByTeam, domain.TeamCycleTime{
+			TeamName:  teamName,
+			CycleTime: summarizeDurations(byTeam[teamName]),
+		})
+	}
+	for _, userID := range sortedKeys(byReviewer) {
+		res.ByReviewer = append(res.ByReviewer, domain.ReviewerCycleTime{
+			UserID:    userID,
+			CycleTime: summarizeDurations(byReviewer[userID]),
+		})
+	}
+
+	span.SetAttributes(
+		attribute.Int("stats.merged", res.Overall.Count),
+		attribute.Int("stats.open", res.OpenAge.Age.Count),
+	)
+
+	return res, nil
+}
+
+// --------------------HELPERS----------------------
+
+// fillBucketGaps дополняет отсортированный ряд пустыми периодами между первым и последним.
+func fillBucketGaps(buckets []domain.StatsBucket, granularity domain.StatsGranularity) []domain.StatsBucket {
+	if len(buckets) == 0 {
+		return []domain.StatsBucket{}
+	}
+
+	res := make([]domain.StatsBucket, 0, len(buckets))
+	next := buckets[0].Start
+
+	for _, b := range buckets {
+		for next.Before(b.Start) {
+			res = append(res, domain.StatsBucket{Start: next})
+			next = granularity.Next(next)
+		}
+		res = append(res, b)
+		next = granularity.Next(b.Start)
+	}
+
+	return res
+}
+
+// summarizeDurations считает среднее и перцентили методом ближайшего ранга.
+func summarizeDurations(ds []time.Duration) domain.DurationSummary {
+	if len(ds) == 0 {
+		return domain.DurationSummary{}
+	}
+
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+
+	percentile := func(p int) time.Duration {
+		rank := (p*len(sorted) + 99) / 100
+		return sorted[rank-1]
+	}
+
+	return domain.DurationSummary{
+		Count: len(sorted),
+		Mean:  sum / time.Duration(len(sorted)),
+		P50:   percentile(50),
+		P90:   percentile(90),
+		P99:   percentile(99),
+	}
+}
+
+// ageBuckets раскладывает длительности по корзинам domain.CycleTimeBuckets плюс корзина без верхней границы.
+func ageBuckets(ds []time.Duration) []domain.AgeBucket {
+	res := make([]domain.AgeBucket, 0, len(domain.CycleTimeBuckets)+1)
+	for _, b := range domain.CycleTimeBuckets {
+		res = append(res, domain.AgeBucket{UpperBound: b})
+	}
+	res = append(res, domain.AgeBucket{})
+
+	for _, d := range ds {
+		i := sort.Search(len(domain.CycleTimeBuckets), func(i int) bool {
+			return d <= domain.CycleTimeBuckets[i]
+		})
+		res[i].Count++
+	}
+
+	return res
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
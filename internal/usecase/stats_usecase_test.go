@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
 	mock_usecase "github.com/alnoi/pr-reviewer-service/internal/mocks"
@@ -12,18 +13,21 @@ import (
 )
 
 type statsDeps struct {
-	prRepo *mock_usecase.MockPRRepository
+	prRepo   *mock_usecase.MockPRRepository
+	teamRepo *mock_usecase.MockTeamRepository
 }
 
 func newStatsService(t *testing.T) (*serviceImpl, *statsDeps) {
 	ctrl := gomock.NewController(t)
 
 	deps := &statsDeps{
-		prRepo: mock_usecase.NewMockPRRepository(ctrl),
+		prRepo:   mock_usecase.NewMockPRRepository(ctrl),
+		teamRepo: mock_usecase.NewMockTeamRepository(ctrl),
 	}
 
 	s := &serviceImpl{
-		prRepo: deps.prRepo,
+		prRepo:   deps.prRepo,
+		teamRepo: deps.teamRepo,
 	}
 
 	return s, deps
@@ -45,16 +49,23 @@ func TestGetStats_Success(t *testing.T) {
 	}
 
 	deps.prRepo.EXPECT().
-		GetAssignmentsCountByUser(ctx).
+		GetAssignmentsCountByUser(gomock.Any(), domain.StatsFilter{}).
 		Return(stats, nil)
 
 	deps.prRepo.EXPECT().
-		GetPRStatusCounts(ctx).
+		GetPRStatusCounts(gomock.Any(), domain.StatsFilter{}).
 		Return(counts, nil)
 
-	res, err := s.GetStats(ctx)
+	deps.prRepo.EXPECT().
+		GetPRStatusCountsByTeam(gomock.Any(), domain.StatsFilter{}).
+		Return([]domain.TeamStats{{TeamName: "backend", PRStatusCounts: counts}}, nil)
+
+	res, err := s.GetStats(ctx, domain.StatsFilter{}, "")
 	require.NoError(t, err)
 
+	require.Equal(t, []domain.TeamStats{{TeamName: "backend", PRStatusCounts: counts}}, res.ByTeam)
+	require.Empty(t, res.TimeSeries)
+
 	require.Equal(t, stats, res.AssignmentsByUser)
 	require.Equal(t, counts.Open, res.PRStatusCounts.Open)
 	require.Equal(t, counts.Merged, res.PRStatusCounts.Merged)
@@ -68,10 +79,10 @@ func TestGetStats_ErrorAssignments(t *testing.T) {
 	wantErr := errors.New("assignments failed")
 
 	deps.prRepo.EXPECT().
-		GetAssignmentsCountByUser(ctx).
+		GetAssignmentsCountByUser(gomock.Any(), domain.StatsFilter{}).
 		Return(nil, wantErr)
 
-	res, err := s.GetStats(ctx)
+	res, err := s.GetStats(ctx, domain.StatsFilter{}, "")
 	require.Error(t, err)
 	require.Equal(t, domain.Stats{}, res)
 	require.ErrorIs(t, err, wantErr)
@@ -88,15 +99,135 @@ func TestGetStats_ErrorPRStatus(t *testing.T) {
 	wantErr := errors.New("status failed")
 
 	deps.prRepo.EXPECT().
-		GetAssignmentsCountByUser(ctx).
+		GetAssignmentsCountByUser(gomock.Any(), domain.StatsFilter{}).
 		Return(stats, nil)
 
 	deps.prRepo.EXPECT().
-		GetPRStatusCounts(ctx).
+		GetPRStatusCounts(gomock.Any(), domain.StatsFilter{}).
 		Return(domain.PRStatusCounts{}, wantErr)
 
-	res, err := s.GetStats(ctx)
+	res, err := s.GetStats(ctx, domain.StatsFilter{}, "")
 	require.Error(t, err)
 	require.Equal(t, domain.Stats{}, res)
 	require.ErrorIs(t, err, wantErr)
 }
+
+func TestGetStats_TeamNotFound(t *testing.T) {
+	s, deps := newStatsService(t)
+	ctx := context.Background()
+
+	filter := domain.StatsFilter{TeamName: "missing"}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "missing").
+		Return(domain.Team{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found"))
+
+	_, err := s.GetStats(ctx, filter, "")
+	require.Error(t, err)
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNotFound, derr.Code)
+}
+
+func TestGetStats_TimeSeriesFillsGaps(t *testing.T) {
+	s, deps := newStatsService(t)
+	ctx := context.Background()
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	filter := domain.StatsFilter{TeamName: "backend", CreatedFrom: &from}
+
+	week1 := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	week3 := time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "backend").
+		Return(domain.Team{TeamName: "backend"}, nil)
+	deps.prRepo.EXPECT().
+		GetAssignmentsCountByUser(gomock.Any(), filter).
+		Return(nil, nil)
+	deps.prRepo.EXPECT().
+		GetPRStatusCounts(gomock.Any(), filter).
+		Return(domain.PRStatusCounts{Open: 2, Merged: 1, Total: 3}, nil)
+	deps.prRepo.EXPECT().
+		GetPRStatusCountsByTeam(gomock.Any(), filter).
+		Return(nil, nil)
+	deps.prRepo.EXPECT().
+		GetPRCountsByPeriod(gomock.Any(), filter, domain.StatsGranularityWeek).
+		Return([]domain.StatsBucket{
+			{Start: week1, Created: 2},
+			{Start: week3, Created: 1, Merged: 1},
+		}, nil)
+
+	res, err := s.GetStats(ctx, filter, domain.StatsGranularityWeek)
+	require.NoError(t, err)
+	require.Equal(t, []domain.StatsBucket{
+		{Start: week1, Created: 2},
+		{Start: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{Start: week3, Created: 1, Merged: 1},
+	}, res.TimeSeries)
+}
+
+func TestGetCycleTime_Success(t *testing.T) {
+	s, deps := newStatsService(t)
+	ctx := context.Background()
+
+	now := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC)
+	created := now.Add(-10 * 24 * time.Hour)
+	merged := func(d time.Duration) *time.Time {
+		at := created.Add(d)
+		return &at
+	}
+
+	deps.prRepo.EXPECT().
+		GetPRTimings(gomock.Any(), domain.StatsFilter{}).
+		Return([]domain.PRTiming{
+			{PullRequestID: "pr-1", TeamName: "backend", Reviewers: []string{"u2"}, CreatedAt: created, MergedAt: merged(time.Hour)},
+			{PullRequestID: "pr-2", TeamName: "backend", Reviewers: []string{"u2", "u3"}, CreatedAt: created, MergedAt: merged(3 * time.Hour)},
+			{PullRequestID: "pr-3", TeamName: "frontend", Reviewers: []string{"u3"}, CreatedAt: created, MergedAt: merged(8 * time.Hour)},
+			{PullRequestID: "pr-4", TeamName: "backend", CreatedAt: now.Add(-2 * time.Hour)},
+			{PullRequestID: "pr-5", TeamName: "backend", CreatedAt: now.Add(-40 * 24 * time.Hour)},
+		}, nil)
+
+	res, err := s.GetCycleTime(ctx, domain.StatsFilter{}, now)
+	require.NoError(t, err)
+
+	require.Equal(t, domain.DurationSummary{
+		Count: 3,
+		Mean:  4 * time.Hour,
+		P50:   3 * time.Hour,
+		P90:   8 * time.Hour,
+		P99:   8 * time.Hour,
+	}, res.Overall)
+
+	require.Equal(t, []domain.TeamCycleTime{
+		{TeamName: "backend", CycleTime: domain.DurationSummary{Count: 2, Mean: 2 * time.Hour, P50: time.Hour, P90: 3 * time.Hour, P99: 3 * time.Hour}},
+		{TeamName: "frontend", CycleTime: domain.DurationSummary{Count: 1, Mean: 8 * time.Hour, P50: 8 * time.Hour, P90: 8 * time.Hour, P99: 8 * time.Hour}},
+	}, res.ByTeam)
+
+	require.Len(t, res.ByReviewer, 2)
+	require.Equal(t, "u2", res.ByReviewer[0].UserID)
+	require.Equal(t, 2*time.Hour, res.ByReviewer[0].CycleTime.Mean)
+	require.Equal(t, "u3", res.ByReviewer[1].UserID)
+	require.Equal(t, 5*time.Hour+30*time.Minute, res.ByReviewer[1].CycleTime.Mean)
+
+	require.Equal(t, 2, res.OpenAge.Age.Count)
+	require.Len(t, res.OpenAge.Buckets, len(domain.CycleTimeBuckets)+1)
+	require.Equal(t, domain.AgeBucket{UpperBound: 4 * time.Hour, Count: 1}, res.OpenAge.Buckets[1])
+	require.Equal(t, domain.AgeBucket{Count: 1}, res.OpenAge.Buckets[len(domain.CycleTimeBuckets)])
+}
+
+func TestGetCycleTime_Empty(t *testing.T) {
+	s, deps := newStatsService(t)
+
+	deps.prRepo.EXPECT().
+		GetPRTimings(gomock.Any(), domain.StatsFilter{}).
+		Return([]domain.PRTiming{}, nil)
+
+	res, err := s.GetCycleTime(context.Background(), domain.StatsFilter{}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, domain.DurationSummary{}, res.Overall)
+	require.Empty(t, res.ByTeam)
+	require.Empty(t, res.ByReviewer)
+	require.Len(t, res.OpenAge.Buckets, len(domain.CycleTimeBuckets)+1)
+}
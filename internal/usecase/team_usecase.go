@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
 	"github.com/alnoi/pr-reviewer-service/internal/logger"
 	"github.com/alnoi/pr-reviewer-service/internal/metrics"
@@ -10,11 +11,16 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"math/rand"
+	"time"
 )
 
 type prUpdate struct {
 	id        string
+	name      string
+	author    string
 	reviewers []string
+	removed   []string
+	added     []string
 }
 
 func (s *serviceImpl) CreateTeam(ctx context.Context, team domain.Team) (domain.Team, error) {
@@ -28,6 +34,12 @@ func (s *serviceImpl) CreateTeam(ctx context.Context, team domain.Team) (domain.
 	)
 	defer span.End()
 
+	if err := checkMembersSeniority(team.Members); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.Team{}, err
+	}
+
 	var res domain.Team
 
 	err := s.transactor.WithTx(ctx, func(ctx context.Context) error {
@@ -93,13 +105,108 @@ func (s *serviceImpl) GetTeam(ctx context.Context, teamName string) (domain.Team
 	return team, nil
 }
 
-func (s *serviceImpl) DeactivateTeamMembers(ctx context.Context, teamName string, userIDs []string) (domain.Team, error) {
+func (s *serviceImpl) GetTeamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.GetTeamSettings",
+		trace.WithAttributes(attribute.String("team.name", teamName)),
+	)
+	defer span.End()
+
+	settings, err := s.teamRepo.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team settings",
+			zap.String("team_name", teamName),
+		)
+		return domain.TeamSettings{}, err
+	}
+
+	return settings, nil
+}
+
+func (s *serviceImpl) UpdateTeamSettings(ctx context.Context, settings domain.TeamSettings) (domain.TeamSettings, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.UpdateTeamSettings",
+		trace.WithAttributes(
+			attribute.String("team.name", settings.TeamName),
+			attribute.String("team.stale_after", settings.StaleAfter.String()),
+			attribute.Bool("team.stale_auto_rotate", settings.StaleAutoRotate),
+			attribute.Int("team.min_senior_reviewers", settings.MinSeniorReviewers),
+		),
+	)
+	defer span.End()
+
+	if _, err := s.teamRepo.GetTeam(ctx, settings.TeamName); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team for settings update",
+			zap.String("team_name", settings.TeamName),
+		)
+		return domain.TeamSettings{}, err
+	}
+
+	if settings.MinSeniorReviewers < 0 || settings.MinSeniorReviewers > maxReviewersPerPR {
+		err := domain.NewDomainError(domain.ErrorCodeBadRequest,
+			fmt.Sprintf("min_senior_reviewers must be between 0 and %d", maxReviewersPerPR))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.TeamSettings{}, err
+	}
+
+	if err := s.checkReviewerTeams(ctx, settings); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "invalid reviewer teams in team settings",
+			zap.String("team_name", settings.TeamName),
+			zap.Strings("reviewer_teams", settings.ReviewerTeams),
+		)
+		return domain.TeamSettings{}, err
+	}
+
+	if err := s.teamRepo.UpsertTeamSettings(ctx, settings); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to update team settings",
+			zap.String("team_name", settings.TeamName),
+		)
+		return domain.TeamSettings{}, err
+	}
+
+	return settings, nil
+}
+
+// checkReviewerTeams проверяет, что разрешённые для запроса ревьюверов команды существуют,
+// не повторяются и не совпадают с самой командой.
+func (s *serviceImpl) checkReviewerTeams(ctx context.Context, settings domain.TeamSettings) error {
+	seen := make(map[string]struct{}, len(settings.ReviewerTeams))
+	for _, name := range settings.ReviewerTeams {
+		if name == "" || name == settings.TeamName {
+			return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("invalid reviewer team %q", name))
+		}
+		if _, dup := seen[name]; dup {
+			return domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("reviewer team %q is duplicated", name))
+		}
+		seen[name] = struct{}{}
+
+		if _, err := s.teamRepo.GetTeam(ctx, name); err != nil {
+			return notFoundAs(err, fmt.Sprintf("reviewer team %q not found", name))
+		}
+	}
+	return nil
+}
+
+func (s *serviceImpl) DeactivateTeamMembers(ctx context.Context, teamName string, userIDs []string, dryRun bool) (domain.DeactivationResult, error) {
 	ctx, span := tracer.Start(
 		ctx,
 		"Service.DeactivateTeamMembers",
 		trace.WithAttributes(
 			attribute.String("team.name", teamName),
 			attribute.Int("deactivate.requested_count", len(userIDs)),
+			attribute.Bool("deactivate.dry_run", dryRun),
+			attribute.Int64("selection.base_seed", s.seed),
 		),
 	)
 	defer span.End()
@@ -111,7 +218,7 @@ func (s *serviceImpl) DeactivateTeamMembers(ctx context.Context, teamName string
 		logger.LogDomainAware(ctx, err, "failed to get team before deactivation",
 			zap.String("team_name", teamName),
 		)
-		return domain.Team{}, err
+		return domain.DeactivationResult{}, err
 	}
 
 	if err := validateUsersInTeam(team, userIDs); err != nil {
@@ -120,11 +227,16 @@ func (s *serviceImpl) DeactivateTeamMembers(ctx context.Context, teamName string
 		logger.LogDomainAware(ctx, err, "attempt to deactivate user not in team",
 			zap.String("team_name", teamName),
 		)
-		return domain.Team{}, err
+		return domain.DeactivationResult{}, err
+	}
+
+	res := domain.DeactivationResult{
+		Team:   team,
+		DryRun: dryRun,
 	}
 
 	if len(userIDs) == 0 {
-		return team, nil
+		return res, nil
 	}
 
 	toDeactivate, candidatePool, err := s.prepareDeactivationTargets(ctx, teamName, userIDs)
@@ -134,12 +246,14 @@ func (s *serviceImpl) DeactivateTeamMembers(ctx context.Context, teamName string
 		logger.LogDomainAware(ctx, err, "failed to prepare deactivation targets",
 			zap.String("team_name", teamName),
 		)
-		return domain.Team{}, err
+		return domain.DeactivationResult{}, err
 	}
 	if len(toDeactivate) == 0 {
-		return team, nil
+		return res, nil
 	}
 
+	res.Deactivated = toDeactivate
+
 	prs, err := s.prRepo.GetOpenPRsByReviewers(ctx, toDeactivate)
 	if err != nil {
 		span.RecordError(err)
@@ -147,23 +261,28 @@ func (s *serviceImpl) DeactivateTeamMembers(ctx context.Context, teamName string
 		logger.LogDomainAware(ctx, err, "failed to get open PRs for deactivated reviewers",
 			zap.String("team_name", teamName),
 		)
-		return domain.Team{}, err
+		return domain.DeactivationResult{}, err
 	}
 
 	if len(prs) == 0 {
-		if err := s.applyDeactivationAndUpdates(ctx, toDeactivate, nil); err != nil {
+		if dryRun {
+			return res, nil
+		}
+		evs, err := s.applyDeactivationAndUpdates(ctx, teamName, toDeactivate, nil)
+		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			logger.LogDomainAware(ctx, err, "failed to apply deactivation for users without PRs",
 				zap.String("team_name", teamName),
 			)
-			return domain.Team{}, err
+			return domain.DeactivationResult{}, err
 		}
 		span.SetAttributes(
 			attribute.Int("deactivate.applied_count", len(toDeactivate)),
 			attribute.Bool("deactivate.reassigned_prs", false),
 		)
-		return team, nil
+		s.eventsCommitted(evs...)
+		return res, nil
 	}
 
 	if len(candidatePool) == 0 {
@@ -173,26 +292,44 @@ func (s *serviceImpl) DeactivateTeamMembers(ctx context.Context, teamName string
 		logger.LogDomainAware(ctx, derr, "no replacement candidates for deactivated team members",
 			zap.String("team_name", teamName),
 		)
-		return domain.Team{}, derr
+		return domain.DeactivationResult{}, derr
 	}
 
-	updates, err := s.preparePRUpdates(prs, candidatePool, toDeactivate)
+	rules, err := s.reviewRulesFor(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get review rules for deactivation",
+			zap.String("team_name", teamName),
+		)
+		return domain.DeactivationResult{}, err
+	}
+
+	updates, err := s.preparePRUpdates(ctx, prs, candidatePool, toDeactivate, rules)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		logger.LogDomainAware(ctx, err, "failed to prepare PR updates for deactivation",
 			zap.String("team_name", teamName),
 		)
-		return domain.Team{}, err
+		return domain.DeactivationResult{}, err
+	}
+
+	res.Updates = toDomainPRUpdates(updates)
+
+	if dryRun {
+		span.SetAttributes(attribute.Int("deactivate.planned_prs_count", len(updates)))
+		return res, nil
 	}
 
-	if err := s.applyDeactivationAndUpdates(ctx, toDeactivate, updates); err != nil {
+	evs, err := s.applyDeactivationAndUpdates(ctx, teamName, toDeactivate, updates)
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		logger.LogDomainAware(ctx, err, "failed to apply deactivation and PR updates",
 			zap.String("team_name", teamName),
 		)
-		return domain.Team{}, err
+		return domain.DeactivationResult{}, err
 	}
 
 	span.SetAttributes(
@@ -205,12 +342,258 @@ func (s *serviceImpl) DeactivateTeamMembers(ctx context.Context, teamName string
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return domain.Team{}, err
+		return domain.DeactivationResult{}, err
 	}
 
 	metrics.TeamDeactivatedTotal.Inc()
 
-	return updatedTeam, nil
+	res.Team = updatedTeam
+	s.eventsCommitted(evs...)
+	s.notifyPRUpdates(ctx, updates)
+
+	return res, nil
+}
+
+func (s *serviceImpl) AddTeamMembers(ctx context.Context, teamName string, members []domain.TeamMember) (domain.Team, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.AddTeamMembers",
+		trace.WithAttributes(
+			attribute.String("team.name", teamName),
+			attribute.Int("team.added_count", len(members)),
+		),
+	)
+	defer span.End()
+
+	if err := checkMembersSeniority(members); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.Team{}, err
+	}
+
+	if _, err := s.teamRepo.GetTeam(ctx, teamName); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team before adding members",
+			zap.String("team_name", teamName),
+		)
+		return domain.Team{}, err
+	}
+
+	var res domain.Team
+
+	err := s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		if err := s.userRepo.UpsertUsers(txCtx, teamName, members); err != nil {
+			logger.LogDomainAware(txCtx, err, "failed to upsert team members inside transaction",
+				zap.String("team_name", teamName),
+			)
+			return err
+		}
+
+		updated, err := s.teamRepo.GetTeam(txCtx, teamName)
+		if err != nil {
+			logger.LogDomainAware(txCtx, err, "failed to fetch updated team inside transaction",
+				zap.String("team_name", teamName),
+			)
+			return err
+		}
+
+		res = updated
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.Team{}, err
+	}
+
+	return res, nil
+}
+
+func (s *serviceImpl) RemoveTeamMembers(ctx context.Context, teamName string, userIDs []string) (domain.Team, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.RemoveTeamMembers",
+		trace.WithAttributes(
+			attribute.String("team.name", teamName),
+			attribute.Int("remove.requested_count", len(userIDs)),
+		),
+	)
+	defer span.End()
+
+	team, err := s.teamRepo.GetTeam(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team before removing members",
+			zap.String("team_name", teamName),
+		)
+		return domain.Team{}, err
+	}
+
+	if err := validateUsersInTeam(team, userIDs); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "attempt to remove user not in team",
+			zap.String("team_name", teamName),
+		)
+		return domain.Team{}, err
+	}
+
+	if len(userIDs) == 0 {
+		return team, nil
+	}
+
+	toRemove := uniqueIDs(userIDs)
+
+	updates, err := s.prepareMemberReplacement(ctx, teamName, toRemove)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to prepare PR updates for removed members",
+			zap.String("team_name", teamName),
+		)
+		return domain.Team{}, err
+	}
+
+	var (
+		res domain.Team
+		evs []domain.Event
+	)
+
+	err = s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		prEvs, err := s.savePRUpdates(txCtx, updates)
+		if err != nil {
+			return err
+		}
+		evs = prEvs
+
+		if err := s.userRepo.DetachUsers(txCtx, toRemove); err != nil {
+			return err
+		}
+
+		updated, err := s.teamRepo.GetTeam(txCtx, teamName)
+		if err != nil {
+			return err
+		}
+
+		res = updated
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to apply members removal and PR updates",
+			zap.String("team_name", teamName),
+		)
+		return domain.Team{}, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("remove.applied_count", len(toRemove)),
+		attribute.Int("remove.updated_prs_count", len(updates)),
+	)
+
+	s.eventsCommitted(evs...)
+	s.notifyPRUpdates(ctx, updates)
+
+	return res, nil
+}
+
+func (s *serviceImpl) UpdateTeamMember(ctx context.Context, teamName string, member domain.TeamMember) (domain.Team, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.UpdateTeamMember",
+		trace.WithAttributes(
+			attribute.String("team.name", teamName),
+			attribute.String("user.id", member.UserID),
+			attribute.Bool("user.is_active", member.IsActive),
+		),
+	)
+	defer span.End()
+
+	if err := checkSeniority(member.Seniority); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.Team{}, err
+	}
+
+	team, err := s.teamRepo.GetTeam(ctx, teamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get team before updating member",
+			zap.String("team_name", teamName),
+		)
+		return domain.Team{}, err
+	}
+
+	current, ok := findTeamMember(team, member.UserID)
+	if !ok {
+		derr := domain.NewDomainError(domain.ErrorCodeNotFound, "user not found in team")
+		span.RecordError(derr)
+		span.SetStatus(codes.Error, derr.Error())
+		logger.LogDomainAware(ctx, derr, "attempt to update user not in team",
+			zap.String("team_name", teamName),
+			zap.String("user_id", member.UserID),
+		)
+		return domain.Team{}, derr
+	}
+
+	var updates []prUpdate
+	if current.IsActive && !member.IsActive {
+		updates, err = s.prepareMemberReplacement(ctx, teamName, []string{member.UserID})
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to prepare PR updates for deactivated member",
+				zap.String("team_name", teamName),
+				zap.String("user_id", member.UserID),
+			)
+			return domain.Team{}, err
+		}
+	}
+
+	var (
+		res domain.Team
+		evs []domain.Event
+	)
+
+	err = s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		prEvs, err := s.savePRUpdates(txCtx, updates)
+		if err != nil {
+			return err
+		}
+		evs = prEvs
+
+		if err := s.userRepo.UpsertUsers(txCtx, teamName, []domain.TeamMember{member}); err != nil {
+			return err
+		}
+
+		updated, err := s.teamRepo.GetTeam(txCtx, teamName)
+		if err != nil {
+			return err
+		}
+
+		res = updated
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to apply member update",
+			zap.String("team_name", teamName),
+			zap.String("user_id", member.UserID),
+		)
+		return domain.Team{}, err
+	}
+
+	span.SetAttributes(attribute.Int("update.updated_prs_count", len(updates)))
+
+	s.eventsCommitted(evs...)
+	s.notifyPRUpdates(ctx, updates)
+
+	return res, nil
 }
 
 // --------------------HELPERS-----------------------------
@@ -236,6 +619,78 @@ func buildUniqueIDSet(ids []string) map[string]struct{} {
 	return uniq
 }
 
+func toDomainPRUpdates(updates []prUpdate) []domain.PRReviewersUpdate {
+	res := make([]domain.PRReviewersUpdate, 0, len(updates))
+	for _, u := range updates {
+		res = append(res, domain.PRReviewersUpdate{
+			PullRequestID: u.id,
+			Removed:       u.removed,
+			Added:         u.added,
+			Reviewers:     u.reviewers,
+		})
+	}
+	return res
+}
+
+func findTeamMember(team domain.Team, userID string) (domain.TeamMember, bool) {
+	for _, m := range team.Members {
+		if m.UserID == userID {
+			return m, true
+		}
+	}
+	return domain.TeamMember{}, false
+}
+
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+	return res
+}
+
+// prepareMemberReplacement готовит замену userIDs во всех открытых PR, где они ревьюверы.
+// Кандидаты — активные и не находящиеся в отпуске участники команды, не входящие в userIDs.
+func (s *serviceImpl) prepareMemberReplacement(ctx context.Context, teamName string, userIDs []string) ([]prUpdate, error) {
+	prs, err := s.prRepo.GetOpenPRsByReviewers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(prs) == 0 {
+		return nil, nil
+	}
+
+	members, err := s.userRepo.GetAvailableTeamMembers(ctx, teamName, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	leaving := buildUniqueIDSet(userIDs)
+	candidatePool := make([]string, 0, len(members))
+	for _, m := range members {
+		if _, skip := leaving[m.UserID]; skip {
+			continue
+		}
+		candidatePool = append(candidatePool, m.UserID)
+	}
+
+	if len(candidatePool) == 0 {
+		return nil, domain.NewDomainError(domain.ErrorCodeNoCandidate, "no active replacement candidate in team")
+	}
+
+	rules, err := s.reviewRulesFor(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.preparePRUpdates(ctx, prs, candidatePool, userIDs, rules)
+}
+
 func (s *serviceImpl) prepareDeactivationTargets(ctx context.Context, teamName string, userIDs []string) ([]string, []string, error) {
 	uniq := buildUniqueIDSet(userIDs)
 
@@ -260,8 +715,14 @@ func (s *serviceImpl) prepareDeactivationTargets(ctx context.Context, teamName s
 		return nil, nil, nil
 	}
 
-	candidatePool := make([]string, 0, len(members))
-	for _, m := range members {
+	// деактивировать можно и отсутствующих, а заменять их — только доступными сейчас
+	available, err := s.userRepo.GetAvailableTeamMembers(ctx, teamName, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candidatePool := make([]string, 0, len(available))
+	for _, m := range available {
 		if _, disable := toDeactivateSet[m.UserID]; disable {
 			continue
 		}
@@ -280,7 +741,9 @@ func buildBaseExclude(pr domain.PullRequest) map[string]struct{} {
 	return baseExclude
 }
 
-func chooseReplacement(candidatePool []string, baseExclude map[string]struct{}) (string, error) {
+// chooseReplacement выбирает случайного кандидата из пула вне baseExclude, для которого fits
+// не вернул причину отказа по правилам команды.
+func chooseReplacement(rnd *rand.Rand, candidatePool []string, baseExclude map[string]struct{}, fits func(id string) string) (string, error) {
 	candidates := make([]string, 0, len(candidatePool))
 	for _, cid := range candidatePool {
 		if _, skip := baseExclude[cid]; skip {
@@ -293,15 +756,20 @@ func chooseReplacement(candidatePool []string, baseExclude map[string]struct{})
 		return "", domain.NewDomainError(domain.ErrorCodeNoCandidate, "no active replacement candidate in team")
 	}
 
+	candidates, reason := filterCandidates(candidates, fits)
+	if len(candidates) == 0 {
+		return "", domain.NewDomainError(domain.ErrorCodeNoCandidate, "no replacement candidate satisfies team rules: "+reason)
+	}
+
 	if len(candidates) == 1 {
 		return candidates[0], nil
 	}
 
-	idx := rand.Intn(len(candidates))
+	idx := rnd.Intn(len(candidates))
 	return candidates[idx], nil
 }
 
-func (s *serviceImpl) preparePRUpdates(prs []domain.PullRequest, candidatePool, toDeactivate []string) ([]prUpdate, error) {
+func (s *serviceImpl) preparePRUpdates(ctx context.Context, prs []domain.PullRequest, candidatePool, toDeactivate []string, rules reviewRules) ([]prUpdate, error) {
 	toDeactivateSet := make(map[string]struct{}, len(toDeactivate))
 	for _, id := range toDeactivate {
 		toDeactivateSet[id] = struct{}{}
@@ -315,47 +783,130 @@ func (s *serviceImpl) preparePRUpdates(prs []domain.PullRequest, candidatePool,
 		}
 
 		baseExclude := buildBaseExclude(pr)
+		rnd, seed := s.selectionRand(pr.PullRequestID)
 
 		newReviewers := make([]string, len(pr.AssignedReviewers))
 		copy(newReviewers, pr.AssignedReviewers)
 
+		var removed, added []string
+
 		for i, rID := range pr.AssignedReviewers {
 			if _, toDisable := toDeactivateSet[rID]; !toDisable {
 				continue
 			}
 
-			chosen, err := chooseReplacement(candidatePool, baseExclude)
+			// ещё не заменённые уходящие ревьюверы в проверку правил не попадают
+			chosen, err := chooseReplacement(rnd, candidatePool, baseExclude, func(id string) string {
+				reviewers := make([]string, 0, len(newReviewers))
+				for j, rID := range newReviewers {
+					if j == i {
+						reviewers = append(reviewers, id)
+						continue
+					}
+					if _, pending := toDeactivateSet[rID]; pending && j > i {
+						continue
+					}
+					reviewers = append(reviewers, rID)
+				}
+				return rules.check(pr.AuthorID, reviewers)
+			})
 			if err != nil {
 				return nil, err
 			}
 
 			newReviewers[i] = chosen
 			baseExclude[chosen] = struct{}{}
+
+			removed = append(removed, rID)
+			added = append(added, chosen)
 		}
 
+		// в одном спане замен по нескольким PR, поэтому сид пишется событием с ID PR
+		trace.SpanFromContext(ctx).AddEvent("replacements selected", trace.WithAttributes(
+			attribute.String("pr.id", pr.PullRequestID),
+			attribute.Int64("selection.seed", seed),
+		))
+		logger.FromContext(ctx).Debug("replacements selected",
+			zap.String("pr_id", pr.PullRequestID),
+			zap.Int64("selection_seed", seed),
+			zap.Strings("removed", removed),
+			zap.Strings("added", added),
+		)
+
 		updates = append(updates, prUpdate{
 			id:        pr.PullRequestID,
+			name:      pr.PullRequestName,
+			author:    pr.AuthorID,
 			reviewers: newReviewers,
+			removed:   removed,
+			added:     added,
 		})
 	}
 
 	return updates, nil
 }
 
-func (s *serviceImpl) applyDeactivationAndUpdates(ctx context.Context, toDeactivate []string, updates []prUpdate) error {
-	return s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+// applyDeactivationAndUpdates деактивирует участников и заменяет их в PR одной транзакцией
+// и возвращает записанные в ней события ленты.
+func (s *serviceImpl) applyDeactivationAndUpdates(ctx context.Context, teamName string, toDeactivate []string, updates []prUpdate) ([]domain.Event, error) {
+	var evs []domain.Event
+
+	err := s.transactor.WithTx(ctx, func(txCtx context.Context) error {
 		for _, id := range toDeactivate {
 			if _, err := s.userRepo.SetUserIsActive(txCtx, id, false); err != nil {
 				return err
 			}
 		}
 
-		for _, u := range updates {
-			if err := s.prRepo.SetPRReviewers(txCtx, u.id, u.reviewers); err != nil {
-				return err
-			}
+		prEvs, err := s.savePRUpdates(txCtx, updates)
+		if err != nil {
+			return err
+		}
+		evs = prEvs
+
+		ev := domain.Event{
+			Type:               domain.EventTeamDeactivated,
+			TeamName:           teamName,
+			DeactivatedUserIDs: toDeactivate,
+		}
+		if err := s.appendEvent(txCtx, ev); err != nil {
+			return err
 		}
+		evs = append(evs, ev)
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return evs, nil
+}
+
+// savePRUpdates сохраняет новых ревьюверов PR, записывает события ленты и ставит отправку на хостинг.
+// Вызывается внутри транзакции, чтобы событие и задание отправки не терялись и не опережали изменение.
+// Возвращает записанные события для eventsCommitted.
+func (s *serviceImpl) savePRUpdates(ctx context.Context, updates []prUpdate) ([]domain.Event, error) {
+	var evs []domain.Event
+	for _, u := range updates {
+		if err := s.prRepo.SetPRReviewers(ctx, u.id, u.reviewers); err != nil {
+			return nil, err
+		}
+
+		prEvs, err := s.appendPRUpdateEvents(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		evs = append(evs, prEvs...)
+
+		forgeSync, err := s.prForgeSyncEnabled(ctx, domain.PullRequest{PullRequestID: u.id, AuthorID: u.author})
+		if err != nil {
+			return nil, err
+		}
+		if forgeSync {
+			if err := s.enqueueForgeSync(ctx, u.id, u.added, u.removed); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return evs, nil
 }
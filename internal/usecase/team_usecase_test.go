@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
 	mock_usecase "github.com/alnoi/pr-reviewer-service/internal/mocks"
@@ -32,12 +33,25 @@ func newTeamService(t *testing.T) (*serviceImpl, *teamDeps) {
 		teamRepo:   deps.teamRepo,
 		userRepo:   deps.userRepo,
 		prRepo:     deps.prRepo,
+		availRepo:  availRepoWithoutPeriods(ctrl),
 		transactor: deps.transactor,
 	}
 
 	return s, deps
 }
 
+// noReviewRules разрешает чтение правил подбора ревьюверов, которых в командах нет.
+func (d *teamDeps) noReviewRules() {
+	d.teamRepo.EXPECT().ListReviewRules(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	d.defaultTeamSettings()
+}
+
+// defaultTeamSettings разрешает чтение настроек команды по умолчанию; ожидания с конкретными
+// настройками нужно объявлять раньше.
+func (d *teamDeps) defaultTeamSettings() {
+	d.teamRepo.EXPECT().GetTeamSettings(gomock.Any(), gomock.Any()).Return(domain.TeamSettings{}, nil).AnyTimes()
+}
+
 func TestCreateTeam_SuccessWithMembers(t *testing.T) {
 	s, deps := newTeamService(t)
 	ctx := context.Background()
@@ -224,7 +238,7 @@ func TestGetTeam_Success(t *testing.T) {
 	}
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, team.TeamName).
+		GetTeam(gomock.Any(), team.TeamName).
 		Return(team, nil)
 
 	res, err := s.GetTeam(ctx, team.TeamName)
@@ -239,7 +253,7 @@ func TestGetTeam_Error(t *testing.T) {
 	wantErr := errors.New("get team failed")
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, "team").
+		GetTeam(gomock.Any(), "team").
 		Return(domain.Team{}, wantErr)
 
 	res, err := s.GetTeam(ctx, "team")
@@ -248,6 +262,103 @@ func TestGetTeam_Error(t *testing.T) {
 	require.ErrorIs(t, err, wantErr)
 }
 
+func TestUpdateTeamSettings_Success(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	settings := domain.TeamSettings{
+		TeamName:        "team",
+		StaleAfter:      24 * time.Hour,
+		StaleAutoRotate: true,
+	}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "team").
+		Return(domain.Team{TeamName: "team"}, nil)
+	deps.teamRepo.EXPECT().
+		UpsertTeamSettings(gomock.Any(), settings).
+		Return(nil)
+
+	res, err := s.UpdateTeamSettings(ctx, settings)
+	require.NoError(t, err)
+	require.Equal(t, settings, res)
+}
+
+func TestUpdateTeamSettings_TeamNotFound(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "ghost").
+		Return(domain.Team{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found"))
+
+	_, err := s.UpdateTeamSettings(ctx, domain.TeamSettings{TeamName: "ghost"})
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNotFound, derr.Code)
+}
+
+func TestUpdateTeamSettings_ReviewerTeams(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	settings := domain.TeamSettings{TeamName: "team", ReviewerTeams: []string{"platform"}}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "team").
+		Return(domain.Team{TeamName: "team"}, nil)
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "platform").
+		Return(domain.Team{TeamName: "platform"}, nil)
+	deps.teamRepo.EXPECT().
+		UpsertTeamSettings(gomock.Any(), settings).
+		Return(nil)
+
+	res, err := s.UpdateTeamSettings(ctx, settings)
+	require.NoError(t, err)
+	require.Equal(t, settings, res)
+}
+
+func TestUpdateTeamSettings_InvalidReviewerTeams(t *testing.T) {
+	tests := []struct {
+		name     string
+		teams    []string
+		wantCode domain.ErrorCode
+	}{
+		{"own team", []string{"team"}, domain.ErrorCodeBadRequest},
+		{"duplicate", []string{"platform", "platform"}, domain.ErrorCodeBadRequest},
+		{"unknown team", []string{"ghost"}, domain.ErrorCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, deps := newTeamService(t)
+
+			deps.teamRepo.EXPECT().
+				GetTeam(gomock.Any(), "team").
+				Return(domain.Team{TeamName: "team"}, nil)
+			deps.teamRepo.EXPECT().
+				GetTeam(gomock.Any(), "platform").
+				Return(domain.Team{TeamName: "platform"}, nil).
+				AnyTimes()
+			deps.teamRepo.EXPECT().
+				GetTeam(gomock.Any(), "ghost").
+				Return(domain.Team{}, domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")).
+				AnyTimes()
+
+			_, err := s.UpdateTeamSettings(context.Background(), domain.TeamSettings{
+				TeamName:      "team",
+				ReviewerTeams: tt.teams,
+			})
+
+			var derr *domain.DomainError
+			require.ErrorAs(t, err, &derr)
+			require.Equal(t, tt.wantCode, derr.Code)
+		})
+	}
+}
+
 func TestDeactivateTeamMembers_EmptyUserIDs(t *testing.T) {
 	s, deps := newTeamService(t)
 	ctx := context.Background()
@@ -260,12 +371,12 @@ func TestDeactivateTeamMembers_EmptyUserIDs(t *testing.T) {
 	}
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, team.TeamName).
+		GetTeam(gomock.Any(), team.TeamName).
 		Return(team, nil)
 
-	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, nil)
+	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, nil, false)
 	require.NoError(t, err)
-	require.Equal(t, team, res)
+	require.Equal(t, team, res.Team)
 }
 
 func TestDeactivateTeamMembers_UserNotInTeam(t *testing.T) {
@@ -280,12 +391,12 @@ func TestDeactivateTeamMembers_UserNotInTeam(t *testing.T) {
 	}
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, team.TeamName).
+		GetTeam(gomock.Any(), team.TeamName).
 		Return(team, nil)
 
-	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u2"})
+	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u2"}, false)
 	require.Error(t, err)
-	require.Equal(t, domain.Team{}, res)
+	require.Equal(t, domain.DeactivationResult{}, res)
 	require.ErrorContains(t, err, "user not found in team")
 }
 
@@ -296,12 +407,12 @@ func TestDeactivateTeamMembers_RepoGetTeamError(t *testing.T) {
 	wantErr := errors.New("get team failed")
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, "team").
+		GetTeam(gomock.Any(), "team").
 		Return(domain.Team{}, wantErr)
 
-	res, err := s.DeactivateTeamMembers(ctx, "team", []string{"u1"})
+	res, err := s.DeactivateTeamMembers(ctx, "team", []string{"u1"}, false)
 	require.Error(t, err)
-	require.Equal(t, domain.Team{}, res)
+	require.Equal(t, domain.DeactivationResult{}, res)
 	require.ErrorIs(t, err, wantErr)
 }
 
@@ -317,16 +428,16 @@ func TestDeactivateTeamMembers_NoActiveMembers(t *testing.T) {
 	}
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, team.TeamName).
+		GetTeam(gomock.Any(), team.TeamName).
 		Return(team, nil)
 
 	deps.userRepo.EXPECT().
-		GetTeamMembers(ctx, team.TeamName, true).
+		GetTeamMembers(gomock.Any(), team.TeamName, true).
 		Return(nil, nil)
 
-	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"})
+	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"}, false)
 	require.NoError(t, err)
-	require.Equal(t, team, res)
+	require.Equal(t, team, res.Team)
 }
 
 func TestDeactivateTeamMembers_NoOpenPRs(t *testing.T) {
@@ -342,18 +453,24 @@ func TestDeactivateTeamMembers_NoOpenPRs(t *testing.T) {
 	}
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, team.TeamName).
+		GetTeam(gomock.Any(), team.TeamName).
 		Return(team, nil)
 
 	deps.userRepo.EXPECT().
-		GetTeamMembers(ctx, team.TeamName, true).
+		GetTeamMembers(gomock.Any(), team.TeamName, true).
+		Return([]domain.User{
+			{UserID: "u1"},
+			{UserID: "u2"},
+		}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), team.TeamName, gomock.Any()).
 		Return([]domain.User{
 			{UserID: "u1"},
 			{UserID: "u2"},
 		}, nil)
 
 	deps.prRepo.EXPECT().
-		GetOpenPRsByReviewers(ctx, []string{"u1"}).
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u1"}).
 		Return(nil, nil)
 
 	deps.transactor.EXPECT().
@@ -365,9 +482,9 @@ func TestDeactivateTeamMembers_NoOpenPRs(t *testing.T) {
 			return f(txCtx)
 		})
 
-	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"})
+	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"}, false)
 	require.NoError(t, err)
-	require.Equal(t, team, res)
+	require.Equal(t, team, res.Team)
 }
 
 func TestDeactivateTeamMembers_NoCandidatePool(t *testing.T) {
@@ -382,17 +499,22 @@ func TestDeactivateTeamMembers_NoCandidatePool(t *testing.T) {
 	}
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, team.TeamName).
+		GetTeam(gomock.Any(), team.TeamName).
 		Return(team, nil)
 
 	deps.userRepo.EXPECT().
-		GetTeamMembers(ctx, team.TeamName, true).
+		GetTeamMembers(gomock.Any(), team.TeamName, true).
+		Return([]domain.User{
+			{UserID: "u1"},
+		}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), team.TeamName, gomock.Any()).
 		Return([]domain.User{
 			{UserID: "u1"},
 		}, nil)
 
 	deps.prRepo.EXPECT().
-		GetOpenPRsByReviewers(ctx, []string{"u1"}).
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u1"}).
 		Return([]domain.PullRequest{
 			{
 				PullRequestID:     "pr1",
@@ -401,9 +523,9 @@ func TestDeactivateTeamMembers_NoCandidatePool(t *testing.T) {
 			},
 		}, nil)
 
-	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"})
+	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"}, false)
 	require.Error(t, err)
-	require.Equal(t, domain.Team{}, res)
+	require.Equal(t, domain.DeactivationResult{}, res)
 	require.ErrorContains(t, err, "no active replacement candidate in team")
 }
 
@@ -420,11 +542,17 @@ func TestDeactivateTeamMembers_ErrorOnGetOpenPRs(t *testing.T) {
 	}
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, team.TeamName).
+		GetTeam(gomock.Any(), team.TeamName).
 		Return(team, nil)
 
 	deps.userRepo.EXPECT().
-		GetTeamMembers(ctx, team.TeamName, true).
+		GetTeamMembers(gomock.Any(), team.TeamName, true).
+		Return([]domain.User{
+			{UserID: "u1"},
+			{UserID: "u2"},
+		}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), team.TeamName, gomock.Any()).
 		Return([]domain.User{
 			{UserID: "u1"},
 			{UserID: "u2"},
@@ -433,17 +561,18 @@ func TestDeactivateTeamMembers_ErrorOnGetOpenPRs(t *testing.T) {
 	wantErr := errors.New("get prs failed")
 
 	deps.prRepo.EXPECT().
-		GetOpenPRsByReviewers(ctx, []string{"u1"}).
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u1"}).
 		Return(nil, wantErr)
 
-	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"})
+	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"}, false)
 	require.Error(t, err)
-	require.Equal(t, domain.Team{}, res)
+	require.Equal(t, domain.DeactivationResult{}, res)
 	require.ErrorIs(t, err, wantErr)
 }
 
 func TestDeactivateTeamMembers_SuccessWithPRReassign(t *testing.T) {
 	s, deps := newTeamService(t)
+	deps.noReviewRules()
 	ctx := context.Background()
 
 	team := domain.Team{
@@ -456,11 +585,18 @@ func TestDeactivateTeamMembers_SuccessWithPRReassign(t *testing.T) {
 	}
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, team.TeamName).
+		GetTeam(gomock.Any(), team.TeamName).
 		Return(team, nil)
 
 	deps.userRepo.EXPECT().
-		GetTeamMembers(ctx, team.TeamName, true).
+		GetTeamMembers(gomock.Any(), team.TeamName, true).
+		Return([]domain.User{
+			{UserID: "u1"},
+			{UserID: "u2"},
+			{UserID: "u3"},
+		}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), team.TeamName, gomock.Any()).
 		Return([]domain.User{
 			{UserID: "u1"},
 			{UserID: "u2"},
@@ -476,7 +612,7 @@ func TestDeactivateTeamMembers_SuccessWithPRReassign(t *testing.T) {
 	}
 
 	deps.prRepo.EXPECT().
-		GetOpenPRsByReviewers(ctx, []string{"u1"}).
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u1"}).
 		Return(prs, nil)
 
 	deps.transactor.EXPECT().
@@ -498,10 +634,377 @@ func TestDeactivateTeamMembers_SuccessWithPRReassign(t *testing.T) {
 		})
 
 	deps.teamRepo.EXPECT().
-		GetTeam(ctx, team.TeamName).
+		GetTeam(gomock.Any(), team.TeamName).
 		Return(team, nil)
 
-	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"})
+	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"}, false)
+	require.NoError(t, err)
+	require.Equal(t, team, res.Team)
+	require.False(t, res.DryRun)
+	require.Len(t, res.Updates, 1)
+	require.Equal(t, "pr1", res.Updates[0].PullRequestID)
+	require.Equal(t, []string{"u1"}, res.Updates[0].Removed)
+	require.Equal(t, []string{"u3"}, res.Updates[0].Added)
+}
+
+func TestDeactivateTeamMembers_DryRunReturnsPlanWithoutChanges(t *testing.T) {
+	s, deps := newTeamService(t)
+	deps.noReviewRules()
+	ctx := context.Background()
+
+	team := domain.Team{
+		TeamName: "team",
+		Members: []domain.TeamMember{
+			{UserID: "u1"},
+			{UserID: "u2"},
+			{UserID: "u3"},
+		},
+	}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	deps.userRepo.EXPECT().
+		GetTeamMembers(gomock.Any(), team.TeamName, true).
+		Return([]domain.User{
+			{UserID: "u1"},
+			{UserID: "u2"},
+			{UserID: "u3"},
+		}, nil)
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), team.TeamName, gomock.Any()).
+		Return([]domain.User{
+			{UserID: "u1"},
+			{UserID: "u2"},
+			{UserID: "u3"},
+		}, nil)
+
+	deps.prRepo.EXPECT().
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u1"}).
+		Return([]domain.PullRequest{
+			{
+				PullRequestID:     "pr1",
+				AuthorID:          "u2",
+				AssignedReviewers: []string{"u1"},
+			},
+		}, nil)
+
+	res, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"}, true)
+	require.NoError(t, err)
+	require.True(t, res.DryRun)
+	require.Equal(t, team, res.Team)
+	require.Equal(t, []string{"u1"}, res.Deactivated)
+	require.Equal(t, []domain.PRReviewersUpdate{
+		{
+			PullRequestID: "pr1",
+			Removed:       []string{"u1"},
+			Added:         []string{"u3"},
+			Reviewers:     []string{"u3"},
+		},
+	}, res.Updates)
+}
+
+func TestDeactivateTeamMembers_SkipsUnavailableCandidates(t *testing.T) {
+	s, deps := newTeamService(t)
+	deps.noReviewRules()
+	ctx := context.Background()
+
+	team := domain.Team{
+		TeamName: "team",
+		Members: []domain.TeamMember{
+			{UserID: "u1"},
+			{UserID: "u2"},
+			{UserID: "u3"},
+		},
+	}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	deps.userRepo.EXPECT().
+		GetTeamMembers(gomock.Any(), team.TeamName, true).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}}, nil)
+
+	// u3 активен, но в отпуске
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), team.TeamName, gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u2"}}, nil)
+
+	deps.prRepo.EXPECT().
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u1"}).
+		Return([]domain.PullRequest{
+			{
+				PullRequestID:     "pr1",
+				AuthorID:          "u2",
+				AssignedReviewers: []string{"u1"},
+			},
+		}, nil)
+
+	_, err := s.DeactivateTeamMembers(ctx, team.TeamName, []string{"u1"}, true)
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNoCandidate, derr.Code)
+}
+
+func TestAddTeamMembers_TeamNotFound(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	wantErr := domain.NewDomainError(domain.ErrorCodeNotFound, "team not found")
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), "team").
+		Return(domain.Team{}, wantErr)
+
+	res, err := s.AddTeamMembers(ctx, "team", []domain.TeamMember{{UserID: "u2"}})
+	require.Error(t, err)
+	require.Equal(t, domain.Team{}, res)
+	require.ErrorIs(t, err, wantErr)
+}
+
+func TestAddTeamMembers_Success(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	team := domain.Team{
+		TeamName: "team",
+		Members:  []domain.TeamMember{{UserID: "u1", IsActive: true}},
+	}
+	newMembers := []domain.TeamMember{{UserID: "u2", Username: "Bob", IsActive: true}}
+	updated := domain.Team{
+		TeamName: "team",
+		Members:  append(append([]domain.TeamMember{}, team.Members...), newMembers...),
+	}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, f func(context.Context) error) error {
+			return f(txCtx)
+		})
+
+	deps.userRepo.EXPECT().
+		UpsertUsers(gomock.Any(), team.TeamName, newMembers).
+		Return(nil)
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(updated, nil)
+
+	res, err := s.AddTeamMembers(ctx, team.TeamName, newMembers)
+	require.NoError(t, err)
+	require.Equal(t, updated, res)
+}
+
+func TestRemoveTeamMembers_UserNotInTeam(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	team := domain.Team{
+		TeamName: "team",
+		Members:  []domain.TeamMember{{UserID: "u1"}},
+	}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	res, err := s.RemoveTeamMembers(ctx, team.TeamName, []string{"u2"})
+	require.Error(t, err)
+	require.Equal(t, domain.Team{}, res)
+	require.ErrorContains(t, err, "user not found in team")
+}
+
+func TestRemoveTeamMembers_NoCandidate(t *testing.T) {
+	s, deps := newTeamService(t)
+	deps.noReviewRules()
+	ctx := context.Background()
+
+	team := domain.Team{
+		TeamName: "team",
+		Members:  []domain.TeamMember{{UserID: "u1"}, {UserID: "u2"}},
+	}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	deps.prRepo.EXPECT().
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u2"}).
+		Return([]domain.PullRequest{
+			{PullRequestID: "pr1", AuthorID: "u1", AssignedReviewers: []string{"u2"}},
+		}, nil)
+
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), team.TeamName, gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u2"}}, nil)
+
+	res, err := s.RemoveTeamMembers(ctx, team.TeamName, []string{"u2"})
+	require.Error(t, err)
+	require.Equal(t, domain.Team{}, res)
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNoCandidate, derr.Code)
+}
+
+func TestRemoveTeamMembers_SuccessWithPRReassign(t *testing.T) {
+	s, deps := newTeamService(t)
+	deps.noReviewRules()
+	ctx := context.Background()
+
+	team := domain.Team{
+		TeamName: "team",
+		Members:  []domain.TeamMember{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}},
+	}
+	updated := domain.Team{
+		TeamName: "team",
+		Members:  []domain.TeamMember{{UserID: "u1"}, {UserID: "u3"}},
+	}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	deps.prRepo.EXPECT().
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u2"}).
+		Return([]domain.PullRequest{
+			{PullRequestID: "pr1", AuthorID: "u1", AssignedReviewers: []string{"u2"}},
+		}, nil)
+
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), team.TeamName, gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}}, nil)
+
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, f func(context.Context) error) error {
+			return f(txCtx)
+		})
+
+	deps.prRepo.EXPECT().
+		SetPRReviewers(gomock.Any(), "pr1", []string{"u3"}).
+		Return(nil)
+
+	deps.userRepo.EXPECT().
+		DetachUsers(gomock.Any(), []string{"u2"}).
+		Return(nil)
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(updated, nil)
+
+	res, err := s.RemoveTeamMembers(ctx, team.TeamName, []string{"u2", "u2"})
+	require.NoError(t, err)
+	require.Equal(t, updated, res)
+}
+
+func TestUpdateTeamMember_UserNotInTeam(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	team := domain.Team{
+		TeamName: "team",
+		Members:  []domain.TeamMember{{UserID: "u1"}},
+	}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	res, err := s.UpdateTeamMember(ctx, team.TeamName, domain.TeamMember{UserID: "u2"})
+	require.Error(t, err)
+	require.Equal(t, domain.Team{}, res)
+	require.ErrorContains(t, err, "user not found in team")
+}
+
+func TestUpdateTeamMember_RenameWithoutReassign(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	team := domain.Team{
+		TeamName: "team",
+		Members:  []domain.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}},
+	}
+	member := domain.TeamMember{UserID: "u1", Username: "Alicia", IsActive: true}
+	updated := domain.Team{TeamName: "team", Members: []domain.TeamMember{member}}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, f func(context.Context) error) error {
+			return f(txCtx)
+		})
+
+	deps.userRepo.EXPECT().
+		UpsertUsers(gomock.Any(), team.TeamName, []domain.TeamMember{member}).
+		Return(nil)
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(updated, nil)
+
+	res, err := s.UpdateTeamMember(ctx, team.TeamName, member)
+	require.NoError(t, err)
+	require.Equal(t, updated, res)
+}
+
+func TestUpdateTeamMember_DeactivateWithPRReassign(t *testing.T) {
+	s, deps := newTeamService(t)
+	deps.noReviewRules()
+	ctx := context.Background()
+
+	team := domain.Team{
+		TeamName: "team",
+		Members: []domain.TeamMember{
+			{UserID: "u1", IsActive: true},
+			{UserID: "u2", IsActive: true},
+			{UserID: "u3", IsActive: true},
+		},
+	}
+	member := domain.TeamMember{UserID: "u2", Username: "Bob", IsActive: false}
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	deps.prRepo.EXPECT().
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u2"}).
+		Return([]domain.PullRequest{
+			{PullRequestID: "pr1", AuthorID: "u1", AssignedReviewers: []string{"u2"}},
+		}, nil)
+
+	deps.userRepo.EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), team.TeamName, gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}}, nil)
+
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, f func(context.Context) error) error {
+			return f(txCtx)
+		})
+
+	deps.prRepo.EXPECT().
+		SetPRReviewers(gomock.Any(), "pr1", []string{"u3"}).
+		Return(nil)
+
+	deps.userRepo.EXPECT().
+		UpsertUsers(gomock.Any(), team.TeamName, []domain.TeamMember{member}).
+		Return(nil)
+
+	deps.teamRepo.EXPECT().
+		GetTeam(gomock.Any(), team.TeamName).
+		Return(team, nil)
+
+	_, err := s.UpdateTeamMember(ctx, team.TeamName, member)
 	require.NoError(t, err)
-	require.Equal(t, team, res)
 }
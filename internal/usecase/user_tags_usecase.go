@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+	"github.com/alnoi/pr-reviewer-service/internal/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// maxUserTags — сколько тегов может быть у одного пользователя.
+const maxUserTags = 32
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._+-]{0,63}$`)
+
+func (s *serviceImpl) GetUserTags(ctx context.Context, userID string) ([]string, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.GetUserTags",
+		trace.WithAttributes(attribute.String("user.id", userID)),
+	)
+	defer span.End()
+
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user for tags",
+			zap.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	tags, err := s.userRepo.GetUserTags(ctx, []string{userID})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user tags",
+			zap.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	return append([]string{}, tags[userID]...), nil
+}
+
+// SetUserTags целиком заменяет теги пользователя. Теги приводятся к нижнему регистру,
+// повторы отбрасываются; возвращаются сохранённые теги по алфавиту.
+func (s *serviceImpl) SetUserTags(ctx context.Context, userID string, tags []string) ([]string, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Service.SetUserTags",
+		trace.WithAttributes(
+			attribute.String("user.id", userID),
+			attribute.StringSlice("user.tags", tags),
+		),
+	)
+	defer span.End()
+
+	normalized, err := normalizeTags(tags)
+	if err == nil && len(normalized) > maxUserTags {
+		err = domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("at most %d tags per user", maxUserTags))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "invalid user tags",
+			zap.String("user_id", userID),
+		)
+		return nil, err
+	}
+	sort.Strings(normalized)
+
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user for tags update",
+			zap.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	err = s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		return s.userRepo.ReplaceUserTags(txCtx, userID, normalized)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to save user tags",
+			zap.String("user_id", userID),
+		)
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+// --------------------HELPERS----------------------
+
+// normalizeTags приводит теги к нижнему регистру и убирает повторы, порядок сохраняется.
+func normalizeTags(tags []string) ([]string, error) {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !tagPattern.MatchString(tag) {
+			return nil, domain.NewDomainError(domain.ErrorCodeBadRequest, fmt.Sprintf("invalid tag %q", tag))
+		}
+		if _, dup := seen[tag]; dup {
+			continue
+		}
+		seen[tag] = struct{}{}
+		res = append(res, tag)
+	}
+
+	return res, nil
+}
+
+// preferTagged ставит вперёд кандидатов, которые покрывают требуемые теги, не покрытые fixed:
+// на каждом шаге берётся первый в ordered кандидат с наибольшим числом ещё не покрытых тегов.
+// Порядок остальных кандидатов сохраняется.
+func preferTagged(ordered []string, tags map[string][]string, required, fixed []string) []string {
+	uncovered := make(map[string]struct{}, len(required))
+	for _, tag := range required {
+		uncovered[tag] = struct{}{}
+	}
+	for _, id := range fixed {
+		for _, tag := range tags[id] {
+			delete(uncovered, tag)
+		}
+	}
+
+	rest := append([]string(nil), ordered...)
+	front := make([]string, 0)
+
+	for len(uncovered) > 0 {
+		best, bestCount := -1, 0
+		for i, id := range rest {
+			count := 0
+			for _, tag := range tags[id] {
+				if _, ok := uncovered[tag]; ok {
+					count++
+				}
+			}
+			if count > bestCount {
+				best, bestCount = i, count
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		for _, tag := range tags[rest[best]] {
+			delete(uncovered, tag)
+		}
+		front = append(front, rest[best])
+		rest = append(rest[:best], rest[best+1:]...)
+	}
+
+	return append(front, rest...)
+}
+
+// unmetTags возвращает требуемые теги, которых нет ни у одного из reviewers, в порядке required.
+func unmetTags(required, reviewers []string, tags map[string][]string) []string {
+	have := make(map[string]struct{})
+	for _, id := range reviewers {
+		for _, tag := range tags[id] {
+			have[tag] = struct{}{}
+		}
+	}
+
+	res := make([]string, 0)
+	for _, tag := range required {
+		if _, ok := have[tag]; !ok {
+			res = append(res, tag)
+		}
+	}
+	return res
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alnoi/pr-reviewer-service/internal/domain"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := normalizeTags([]string{" DB ", "backend", "db", "c++", "ci.cd"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"db", "backend", "c++", "ci.cd"}, tags)
+
+	for _, bad := range []string{"", "two words", "-db", "тег"} {
+		_, err := normalizeTags([]string{bad})
+
+		var derr *domain.DomainError
+		require.ErrorAs(t, err, &derr, bad)
+		require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+	}
+}
+
+func TestPreferTagged(t *testing.T) {
+	tags := map[string][]string{
+		"u2": {"frontend"},
+		"u3": {"db"},
+		"u4": {"db", "security"},
+		"u5": {"security"},
+	}
+	ordered := []string{"u2", "u3", "u4", "u5", "u6"}
+
+	require.Equal(t, ordered, preferTagged(ordered, tags, nil, nil))
+	require.Equal(t, []string{"u4", "u2", "u3", "u5", "u6"}, preferTagged(ordered, tags, []string{"db", "security"}, nil))
+	require.Equal(t, []string{"u3", "u2", "u4", "u5", "u6"}, preferTagged(ordered, tags, []string{"db", "security"}, []string{"u5"}))
+	require.Equal(t, []string{"u4", "u2", "u3", "u5", "u6"}, preferTagged(ordered, tags, []string{"security", "go"}, []string{"u3"}))
+	require.Equal(t, ordered, preferTagged(ordered, tags, []string{"go"}, nil))
+}
+
+func TestUnmetTags(t *testing.T) {
+	tags := map[string][]string{"u3": {"db"}, "u4": {"security"}}
+
+	require.Empty(t, unmetTags(nil, []string{"u3"}, tags))
+	require.Empty(t, unmetTags([]string{"security", "db"}, []string{"u3", "u4"}, tags))
+	require.Equal(t, []string{"go", "security"}, unmetTags([]string{"go", "db", "security"}, []string{"u3", "u5"}, tags))
+}
+
+func TestSetUserTags(t *testing.T) {
+	s, deps := newTeamService(t)
+	ctx := context.Background()
+
+	deps.userRepo.EXPECT().
+		GetUserByID(gomock.Any(), "u1").
+		Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+	deps.transactor.EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, f func(context.Context) error) error {
+			return f(txCtx)
+		})
+	deps.userRepo.EXPECT().ReplaceUserTags(gomock.Any(), "u1", []string{"backend", "db"}).Return(nil)
+
+	tags, err := s.SetUserTags(ctx, "u1", []string{"DB", "backend", "db"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"backend", "db"}, tags)
+
+	_, err = s.SetUserTags(ctx, "u1", []string{"data base"})
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeBadRequest, derr.Code)
+}
+
+func TestCreatePR_CoversRequiredTags(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		s, deps := newTeamService(t)
+		deps.defaultTeamSettings()
+		s.seed = seed
+		ctx := context.Background()
+
+		deps.prRepo.EXPECT().PRExists(gomock.Any(), "pr-1").Return(false, nil)
+		deps.userRepo.EXPECT().
+			GetUserByID(gomock.Any(), "u1").
+			Return(domain.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil)
+		deps.teamRepo.EXPECT().
+			ListReviewRules(gomock.Any(), "backend").
+			Return(backendRules(
+				domain.ReviewRule{Type: domain.ReviewRuleConflict, UserID: "u4", OtherUserID: "u1"},
+			), nil)
+		deps.userRepo.EXPECT().
+			GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+			Return([]domain.User{
+				{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}, {UserID: "u4"}, {UserID: "u5"}, {UserID: "u6"},
+			}, nil)
+		deps.userRepo.EXPECT().
+			GetUserTags(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, ids []string) (map[string][]string, error) {
+				// u4 конфликтует с автором, его теги не запрашиваются
+				require.NotContains(t, ids, "u4")
+				return map[string][]string{
+					"u3": {"qa"},
+					"u5": {"db", "backend"},
+					"u6": {"frontend"},
+				}, nil
+			})
+		deps.transactor.EXPECT().
+			WithTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(txCtx context.Context, f func(context.Context) error) error {
+				return f(txCtx)
+			})
+		deps.prRepo.EXPECT().CreatePR(gomock.Any(), gomock.Any()).Return(nil)
+
+		var assigned []string
+		deps.prRepo.EXPECT().
+			SetPRReviewers(gomock.Any(), "pr-1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, ids []string) error {
+				assigned = ids
+				return nil
+			})
+		deps.prRepo.EXPECT().
+			GetPR(gomock.Any(), "pr-1").
+			DoAndReturn(func(_ context.Context, _ string) (domain.PullRequest, error) {
+				return domain.PullRequest{PullRequestID: "pr-1", AssignedReviewers: assigned}, nil
+			})
+
+		res, unmet, err := s.CreatePR(ctx, "pr-1", "name", "u1", nil, nil, []string{"Frontend", "db", "security"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"u5", "u6"}, res.AssignedReviewers)
+		require.Equal(t, []string{"security"}, unmet)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
@@ -37,10 +38,10 @@ func TestServiceImpl_SetUserIsActive_Success(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		SetUserIsActive(ctx, userID, isActive).
+		SetUserIsActive(gomock.Any(), userID, isActive).
 		Return(expectedUser, nil)
 
-	user, err := svc.SetUserIsActive(ctx, userID, isActive)
+	user, err := svc.SetUserIsActive(ctx, userID, isActive, false)
 	require.NoError(t, err)
 	require.Equal(t, expectedUser, user)
 }
@@ -51,10 +52,13 @@ func TestServiceImpl_SetUserIsActive_Error(t *testing.T) {
 
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	prRepo := mocks.NewMockPRRepository(ctrl)
+	tx := mocks.NewMockTransactor(ctrl)
 
 	svc := &serviceImpl{
-		userRepo: userRepo,
-		prRepo:   prRepo,
+		teamRepo:   teamRepoWithoutRules(ctrl),
+		userRepo:   userRepo,
+		prRepo:     prRepo,
+		transactor: tx,
 	}
 
 	ctx := context.Background()
@@ -65,15 +69,266 @@ func TestServiceImpl_SetUserIsActive_Error(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		SetUserIsActive(ctx, userID, isActive).
+		GetUserByID(gomock.Any(), userID).
+		Return(domain.User{UserID: userID, TeamName: "backend", IsActive: true}, nil)
+
+	prRepo.
+		EXPECT().
+		GetOpenPRsByReviewers(gomock.Any(), []string{userID}).
+		Return(nil, nil)
+
+	tx.
+		EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, fn func(context.Context) error) error {
+			return fn(txCtx)
+		})
+
+	userRepo.
+		EXPECT().
+		SetUserIsActive(gomock.Any(), userID, isActive).
 		Return(domain.User{}, wantErr)
 
-	user, err := svc.SetUserIsActive(ctx, userID, isActive)
+	user, err := svc.SetUserIsActive(ctx, userID, isActive, false)
 	require.Error(t, err)
 	require.ErrorIs(t, err, wantErr)
 	require.Equal(t, domain.User{}, user)
 }
 
+func TestServiceImpl_SetUserIsActive_DeactivateReassignsOpenPRs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	prRepo := mocks.NewMockPRRepository(ctrl)
+	tx := mocks.NewMockTransactor(ctrl)
+
+	svc := &serviceImpl{
+		teamRepo:   teamRepoWithoutRules(ctrl),
+		userRepo:   userRepo,
+		prRepo:     prRepo,
+		transactor: tx,
+	}
+
+	ctx := context.Background()
+
+	userRepo.
+		EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend", IsActive: true}, nil)
+
+	prRepo.
+		EXPECT().
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u2"}).
+		Return([]domain.PullRequest{
+			{PullRequestID: "pr-1", AuthorID: "u1", AssignedReviewers: []string{"u2"}},
+		}, nil)
+
+	userRepo.
+		EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}}, nil)
+
+	tx.
+		EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, fn func(context.Context) error) error {
+			return fn(txCtx)
+		})
+
+	expectedUser := domain.User{UserID: "u2", TeamName: "backend", IsActive: false}
+
+	userRepo.
+		EXPECT().
+		SetUserIsActive(gomock.Any(), "u2", false).
+		Return(expectedUser, nil)
+
+	prRepo.
+		EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-1", []string{"u3"}).
+		Return(nil)
+
+	user, err := svc.SetUserIsActive(ctx, "u2", false, false)
+	require.NoError(t, err)
+	require.Equal(t, expectedUser, user)
+}
+
+func TestServiceImpl_SetUserIsActive_DeactivateNoCandidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	prRepo := mocks.NewMockPRRepository(ctrl)
+
+	svc := &serviceImpl{
+		teamRepo: teamRepoWithoutRules(ctrl),
+		userRepo: userRepo,
+		prRepo:   prRepo,
+	}
+
+	ctx := context.Background()
+
+	userRepo.
+		EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend", IsActive: true}, nil)
+
+	prRepo.
+		EXPECT().
+		GetOpenPRsByReviewers(gomock.Any(), []string{"u2"}).
+		Return([]domain.PullRequest{
+			{PullRequestID: "pr-1", AuthorID: "u1", AssignedReviewers: []string{"u2"}},
+		}, nil)
+
+	userRepo.
+		EXPECT().
+		GetAvailableTeamMembers(gomock.Any(), "backend", gomock.Any()).
+		Return([]domain.User{{UserID: "u1"}, {UserID: "u2"}}, nil)
+
+	user, err := svc.SetUserIsActive(ctx, "u2", false, false)
+	require.Error(t, err)
+
+	var derr *domain.DomainError
+	require.ErrorAs(t, err, &derr)
+	require.Equal(t, domain.ErrorCodeNoCandidate, derr.Code)
+	require.Equal(t, domain.User{}, user)
+}
+
+func TestServiceImpl_SetUserIsActive_ReactivateWithRebalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	prRepo := mocks.NewMockPRRepository(ctrl)
+	availRepo := mocks.NewMockAvailabilityRepository(ctrl)
+	tx := mocks.NewMockTransactor(ctrl)
+
+	svc := &serviceImpl{
+		teamRepo:   teamRepoWithoutRules(ctrl),
+		userRepo:   userRepo,
+		prRepo:     prRepo,
+		availRepo:  availRepo,
+		transactor: tx,
+	}
+
+	ctx := context.Background()
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	userRepo.
+		EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend", IsActive: false}, nil)
+
+	availRepo.
+		EXPECT().
+		ListAvailability(gomock.Any(), "u2").
+		Return([]domain.Availability{{
+			UserID:   "u2",
+			StartsAt: created,
+			EndsAt:   created.Add(24 * time.Hour),
+		}}, nil)
+
+	// больше maxRebalancePRs подходящих PR: берутся те, кому ревьюверов не хватает сильнее,
+	// при равенстве — более старые, поэтому pr-one-new остаётся без изменений
+	prRepo.
+		EXPECT().
+		GetOpenPRsByTeam(gomock.Any(), "backend").
+		Return([]domain.PullRequest{
+			{PullRequestID: "pr-full", AuthorID: "u1", AssignedReviewers: []string{"u3", "u4"}, CreatedAt: created},
+			{PullRequestID: "pr-own", AuthorID: "u2", AssignedReviewers: []string{"u3"}, CreatedAt: created},
+			{PullRequestID: "pr-one-new", AuthorID: "u1", AssignedReviewers: []string{"u4"}, CreatedAt: created.Add(4 * time.Hour)},
+			{PullRequestID: "pr-one", AuthorID: "u1", AssignedReviewers: []string{"u3"}, CreatedAt: created.Add(time.Hour)},
+			{PullRequestID: "pr-none", AuthorID: "u3", CreatedAt: created.Add(3 * time.Hour)},
+			{PullRequestID: "pr-none-old", AuthorID: "u4", CreatedAt: created.Add(2 * time.Hour)},
+		}, nil)
+
+	tx.
+		EXPECT().
+		WithTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(txCtx context.Context, fn func(context.Context) error) error {
+			return fn(txCtx)
+		})
+
+	expectedUser := domain.User{UserID: "u2", TeamName: "backend", IsActive: true}
+
+	userRepo.
+		EXPECT().
+		SetUserIsActive(gomock.Any(), "u2", true).
+		Return(expectedUser, nil)
+
+	prRepo.
+		EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-one", []string{"u3", "u2"}).
+		Return(nil)
+
+	prRepo.
+		EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-none", []string{"u2"}).
+		Return(nil)
+
+	prRepo.
+		EXPECT().
+		SetPRReviewers(gomock.Any(), "pr-none-old", []string{"u2"}).
+		Return(nil)
+
+	user, err := svc.SetUserIsActive(ctx, "u2", true, true)
+	require.NoError(t, err)
+	require.Equal(t, expectedUser, user)
+}
+
+func TestServiceImpl_SetUserIsActive_ReactivateWhileUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	prRepo := mocks.NewMockPRRepository(ctrl)
+	availRepo := mocks.NewMockAvailabilityRepository(ctrl)
+	tx := mocks.NewMockTransactor(ctrl)
+
+	svc := &serviceImpl{
+		teamRepo:   teamRepoWithoutRules(ctrl),
+		userRepo:   userRepo,
+		prRepo:     prRepo,
+		availRepo:  availRepo,
+		transactor: tx,
+	}
+
+	ctx := context.Background()
+	now := time.Now()
+
+	userRepo.
+		EXPECT().
+		GetUserByID(gomock.Any(), "u2").
+		Return(domain.User{UserID: "u2", TeamName: "backend", IsActive: false}, nil)
+
+	availRepo.
+		EXPECT().
+		ListAvailability(gomock.Any(), "u2").
+		Return([]domain.Availability{{
+			UserID:   "u2",
+			StartsAt: now.Add(-time.Hour),
+			EndsAt:   now.Add(time.Hour),
+		}}, nil)
+
+	prRepo.
+		EXPECT().
+		GetOpenPRsByTeam(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	expectTx(tx)
+
+	expectedUser := domain.User{UserID: "u2", TeamName: "backend", IsActive: true}
+
+	userRepo.
+		EXPECT().
+		SetUserIsActive(gomock.Any(), "u2", true).
+		Return(expectedUser, nil)
+
+	user, err := svc.SetUserIsActive(ctx, "u2", true, true)
+	require.NoError(t, err)
+	require.Equal(t, expectedUser, user)
+}
+
 func TestServiceImpl_GetUserReviewPRs_UserNotFound(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -93,7 +348,7 @@ func TestServiceImpl_GetUserReviewPRs_UserNotFound(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, userID).
+		GetUserByID(gomock.Any(), userID).
 		Return(domain.User{}, wantErr)
 
 	prRepo.
@@ -124,7 +379,7 @@ func TestServiceImpl_GetUserReviewPRs_RepoErrorOnPRs(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, userID).
+		GetUserByID(gomock.Any(), userID).
 		Return(domain.User{
 			UserID:   userID,
 			Username: "Alice",
@@ -136,7 +391,7 @@ func TestServiceImpl_GetUserReviewPRs_RepoErrorOnPRs(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPRsWhereReviewer(ctx, userID).
+		GetPRsWhereReviewer(gomock.Any(), userID).
 		Return(nil, wantErr)
 
 	prs, err := svc.GetUserReviewPRs(ctx, userID)
@@ -162,7 +417,7 @@ func TestServiceImpl_GetUserReviewPRs_Success(t *testing.T) {
 
 	userRepo.
 		EXPECT().
-		GetUserByID(ctx, userID).
+		GetUserByID(gomock.Any(), userID).
 		Return(domain.User{
 			UserID:   userID,
 			Username: "Alice",
@@ -187,7 +442,7 @@ func TestServiceImpl_GetUserReviewPRs_Success(t *testing.T) {
 
 	prRepo.
 		EXPECT().
-		GetPRsWhereReviewer(ctx, userID).
+		GetPRsWhereReviewer(gomock.Any(), userID).
 		Return(expectedPRs, nil)
 
 	prs, err := svc.GetUserReviewPRs(ctx, userID)
@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/alnoi/pr-reviewer-service/internal/domain"
 	"github.com/alnoi/pr-reviewer-service/internal/logger"
@@ -11,18 +13,79 @@ import (
 	"go.uber.org/zap"
 )
 
-func (s *serviceImpl) SetUserIsActive(ctx context.Context, userID string, isActive bool) (domain.User, error) {
+func (s *serviceImpl) SetUserIsActive(ctx context.Context, userID string, isActive, rebalance bool) (domain.User, error) {
 	ctx, span := tracer.Start(
 		ctx,
 		"Service.SetUserIsActive",
 		trace.WithAttributes(
 			attribute.String("user.id", userID),
 			attribute.Bool("user.is_active", isActive),
+			attribute.Bool("user.rebalance", rebalance),
 		),
 	)
 	defer span.End()
 
-	user, err := s.userRepo.SetUserIsActive(ctx, userID, isActive)
+	if isActive && !rebalance {
+		user, err := s.userRepo.SetUserIsActive(ctx, userID, true)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.LogDomainAware(ctx, err, "failed to set user active status",
+				zap.String("user_id", userID),
+				zap.Bool("is_active", isActive),
+			)
+			return domain.User{}, err
+		}
+		return user, nil
+	}
+
+	current, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to get user before changing active status",
+			zap.String("user_id", userID),
+		)
+		return domain.User{}, err
+	}
+
+	var updates []prUpdate
+	switch {
+	case !isActive && current.IsActive:
+		updates, err = s.prepareMemberReplacement(ctx, current.TeamName, []string{userID})
+	case isActive && !current.IsActive:
+		updates, err = s.prepareRebalance(ctx, current)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.LogDomainAware(ctx, err, "failed to prepare PR updates for active status change",
+			zap.String("user_id", userID),
+			zap.Bool("is_active", isActive),
+		)
+		return domain.User{}, err
+	}
+
+	var (
+		res domain.User
+		evs []domain.Event
+	)
+
+	err = s.transactor.WithTx(ctx, func(txCtx context.Context) error {
+		user, err := s.userRepo.SetUserIsActive(txCtx, userID, isActive)
+		if err != nil {
+			return err
+		}
+
+		prEvs, err := s.savePRUpdates(txCtx, updates)
+		if err != nil {
+			return err
+		}
+		evs = prEvs
+
+		res = user
+		return nil
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -33,7 +96,12 @@ func (s *serviceImpl) SetUserIsActive(ctx context.Context, userID string, isActi
 		return domain.User{}, err
 	}
 
-	return user, nil
+	span.SetAttributes(attribute.Int("user.updated_prs_count", len(updates)))
+
+	s.eventsCommitted(evs...)
+	s.notifyPRUpdates(ctx, updates)
+
+	return res, nil
 }
 
 func (s *serviceImpl) GetUserReviewPRs(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
@@ -69,3 +137,77 @@ func (s *serviceImpl) GetUserReviewPRs(ctx context.Context, userID string) ([]do
 
 	return prs, nil
 }
+
+// --------------------HELPERS----------------------
+
+// maxRebalancePRs — на сколько PR максимум назначается вернувшийся пользователь при rebalance.
+const maxRebalancePRs = 3
+
+// prepareRebalance предлагает вернувшегося пользователя открытым PR его команды,
+// у которых меньше maxReviewersPerPR ревьюверов и где он не нарушит правила команды.
+// Берутся не больше maxRebalancePRs PR, которым ревьюверов не хватает сильнее всего,
+// при равенстве — более старые. Во время периода недоступности пользователь никуда не добавляется.
+func (s *serviceImpl) prepareRebalance(ctx context.Context, user domain.User) ([]prUpdate, error) {
+	period, unavailable, err := s.activeAvailability(ctx, user.UserID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if unavailable {
+		logger.FromContext(ctx).Debug("rebalance skipped: user is unavailable",
+			zap.String("user_id", user.UserID),
+			zap.Time("unavailable_until", period.EndsAt),
+		)
+		return nil, nil
+	}
+
+	prs, err := s.prRepo.GetOpenPRsByTeam(ctx, user.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if len(prs) == 0 {
+		return nil, nil
+	}
+
+	rules, err := s.reviewRulesFor(ctx, user.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	prs = append([]domain.PullRequest(nil), prs...)
+	sort.SliceStable(prs, func(i, j int) bool {
+		if len(prs[i].AssignedReviewers) != len(prs[j].AssignedReviewers) {
+			return len(prs[i].AssignedReviewers) < len(prs[j].AssignedReviewers)
+		}
+		return prs[i].CreatedAt.Before(prs[j].CreatedAt)
+	})
+
+	updates := make([]prUpdate, 0, maxRebalancePRs)
+	for _, pr := range prs {
+		if len(updates) == maxRebalancePRs {
+			break
+		}
+		if len(pr.AssignedReviewers) >= maxReviewersPerPR {
+			continue
+		}
+		if pr.AuthorID == user.UserID || isReviewerAssigned(pr, user.UserID) {
+			continue
+		}
+
+		reviewers := make([]string, 0, len(pr.AssignedReviewers)+1)
+		reviewers = append(reviewers, pr.AssignedReviewers...)
+		reviewers = append(reviewers, user.UserID)
+		if rules.check(pr.AuthorID, reviewers) != "" {
+			continue
+		}
+
+		updates = append(updates, prUpdate{
+			id:        pr.PullRequestID,
+			name:      pr.PullRequestName,
+			author:    pr.AuthorID,
+			reviewers: reviewers,
+			added:     []string{user.UserID},
+		})
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,4390 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.5.1-go
+// source: reviewer/v1/reviewer.proto
+
+// gRPC-контракт сервиса. Повторяет ручки openapi.yml для команд, пользователей, PR и статистики;
+// ошибки домена возвращаются статусами gRPC, код домена — в google.rpc.ErrorInfo.reason.
+
+package reviewerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Seniority int32
+
+const (
+	Seniority_SENIORITY_UNSPECIFIED Seniority = 0
+	Seniority_SENIORITY_JUNIOR      Seniority = 1
+	Seniority_SENIORITY_MIDDLE      Seniority = 2
+	Seniority_SENIORITY_SENIOR      Seniority = 3
+)
+
+// Enum value maps for Seniority.
+var (
+	Seniority_name = map[int32]string{
+		0: "SENIORITY_UNSPECIFIED",
+		1: "SENIORITY_JUNIOR",
+		2: "SENIORITY_MIDDLE",
+		3: "SENIORITY_SENIOR",
+	}
+	Seniority_value = map[string]int32{
+		"SENIORITY_UNSPECIFIED": 0,
+		"SENIORITY_JUNIOR":      1,
+		"SENIORITY_MIDDLE":      2,
+		"SENIORITY_SENIOR":      3,
+	}
+)
+
+func (x Seniority) Enum() *Seniority {
+	p := new(Seniority)
+	*p = x
+	return p
+}
+
+func (x Seniority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Seniority) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewer_v1_reviewer_proto_enumTypes[0].Descriptor()
+}
+
+func (Seniority) Type() protoreflect.EnumType {
+	return &file_reviewer_v1_reviewer_proto_enumTypes[0]
+}
+
+func (x Seniority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Seniority.Descriptor instead.
+func (Seniority) EnumDescriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{0}
+}
+
+type PullRequestStatus int32
+
+const (
+	PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED PullRequestStatus = 0
+	PullRequestStatus_PULL_REQUEST_STATUS_OPEN        PullRequestStatus = 1
+	PullRequestStatus_PULL_REQUEST_STATUS_MERGED      PullRequestStatus = 2
+)
+
+// Enum value maps for PullRequestStatus.
+var (
+	PullRequestStatus_name = map[int32]string{
+		0: "PULL_REQUEST_STATUS_UNSPECIFIED",
+		1: "PULL_REQUEST_STATUS_OPEN",
+		2: "PULL_REQUEST_STATUS_MERGED",
+	}
+	PullRequestStatus_value = map[string]int32{
+		"PULL_REQUEST_STATUS_UNSPECIFIED": 0,
+		"PULL_REQUEST_STATUS_OPEN":        1,
+		"PULL_REQUEST_STATUS_MERGED":      2,
+	}
+)
+
+func (x PullRequestStatus) Enum() *PullRequestStatus {
+	p := new(PullRequestStatus)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewer_v1_reviewer_proto_enumTypes[1].Descriptor()
+}
+
+func (PullRequestStatus) Type() protoreflect.EnumType {
+	return &file_reviewer_v1_reviewer_proto_enumTypes[1]
+}
+
+func (x PullRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus.Descriptor instead.
+func (PullRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{1}
+}
+
+type StatsGranularity int32
+
+const (
+	// Без временного ряда.
+	StatsGranularity_STATS_GRANULARITY_UNSPECIFIED StatsGranularity = 0
+	StatsGranularity_STATS_GRANULARITY_DAY         StatsGranularity = 1
+	StatsGranularity_STATS_GRANULARITY_WEEK        StatsGranularity = 2
+)
+
+// Enum value maps for StatsGranularity.
+var (
+	StatsGranularity_name = map[int32]string{
+		0: "STATS_GRANULARITY_UNSPECIFIED",
+		1: "STATS_GRANULARITY_DAY",
+		2: "STATS_GRANULARITY_WEEK",
+	}
+	StatsGranularity_value = map[string]int32{
+		"STATS_GRANULARITY_UNSPECIFIED": 0,
+		"STATS_GRANULARITY_DAY":         1,
+		"STATS_GRANULARITY_WEEK":        2,
+	}
+)
+
+func (x StatsGranularity) Enum() *StatsGranularity {
+	p := new(StatsGranularity)
+	*p = x
+	return p
+}
+
+func (x StatsGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewer_v1_reviewer_proto_enumTypes[2].Descriptor()
+}
+
+func (StatsGranularity) Type() protoreflect.EnumType {
+	return &file_reviewer_v1_reviewer_proto_enumTypes[2]
+}
+
+func (x StatsGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsGranularity.Descriptor instead.
+func (StatsGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{2}
+}
+
+type TeamMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Не указан — при обновлении сохраняется прежний уровень.
+	Seniority     Seniority `protobuf:"varint,4,opt,name=seniority,proto3,enum=reviewer.v1.Seniority" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TeamMember) GetSeniority() Seniority {
+	if x != nil {
+		return x.Seniority
+	}
+	return Seniority_SENIORITY_UNSPECIFIED
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Seniority     Seniority              `protobuf:"varint,5,opt,name=seniority,proto3,enum=reviewer.v1.Seniority" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetSeniority() Seniority {
+	if x != nil {
+		return x.Seniority
+	}
+	return Seniority_SENIORITY_UNSPECIFIED
+}
+
+type PullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status            PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=reviewer.v1.PullRequestStatus" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=reviewer.v1.PullRequestStatus" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+type TeamSettings struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Целое число секунд; ноль — порог сервиса по умолчанию.
+	StaleAfter         *durationpb.Duration `protobuf:"bytes,2,opt,name=stale_after,json=staleAfter,proto3" json:"stale_after,omitempty"`
+	StaleAutoRotate    bool                 `protobuf:"varint,3,opt,name=stale_auto_rotate,json=staleAutoRotate,proto3" json:"stale_auto_rotate,omitempty"`
+	ReviewerTeams      []string             `protobuf:"bytes,4,rep,name=reviewer_teams,json=reviewerTeams,proto3" json:"reviewer_teams,omitempty"`
+	MinSeniorReviewers int32                `protobuf:"varint,5,opt,name=min_senior_reviewers,json=minSeniorReviewers,proto3" json:"min_senior_reviewers,omitempty"`
+	ForgeSync          bool                 `protobuf:"varint,6,opt,name=forge_sync,json=forgeSync,proto3" json:"forge_sync,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TeamSettings) Reset() {
+	*x = TeamSettings{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSettings) ProtoMessage() {}
+
+func (x *TeamSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSettings.ProtoReflect.Descriptor instead.
+func (*TeamSettings) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TeamSettings) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamSettings) GetStaleAfter() *durationpb.Duration {
+	if x != nil {
+		return x.StaleAfter
+	}
+	return nil
+}
+
+func (x *TeamSettings) GetStaleAutoRotate() bool {
+	if x != nil {
+		return x.StaleAutoRotate
+	}
+	return false
+}
+
+func (x *TeamSettings) GetReviewerTeams() []string {
+	if x != nil {
+		return x.ReviewerTeams
+	}
+	return nil
+}
+
+func (x *TeamSettings) GetMinSeniorReviewers() int32 {
+	if x != nil {
+		return x.MinSeniorReviewers
+	}
+	return 0
+}
+
+func (x *TeamSettings) GetForgeSync() bool {
+	if x != nil {
+		return x.ForgeSync
+	}
+	return false
+}
+
+type PRReviewersUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Removed       []string               `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	Added         []string               `protobuf:"bytes,3,rep,name=added,proto3" json:"added,omitempty"`
+	Reviewers     []string               `protobuf:"bytes,4,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PRReviewersUpdate) Reset() {
+	*x = PRReviewersUpdate{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRReviewersUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRReviewersUpdate) ProtoMessage() {}
+
+func (x *PRReviewersUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRReviewersUpdate.ProtoReflect.Descriptor instead.
+func (*PRReviewersUpdate) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PRReviewersUpdate) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PRReviewersUpdate) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *PRReviewersUpdate) GetAdded() []string {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *PRReviewersUpdate) GetReviewers() []string {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamSettingsRequest) Reset() {
+	*x = GetTeamSettingsRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamSettingsRequest) ProtoMessage() {}
+
+func (x *GetTeamSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTeamSettingsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TeamSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamSettingsResponse) Reset() {
+	*x = GetTeamSettingsResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamSettingsResponse) ProtoMessage() {}
+
+func (x *GetTeamSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTeamSettingsResponse) GetSettings() *TeamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateTeamSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TeamSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamSettingsRequest) Reset() {
+	*x = UpdateTeamSettingsRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateTeamSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateTeamSettingsRequest) GetSettings() *TeamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateTeamSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TeamSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamSettingsResponse) Reset() {
+	*x = UpdateTeamSettingsResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateTeamSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTeamSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateTeamSettingsResponse) GetSettings() *TeamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type AddTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMembersRequest) Reset() {
+	*x = AddTeamMembersRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMembersRequest) ProtoMessage() {}
+
+func (x *AddTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddTeamMembersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AddTeamMembersRequest) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddTeamMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMembersResponse) Reset() {
+	*x = AddTeamMembersResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMembersResponse) ProtoMessage() {}
+
+func (x *AddTeamMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMembersResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamMembersResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddTeamMembersResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type RemoveTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMembersRequest) Reset() {
+	*x = RemoveTeamMembersRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMembersRequest) ProtoMessage() {}
+
+func (x *RemoveTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveTeamMembersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *RemoveTeamMembersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type RemoveTeamMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMembersResponse) Reset() {
+	*x = RemoveTeamMembersResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMembersResponse) ProtoMessage() {}
+
+func (x *RemoveTeamMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMembersResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMembersResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveTeamMembersResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type UpdateTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Member        *TeamMember            `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamMemberRequest) Reset() {
+	*x = UpdateTeamMemberRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamMemberRequest) ProtoMessage() {}
+
+func (x *UpdateTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateTeamMemberRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *UpdateTeamMemberRequest) GetMember() *TeamMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateTeamMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamMemberResponse) Reset() {
+	*x = UpdateTeamMemberResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamMemberResponse) ProtoMessage() {}
+
+func (x *UpdateTeamMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTeamMemberResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateTeamMemberResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type DeactivateTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamMembersRequest) Reset() {
+	*x = DeactivateTeamMembersRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamMembersRequest) ProtoMessage() {}
+
+func (x *DeactivateTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeactivateTeamMembersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *DeactivateTeamMembersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *DeactivateTeamMembersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DeactivateTeamMembersResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Team               *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	DryRun             bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	DeactivatedUserIds []string               `protobuf:"bytes,3,rep,name=deactivated_user_ids,json=deactivatedUserIds,proto3" json:"deactivated_user_ids,omitempty"`
+	PrUpdates          []*PRReviewersUpdate   `protobuf:"bytes,4,rep,name=pr_updates,json=prUpdates,proto3" json:"pr_updates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamMembersResponse) Reset() {
+	*x = DeactivateTeamMembersResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamMembersResponse) ProtoMessage() {}
+
+func (x *DeactivateTeamMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamMembersResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamMembersResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeactivateTeamMembersResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *DeactivateTeamMembersResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeactivateTeamMembersResponse) GetDeactivatedUserIds() []string {
+	if x != nil {
+		return x.DeactivatedUserIds
+	}
+	return nil
+}
+
+func (x *DeactivateTeamMembersResponse) GetPrUpdates() []*PRReviewersUpdate {
+	if x != nil {
+		return x.PrUpdates
+	}
+	return nil
+}
+
+type SetUserIsActiveRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Перераспределить открытые ревью вернувшегося пользователя.
+	Rebalance     bool `protobuf:"varint,3,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserIsActiveRequest) Reset() {
+	*x = SetUserIsActiveRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserIsActiveRequest) ProtoMessage() {}
+
+func (x *SetUserIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetUserIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SetUserIsActiveRequest) GetRebalance() bool {
+	if x != nil {
+		return x.Rebalance
+	}
+	return false
+}
+
+type SetUserIsActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserIsActiveResponse) Reset() {
+	*x = SetUserIsActiveResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserIsActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserIsActiveResponse) ProtoMessage() {}
+
+func (x *SetUserIsActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserIsActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetUserIsActiveResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetUserIsActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SetUserSeniorityRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Обязателен.
+	Seniority     Seniority `protobuf:"varint,2,opt,name=seniority,proto3,enum=reviewer.v1.Seniority" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserSeniorityRequest) Reset() {
+	*x = SetUserSeniorityRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserSeniorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSeniorityRequest) ProtoMessage() {}
+
+func (x *SetUserSeniorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSeniorityRequest.ProtoReflect.Descriptor instead.
+func (*SetUserSeniorityRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetUserSeniorityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserSeniorityRequest) GetSeniority() Seniority {
+	if x != nil {
+		return x.Seniority
+	}
+	return Seniority_SENIORITY_UNSPECIFIED
+}
+
+type SetUserSeniorityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserSeniorityResponse) Reset() {
+	*x = SetUserSeniorityResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserSeniorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSeniorityResponse) ProtoMessage() {}
+
+func (x *SetUserSeniorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSeniorityResponse.ProtoReflect.Descriptor instead.
+func (*SetUserSeniorityResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetUserSeniorityResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewRequest) Reset() {
+	*x = GetUserReviewRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewRequest) ProtoMessage() {}
+
+func (x *GetUserReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReviewRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetUserReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewResponse) Reset() {
+	*x = GetUserReviewResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewResponse) ProtoMessage() {}
+
+func (x *GetUserReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReviewResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUserReviewResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type GetUserTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTagsRequest) Reset() {
+	*x = GetUserTagsRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTagsRequest) ProtoMessage() {}
+
+func (x *GetUserTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTagsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetUserTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTagsResponse) Reset() {
+	*x = GetUserTagsResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTagsResponse) ProtoMessage() {}
+
+func (x *GetUserTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserTagsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetUserTagsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetUserTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserTagsRequest) Reset() {
+	*x = SetUserTagsRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTagsRequest) ProtoMessage() {}
+
+func (x *SetUserTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetUserTagsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetUserTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetUserTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserTagsResponse) Reset() {
+	*x = SetUserTagsResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTagsResponse) ProtoMessage() {}
+
+func (x *SetUserTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTagsResponse.ProtoReflect.Descriptor instead.
+func (*SetUserTagsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetUserTagsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreatePullRequestRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId      string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName    string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId           string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	RequestedReviewers []string               `protobuf:"bytes,4,rep,name=requested_reviewers,json=requestedReviewers,proto3" json:"requested_reviewers,omitempty"`
+	ChangedPaths       []string               `protobuf:"bytes,5,rep,name=changed_paths,json=changedPaths,proto3" json:"changed_paths,omitempty"`
+	RequiredTags       []string               `protobuf:"bytes,6,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetRequestedReviewers() []string {
+	if x != nil {
+		return x.RequestedReviewers
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetChangedPaths() []string {
+	if x != nil {
+		return x.ChangedPaths
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+type CreatePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	UnmetTags     []string               `protobuf:"bytes,2,rep,name=unmet_tags,json=unmetTags,proto3" json:"unmet_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreatePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *CreatePullRequestResponse) GetUnmetTags() []string {
+	if x != nil {
+		return x.UnmetTags
+	}
+	return nil
+}
+
+type NewPullRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NewPullRequest) Reset() {
+	*x = NewPullRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPullRequest) ProtoMessage() {}
+
+func (x *NewPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPullRequest.ProtoReflect.Descriptor instead.
+func (*NewPullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NewPullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *NewPullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *NewPullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type CreatePullRequestBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests  []*NewPullRequest      `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestBatchRequest) Reset() {
+	*x = CreatePullRequestBatchRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestBatchRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestBatchRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreatePullRequestBatchRequest) GetPullRequests() []*NewPullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type PullRequestBatchError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestBatchError) Reset() {
+	*x = PullRequestBatchError{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestBatchError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestBatchError) ProtoMessage() {}
+
+func (x *PullRequestBatchError) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestBatchError.ProtoReflect.Descriptor instead.
+func (*PullRequestBatchError) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PullRequestBatchError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PullRequestBatchError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PullRequestBatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	// Заполнен, если PR создан.
+	Pr *PullRequest `protobuf:"bytes,2,opt,name=pr,proto3" json:"pr,omitempty"`
+	// Заполнен, если PR пропущен.
+	Error         *PullRequestBatchError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestBatchResult) Reset() {
+	*x = PullRequestBatchResult{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestBatchResult) ProtoMessage() {}
+
+func (x *PullRequestBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestBatchResult.ProtoReflect.Descriptor instead.
+func (*PullRequestBatchResult) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PullRequestBatchResult) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestBatchResult) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *PullRequestBatchResult) GetError() *PullRequestBatchError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CreatePullRequestBatchResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Created       int32                     `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Results       []*PullRequestBatchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestBatchResponse) Reset() {
+	*x = CreatePullRequestBatchResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestBatchResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestBatchResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestBatchResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreatePullRequestBatchResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *CreatePullRequestBatchResponse) GetResults() []*PullRequestBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type MergePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestResponse) Reset() {
+	*x = MergePullRequestResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestResponse) ProtoMessage() {}
+
+func (x *MergePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*MergePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MergePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReassignReviewerResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type SetReviewersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Reviewers     []string               `protobuf:"bytes,2,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReviewersRequest) Reset() {
+	*x = SetReviewersRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReviewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReviewersRequest) ProtoMessage() {}
+
+func (x *SetReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReviewersRequest.ProtoReflect.Descriptor instead.
+func (*SetReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetReviewersRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *SetReviewersRequest) GetReviewers() []string {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type SetReviewersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReviewersResponse) Reset() {
+	*x = SetReviewersResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReviewersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReviewersResponse) ProtoMessage() {}
+
+func (x *SetReviewersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReviewersResponse.ProtoReflect.Descriptor instead.
+func (*SetReviewersResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetReviewersResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ListStalePullRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пустое — зависшие PR всех команд.
+	TeamName      string `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStalePullRequestsRequest) Reset() {
+	*x = ListStalePullRequestsRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStalePullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStalePullRequestsRequest) ProtoMessage() {}
+
+func (x *ListStalePullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStalePullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListStalePullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListStalePullRequestsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type StalePullRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Pr             *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	TeamName       string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	LastActivityAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	StaleAfter     *durationpb.Duration   `protobuf:"bytes,4,opt,name=stale_after,json=staleAfter,proto3" json:"stale_after,omitempty"`
+	MarkedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=marked_at,json=markedAt,proto3" json:"marked_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StalePullRequest) Reset() {
+	*x = StalePullRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StalePullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StalePullRequest) ProtoMessage() {}
+
+func (x *StalePullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StalePullRequest.ProtoReflect.Descriptor instead.
+func (*StalePullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StalePullRequest) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *StalePullRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *StalePullRequest) GetLastActivityAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return nil
+}
+
+func (x *StalePullRequest) GetStaleAfter() *durationpb.Duration {
+	if x != nil {
+		return x.StaleAfter
+	}
+	return nil
+}
+
+func (x *StalePullRequest) GetMarkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MarkedAt
+	}
+	return nil
+}
+
+// Фильтр PR для статистики; пустые поля не ограничивают. Границы периодов: [from, to).
+type StatsFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	MergedFrom    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=merged_from,json=mergedFrom,proto3" json:"merged_from,omitempty"`
+	MergedTo      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=merged_to,json=mergedTo,proto3" json:"merged_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsFilter) Reset() {
+	*x = StatsFilter{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsFilter) ProtoMessage() {}
+
+func (x *StatsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsFilter.ProtoReflect.Descriptor instead.
+func (*StatsFilter) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StatsFilter) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *StatsFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetMergedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedFrom
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetMergedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedTo
+	}
+	return nil
+}
+
+type PRStatusCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Open          int32                  `protobuf:"varint,1,opt,name=open,proto3" json:"open,omitempty"`
+	Merged        int32                  `protobuf:"varint,2,opt,name=merged,proto3" json:"merged,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PRStatusCounts) Reset() {
+	*x = PRStatusCounts{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRStatusCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRStatusCounts) ProtoMessage() {}
+
+func (x *PRStatusCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRStatusCounts.ProtoReflect.Descriptor instead.
+func (*PRStatusCounts) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PRStatusCounts) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *PRStatusCounts) GetMerged() int32 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+func (x *PRStatusCounts) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UserAssignmentsStat struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserId                 string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReviewAssignmentsCount int32                  `protobuf:"varint,2,opt,name=review_assignments_count,json=reviewAssignmentsCount,proto3" json:"review_assignments_count,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UserAssignmentsStat) Reset() {
+	*x = UserAssignmentsStat{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAssignmentsStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAssignmentsStat) ProtoMessage() {}
+
+func (x *UserAssignmentsStat) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAssignmentsStat.ProtoReflect.Descriptor instead.
+func (*UserAssignmentsStat) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UserAssignmentsStat) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAssignmentsStat) GetReviewAssignmentsCount() int32 {
+	if x != nil {
+		return x.ReviewAssignmentsCount
+	}
+	return 0
+}
+
+type TeamStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TeamName       string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	PrStatusCounts *PRStatusCounts        `protobuf:"bytes,2,opt,name=pr_status_counts,json=prStatusCounts,proto3" json:"pr_status_counts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TeamStats) Reset() {
+	*x = TeamStats{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStats) ProtoMessage() {}
+
+func (x *TeamStats) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStats.ProtoReflect.Descriptor instead.
+func (*TeamStats) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TeamStats) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamStats) GetPrStatusCounts() *PRStatusCounts {
+	if x != nil {
+		return x.PrStatusCounts
+	}
+	return nil
+}
+
+type StatsBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Merged        int32                  `protobuf:"varint,3,opt,name=merged,proto3" json:"merged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsBucket) Reset() {
+	*x = StatsBucket{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsBucket) ProtoMessage() {}
+
+func (x *StatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsBucket.ProtoReflect.Descriptor instead.
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StatsBucket) GetBucketStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BucketStart
+	}
+	return nil
+}
+
+func (x *StatsBucket) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *StatsBucket) GetMerged() int32 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+type GetStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Granularity   StatsGranularity       `protobuf:"varint,2,opt,name=granularity,proto3,enum=reviewer.v1.StatsGranularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetGranularity() StatsGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return StatsGranularity_STATS_GRANULARITY_UNSPECIFIED
+}
+
+type GetStatsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentsByUser []*UserAssignmentsStat `protobuf:"bytes,1,rep,name=assignments_by_user,json=assignmentsByUser,proto3" json:"assignments_by_user,omitempty"`
+	PrStatusCounts    *PRStatusCounts        `protobuf:"bytes,2,opt,name=pr_status_counts,json=prStatusCounts,proto3" json:"pr_status_counts,omitempty"`
+	ByTeam            []*TeamStats           `protobuf:"bytes,3,rep,name=by_team,json=byTeam,proto3" json:"by_team,omitempty"`
+	TimeSeries        []*StatsBucket         `protobuf:"bytes,4,rep,name=time_series,json=timeSeries,proto3" json:"time_series,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetStatsResponse) GetAssignmentsByUser() []*UserAssignmentsStat {
+	if x != nil {
+		return x.AssignmentsByUser
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetPrStatusCounts() *PRStatusCounts {
+	if x != nil {
+		return x.PrStatusCounts
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetByTeam() []*TeamStats {
+	if x != nil {
+		return x.ByTeam
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetTimeSeries() []*StatsBucket {
+	if x != nil {
+		return x.TimeSeries
+	}
+	return nil
+}
+
+type DurationSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Mean          *durationpb.Duration   `protobuf:"bytes,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	P50           *durationpb.Duration   `protobuf:"bytes,3,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90           *durationpb.Duration   `protobuf:"bytes,4,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99           *durationpb.Duration   `protobuf:"bytes,5,opt,name=p99,proto3" json:"p99,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DurationSummary) Reset() {
+	*x = DurationSummary{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DurationSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationSummary) ProtoMessage() {}
+
+func (x *DurationSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationSummary.ProtoReflect.Descriptor instead.
+func (*DurationSummary) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DurationSummary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DurationSummary) GetMean() *durationpb.Duration {
+	if x != nil {
+		return x.Mean
+	}
+	return nil
+}
+
+func (x *DurationSummary) GetP50() *durationpb.Duration {
+	if x != nil {
+		return x.P50
+	}
+	return nil
+}
+
+func (x *DurationSummary) GetP90() *durationpb.Duration {
+	if x != nil {
+		return x.P90
+	}
+	return nil
+}
+
+func (x *DurationSummary) GetP99() *durationpb.Duration {
+	if x != nil {
+		return x.P99
+	}
+	return nil
+}
+
+type TeamCycleTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	CycleTime     *DurationSummary       `protobuf:"bytes,2,opt,name=cycle_time,json=cycleTime,proto3" json:"cycle_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamCycleTime) Reset() {
+	*x = TeamCycleTime{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamCycleTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamCycleTime) ProtoMessage() {}
+
+func (x *TeamCycleTime) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamCycleTime.ProtoReflect.Descriptor instead.
+func (*TeamCycleTime) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TeamCycleTime) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamCycleTime) GetCycleTime() *DurationSummary {
+	if x != nil {
+		return x.CycleTime
+	}
+	return nil
+}
+
+type ReviewerCycleTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CycleTime     *DurationSummary       `protobuf:"bytes,2,opt,name=cycle_time,json=cycleTime,proto3" json:"cycle_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerCycleTime) Reset() {
+	*x = ReviewerCycleTime{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerCycleTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerCycleTime) ProtoMessage() {}
+
+func (x *ReviewerCycleTime) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerCycleTime.ProtoReflect.Descriptor instead.
+func (*ReviewerCycleTime) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReviewerCycleTime) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerCycleTime) GetCycleTime() *DurationSummary {
+	if x != nil {
+		return x.CycleTime
+	}
+	return nil
+}
+
+type AgeBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Не задана — корзина без верхней границы.
+	Le            *durationpb.Duration `protobuf:"bytes,1,opt,name=le,proto3" json:"le,omitempty"`
+	Count         int32                `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgeBucket) Reset() {
+	*x = AgeBucket{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgeBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgeBucket) ProtoMessage() {}
+
+func (x *AgeBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgeBucket.ProtoReflect.Descriptor instead.
+func (*AgeBucket) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AgeBucket) GetLe() *durationpb.Duration {
+	if x != nil {
+		return x.Le
+	}
+	return nil
+}
+
+func (x *AgeBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type OpenPRAge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Age           *DurationSummary       `protobuf:"bytes,1,opt,name=age,proto3" json:"age,omitempty"`
+	Buckets       []*AgeBucket           `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenPRAge) Reset() {
+	*x = OpenPRAge{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenPRAge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPRAge) ProtoMessage() {}
+
+func (x *OpenPRAge) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPRAge.ProtoReflect.Descriptor instead.
+func (*OpenPRAge) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *OpenPRAge) GetAge() *DurationSummary {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *OpenPRAge) GetBuckets() []*AgeBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type GetCycleTimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCycleTimeRequest) Reset() {
+	*x = GetCycleTimeRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCycleTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCycleTimeRequest) ProtoMessage() {}
+
+func (x *GetCycleTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCycleTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetCycleTimeRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetCycleTimeRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetCycleTimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overall       *DurationSummary       `protobuf:"bytes,1,opt,name=overall,proto3" json:"overall,omitempty"`
+	ByTeam        []*TeamCycleTime       `protobuf:"bytes,2,rep,name=by_team,json=byTeam,proto3" json:"by_team,omitempty"`
+	ByReviewer    []*ReviewerCycleTime   `protobuf:"bytes,3,rep,name=by_reviewer,json=byReviewer,proto3" json:"by_reviewer,omitempty"`
+	OpenAge       *OpenPRAge             `protobuf:"bytes,4,opt,name=open_age,json=openAge,proto3" json:"open_age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCycleTimeResponse) Reset() {
+	*x = GetCycleTimeResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCycleTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCycleTimeResponse) ProtoMessage() {}
+
+func (x *GetCycleTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCycleTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetCycleTimeResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCycleTimeResponse) GetOverall() *DurationSummary {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *GetCycleTimeResponse) GetByTeam() []*TeamCycleTime {
+	if x != nil {
+		return x.ByTeam
+	}
+	return nil
+}
+
+func (x *GetCycleTimeResponse) GetByReviewer() []*ReviewerCycleTime {
+	if x != nil {
+		return x.ByReviewer
+	}
+	return nil
+}
+
+func (x *GetCycleTimeResponse) GetOpenAge() *OpenPRAge {
+	if x != nil {
+		return x.OpenAge
+	}
+	return nil
+}
+
+type MemberLoad struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OpenAssignments int32                  `protobuf:"varint,2,opt,name=open_assignments,json=openAssignments,proto3" json:"open_assignments,omitempty"`
+	LastAssignedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_assigned_at,json=lastAssignedAt,proto3" json:"last_assigned_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MemberLoad) Reset() {
+	*x = MemberLoad{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberLoad) ProtoMessage() {}
+
+func (x *MemberLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberLoad.ProtoReflect.Descriptor instead.
+func (*MemberLoad) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MemberLoad) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberLoad) GetOpenAssignments() int32 {
+	if x != nil {
+		return x.OpenAssignments
+	}
+	return 0
+}
+
+func (x *MemberLoad) GetLastAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAssignedAt
+	}
+	return nil
+}
+
+type TeamFairness struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TeamName        string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ActiveMembers   int32                  `protobuf:"varint,2,opt,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
+	OpenAssignments int32                  `protobuf:"varint,3,opt,name=open_assignments,json=openAssignments,proto3" json:"open_assignments,omitempty"`
+	MeanLoad        float64                `protobuf:"fixed64,4,opt,name=mean_load,json=meanLoad,proto3" json:"mean_load,omitempty"`
+	MinLoad         int32                  `protobuf:"varint,5,opt,name=min_load,json=minLoad,proto3" json:"min_load,omitempty"`
+	MaxLoad         int32                  `protobuf:"varint,6,opt,name=max_load,json=maxLoad,proto3" json:"max_load,omitempty"`
+	Spread          int32                  `protobuf:"varint,7,opt,name=spread,proto3" json:"spread,omitempty"`
+	Gini            float64                `protobuf:"fixed64,8,opt,name=gini,proto3" json:"gini,omitempty"`
+	Members         []*MemberLoad          `protobuf:"bytes,9,rep,name=members,proto3" json:"members,omitempty"`
+	IdleMembers     []string               `protobuf:"bytes,10,rep,name=idle_members,json=idleMembers,proto3" json:"idle_members,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TeamFairness) Reset() {
+	*x = TeamFairness{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamFairness) ProtoMessage() {}
+
+func (x *TeamFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamFairness.ProtoReflect.Descriptor instead.
+func (*TeamFairness) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TeamFairness) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamFairness) GetActiveMembers() int32 {
+	if x != nil {
+		return x.ActiveMembers
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetOpenAssignments() int32 {
+	if x != nil {
+		return x.OpenAssignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMeanLoad() float64 {
+	if x != nil {
+		return x.MeanLoad
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMinLoad() int32 {
+	if x != nil {
+		return x.MinLoad
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMaxLoad() int32 {
+	if x != nil {
+		return x.MaxLoad
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetSpread() int32 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetGini() float64 {
+	if x != nil {
+		return x.Gini
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMembers() []*MemberLoad {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *TeamFairness) GetIdleMembers() []string {
+	if x != nil {
+		return x.IdleMembers
+	}
+	return nil
+}
+
+type GetFairnessRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Ноль — 14 дней, как в REST.
+	IdleDays      int32 `protobuf:"varint,2,opt,name=idle_days,json=idleDays,proto3" json:"idle_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFairnessRequest) Reset() {
+	*x = GetFairnessRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFairnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFairnessRequest) ProtoMessage() {}
+
+func (x *GetFairnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFairnessRequest.ProtoReflect.Descriptor instead.
+func (*GetFairnessRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetFairnessRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetFairnessRequest) GetIdleDays() int32 {
+	if x != nil {
+		return x.IdleDays
+	}
+	return 0
+}
+
+type GetFairnessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdleSince     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=idle_since,json=idleSince,proto3" json:"idle_since,omitempty"`
+	Teams         []*TeamFairness        `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFairnessResponse) Reset() {
+	*x = GetFairnessResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFairnessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFairnessResponse) ProtoMessage() {}
+
+func (x *GetFairnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFairnessResponse.ProtoReflect.Descriptor instead.
+func (*GetFairnessResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetFairnessResponse) GetIdleSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IdleSince
+	}
+	return nil
+}
+
+func (x *GetFairnessResponse) GetTeams() []*TeamFairness {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+var File_reviewer_v1_reviewer_proto protoreflect.FileDescriptor
+
+const file_reviewer_v1_reviewer_proto_rawDesc = "" +
+	"\n" +
+	"\x1areviewer/v1/reviewer.proto\x12\vreviewer.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x94\x01\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x124\n" +
+	"\tseniority\x18\x04 \x01(\x0e2\x16.reviewer.v1.SeniorityR\tseniority\"V\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\amembers\x18\x02 \x03(\v2\x17.reviewer.v1.TeamMemberR\amembers\"\xab\x01\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x124\n" +
+	"\tseniority\x18\x05 \x01(\x0e2\x16.reviewer.v1.SeniorityR\tseniority\"\xd9\x02\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x126\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1e.reviewer.v1.PullRequestStatusR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\xbb\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x126\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1e.reviewer.v1.PullRequestStatusR\x06status\"\x8b\x02\n" +
+	"\fTeamSettings\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12:\n" +
+	"\vstale_after\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"staleAfter\x12*\n" +
+	"\x11stale_auto_rotate\x18\x03 \x01(\bR\x0fstaleAutoRotate\x12%\n" +
+	"\x0ereviewer_teams\x18\x04 \x03(\tR\rreviewerTeams\x120\n" +
+	"\x14min_senior_reviewers\x18\x05 \x01(\x05R\x12minSeniorReviewers\x12\x1d\n" +
+	"\n" +
+	"forge_sync\x18\x06 \x01(\bR\tforgeSync\"\x89\x01\n" +
+	"\x11PRReviewersUpdate\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x18\n" +
+	"\aremoved\x18\x02 \x03(\tR\aremoved\x12\x14\n" +
+	"\x05added\x18\x03 \x03(\tR\x05added\x12\x1c\n" +
+	"\treviewers\x18\x04 \x03(\tR\treviewers\"7\n" +
+	"\x0eAddTeamRequest\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\"8\n" +
+	"\x0fAddTeamResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"8\n" +
+	"\x0fGetTeamResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\"5\n" +
+	"\x16GetTeamSettingsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"P\n" +
+	"\x17GetTeamSettingsResponse\x125\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.reviewer.v1.TeamSettingsR\bsettings\"R\n" +
+	"\x19UpdateTeamSettingsRequest\x125\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.reviewer.v1.TeamSettingsR\bsettings\"S\n" +
+	"\x1aUpdateTeamSettingsResponse\x125\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.reviewer.v1.TeamSettingsR\bsettings\"g\n" +
+	"\x15AddTeamMembersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\amembers\x18\x02 \x03(\v2\x17.reviewer.v1.TeamMemberR\amembers\"?\n" +
+	"\x16AddTeamMembersResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\"R\n" +
+	"\x18RemoveTeamMembersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\"B\n" +
+	"\x19RemoveTeamMembersResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\"g\n" +
+	"\x17UpdateTeamMemberRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\x06member\x18\x02 \x01(\v2\x17.reviewer.v1.TeamMemberR\x06member\"A\n" +
+	"\x18UpdateTeamMemberResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\"o\n" +
+	"\x1cDeactivateTeamMembersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xd0\x01\n" +
+	"\x1dDeactivateTeamMembersResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x120\n" +
+	"\x14deactivated_user_ids\x18\x03 \x03(\tR\x12deactivatedUserIds\x12=\n" +
+	"\n" +
+	"pr_updates\x18\x04 \x03(\v2\x1e.reviewer.v1.PRReviewersUpdateR\tprUpdates\"l\n" +
+	"\x16SetUserIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12\x1c\n" +
+	"\trebalance\x18\x03 \x01(\bR\trebalance\"@\n" +
+	"\x17SetUserIsActiveResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.reviewer.v1.UserR\x04user\"h\n" +
+	"\x17SetUserSeniorityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x124\n" +
+	"\tseniority\x18\x02 \x01(\x0e2\x16.reviewer.v1.SeniorityR\tseniority\"A\n" +
+	"\x18SetUserSeniorityResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.reviewer.v1.UserR\x04user\"/\n" +
+	"\x14GetUserReviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"t\n" +
+	"\x15GetUserReviewResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1d.reviewer.v1.PullRequestShortR\fpullRequests\"-\n" +
+	"\x12GetUserTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"B\n" +
+	"\x13GetUserTagsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"A\n" +
+	"\x12SetUserTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"B\n" +
+	"\x13SetUserTagsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"\x86\x02\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12/\n" +
+	"\x13requested_reviewers\x18\x04 \x03(\tR\x12requestedReviewers\x12#\n" +
+	"\rchanged_paths\x18\x05 \x03(\tR\fchangedPaths\x12#\n" +
+	"\rrequired_tags\x18\x06 \x03(\tR\frequiredTags\"d\n" +
+	"\x19CreatePullRequestResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\x12\x1d\n" +
+	"\n" +
+	"unmet_tags\x18\x02 \x03(\tR\tunmetTags\"\x81\x01\n" +
+	"\x0eNewPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\"a\n" +
+	"\x1dCreatePullRequestBatchRequest\x12@\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x1b.reviewer.v1.NewPullRequestR\fpullRequests\"E\n" +
+	"\x15PullRequestBatchError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa4\x01\n" +
+	"\x16PullRequestBatchResult\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12(\n" +
+	"\x02pr\x18\x02 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\x128\n" +
+	"\x05error\x18\x03 \x01(\v2\".reviewer.v1.PullRequestBatchErrorR\x05error\"y\n" +
+	"\x1eCreatePullRequestBatchResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12=\n" +
+	"\aresults\x18\x02 \x03(\v2#.reviewer.v1.PullRequestBatchResultR\aresults\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"D\n" +
+	"\x18MergePullRequestResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\"a\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"e\n" +
+	"\x18ReassignReviewerResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"[\n" +
+	"\x13SetReviewersRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1c\n" +
+	"\treviewers\x18\x02 \x03(\tR\treviewers\"@\n" +
+	"\x14SetReviewersResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\";\n" +
+	"\x1cListStalePullRequestsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"\x94\x02\n" +
+	"\x10StalePullRequest\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12D\n" +
+	"\x10last_activity_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastActivityAt\x12:\n" +
+	"\vstale_after\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"staleAfter\x127\n" +
+	"\tmarked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bmarkedAt\"\x9a\x02\n" +
+	"\vStatsFilter\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12=\n" +
+	"\fcreated_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12;\n" +
+	"\vmerged_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mergedFrom\x127\n" +
+	"\tmerged_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bmergedTo\"R\n" +
+	"\x0ePRStatusCounts\x12\x12\n" +
+	"\x04open\x18\x01 \x01(\x05R\x04open\x12\x16\n" +
+	"\x06merged\x18\x02 \x01(\x05R\x06merged\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"h\n" +
+	"\x13UserAssignmentsStat\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x128\n" +
+	"\x18review_assignments_count\x18\x02 \x01(\x05R\x16reviewAssignmentsCount\"o\n" +
+	"\tTeamStats\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12E\n" +
+	"\x10pr_status_counts\x18\x02 \x01(\v2\x1b.reviewer.v1.PRStatusCountsR\x0eprStatusCounts\"~\n" +
+	"\vStatsBucket\x12=\n" +
+	"\fbucket_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vbucketStart\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x16\n" +
+	"\x06merged\x18\x03 \x01(\x05R\x06merged\"\x84\x01\n" +
+	"\x0fGetStatsRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.reviewer.v1.StatsFilterR\x06filter\x12?\n" +
+	"\vgranularity\x18\x02 \x01(\x0e2\x1d.reviewer.v1.StatsGranularityR\vgranularity\"\x97\x02\n" +
+	"\x10GetStatsResponse\x12P\n" +
+	"\x13assignments_by_user\x18\x01 \x03(\v2 .reviewer.v1.UserAssignmentsStatR\x11assignmentsByUser\x12E\n" +
+	"\x10pr_status_counts\x18\x02 \x01(\v2\x1b.reviewer.v1.PRStatusCountsR\x0eprStatusCounts\x12/\n" +
+	"\aby_team\x18\x03 \x03(\v2\x16.reviewer.v1.TeamStatsR\x06byTeam\x129\n" +
+	"\vtime_series\x18\x04 \x03(\v2\x18.reviewer.v1.StatsBucketR\n" +
+	"timeSeries\"\xdd\x01\n" +
+	"\x0fDurationSummary\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12-\n" +
+	"\x04mean\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x04mean\x12+\n" +
+	"\x03p50\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03p50\x12+\n" +
+	"\x03p90\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03p90\x12+\n" +
+	"\x03p99\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x03p99\"i\n" +
+	"\rTeamCycleTime\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12;\n" +
+	"\n" +
+	"cycle_time\x18\x02 \x01(\v2\x1c.reviewer.v1.DurationSummaryR\tcycleTime\"i\n" +
+	"\x11ReviewerCycleTime\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\n" +
+	"cycle_time\x18\x02 \x01(\v2\x1c.reviewer.v1.DurationSummaryR\tcycleTime\"L\n" +
+	"\tAgeBucket\x12)\n" +
+	"\x02le\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x02le\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"m\n" +
+	"\tOpenPRAge\x12.\n" +
+	"\x03age\x18\x01 \x01(\v2\x1c.reviewer.v1.DurationSummaryR\x03age\x120\n" +
+	"\abuckets\x18\x02 \x03(\v2\x16.reviewer.v1.AgeBucketR\abuckets\"G\n" +
+	"\x13GetCycleTimeRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.reviewer.v1.StatsFilterR\x06filter\"\xf7\x01\n" +
+	"\x14GetCycleTimeResponse\x126\n" +
+	"\aoverall\x18\x01 \x01(\v2\x1c.reviewer.v1.DurationSummaryR\aoverall\x123\n" +
+	"\aby_team\x18\x02 \x03(\v2\x1a.reviewer.v1.TeamCycleTimeR\x06byTeam\x12?\n" +
+	"\vby_reviewer\x18\x03 \x03(\v2\x1e.reviewer.v1.ReviewerCycleTimeR\n" +
+	"byReviewer\x121\n" +
+	"\bopen_age\x18\x04 \x01(\v2\x16.reviewer.v1.OpenPRAgeR\aopenAge\"\x96\x01\n" +
+	"\n" +
+	"MemberLoad\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
+	"\x10open_assignments\x18\x02 \x01(\x05R\x0fopenAssignments\x12D\n" +
+	"\x10last_assigned_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAssignedAt\"\xd2\x02\n" +
+	"\fTeamFairness\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12%\n" +
+	"\x0eactive_members\x18\x02 \x01(\x05R\ractiveMembers\x12)\n" +
+	"\x10open_assignments\x18\x03 \x01(\x05R\x0fopenAssignments\x12\x1b\n" +
+	"\tmean_load\x18\x04 \x01(\x01R\bmeanLoad\x12\x19\n" +
+	"\bmin_load\x18\x05 \x01(\x05R\aminLoad\x12\x19\n" +
+	"\bmax_load\x18\x06 \x01(\x05R\amaxLoad\x12\x16\n" +
+	"\x06spread\x18\a \x01(\x05R\x06spread\x12\x12\n" +
+	"\x04gini\x18\b \x01(\x01R\x04gini\x121\n" +
+	"\amembers\x18\t \x03(\v2\x17.reviewer.v1.MemberLoadR\amembers\x12!\n" +
+	"\fidle_members\x18\n" +
+	" \x03(\tR\vidleMembers\"N\n" +
+	"\x12GetFairnessRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tidle_days\x18\x02 \x01(\x05R\bidleDays\"\x81\x01\n" +
+	"\x13GetFairnessResponse\x129\n" +
+	"\n" +
+	"idle_since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tidleSince\x12/\n" +
+	"\x05teams\x18\x02 \x03(\v2\x19.reviewer.v1.TeamFairnessR\x05teams*h\n" +
+	"\tSeniority\x12\x19\n" +
+	"\x15SENIORITY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SENIORITY_JUNIOR\x10\x01\x12\x14\n" +
+	"\x10SENIORITY_MIDDLE\x10\x02\x12\x14\n" +
+	"\x10SENIORITY_SENIOR\x10\x03*v\n" +
+	"\x11PullRequestStatus\x12#\n" +
+	"\x1fPULL_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PULL_REQUEST_STATUS_OPEN\x10\x01\x12\x1e\n" +
+	"\x1aPULL_REQUEST_STATUS_MERGED\x10\x02*l\n" +
+	"\x10StatsGranularity\x12!\n" +
+	"\x1dSTATS_GRANULARITY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15STATS_GRANULARITY_DAY\x10\x01\x12\x1a\n" +
+	"\x16STATS_GRANULARITY_WEEK\x10\x022\xee\x05\n" +
+	"\vTeamService\x12D\n" +
+	"\aAddTeam\x12\x1b.reviewer.v1.AddTeamRequest\x1a\x1c.reviewer.v1.AddTeamResponse\x12D\n" +
+	"\aGetTeam\x12\x1b.reviewer.v1.GetTeamRequest\x1a\x1c.reviewer.v1.GetTeamResponse\x12\\\n" +
+	"\x0fGetTeamSettings\x12#.reviewer.v1.GetTeamSettingsRequest\x1a$.reviewer.v1.GetTeamSettingsResponse\x12e\n" +
+	"\x12UpdateTeamSettings\x12&.reviewer.v1.UpdateTeamSettingsRequest\x1a'.reviewer.v1.UpdateTeamSettingsResponse\x12Y\n" +
+	"\x0eAddTeamMembers\x12\".reviewer.v1.AddTeamMembersRequest\x1a#.reviewer.v1.AddTeamMembersResponse\x12b\n" +
+	"\x11RemoveTeamMembers\x12%.reviewer.v1.RemoveTeamMembersRequest\x1a&.reviewer.v1.RemoveTeamMembersResponse\x12_\n" +
+	"\x10UpdateTeamMember\x12$.reviewer.v1.UpdateTeamMemberRequest\x1a%.reviewer.v1.UpdateTeamMemberResponse\x12n\n" +
+	"\x15DeactivateTeamMembers\x12).reviewer.v1.DeactivateTeamMembersRequest\x1a*.reviewer.v1.DeactivateTeamMembersResponse2\xc8\x03\n" +
+	"\vUserService\x12\\\n" +
+	"\x0fSetUserIsActive\x12#.reviewer.v1.SetUserIsActiveRequest\x1a$.reviewer.v1.SetUserIsActiveResponse\x12_\n" +
+	"\x10SetUserSeniority\x12$.reviewer.v1.SetUserSeniorityRequest\x1a%.reviewer.v1.SetUserSeniorityResponse\x12V\n" +
+	"\rGetUserReview\x12!.reviewer.v1.GetUserReviewRequest\x1a\".reviewer.v1.GetUserReviewResponse\x12P\n" +
+	"\vGetUserTags\x12\x1f.reviewer.v1.GetUserTagsRequest\x1a .reviewer.v1.GetUserTagsResponse\x12P\n" +
+	"\vSetUserTags\x12\x1f.reviewer.v1.SetUserTagsRequest\x1a .reviewer.v1.SetUserTagsResponse2\xe7\x04\n" +
+	"\x12PullRequestService\x12b\n" +
+	"\x11CreatePullRequest\x12%.reviewer.v1.CreatePullRequestRequest\x1a&.reviewer.v1.CreatePullRequestResponse\x12q\n" +
+	"\x16CreatePullRequestBatch\x12*.reviewer.v1.CreatePullRequestBatchRequest\x1a+.reviewer.v1.CreatePullRequestBatchResponse\x12_\n" +
+	"\x10MergePullRequest\x12$.reviewer.v1.MergePullRequestRequest\x1a%.reviewer.v1.MergePullRequestResponse\x12_\n" +
+	"\x10ReassignReviewer\x12$.reviewer.v1.ReassignReviewerRequest\x1a%.reviewer.v1.ReassignReviewerResponse\x12S\n" +
+	"\fSetReviewers\x12 .reviewer.v1.SetReviewersRequest\x1a!.reviewer.v1.SetReviewersResponse\x12c\n" +
+	"\x15ListStalePullRequests\x12).reviewer.v1.ListStalePullRequestsRequest\x1a\x1d.reviewer.v1.StalePullRequest0\x012\xfe\x01\n" +
+	"\fStatsService\x12G\n" +
+	"\bGetStats\x12\x1c.reviewer.v1.GetStatsRequest\x1a\x1d.reviewer.v1.GetStatsResponse\x12S\n" +
+	"\fGetCycleTime\x12 .reviewer.v1.GetCycleTimeRequest\x1a!.reviewer.v1.GetCycleTimeResponse\x12P\n" +
+	"\vGetFairness\x12\x1f.reviewer.v1.GetFairnessRequest\x1a .reviewer.v1.GetFairnessResponseBEZCgithub.com/alnoi/pr-reviewer-service/pkg/api/reviewer/v1;reviewerv1b\x06proto3"
+
+var (
+	file_reviewer_v1_reviewer_proto_rawDescOnce sync.Once
+	file_reviewer_v1_reviewer_proto_rawDescData []byte
+)
+
+func file_reviewer_v1_reviewer_proto_rawDescGZIP() []byte {
+	file_reviewer_v1_reviewer_proto_rawDescOnce.Do(func() {
+		file_reviewer_v1_reviewer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reviewer_v1_reviewer_proto_rawDesc), len(file_reviewer_v1_reviewer_proto_rawDesc)))
+	})
+	return file_reviewer_v1_reviewer_proto_rawDescData
+}
+
+var file_reviewer_v1_reviewer_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_reviewer_v1_reviewer_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_reviewer_v1_reviewer_proto_goTypes = []any{
+	(Seniority)(0),                         // 0: reviewer.v1.Seniority
+	(PullRequestStatus)(0),                 // 1: reviewer.v1.PullRequestStatus
+	(StatsGranularity)(0),                  // 2: reviewer.v1.StatsGranularity
+	(*TeamMember)(nil),                     // 3: reviewer.v1.TeamMember
+	(*Team)(nil),                           // 4: reviewer.v1.Team
+	(*User)(nil),                           // 5: reviewer.v1.User
+	(*PullRequest)(nil),                    // 6: reviewer.v1.PullRequest
+	(*PullRequestShort)(nil),               // 7: reviewer.v1.PullRequestShort
+	(*TeamSettings)(nil),                   // 8: reviewer.v1.TeamSettings
+	(*PRReviewersUpdate)(nil),              // 9: reviewer.v1.PRReviewersUpdate
+	(*AddTeamRequest)(nil),                 // 10: reviewer.v1.AddTeamRequest
+	(*AddTeamResponse)(nil),                // 11: reviewer.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),                 // 12: reviewer.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),                // 13: reviewer.v1.GetTeamResponse
+	(*GetTeamSettingsRequest)(nil),         // 14: reviewer.v1.GetTeamSettingsRequest
+	(*GetTeamSettingsResponse)(nil),        // 15: reviewer.v1.GetTeamSettingsResponse
+	(*UpdateTeamSettingsRequest)(nil),      // 16: reviewer.v1.UpdateTeamSettingsRequest
+	(*UpdateTeamSettingsResponse)(nil),     // 17: reviewer.v1.UpdateTeamSettingsResponse
+	(*AddTeamMembersRequest)(nil),          // 18: reviewer.v1.AddTeamMembersRequest
+	(*AddTeamMembersResponse)(nil),         // 19: reviewer.v1.AddTeamMembersResponse
+	(*RemoveTeamMembersRequest)(nil),       // 20: reviewer.v1.RemoveTeamMembersRequest
+	(*RemoveTeamMembersResponse)(nil),      // 21: reviewer.v1.RemoveTeamMembersResponse
+	(*UpdateTeamMemberRequest)(nil),        // 22: reviewer.v1.UpdateTeamMemberRequest
+	(*UpdateTeamMemberResponse)(nil),       // 23: reviewer.v1.UpdateTeamMemberResponse
+	(*DeactivateTeamMembersRequest)(nil),   // 24: reviewer.v1.DeactivateTeamMembersRequest
+	(*DeactivateTeamMembersResponse)(nil),  // 25: reviewer.v1.DeactivateTeamMembersResponse
+	(*SetUserIsActiveRequest)(nil),         // 26: reviewer.v1.SetUserIsActiveRequest
+	(*SetUserIsActiveResponse)(nil),        // 27: reviewer.v1.SetUserIsActiveResponse
+	(*SetUserSeniorityRequest)(nil),        // 28: reviewer.v1.SetUserSeniorityRequest
+	(*SetUserSeniorityResponse)(nil),       // 29: reviewer.v1.SetUserSeniorityResponse
+	(*GetUserReviewRequest)(nil),           // 30: reviewer.v1.GetUserReviewRequest
+	(*GetUserReviewResponse)(nil),          // 31: reviewer.v1.GetUserReviewResponse
+	(*GetUserTagsRequest)(nil),             // 32: reviewer.v1.GetUserTagsRequest
+	(*GetUserTagsResponse)(nil),            // 33: reviewer.v1.GetUserTagsResponse
+	(*SetUserTagsRequest)(nil),             // 34: reviewer.v1.SetUserTagsRequest
+	(*SetUserTagsResponse)(nil),            // 35: reviewer.v1.SetUserTagsResponse
+	(*CreatePullRequestRequest)(nil),       // 36: reviewer.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil),      // 37: reviewer.v1.CreatePullRequestResponse
+	(*NewPullRequest)(nil),                 // 38: reviewer.v1.NewPullRequest
+	(*CreatePullRequestBatchRequest)(nil),  // 39: reviewer.v1.CreatePullRequestBatchRequest
+	(*PullRequestBatchError)(nil),          // 40: reviewer.v1.PullRequestBatchError
+	(*PullRequestBatchResult)(nil),         // 41: reviewer.v1.PullRequestBatchResult
+	(*CreatePullRequestBatchResponse)(nil), // 42: reviewer.v1.CreatePullRequestBatchResponse
+	(*MergePullRequestRequest)(nil),        // 43: reviewer.v1.MergePullRequestRequest
+	(*MergePullRequestResponse)(nil),       // 44: reviewer.v1.MergePullRequestResponse
+	(*ReassignReviewerRequest)(nil),        // 45: reviewer.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),       // 46: reviewer.v1.ReassignReviewerResponse
+	(*SetReviewersRequest)(nil),            // 47: reviewer.v1.SetReviewersRequest
+	(*SetReviewersResponse)(nil),           // 48: reviewer.v1.SetReviewersResponse
+	(*ListStalePullRequestsRequest)(nil),   // 49: reviewer.v1.ListStalePullRequestsRequest
+	(*StalePullRequest)(nil),               // 50: reviewer.v1.StalePullRequest
+	(*StatsFilter)(nil),                    // 51: reviewer.v1.StatsFilter
+	(*PRStatusCounts)(nil),                 // 52: reviewer.v1.PRStatusCounts
+	(*UserAssignmentsStat)(nil),            // 53: reviewer.v1.UserAssignmentsStat
+	(*TeamStats)(nil),                      // 54: reviewer.v1.TeamStats
+	(*StatsBucket)(nil),                    // 55: reviewer.v1.StatsBucket
+	(*GetStatsRequest)(nil),                // 56: reviewer.v1.GetStatsRequest
+	(*GetStatsResponse)(nil),               // 57: reviewer.v1.GetStatsResponse
+	(*DurationSummary)(nil),                // 58: reviewer.v1.DurationSummary
+	(*TeamCycleTime)(nil),                  // 59: reviewer.v1.TeamCycleTime
+	(*ReviewerCycleTime)(nil),              // 60: reviewer.v1.ReviewerCycleTime
+	(*AgeBucket)(nil),                      // 61: reviewer.v1.AgeBucket
+	(*OpenPRAge)(nil),                      // 62: reviewer.v1.OpenPRAge
+	(*GetCycleTimeRequest)(nil),            // 63: reviewer.v1.GetCycleTimeRequest
+	(*GetCycleTimeResponse)(nil),           // 64: reviewer.v1.GetCycleTimeResponse
+	(*MemberLoad)(nil),                     // 65: reviewer.v1.MemberLoad
+	(*TeamFairness)(nil),                   // 66: reviewer.v1.TeamFairness
+	(*GetFairnessRequest)(nil),             // 67: reviewer.v1.GetFairnessRequest
+	(*GetFairnessResponse)(nil),            // 68: reviewer.v1.GetFairnessResponse
+	(*timestamppb.Timestamp)(nil),          // 69: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 70: google.protobuf.Duration
+}
+var file_reviewer_v1_reviewer_proto_depIdxs = []int32{
+	0,  // 0: reviewer.v1.TeamMember.seniority:type_name -> reviewer.v1.Seniority
+	3,  // 1: reviewer.v1.Team.members:type_name -> reviewer.v1.TeamMember
+	0,  // 2: reviewer.v1.User.seniority:type_name -> reviewer.v1.Seniority
+	1,  // 3: reviewer.v1.PullRequest.status:type_name -> reviewer.v1.PullRequestStatus
+	69, // 4: reviewer.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	69, // 5: reviewer.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: reviewer.v1.PullRequestShort.status:type_name -> reviewer.v1.PullRequestStatus
+	70, // 7: reviewer.v1.TeamSettings.stale_after:type_name -> google.protobuf.Duration
+	4,  // 8: reviewer.v1.AddTeamRequest.team:type_name -> reviewer.v1.Team
+	4,  // 9: reviewer.v1.AddTeamResponse.team:type_name -> reviewer.v1.Team
+	4,  // 10: reviewer.v1.GetTeamResponse.team:type_name -> reviewer.v1.Team
+	8,  // 11: reviewer.v1.GetTeamSettingsResponse.settings:type_name -> reviewer.v1.TeamSettings
+	8,  // 12: reviewer.v1.UpdateTeamSettingsRequest.settings:type_name -> reviewer.v1.TeamSettings
+	8,  // 13: reviewer.v1.UpdateTeamSettingsResponse.settings:type_name -> reviewer.v1.TeamSettings
+	3,  // 14: reviewer.v1.AddTeamMembersRequest.members:type_name -> reviewer.v1.TeamMember
+	4,  // 15: reviewer.v1.AddTeamMembersResponse.team:type_name -> reviewer.v1.Team
+	4,  // 16: reviewer.v1.RemoveTeamMembersResponse.team:type_name -> reviewer.v1.Team
+	3,  // 17: reviewer.v1.UpdateTeamMemberRequest.member:type_name -> reviewer.v1.TeamMember
+	4,  // 18: reviewer.v1.UpdateTeamMemberResponse.team:type_name -> reviewer.v1.Team
+	4,  // 19: reviewer.v1.DeactivateTeamMembersResponse.team:type_name -> reviewer.v1.Team
+	9,  // 20: reviewer.v1.DeactivateTeamMembersResponse.pr_updates:type_name -> reviewer.v1.PRReviewersUpdate
+	5,  // 21: reviewer.v1.SetUserIsActiveResponse.user:type_name -> reviewer.v1.User
+	0,  // 22: reviewer.v1.SetUserSeniorityRequest.seniority:type_name -> reviewer.v1.Seniority
+	5,  // 23: reviewer.v1.SetUserSeniorityResponse.user:type_name -> reviewer.v1.User
+	7,  // 24: reviewer.v1.GetUserReviewResponse.pull_requests:type_name -> reviewer.v1.PullRequestShort
+	6,  // 25: reviewer.v1.CreatePullRequestResponse.pr:type_name -> reviewer.v1.PullRequest
+	38, // 26: reviewer.v1.CreatePullRequestBatchRequest.pull_requests:type_name -> reviewer.v1.NewPullRequest
+	6,  // 27: reviewer.v1.PullRequestBatchResult.pr:type_name -> reviewer.v1.PullRequest
+	40, // 28: reviewer.v1.PullRequestBatchResult.error:type_name -> reviewer.v1.PullRequestBatchError
+	41, // 29: reviewer.v1.CreatePullRequestBatchResponse.results:type_name -> reviewer.v1.PullRequestBatchResult
+	6,  // 30: reviewer.v1.MergePullRequestResponse.pr:type_name -> reviewer.v1.PullRequest
+	6,  // 31: reviewer.v1.ReassignReviewerResponse.pr:type_name -> reviewer.v1.PullRequest
+	6,  // 32: reviewer.v1.SetReviewersResponse.pr:type_name -> reviewer.v1.PullRequest
+	6,  // 33: reviewer.v1.StalePullRequest.pr:type_name -> reviewer.v1.PullRequest
+	69, // 34: reviewer.v1.StalePullRequest.last_activity_at:type_name -> google.protobuf.Timestamp
+	70, // 35: reviewer.v1.StalePullRequest.stale_after:type_name -> google.protobuf.Duration
+	69, // 36: reviewer.v1.StalePullRequest.marked_at:type_name -> google.protobuf.Timestamp
+	69, // 37: reviewer.v1.StatsFilter.created_from:type_name -> google.protobuf.Timestamp
+	69, // 38: reviewer.v1.StatsFilter.created_to:type_name -> google.protobuf.Timestamp
+	69, // 39: reviewer.v1.StatsFilter.merged_from:type_name -> google.protobuf.Timestamp
+	69, // 40: reviewer.v1.StatsFilter.merged_to:type_name -> google.protobuf.Timestamp
+	52, // 41: reviewer.v1.TeamStats.pr_status_counts:type_name -> reviewer.v1.PRStatusCounts
+	69, // 42: reviewer.v1.StatsBucket.bucket_start:type_name -> google.protobuf.Timestamp
+	51, // 43: reviewer.v1.GetStatsRequest.filter:type_name -> reviewer.v1.StatsFilter
+	2,  // 44: reviewer.v1.GetStatsRequest.granularity:type_name -> reviewer.v1.StatsGranularity
+	53, // 45: reviewer.v1.GetStatsResponse.assignments_by_user:type_name -> reviewer.v1.UserAssignmentsStat
+	52, // 46: reviewer.v1.GetStatsResponse.pr_status_counts:type_name -> reviewer.v1.PRStatusCounts
+	54, // 47: reviewer.v1.GetStatsResponse.by_team:type_name -> reviewer.v1.TeamStats
+	55, // 48: reviewer.v1.GetStatsResponse.time_series:type_name -> reviewer.v1.StatsBucket
+	70, // 49: reviewer.v1.DurationSummary.mean:type_name -> google.protobuf.Duration
+	70, // 50: reviewer.v1.DurationSummary.p50:type_name -> google.protobuf.Duration
+	70, // 51: reviewer.v1.DurationSummary.p90:type_name -> google.protobuf.Duration
+	70, // 52: reviewer.v1.DurationSummary.p99:type_name -> google.protobuf.Duration
+	58, // 53: reviewer.v1.TeamCycleTime.cycle_time:type_name -> reviewer.v1.DurationSummary
+	58, // 54: reviewer.v1.ReviewerCycleTime.cycle_time:type_name -> reviewer.v1.DurationSummary
+	70, // 55: reviewer.v1.AgeBucket.le:type_name -> google.protobuf.Duration
+	58, // 56: reviewer.v1.OpenPRAge.age:type_name -> reviewer.v1.DurationSummary
+	61, // 57: reviewer.v1.OpenPRAge.buckets:type_name -> reviewer.v1.AgeBucket
+	51, // 58: reviewer.v1.GetCycleTimeRequest.filter:type_name -> reviewer.v1.StatsFilter
+	58, // 59: reviewer.v1.GetCycleTimeResponse.overall:type_name -> reviewer.v1.DurationSummary
+	59, // 60: reviewer.v1.GetCycleTimeResponse.by_team:type_name -> reviewer.v1.TeamCycleTime
+	60, // 61: reviewer.v1.GetCycleTimeResponse.by_reviewer:type_name -> reviewer.v1.ReviewerCycleTime
+	62, // 62: reviewer.v1.GetCycleTimeResponse.open_age:type_name -> reviewer.v1.OpenPRAge
+	69, // 63: reviewer.v1.MemberLoad.last_assigned_at:type_name -> google.protobuf.Timestamp
+	65, // 64: reviewer.v1.TeamFairness.members:type_name -> reviewer.v1.MemberLoad
+	69, // 65: reviewer.v1.GetFairnessResponse.idle_since:type_name -> google.protobuf.Timestamp
+	66, // 66: reviewer.v1.GetFairnessResponse.teams:type_name -> reviewer.v1.TeamFairness
+	10, // 67: reviewer.v1.TeamService.AddTeam:input_type -> reviewer.v1.AddTeamRequest
+	12, // 68: reviewer.v1.TeamService.GetTeam:input_type -> reviewer.v1.GetTeamRequest
+	14, // 69: reviewer.v1.TeamService.GetTeamSettings:input_type -> reviewer.v1.GetTeamSettingsRequest
+	16, // 70: reviewer.v1.TeamService.UpdateTeamSettings:input_type -> reviewer.v1.UpdateTeamSettingsRequest
+	18, // 71: reviewer.v1.TeamService.AddTeamMembers:input_type -> reviewer.v1.AddTeamMembersRequest
+	20, // 72: reviewer.v1.TeamService.RemoveTeamMembers:input_type -> reviewer.v1.RemoveTeamMembersRequest
+	22, // 73: reviewer.v1.TeamService.UpdateTeamMember:input_type -> reviewer.v1.UpdateTeamMemberRequest
+	24, // 74: reviewer.v1.TeamService.DeactivateTeamMembers:input_type -> reviewer.v1.DeactivateTeamMembersRequest
+	26, // 75: reviewer.v1.UserService.SetUserIsActive:input_type -> reviewer.v1.SetUserIsActiveRequest
+	28, // 76: reviewer.v1.UserService.SetUserSeniority:input_type -> reviewer.v1.SetUserSeniorityRequest
+	30, // 77: reviewer.v1.UserService.GetUserReview:input_type -> reviewer.v1.GetUserReviewRequest
+	32, // 78: reviewer.v1.UserService.GetUserTags:input_type -> reviewer.v1.GetUserTagsRequest
+	34, // 79: reviewer.v1.UserService.SetUserTags:input_type -> reviewer.v1.SetUserTagsRequest
+	36, // 80: reviewer.v1.PullRequestService.CreatePullRequest:input_type -> reviewer.v1.CreatePullRequestRequest
+	39, // 81: reviewer.v1.PullRequestService.CreatePullRequestBatch:input_type -> reviewer.v1.CreatePullRequestBatchRequest
+	43, // 82: reviewer.v1.PullRequestService.MergePullRequest:input_type -> reviewer.v1.MergePullRequestRequest
+	45, // 83: reviewer.v1.PullRequestService.ReassignReviewer:input_type -> reviewer.v1.ReassignReviewerRequest
+	47, // 84: reviewer.v1.PullRequestService.SetReviewers:input_type -> reviewer.v1.SetReviewersRequest
+	49, // 85: reviewer.v1.PullRequestService.ListStalePullRequests:input_type -> reviewer.v1.ListStalePullRequestsRequest
+	56, // 86: reviewer.v1.StatsService.GetStats:input_type -> reviewer.v1.GetStatsRequest
+	63, // 87: reviewer.v1.StatsService.GetCycleTime:input_type -> reviewer.v1.GetCycleTimeRequest
+	67, // 88: reviewer.v1.StatsService.GetFairness:input_type -> reviewer.v1.GetFairnessRequest
+	11, // 89: reviewer.v1.TeamService.AddTeam:output_type -> reviewer.v1.AddTeamResponse
+	13, // 90: reviewer.v1.TeamService.GetTeam:output_type -> reviewer.v1.GetTeamResponse
+	15, // 91: reviewer.v1.TeamService.GetTeamSettings:output_type -> reviewer.v1.GetTeamSettingsResponse
+	17, // 92: reviewer.v1.TeamService.UpdateTeamSettings:output_type -> reviewer.v1.UpdateTeamSettingsResponse
+	19, // 93: reviewer.v1.TeamService.AddTeamMembers:output_type -> reviewer.v1.AddTeamMembersResponse
+	21, // 94: reviewer.v1.TeamService.RemoveTeamMembers:output_type -> reviewer.v1.RemoveTeamMembersResponse
+	23, // 95: reviewer.v1.TeamService.UpdateTeamMember:output_type -> reviewer.v1.UpdateTeamMemberResponse
+	25, // 96: reviewer.v1.TeamService.DeactivateTeamMembers:output_type -> reviewer.v1.DeactivateTeamMembersResponse
+	27, // 97: reviewer.v1.UserService.SetUserIsActive:output_type -> reviewer.v1.SetUserIsActiveResponse
+	29, // 98: reviewer.v1.UserService.SetUserSeniority:output_type -> reviewer.v1.SetUserSeniorityResponse
+	31, // 99: reviewer.v1.UserService.GetUserReview:output_type -> reviewer.v1.GetUserReviewResponse
+	33, // 100: reviewer.v1.UserService.GetUserTags:output_type -> reviewer.v1.GetUserTagsResponse
+	35, // 101: reviewer.v1.UserService.SetUserTags:output_type -> reviewer.v1.SetUserTagsResponse
+	37, // 102: reviewer.v1.PullRequestService.CreatePullRequest:output_type -> reviewer.v1.CreatePullRequestResponse
+	42, // 103: reviewer.v1.PullRequestService.CreatePullRequestBatch:output_type -> reviewer.v1.CreatePullRequestBatchResponse
+	44, // 104: reviewer.v1.PullRequestService.MergePullRequest:output_type -> reviewer.v1.MergePullRequestResponse
+	46, // 105: reviewer.v1.PullRequestService.ReassignReviewer:output_type -> reviewer.v1.ReassignReviewerResponse
+	48, // 106: reviewer.v1.PullRequestService.SetReviewers:output_type -> reviewer.v1.SetReviewersResponse
+	50, // 107: reviewer.v1.PullRequestService.ListStalePullRequests:output_type -> reviewer.v1.StalePullRequest
+	57, // 108: reviewer.v1.StatsService.GetStats:output_type -> reviewer.v1.GetStatsResponse
+	64, // 109: reviewer.v1.StatsService.GetCycleTime:output_type -> reviewer.v1.GetCycleTimeResponse
+	68, // 110: reviewer.v1.StatsService.GetFairness:output_type -> reviewer.v1.GetFairnessResponse
+	89, // [89:111] is the sub-list for method output_type
+	67, // [67:89] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
+}
+
+func init() { file_reviewer_v1_reviewer_proto_init() }
+func file_reviewer_v1_reviewer_proto_init() {
+	if File_reviewer_v1_reviewer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reviewer_v1_reviewer_proto_rawDesc), len(file_reviewer_v1_reviewer_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   66,
+			NumExtensions: 0,
+			NumServices:   4,
+		},
+		GoTypes:           file_reviewer_v1_reviewer_proto_goTypes,
+		DependencyIndexes: file_reviewer_v1_reviewer_proto_depIdxs,
+		EnumInfos:         file_reviewer_v1_reviewer_proto_enumTypes,
+		MessageInfos:      file_reviewer_v1_reviewer_proto_msgTypes,
+	}.Build()
+	File_reviewer_v1_reviewer_proto = out.File
+	file_reviewer_v1_reviewer_proto_goTypes = nil
+	file_reviewer_v1_reviewer_proto_depIdxs = nil
+}
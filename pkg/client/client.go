@@ -0,0 +1,357 @@
+// Package client — Go-клиент HTTP API сервиса.
+//
+// Типы и методы в client_gen.go генерируются из openapi/openapi.yml (make generate).
+// API добавляет к ним то, чего нет в сгенерированном коде:
+//   - ответ 4xx/5xx возвращается как *APIError, errors.Is сопоставляет его с ErrNotFound, ErrPRMerged и т. д.;
+//   - идемпотентные запросы (GET и POST из idempotentPaths) повторяются при сетевых ошибках
+//     и ответах 429/502/503/504;
+//   - запрос без дедлайна в контексте ограничен таймаутом клиента.
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTimeout — таймаут запроса, если у контекста нет своего дедлайна.
+	DefaultTimeout = 10 * time.Second
+	// DefaultRetries — сколько раз повторяется идемпотентный запрос после первой попытки.
+	DefaultRetries = 2
+	// DefaultBackoff — пауза перед первым повтором, дальше она удваивается.
+	DefaultBackoff = 100 * time.Millisecond
+)
+
+// maxEventSize — самая длинная строка SSE, которую готов прочитать клиент.
+const maxEventSize = 1 << 20
+
+// idempotentPaths — операции API, которые идут через POST, но повтор которых не меняет результат:
+// они задают состояние целиком, а не добавляют к нему.
+var idempotentPaths = map[string]struct{}{
+	"/pullRequest/merge":        {},
+	"/pullRequest/setReviewers": {},
+	"/team/settings":            {},
+	"/team/notifications":       {},
+	"/team/codeowners":          {},
+	"/team/members/update":      {},
+	"/users/setIsActive":        {},
+	"/users/setSeniority":       {},
+	"/users/setNotifications":   {},
+	"/users/tags":               {},
+	"/users/preferences":        {},
+	"/integrations/accounts":    {},
+}
+
+// API — клиент сервиса. Методы XWithResponse встроены из ClientWithResponses;
+// при ответе с ошибкой они возвращают (nil, *APIError).
+type API struct {
+	*ClientWithResponses
+
+	server string
+	// basePath — путь из адреса сервера, который предшествует путям операций
+	basePath string
+	doer     HttpRequestDoer
+	timeout  time.Duration
+	retries  int
+	backoff  time.Duration
+}
+
+// Option настраивает API.
+type Option func(*API)
+
+// WithTimeout задаёт таймаут запросов без дедлайна в контексте; 0 отключает таймаут.
+func WithTimeout(d time.Duration) Option {
+	return func(a *API) { a.timeout = d }
+}
+
+// WithRetries задаёт число повторов идемпотентных запросов и паузу перед первым повтором; 0 отключает повторы.
+func WithRetries(n int, backoff time.Duration) Option {
+	return func(a *API) {
+		a.retries = n
+		a.backoff = backoff
+	}
+}
+
+// WithDoer подменяет HTTP-клиент, через который уходят запросы.
+func WithDoer(doer HttpRequestDoer) Option {
+	return func(a *API) { a.doer = doer }
+}
+
+// New создаёт клиент для сервиса по адресу server, например http://localhost:8080.
+func New(server string, opts ...Option) (*API, error) {
+	a := &API{
+		server:  server,
+		doer:    http.DefaultClient,
+		timeout: DefaultTimeout,
+		retries: DefaultRetries,
+		backoff: DefaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	c, err := NewClientWithResponses(server, WithHTTPClient(doerFunc(a.do)))
+	if err != nil {
+		return nil, err
+	}
+	a.ClientWithResponses = c
+
+	u, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+	a.basePath = strings.TrimSuffix(u.Path, "/")
+
+	return a, nil
+}
+
+// StreamEvents читает ленту /events/stream и передаёт события в fn, пока не отменён ctx
+// или fn не вернёт ошибку. Таймаут клиента к ленте не применяется. После обрыва соединения
+// лента переоткрывается с Last-Event-ID последнего полученного события; число попыток подряд
+// ограничено повторами клиента. Отмена ctx — штатное завершение, тогда возвращается nil.
+func (a *API) StreamEvents(ctx context.Context, params *GetEventsStreamParams, fn func(Event) error) error {
+	var p GetEventsStreamParams
+	if params != nil {
+		p = *params
+	}
+
+	var (
+		stopErr  error
+		failures int
+	)
+	handle := func(e Event) error {
+		if err := fn(e); err != nil {
+			stopErr = err
+			return err
+		}
+		id := e.Id
+		p.LastEventID = &id
+		failures = 0
+		return nil
+	}
+
+	for {
+		err := a.stream(ctx, &p, handle)
+		switch {
+		case stopErr != nil:
+			return stopErr
+		case ctx.Err() != nil:
+			return nil
+		}
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) || failures >= a.retries {
+			return err
+		}
+		failures++
+
+		if err := sleep(ctx, a.backoffFor(failures)); err != nil {
+			return nil
+		}
+	}
+}
+
+// stream открывает ленту один раз и читает её до обрыва.
+func (a *API) stream(ctx context.Context, params *GetEventsStreamParams, fn func(Event) error) error {
+	req, err := NewGetEventsStreamRequest(a.server, params)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := a.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return readAPIError(resp)
+	}
+
+	if err := readEvents(resp.Body, fn); err != nil {
+		return err
+	}
+	// сервер закрывает ленту только при остановке, это тоже обрыв
+	return io.ErrUnexpectedEOF
+}
+
+// do — HttpRequestDoer сгенерированного клиента: таймаут, повторы и разбор ошибок.
+func (a *API) do(req *http.Request) (*http.Response, error) {
+	cancel := context.CancelFunc(func() {})
+	if _, ok := req.Context().Deadline(); !ok && a.timeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(req.Context(), a.timeout)
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := a.send(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		defer cancel()
+		defer resp.Body.Close()
+		return nil, readAPIError(resp)
+	}
+
+	// контекст нужен, пока вызывающий читает тело
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// send отправляет запрос и повторяет его, если метод идемпотентный, а ошибка временная.
+func (a *API) send(req *http.Request) (*http.Response, error) {
+	attempts := 1
+	if a.isIdempotent(req) {
+		attempts += a.retries
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := a.doer.Do(req)
+		if attempt >= attempts || !isRetryable(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBody))
+			resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), a.backoffFor(attempt)); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (a *API) backoffFor(attempt int) time.Duration {
+	return a.backoff << (attempt - 1)
+}
+
+// --------------------HELPERS----------------------
+
+type doerFunc func(*http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// cancelOnClose отменяет контекст запроса, когда тело ответа закрыто.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// isIdempotent — повтор запроса не меняет результат. Тело без GetBody нельзя отправить повторно.
+func (a *API) isIdempotent(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		_, ok := idempotentPaths[strings.TrimPrefix(req.URL.Path, a.basePath)]
+		return ok
+	}
+	return false
+}
+
+// isRetryable — ошибки, которые могут пройти сами: сеть, перегрузка и недоступность сервиса.
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// rewind готовит копию запроса для повтора с новым телом.
+func rewind(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+	return next, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// readEvents разбирает поток SSE и передаёт события в fn. Комментарии (": ping") пропускаются.
+func readEvents(r io.Reader, fn func(Event) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 4096), maxEventSize)
+
+	var data bytes.Buffer
+	for sc.Scan() {
+		line := sc.Text()
+
+		if line == "" {
+			if data.Len() == 0 {
+				continue
+			}
+
+			var e Event
+			if err := json.Unmarshal(data.Bytes(), &e); err != nil {
+				return err
+			}
+			data.Reset()
+
+			if err := fn(e); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// id и тип события повторяются в data, поэтому читается только она
+		field, value, _ := strings.Cut(line, ":")
+		if field != "data" {
+			continue
+		}
+		if data.Len() > 0 {
+			data.WriteByte('\n')
+		}
+		data.WriteString(strings.TrimPrefix(value, " "))
+	}
+
+	return sc.Err()
+}
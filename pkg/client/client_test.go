@@ -0,0 +1,226 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAPI(t *testing.T, h http.HandlerFunc, opts ...Option) *API {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	api, err := New(srv.URL, append([]Option{WithRetries(2, time.Millisecond)}, opts...)...)
+	require.NoError(t, err)
+	return api
+}
+
+func writeError(w http.ResponseWriter, status int, code, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"error":{"code":%q,"message":%q}}`, code, msg)
+}
+
+func TestAPI_MapsErrorCodes(t *testing.T) {
+	tests := []struct {
+		status int
+		code   string
+		want   error
+	}{
+		{http.StatusNotFound, "NOT_FOUND", ErrNotFound},
+		{http.StatusConflict, "PR_MERGED", ErrPRMerged},
+		{http.StatusConflict, "NO_CANDIDATE", ErrNoCandidate},
+		{http.StatusBadRequest, "TEAM_EXISTS", ErrTeamExists},
+		{http.StatusUnauthorized, "UNAUTHORIZED", ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+			writeError(w, tt.status, tt.code, "boom")
+		})
+
+		resp, err := api.PostPullRequestMergeWithResponse(context.Background(), PostPullRequestMergeJSONRequestBody{PullRequestId: "pr-1"})
+		require.Nil(t, resp, tt.code)
+		require.ErrorIs(t, err, tt.want, tt.code)
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, tt.status, apiErr.StatusCode)
+		require.Equal(t, ErrorResponseErrorCode(tt.code), apiErr.Code)
+		require.Equal(t, "boom", apiErr.Message)
+	}
+}
+
+func TestAPI_ErrorWithoutErrorResponse(t *testing.T) {
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream is down", http.StatusBadGateway)
+	}, WithRetries(0, 0))
+
+	_, err := api.GetTeamGetWithResponse(context.Background(), &GetTeamGetParams{TeamName: "backend"})
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	require.Empty(t, apiErr.Code)
+	require.Equal(t, "upstream is down", apiErr.Message)
+	require.NoError(t, apiErr.Unwrap())
+}
+
+func TestAPI_RetriesIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"team_name":"backend","members":[]}`)
+	})
+
+	resp, err := api.GetTeamGetWithResponse(context.Background(), &GetTeamGetParams{TeamName: "backend"})
+	require.NoError(t, err)
+	require.Equal(t, "backend", resp.JSON200.TeamName)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestAPI_GivesUpAfterRetries(t *testing.T) {
+	var calls atomic.Int32
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		writeError(w, http.StatusServiceUnavailable, "INTERNAL", "overloaded")
+	})
+
+	_, err := api.GetTeamGetWithResponse(context.Background(), &GetTeamGetParams{TeamName: "backend"})
+	require.ErrorIs(t, err, ErrInternal)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestAPI_DoesNotRetryPost(t *testing.T) {
+	var calls atomic.Int32
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := api.PostPullRequestCreateWithResponse(context.Background(), PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Test",
+		AuthorId:        "u1",
+	})
+	require.Error(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestAPI_RetriesIdempotentPost(t *testing.T) {
+	var calls atomic.Int32
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		require.JSONEq(t, `{"pull_request_id":"pr-1"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"pr":{"pull_request_id":"pr-1","pull_request_name":"Test","author_id":"u1","status":"MERGED","assigned_reviewers":[]}}`)
+	})
+
+	resp, err := api.PostPullRequestMergeWithResponse(context.Background(), PostPullRequestMergeJSONRequestBody{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	require.Equal(t, "pr-1", resp.JSON200.Pr.PullRequestId)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestAPI_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "team not found")
+	})
+
+	_, err := api.GetTeamGetWithResponse(context.Background(), &GetTeamGetParams{TeamName: "missing"})
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestAPI_Timeout(t *testing.T) {
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+	}, WithTimeout(20*time.Millisecond), WithRetries(0, 0))
+
+	_, err := api.GetTeamGetWithResponse(context.Background(), &GetTeamGetParams{TeamName: "backend"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// дедлайн вызывающего важнее таймаута клиента
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	api = newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"team_name":"backend","members":[]}`)
+	}, WithTimeout(20*time.Millisecond))
+
+	resp, err := api.GetTeamGetWithResponse(ctx, &GetTeamGetParams{TeamName: "backend"})
+	require.NoError(t, err)
+	require.Equal(t, "backend", resp.JSON200.TeamName)
+}
+
+func TestAPI_StreamEventsResumesAfterDisconnect(t *testing.T) {
+	var lastIDs []string
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		lastIDs = append(lastIDs, r.Header.Get("Last-Event-ID"))
+		w.Header().Set("Content-Type", "text/event-stream")
+
+		if len(lastIDs) == 1 {
+			fmt.Fprint(w, ": ping\n\n")
+			fmt.Fprint(w, "id: 1\nevent: pr.created\ndata: {\"id\":1,\"type\":\"pr.created\",\"team_name\":\"backend\",\"created_at\":\"2024-05-01T10:00:00Z\"}\n\n")
+			fmt.Fprint(w, "id: 2\nevent: pr.merged\ndata: {\"id\":2,\"type\":\"pr.merged\",")
+			return
+		}
+		fmt.Fprint(w, "id: 2\nevent: pr.merged\ndata: {\"id\":2,\"type\":\"pr.merged\",\"team_name\":\"backend\",\"created_at\":\"2024-05-01T10:00:00Z\"}\n\n")
+	})
+
+	errDone := errors.New("done")
+	var got []int64
+	err := api.StreamEvents(context.Background(), &GetEventsStreamParams{}, func(e Event) error {
+		got = append(got, e.Id)
+		if e.Id == 2 {
+			return errDone
+		}
+		return nil
+	})
+
+	require.ErrorIs(t, err, errDone)
+	require.Equal(t, []int64{1, 2}, got)
+	require.Equal(t, []string{"", "1"}, lastIDs)
+}
+
+func TestAPI_StreamEventsReturnsAPIError(t *testing.T) {
+	var calls atomic.Int32
+	api := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "last event id must be non-negative")
+	})
+
+	lastID := int64(-1)
+	err := api.StreamEvents(context.Background(), &GetEventsStreamParams{LastEventID: &lastID}, func(Event) error {
+		return nil
+	})
+
+	require.ErrorIs(t, err, ErrBadRequest)
+	require.Equal(t, int32(1), calls.Load())
+}
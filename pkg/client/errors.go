@@ -0,0 +1,78 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Ошибки по кодам ErrorResponse.error.code. Проверяются через errors.Is на ошибке метода API.
+var (
+	ErrTeamExists   = errors.New("team already exists")
+	ErrPRExists     = errors.New("pull request already exists")
+	ErrPRMerged     = errors.New("pull request is merged")
+	ErrNotAssigned  = errors.New("reviewer is not assigned")
+	ErrNoCandidate  = errors.New("no active replacement candidate")
+	ErrNotFound     = errors.New("resource not found")
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrInternal     = errors.New("internal server error")
+)
+
+var errorsByCode = map[ErrorResponseErrorCode]error{
+	TEAMEXISTS:   ErrTeamExists,
+	PREXISTS:     ErrPRExists,
+	PRMERGED:     ErrPRMerged,
+	NOTASSIGNED:  ErrNotAssigned,
+	NOCANDIDATE:  ErrNoCandidate,
+	NOTFOUND:     ErrNotFound,
+	BADREQUEST:   ErrBadRequest,
+	UNAUTHORIZED: ErrUnauthorized,
+	INTERNAL:     ErrInternal,
+}
+
+// maxErrorBody — сколько байт тела ответа с ошибкой читается для разбора.
+const maxErrorBody = 64 << 10
+
+// APIError — ответ сервиса со статусом 4xx или 5xx.
+// Code пустой, если тело не ErrorResponse (например, ошибка прокси или роутера).
+type APIError struct {
+	StatusCode int
+	Code       ErrorResponseErrorCode
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("http %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s (http %d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+// Unwrap возвращает ошибку-маркер для кода, чтобы работал errors.Is(err, ErrNotFound).
+func (e *APIError) Unwrap() error {
+	return errorsByCode[e.Code]
+}
+
+// readAPIError читает тело ответа с ошибкой и разбирает ErrorResponse.
+func readAPIError(resp *http.Response) *APIError {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+
+	var er ErrorResponse
+	if err := json.Unmarshal(body, &er); err == nil && er.Error.Code != "" {
+		apiErr.Code = er.Error.Code
+		apiErr.Message = er.Error.Message
+		return apiErr
+	}
+
+	apiErr.Message = strings.TrimSpace(string(body))
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}